@@ -1,15 +1,18 @@
 package baseapp
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"syscall"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/snapshots/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -27,7 +30,9 @@ func (app *BaseApp) InitChain(req abci.RequestInitChain) (res abci.ResponseInitC
 
 	// initialize the deliver state and check state with a correct header
 	app.setDeliverState(initHeader)
+	app.queryMtx.Lock()
 	app.setCheckState(initHeader)
+	app.queryMtx.Unlock()
 
 	if app.initChainer == nil {
 		return
@@ -131,6 +136,7 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	}
 
 	app.deliverState.ctx = app.deliverState.ctx.WithBlockGasMeter(gasMeter)
+	app.addDeliverStateListeners()
 
 	if app.beginBlocker != nil {
 		res = app.beginBlocker(app.deliverState.ctx, req)
@@ -138,6 +144,13 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
+
+	for _, streamingListener := range app.abciListeners {
+		if err := streamingListener.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+		}
+	}
+
 	return res
 }
 
@@ -151,6 +164,12 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res = app.endBlocker(app.deliverState.ctx, req)
 	}
 
+	for _, streamingListener := range app.abciListeners {
+		if err := streamingListener.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+		}
+	}
+
 	return
 }
 
@@ -179,32 +198,81 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
 
-	gInfo, result, err := app.runTx(mode, req.Tx, tx)
+	gInfo, result, mempoolTx, err := app.runTx(mode, req.Tx, tx)
 	if err != nil {
+		if mode == runTxModeReCheck && app.mempool != nil {
+			app.mempool.Remove(req.Tx)
+		}
+
 		return sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed)
 	}
 
+	if mode == runTxModeReCheck {
+		// the AnteHandler does not track the nonces of the senders on recheck,
+		// so the tx keeps the sender and nonce it was checked with
+		if checked, ok := app.getMempoolTx(req.Tx); ok {
+			mempoolTx.Sender, mempoolTx.Nonce = checked.Sender, checked.Nonce
+			app.mempool.Insert(mempoolTx)
+		}
+	} else if app.mempool != nil {
+		app.mempool.Insert(mempoolTx)
+	}
+
+	// NOTE: The ResponseCheckTx of this version of Tendermint has no priority,
+	// sender and nonce fields, they are returned as the attributes of an event.
 	return abci.ResponseCheckTx{
 		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
 		Log:       result.Log,
 		Data:      result.Data,
-		Events:    result.Events,
+		Events:    append(result.Events, mempoolEvent(mempoolTx).ToABCIEvents()...),
 	}
 }
 
+// getMempoolTx returns the tx of the given bytes from the mempool, if a
+// mempool is set and the tx is in it.
+func (app *BaseApp) getMempoolTx(txBytes []byte) (MempoolTx, bool) {
+	if app.mempool == nil {
+		return MempoolTx{}, false
+	}
+
+	return app.mempool.Get(txBytes)
+}
+
+// mempoolEvent returns the event holding the mempool hints of a tx.
+func mempoolEvent(tx MempoolTx) sdk.Events {
+	return sdk.Events{sdk.NewEvent(
+		sdk.EventTypeMempool,
+		sdk.NewAttribute(sdk.AttributeKeyPriority, strconv.FormatInt(tx.Priority, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, tx.Sender.String()),
+		sdk.NewAttribute(sdk.AttributeKeyNonce, strconv.FormatUint(tx.Nonce, 10)),
+	)}
+}
+
 // DeliverTx implements the ABCI interface and executes a tx in DeliverTx mode.
 // State only gets persisted if all messages are valid and get executed successfully.
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
+	defer func() {
+		for _, streamingListener := range app.abciListeners {
+			if err := streamingListener.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
+				app.logger.Error("DeliverTx listening hook failed", "err", err)
+			}
+		}
+	}()
+
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, 0, 0)
 	}
 
-	gInfo, result, err := app.runTx(runTxModeDeliver, req.Tx, tx)
+	if app.mempool != nil {
+		app.mempool.Remove(req.Tx)
+	}
+
+	gInfo, result, _, err := app.runTx(runTxModeDeliver, req.Tx, tx)
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed)
 	}
@@ -232,6 +300,9 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
 	app.deliverState.ms.Write()
+
+	// the gRPC queries must not read the committed state while it changes
+	app.queryMtx.Lock()
 	commitID := app.cms.Commit()
 	app.logger.Debug("Commit synced", "commit", fmt.Sprintf("%X", commitID))
 
@@ -240,6 +311,13 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
 	// Commit. Use the header from this latest block.
 	app.setCheckState(header)
+	app.queryMtx.Unlock()
+
+	// the txs which were not rechecked after the previous block were dropped
+	// from the mempool of Tendermint
+	if app.mempool != nil {
+		app.mempool.RemoveStale(header.Height - 1)
+	}
 
 	// empty/reset the deliver state
 	app.deliverState = nil
@@ -262,11 +340,45 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		app.halt()
 	}
 
+	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
+		go app.snapshot(header.Height)
+	}
+
 	return abci.ResponseCommit{
 		Data: commitID.Hash,
 	}
 }
 
+// snapshot takes a snapshot of the current state and prunes any old snapshots.
+func (app *BaseApp) snapshot(height int64) {
+	if app.snapshotManager == nil {
+		app.logger.Info("snapshot manager not configured")
+		return
+	}
+
+	app.logger.Info("creating state snapshot", "height", height)
+
+	snapshot, err := app.snapshotManager.Create(uint64(height))
+	if err != nil {
+		app.logger.Error("failed to create state snapshot", "height", height, "err", err)
+		return
+	}
+
+	app.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+
+	if app.snapshotKeepRecent > 0 {
+		app.logger.Debug("pruning state snapshots")
+
+		pruned, err := app.snapshotManager.Prune(app.snapshotKeepRecent)
+		if err != nil {
+			app.logger.Error("Failed to prune state snapshots", "err", err)
+			return
+		}
+
+		app.logger.Debug("pruned state snapshots", "pruned", pruned)
+	}
+}
+
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
 // back on os.Exit if both fail.
 func (app *BaseApp) halt() {
@@ -289,9 +401,97 @@ func (app *BaseApp) halt() {
 	os.Exit(0)
 }
 
+// ListSnapshots returns the metadata of all available state sync snapshots,
+// newest first. It mirrors the ABCI ListSnapshots call.
+func (app *BaseApp) ListSnapshots() ([]*snapshottypes.Snapshot, error) {
+	if app.snapshotManager == nil {
+		return []*snapshottypes.Snapshot{}, nil
+	}
+
+	return app.snapshotManager.List()
+}
+
+// LoadSnapshotChunk returns a binary chunk of a state sync snapshot, or nil if
+// the chunk does not exist. It mirrors the ABCI LoadSnapshotChunk call.
+func (app *BaseApp) LoadSnapshotChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
+	if app.snapshotManager == nil {
+		return nil, nil
+	}
+
+	return app.snapshotManager.LoadChunk(height, format, chunk)
+}
+
+// OfferSnapshot begins restoring a state sync snapshot into the (empty)
+// CommitMultiStore. Once all chunks have been applied, the restored state must
+// hash to the given app hash, which should be taken from a trusted block
+// header. It mirrors the ABCI OfferSnapshot call.
+func (app *BaseApp) OfferSnapshot(snapshot snapshottypes.Snapshot, appHash []byte) error {
+	if app.snapshotManager == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "snapshot manager not configured")
+	}
+
+	if err := snapshot.Validate(); err != nil {
+		return err
+	}
+
+	if len(appHash) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "app hash to restore cannot be empty")
+	}
+
+	if err := app.snapshotManager.Restore(snapshot); err != nil {
+		return err
+	}
+
+	app.snapshotRestoreAppHash = appHash
+	return nil
+}
+
+// ApplySnapshotChunk applies the next chunk of the snapshot being restored.
+// Each chunk is verified against the snapshot's chunk hashes, and once the final
+// chunk has been applied, the restored state is checked against the app hash
+// given to OfferSnapshot, the restored state being rolled back if it does not
+// match. It returns true when the restore is complete. It mirrors the ABCI
+// ApplySnapshotChunk call.
+func (app *BaseApp) ApplySnapshotChunk(chunk []byte) (bool, error) {
+	if app.snapshotManager == nil {
+		return false, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "snapshot manager not configured")
+	}
+
+	done, err := app.snapshotManager.RestoreChunk(chunk)
+	if err != nil || !done {
+		return done, err
+	}
+
+	appHash := app.snapshotRestoreAppHash
+	app.snapshotRestoreAppHash = nil
+
+	lastCommitID := app.cms.LastCommitID()
+	if !bytes.Equal(lastCommitID.Hash, appHash) {
+		if err := app.cms.RollbackRestore(uint64(lastCommitID.Version)); err != nil {
+			panic(fmt.Errorf("failed to roll back the restored snapshot: %w", err))
+		}
+
+		return false, sdkerrors.Wrapf(
+			snapshottypes.ErrAppHashMismatch, "expected %X, got %X at height %d",
+			appHash, lastCommitID.Hash, lastCommitID.Version,
+		)
+	}
+
+	// reload the consensus params and check state from the restored state
+	app.loadConsensusParams()
+	app.setCheckState(abci.Header{Height: lastCommitID.Version})
+
+	return true, nil
+}
+
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
 func (app *BaseApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+	// paths of gRPC query services take precedence over the other query paths
+	if grpcHandler := app.grpcQueryRouter.Route(req.Path); grpcHandler != nil {
+		return handleQueryGRPC(app, grpcHandler, req)
+	}
+
 	path := splitPath(req.Path)
 	if len(path) == 0 {
 		sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "no query path provided"))
@@ -422,6 +622,62 @@ func handleQueryP2P(app *BaseApp, path []string) abci.ResponseQuery {
 	)
 }
 
+func handleQueryGRPC(app *BaseApp, handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
+	// when a client did not provide a query height, manually inject the latest
+	if req.Height == 0 {
+		req.Height = app.LastBlockHeight()
+	}
+
+	ctx, err := app.createQueryContext(req.Height, req.Prove)
+	if err != nil {
+		return sdkerrors.QueryResult(err)
+	}
+
+	res, err := handler(ctx, req)
+	if err != nil {
+		space, code, log := sdkerrors.ABCIInfo(err, false)
+		return abci.ResponseQuery{
+			Code:      code,
+			Codespace: space,
+			Log:       log,
+			Height:    req.Height,
+		}
+	}
+
+	return res
+}
+
+// createQueryContext creates a new sdk.Context for a query, cache wrapping the
+// committed multi-store at the given height. A height of 0 stands for the
+// latest committed height.
+func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, error) {
+	if height == 0 {
+		height = app.LastBlockHeight()
+	}
+
+	if height <= 1 && prove {
+		return sdk.Context{}, sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"cannot query with proof when height <= 1; please provide a valid height",
+		)
+	}
+
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Context{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"failed to load state at height %d; %s (latest height: %d)", height, err, app.LastBlockHeight(),
+		)
+	}
+
+	// cache wrap the commit-multistore for safety
+	ctx := sdk.NewContext(
+		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
+	).WithMinGasPrices(app.minGasPrices)
+
+	return ctx, nil
+}
+
 func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.ResponseQuery {
 	// path[0] should be "custom" because "/custom" prefix is required for keeper
 	// queries.
@@ -442,30 +698,11 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 		req.Height = app.LastBlockHeight()
 	}
 
-	if req.Height <= 1 && req.Prove {
-		return sdkerrors.QueryResult(
-			sdkerrors.Wrap(
-				sdkerrors.ErrInvalidRequest,
-				"cannot query with proof when height <= 1; please provide a valid height",
-			),
-		)
-	}
-
-	cacheMS, err := app.cms.CacheMultiStoreWithVersion(req.Height)
+	ctx, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
-		return sdkerrors.QueryResult(
-			sdkerrors.Wrapf(
-				sdkerrors.ErrInvalidRequest,
-				"failed to load state at height %d; %s (latest height: %d)", req.Height, err, app.LastBlockHeight(),
-			),
-		)
+		return sdkerrors.QueryResult(err)
 	}
 
-	// cache wrap the commit-multistore for safety
-	ctx := sdk.NewContext(
-		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
-
 	// Passes the rest of the path as an argument to the querier.
 	//
 	// For example, in the path "custom/gov/proposal/test", the gov querier gets
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"runtime/debug"
 	"strings"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -14,6 +15,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -59,6 +61,9 @@ type BaseApp struct { // nolint: maligned
 	queryRouter sdk.QueryRouter      // router for redirecting query calls
 	txDecoder   sdk.TxDecoder        // unmarshal []byte into sdk.Tx
 
+	grpcQueryRouter  *GRPCQueryRouter  // router for redirecting gRPC query calls
+	msgServiceRouter *MsgServiceRouter // router for redirecting Msg service calls
+
 	// set upon LoadVersion or LoadLatestVersion.
 	baseKey *sdk.KVStoreKey // Main KVStore in cms
 
@@ -70,6 +75,19 @@ type BaseApp struct { // nolint: maligned
 	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
+	// manages snapshots, i.e. dumps of app state at certain intervals
+	snapshotManager    *snapshots.Manager
+	snapshotInterval   uint64 // block interval between state sync snapshots
+	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+
+	// app hash the state being restored from a snapshot must match
+	snapshotRestoreAppHash []byte
+
+	// abciListeners for hooking into the ABCI message processing of the BaseApp
+	// and writeListeners for the KVStores, both set by the streaming services
+	abciListeners  []ABCIListener
+	writeListeners map[sdk.StoreKey][]sdk.WriteListener
+
 	// volatile states:
 	//
 	// checkState is set on InitChain and reset on Commit
@@ -77,6 +95,10 @@ type BaseApp struct { // nolint: maligned
 	checkState   *state // for CheckTx
 	deliverState *state // for DeliverTx
 
+	// queryMtx guards the committed state and the checkState read by the gRPC
+	// queries, which run concurrently with the ABCI calls, against Commit
+	queryMtx sync.RWMutex
+
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache sdk.MultiStorePersistentCache
 
@@ -102,6 +124,9 @@ type BaseApp struct { // nolint: maligned
 
 	// application's version string
 	appVersion string
+
+	// app-side mempool kept in sync with the txs accepted by CheckTx, if set
+	mempool Mempool
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -114,15 +139,17 @@ func NewBaseApp(
 ) *BaseApp {
 
 	app := &BaseApp{
-		logger:         logger,
-		name:           name,
-		db:             db,
-		cms:            store.NewCommitMultiStore(db),
-		storeLoader:    DefaultStoreLoader,
-		router:         NewRouter(),
-		queryRouter:    NewQueryRouter(),
-		txDecoder:      txDecoder,
-		fauxMerkleMode: false,
+		logger:           logger,
+		name:             name,
+		db:               db,
+		cms:              store.NewCommitMultiStore(db),
+		storeLoader:      DefaultStoreLoader,
+		router:           NewRouter(),
+		queryRouter:      NewQueryRouter(),
+		grpcQueryRouter:  NewGRPCQueryRouter(),
+		msgServiceRouter: NewMsgServiceRouter(),
+		txDecoder:        txDecoder,
+		fauxMerkleMode:   false,
 	}
 	for _, option := range options {
 		option(app)
@@ -259,6 +286,20 @@ func (app *BaseApp) initFromMainStore(baseKey *sdk.KVStoreKey) error {
 	// nil, it will be saved later during InitChain.
 	//
 	// TODO: assert that InitChain hasn't yet been called.
+	app.loadConsensusParams()
+
+	// needed for the export command which inits from store but never calls initchain
+	app.setCheckState(abci.Header{})
+	app.Seal()
+
+	return nil
+}
+
+// loadConsensusParams loads and memoizes the consensus params from the main
+// store, if they have been stored.
+func (app *BaseApp) loadConsensusParams() {
+	mainStore := app.cms.GetKVStore(app.baseKey)
+
 	consensusParamsBz := mainStore.Get(mainConsensusParamsKey)
 	if consensusParamsBz != nil {
 		var consensusParams = &abci.ConsensusParams{}
@@ -270,12 +311,6 @@ func (app *BaseApp) initFromMainStore(baseKey *sdk.KVStoreKey) error {
 
 		app.setConsensusParams(consensusParams)
 	}
-
-	// needed for the export command which inits from store but never calls initchain
-	app.setCheckState(abci.Header{})
-	app.Seal()
-
-	return nil
 }
 
 func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
@@ -294,6 +329,22 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setSnapshotStore(snapshotStore *snapshots.Store) {
+	if snapshotStore == nil {
+		app.snapshotManager = nil
+		return
+	}
+	app.snapshotManager = snapshots.NewManager(snapshotStore, app.cms)
+}
+
+func (app *BaseApp) setSnapshotInterval(snapshotInterval uint64) {
+	app.snapshotInterval = snapshotInterval
+}
+
+func (app *BaseApp) setSnapshotKeepRecent(snapshotKeepRecent uint32) {
+	app.snapshotKeepRecent = snapshotKeepRecent
+}
+
 // Router returns the router of the BaseApp.
 func (app *BaseApp) Router() sdk.Router {
 	if app.sealed {
@@ -307,6 +358,19 @@ func (app *BaseApp) Router() sdk.Router {
 // QueryRouter returns the QueryRouter of a BaseApp.
 func (app *BaseApp) QueryRouter() sdk.QueryRouter { return app.queryRouter }
 
+// GRPCQueryRouter returns the GRPCQueryRouter of a BaseApp.
+func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRouter }
+
+// MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
+func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter {
+	if app.sealed {
+		// services cannot be registered once the app is sealed, as for the
+		// routes of the Router
+		panic("MsgServiceRouter() on sealed BaseApp")
+	}
+	return app.msgServiceRouter
+}
+
 // Seal seals a BaseApp. It prohibits any further modifications to a BaseApp.
 func (app *BaseApp) Seal() { app.sealed = true }
 
@@ -337,6 +401,27 @@ func (app *BaseApp) setDeliverState(header abci.Header) {
 	}
 }
 
+// setStreamingService registers the listeners of a streaming service with the
+// BaseApp.
+func (app *BaseApp) setStreamingService(s StreamingService) {
+	if app.writeListeners == nil {
+		app.writeListeners = make(map[sdk.StoreKey][]sdk.WriteListener)
+	}
+	for key, listeners := range s.Listeners() {
+		app.writeListeners[key] = append(app.writeListeners[key], listeners...)
+	}
+	app.abciListeners = append(app.abciListeners, s)
+}
+
+func (app *BaseApp) setMempool(mempool Mempool) {
+	app.mempool = mempool
+}
+
+// Mempool returns the app-side mempool of the BaseApp, nil if none is set.
+func (app *BaseApp) Mempool() Mempool {
+	return app.mempool
+}
+
 // setConsensusParams memoizes the consensus params.
 func (app *BaseApp) setConsensusParams(consensusParams *abci.ConsensusParams) {
 	app.consensusParams = consensusParams
@@ -454,8 +539,9 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // if all messages get executed successfully and the execution mode is DeliverTx.
 // Note, gas execution info is always returned. A reference to a Result is
 // returned if the tx does not run out of gas and if all the messages are valid
-// and execute successfully. An error is returned otherwise.
-func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
+// and execute successfully. An error is returned otherwise. The tx is also
+// returned along with the mempool hints set by the AnteHandler if it passes.
+func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, mempoolTx MempoolTx, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
@@ -467,7 +553,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
 		gInfo = sdk.GasInfo{GasUsed: ctx.BlockGasMeter().GasConsumed()}
-		return gInfo, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
+		return gInfo, nil, MempoolTx{}, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
 	var startingGas uint64
@@ -497,6 +583,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 			}
 
 			result = nil
+			mempoolTx = MempoolTx{}
 		}
 
 		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
@@ -521,7 +608,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 
 	msgs := tx.GetMsgs()
 	if err := validateBasicTxMsgs(msgs); err != nil {
-		return sdk.GasInfo{}, nil, err
+		return sdk.GasInfo{}, nil, MempoolTx{}, err
 	}
 
 	if app.anteHandler != nil {
@@ -553,12 +640,23 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
-			return gInfo, nil, err
+			return gInfo, nil, MempoolTx{}, err
 		}
 
 		msCache.Write()
 	}
 
+	// the mempool hints are set by the AnteHandler in the Context
+	mempoolTx = MempoolTx{
+		Tx:        tx,
+		Bytes:     txBytes,
+		GasWanted: gasWanted,
+		Priority:  ctx.Priority(),
+		Sender:    ctx.TxSender(),
+		Nonce:     ctx.TxNonce(),
+		Height:    ctx.BlockHeight(),
+	}
+
 	// Create a new Context based off of the existing Context with a cache-wrapped
 	// MultiStore in case message processing fails. At this point, the MultiStore
 	// is doubly cached-wrapped.
@@ -572,7 +670,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 		msCache.Write()
 	}
 
-	return gInfo, result, err
+	return gInfo, result, mempoolTx, err
 }
 
 // runMsgs iterates through a list of messages and executes them with the provided
@@ -592,10 +690,15 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			break
 		}
 
-		msgRoute := msg.Route()
-		handler := app.router.Route(ctx, msgRoute)
+		// Msgs accepted by a Msg service are routed to it by their type URL,
+		// other Msgs to the handler of their legacy route.
+		handler := app.msgServiceRouter.Handler(msg)
 		if handler == nil {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+			msgRoute := msg.Route()
+			handler = app.router.Route(ctx, msgRoute)
+			if handler == nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+			}
 		}
 
 		msgResult, err := handler(ctx, msg)
@@ -3,11 +3,14 @@ package baseapp
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/stretchr/testify/assert"
@@ -19,6 +22,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/snapshots/types"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -1546,3 +1551,296 @@ func TestWithRouter(t *testing.T) {
 		app.Commit()
 	}
 }
+
+func TestSnapshotRestore(t *testing.T) {
+	snapshotDir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	defer os.RemoveAll(snapshotDir)
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), snapshotDir)
+	require.NoError(t, err)
+
+	pruningOpt := SetPruning(store.PruningOptions{KeepEvery: 1, SnapshotEvery: 2})
+	app := setupBaseApp(t, pruningOpt, SetSnapshotStore(snapshotStore), SetSnapshotInterval(2))
+
+	// commit a few blocks with some state, taking snapshots at heights 2 and 4
+	for height := int64(1); height <= 4; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		kv := app.deliverState.ctx.KVStore(capKey2)
+		for i := 0; i < 10; i++ {
+			kv.Set([]byte(fmt.Sprintf("key%d-%d", height, i)), []byte(fmt.Sprintf("value%d", i)))
+		}
+		app.Commit()
+
+		if height%2 == 0 {
+			// snapshots are taken asynchronously, so wait for it before moving on
+			require.Eventually(t, func() bool {
+				list, err := app.ListSnapshots()
+				return err == nil && len(list) > 0 && list[0].Height == uint64(height)
+			}, 5*time.Second, 10*time.Millisecond)
+		}
+	}
+
+	snapshotList, err := app.ListSnapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshotList, 2)
+	snapshot := snapshotList[0]
+	require.EqualValues(t, 4, snapshot.Height)
+
+	// restore the latest snapshot into a fresh app
+	targetDir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	defer os.RemoveAll(targetDir)
+
+	targetStore, err := snapshots.NewStore(dbm.NewMemDB(), targetDir)
+	require.NoError(t, err)
+	target := newBaseApp(t.Name(), SetSnapshotStore(targetStore))
+	target.MountStores(capKey1, capKey2)
+	require.NoError(t, target.LoadLatestVersion(capKey1))
+
+	// offering a snapshot with a wrong app hash should fail once all chunks are applied
+	err = target.OfferSnapshot(*snapshot, []byte{1, 2, 3})
+	require.NoError(t, err)
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := app.LoadSnapshotChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		_, err = target.ApplySnapshotChunk(chunk)
+		if i == snapshot.Chunks-1 {
+			require.Error(t, err)
+			require.True(t, errors.Is(err, snapshottypes.ErrAppHashMismatch))
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+	// the mismatched state is rolled back, so the snapshot can be restored again
+	require.EqualValues(t, 0, target.LastBlockHeight())
+	require.Nil(t, target.cms.GetKVStore(capKey2).Get([]byte("key2-3")))
+
+	appHash := app.cms.(*rootmulti.Store).LastCommitID().Hash
+	err = target.OfferSnapshot(*snapshot, appHash)
+	require.NoError(t, err)
+
+	var done bool
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := app.LoadSnapshotChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		done, err = target.ApplySnapshotChunk(chunk)
+		require.NoError(t, err)
+	}
+	require.True(t, done)
+
+	require.Equal(t, app.LastCommitID(), target.LastCommitID())
+	require.EqualValues(t, 4, target.LastBlockHeight())
+
+	kv := target.checkState.ctx.KVStore(capKey2)
+	require.Equal(t, []byte("value3"), kv.Get([]byte("key2-3")))
+	require.Equal(t, []byte("value9"), kv.Get([]byte("key4-9")))
+}
+
+// mockStreamingService records the state changes reported for each ABCI
+// message.
+type mockStreamingService struct {
+	keys    []sdk.StoreKey
+	pending []sdk.StoreKVPair
+	begin   [][]sdk.StoreKVPair
+	txs     [][]sdk.StoreKVPair
+	end     [][]sdk.StoreKVPair
+}
+
+func (s *mockStreamingService) Listeners() map[sdk.StoreKey][]sdk.WriteListener {
+	listeners := make(map[sdk.StoreKey][]sdk.WriteListener)
+	for _, key := range s.keys {
+		listeners[key] = []sdk.WriteListener{s}
+	}
+	return listeners
+}
+
+func (s *mockStreamingService) OnWrite(storeKey sdk.StoreKey, key []byte, value []byte, delete bool) error {
+	s.pending = append(s.pending, sdk.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	return nil
+}
+
+func (s *mockStreamingService) flush() []sdk.StoreKVPair {
+	pending := s.pending
+	s.pending = nil
+	return pending
+}
+
+func (s *mockStreamingService) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	s.begin = append(s.begin, s.flush())
+	return nil
+}
+
+func (s *mockStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	s.txs = append(s.txs, s.flush())
+	return nil
+}
+
+func (s *mockStreamingService) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	s.end = append(s.end, s.flush())
+	return nil
+}
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	beginKey := []byte("begin-key")
+	endKey := []byte("end-key")
+
+	service := &mockStreamingService{keys: []sdk.StoreKey{capKey1}}
+	app := setupBaseApp(t, SetStreamingService(service), func(bapp *BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey1).Set(beginKey, []byte("begin"))
+			ctx.KVStore(capKey2).Set(beginKey, []byte("not listened"))
+			return abci.ResponseBeginBlock{}
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context, _ abci.RequestEndBlock) abci.ResponseEndBlock {
+			ctx.KVStore(capKey1).Delete(beginKey)
+			ctx.KVStore(capKey1).Set(endKey, []byte("end"))
+			return abci.ResponseEndBlock{}
+		})
+	})
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+
+	// a successful tx reports the writes of both the ante handler and the msg handler
+	txBytes, err := codec.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	// a tx failing in the msg handler only reports the writes of the ante handler
+	tx := newTxCounter(1, 1)
+	tx.Msgs[0] = msgCounter{Counter: 1, FailOnHandler: true}
+	txBytes, err = codec.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK())
+
+	// writes made in CheckTx are never reported
+	txBytes, err = codec.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+	checkRes := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, checkRes.IsOK(), fmt.Sprintf("%v", checkRes))
+
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	require.Equal(t, [][]sdk.StoreKVPair{
+		{{StoreKey: capKey1.Name(), Key: beginKey, Value: []byte("begin")}},
+	}, service.begin)
+	require.Equal(t, [][]sdk.StoreKVPair{
+		{
+			{StoreKey: capKey1.Name(), Key: anteKey, Value: encodeInt(1)},
+			{StoreKey: capKey1.Name(), Key: deliverKey, Value: encodeInt(1)},
+		},
+		{
+			{StoreKey: capKey1.Name(), Key: anteKey, Value: encodeInt(2)},
+		},
+	}, service.txs)
+	require.Equal(t, [][]sdk.StoreKVPair{
+		{
+			{StoreKey: capKey1.Name(), Key: beginKey, Delete: true},
+			{StoreKey: capKey1.Name(), Key: endKey, Value: []byte("end")},
+		},
+	}, service.end)
+	require.Empty(t, service.pending)
+}
+
+func encodeInt(i int64) []byte {
+	bz := make([]byte, 8)
+	n := binary.PutVarint(bz, i)
+	return bz[:n]
+}
+
+func TestCheckTxMempool(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender______________"))
+
+	// The priority of a tx is ten times its counter, plus one on recheck, and
+	// its nonce is its counter. As the auth AnteHandler, the ante handler only
+	// sets the sender and nonce of a tx when it is first checked, and the tx of
+	// counter 2 fails on recheck.
+	options := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			counter := tx.(txTest).Counter
+			if ctx.IsReCheckTx() {
+				if counter == 2 {
+					return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+				}
+				return ctx.WithPriority(counter*10 + 1), nil
+			}
+			return ctx.WithPriority(counter*10).WithTxSender(sender, uint64(counter)), nil
+		})
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		})
+	}
+
+	app := setupBaseApp(t, options, SetMempool(NewPriorityMempool()))
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	txs := make([][]byte, 3)
+	for i := range txs {
+		txBytes, err := codec.MarshalBinaryBare(newTxCounter(int64(i), int64(i)))
+		require.NoError(t, err)
+		txs[i] = txBytes
+
+		res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+		require.Equal(t, sdk.StringifyEvents(res.Events), sdk.StringEvents{{
+			Type: sdk.EventTypeMempool,
+			Attributes: []sdk.Attribute{
+				{Key: sdk.AttributeKeyPriority, Value: fmt.Sprintf("%d", i*10)},
+				{Key: sdk.AttributeKeySender, Value: sender.String()},
+				{Key: sdk.AttributeKeyNonce, Value: fmt.Sprintf("%d", i)},
+			},
+		}})
+	}
+	require.Equal(t, 3, app.Mempool().Len())
+
+	// the delivered txs are removed from the mempool
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txs[0]})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	require.Equal(t, 2, app.Mempool().Len())
+	_, ok := app.Mempool().Get(txs[0])
+	require.False(t, ok)
+
+	// the rechecked txs keep their sender and nonce, and are removed from the
+	// mempool if they fail
+	for _, txBytes := range txs[1:] {
+		app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck})
+	}
+
+	require.Equal(t, 1, app.Mempool().Len())
+	mempoolTx, ok := app.Mempool().Get(txs[1])
+	require.True(t, ok)
+	require.Equal(t, int64(11), mempoolTx.Priority)
+	require.Equal(t, sender, mempoolTx.Sender)
+	require.Equal(t, uint64(1), mempoolTx.Nonce)
+	require.Equal(t, int64(1), mempoolTx.Height)
+
+	// the txs which are not rechecked after a block are removed from the mempool
+	// on the next Commit
+	for height := int64(2); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	require.Equal(t, 0, app.Mempool().Len())
+}
@@ -0,0 +1,194 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GRPCBlockHeightHeader is the gRPC metadata header a client may set to query
+// the state at a given block height. The latest height is queried otherwise.
+const GRPCBlockHeightHeader = "x-cosmos-block-height"
+
+// GRPCQueryHandler defines a function type which handles ABCI Query requests
+// using gRPC.
+type GRPCQueryHandler = func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error)
+
+// GRPCQueryRouter routes ABCI Query requests to the methods of the gRPC query
+// services registered on it, by their fully-qualified "/service.Name/Method"
+// path.
+type GRPCQueryRouter struct {
+	routes      map[string]GRPCQueryHandler
+	serviceData []serviceData
+}
+
+// serviceData holds a gRPC service registered on the GRPCQueryRouter.
+type serviceData struct {
+	serviceDesc *grpc.ServiceDesc
+	handler     interface{}
+}
+
+var _ sdk.GRPCServer = NewGRPCQueryRouter()
+
+// NewGRPCQueryRouter returns a reference to a new GRPCQueryRouter.
+func NewGRPCQueryRouter() *GRPCQueryRouter {
+	return &GRPCQueryRouter{
+		routes: map[string]GRPCQueryHandler{},
+	}
+}
+
+// Route returns the GRPCQueryHandler for a given query route path or nil if
+// no method of a registered service has this path.
+func (qrt *GRPCQueryRouter) Route(path string) GRPCQueryHandler {
+	return qrt.routes[path]
+}
+
+// RegisterService implements the GRPCServer interface. It adds a route for
+// each unary method of the service, which decodes the request from the data of
+// the ABCI Query request and encodes the response as its value. The request and
+// response types must be protobuf messages. It will panic if a method has
+// already been registered.
+func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	for _, method := range sd.Methods {
+		fqName := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		methodHandler := method.Handler
+
+		if qrt.routes[fqName] != nil {
+			panic(fmt.Sprintf("gRPC query route %s has already been registered", fqName))
+		}
+
+		qrt.routes[fqName] = func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), func(msg interface{}) error {
+				protoMsg, ok := msg.(codec.ProtoMarshaler)
+				if !ok {
+					return fmt.Errorf("%T is not a protobuf message", msg)
+				}
+				if err := protoMsg.Unmarshal(req.Data); err != nil {
+					return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return abci.ResponseQuery{}, err
+			}
+
+			protoRes, ok := res.(codec.ProtoMarshaler)
+			if !ok {
+				return abci.ResponseQuery{}, fmt.Errorf("%T is not a protobuf message", res)
+			}
+
+			resBytes, err := protoRes.Marshal()
+			if err != nil {
+				return abci.ResponseQuery{}, err
+			}
+
+			return abci.ResponseQuery{
+				Height: req.Height,
+				Value:  resBytes,
+			}, nil
+		}
+	}
+
+	qrt.serviceData = append(qrt.serviceData, serviceData{
+		serviceDesc: sd,
+		handler:     handler,
+	})
+}
+
+// RegisterGRPCServer registers the gRPC query services of the BaseApp on a
+// gRPC server, such as a *grpc.Server. The methods are executed against the
+// committed state at the height set by the GRPCBlockHeightHeader of the
+// request, like ABCI Query requests.
+func (app *BaseApp) RegisterGRPCServer(server sdk.GRPCServer) {
+	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var height int64
+		if md, ok := metadata.FromIncomingContext(grpcCtx); ok {
+			if heights := md.Get(GRPCBlockHeightHeader); len(heights) > 0 {
+				var err error
+				height, err = strconv.ParseInt(heights[0], 10, 64)
+				if err != nil {
+					return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid height %s: %s", heights[0], err)
+				}
+			}
+		}
+
+		// unlike ABCI queries, the gRPC queries are not serialized with Commit
+		app.queryMtx.RLock()
+		ctx, err := app.createQueryContext(height, false)
+		app.queryMtx.RUnlock()
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(sdk.WrapSDKContext(ctx.WithContext(grpcCtx)), req)
+	}
+
+	for _, data := range app.grpcQueryRouter.serviceData {
+		methods := make([]grpc.MethodDesc, len(data.serviceDesc.Methods))
+		for i, method := range data.serviceDesc.Methods {
+			methodHandler := method.Handler
+			methods[i] = grpc.MethodDesc{
+				MethodName: method.MethodName,
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+					res, err := methodHandler(srv, ctx, dec, interceptor)
+					if err != nil {
+						return nil, gRPCError(err)
+					}
+
+					return res, nil
+				},
+			}
+		}
+
+		server.RegisterService(&grpc.ServiceDesc{
+			ServiceName: data.serviceDesc.ServiceName,
+			HandlerType: data.serviceDesc.HandlerType,
+			Methods:     methods,
+			Streams:     data.serviceDesc.Streams,
+			Metadata:    data.serviceDesc.Metadata,
+		}, data.handler)
+	}
+}
+
+// gRPCError converts an error of a gRPC query method into a gRPC status error,
+// mapping the errors of the root codespace to the closest gRPC status code.
+// The errors which already are status errors are returned as is.
+func gRPCError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code := codes.Unknown
+	switch {
+	case errors.Is(err, sdkerrors.ErrInvalidRequest), errors.Is(err, sdkerrors.ErrUnknownRequest),
+		errors.Is(err, sdkerrors.ErrInvalidAddress), errors.Is(err, sdkerrors.ErrInvalidPubKey),
+		errors.Is(err, sdkerrors.ErrInvalidCoins), errors.Is(err, sdkerrors.ErrTxDecode),
+		errors.Is(err, sdkerrors.ErrJSONUnmarshal):
+		code = codes.InvalidArgument
+
+	case errors.Is(err, sdkerrors.ErrKeyNotFound), errors.Is(err, sdkerrors.ErrUnknownAddress):
+		code = codes.NotFound
+
+	case errors.Is(err, sdkerrors.ErrUnauthorized):
+		code = codes.PermissionDenied
+
+	case errors.Is(err, sdkerrors.ErrOutOfGas):
+		code = codes.ResourceExhausted
+
+	case errors.Is(err, sdkerrors.ErrPanic):
+		code = codes.Internal
+	}
+
+	return status.Error(code, err.Error())
+}
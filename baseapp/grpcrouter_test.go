@@ -0,0 +1,208 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// testQueryServer is a gRPC query service returning the coin of the requested
+// denom with the amount stored under the denom in capKey1.
+type testQueryServer interface {
+	Balance(context.Context, *sdk.Coin) (*sdk.Coin, error)
+}
+
+type testQueryServerImpl struct{}
+
+func (testQueryServerImpl) Balance(goCtx context.Context, req *sdk.Coin) (*sdk.Coin, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount, ok := sdk.NewIntFromString(string(ctx.KVStore(capKey1).Get([]byte(req.Denom))))
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no balance for %s", req.Denom)
+	}
+
+	coin := sdk.NewCoin(req.Denom, amount)
+	return &coin, nil
+}
+
+func testQueryBalanceHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sdk.Coin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(testQueryServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/test.Query/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(testQueryServer).Balance(ctx, req.(*sdk.Coin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var testQueryServiceDesc = grpc.ServiceDesc{
+	ServiceName: "test.Query",
+	HandlerType: (*testQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Balance",
+			Handler:    testQueryBalanceHandler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+// grpcServerMock records the services registered on it.
+type grpcServerMock struct {
+	services map[string]*grpc.ServiceDesc
+}
+
+func (s *grpcServerMock) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	s.services[sd.ServiceName] = sd
+}
+
+// setupGRPCQueryApp returns a BaseApp with the test query service, which
+// committed a balance of 5 foo at height 1 and 7 foo at height 2.
+func setupGRPCQueryApp(t *testing.T) *BaseApp {
+	app := setupBaseApp(t, func(bapp *BaseApp) {
+		bapp.GRPCQueryRouter().RegisterService(&testQueryServiceDesc, testQueryServerImpl{})
+	})
+	app.InitChain(abci.RequestInitChain{})
+
+	for height, amount := range []string{"5", "7"} {
+		header := abci.Header{Height: int64(height) + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.deliverState.ctx.KVStore(capKey1).Set([]byte("foo"), []byte(amount))
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	return app
+}
+
+func TestGRPCQueryRouter(t *testing.T) {
+	app := setupGRPCQueryApp(t)
+
+	require.NotNil(t, app.GRPCQueryRouter().Route("/test.Query/Balance"))
+	require.Nil(t, app.GRPCQueryRouter().Route("/test.Query/Unknown"))
+	require.Panics(t, func() {
+		app.GRPCQueryRouter().RegisterService(&testQueryServiceDesc, testQueryServerImpl{})
+	})
+
+	req := sdk.Coin{Denom: "foo"}
+	data, err := req.Marshal()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		height int64
+		amount int64
+	}{
+		{0, 7},
+		{1, 5},
+		{2, 7},
+	}
+
+	for _, tc := range testCases {
+		res := app.Query(abci.RequestQuery{Path: "/test.Query/Balance", Data: data, Height: tc.height})
+		require.True(t, res.IsOK(), res.Log)
+
+		var coin sdk.Coin
+		require.NoError(t, coin.Unmarshal(res.Value))
+		require.Equal(t, sdk.NewInt64Coin("foo", tc.amount), coin, "height %d", tc.height)
+	}
+
+	// errors of the service are returned as ABCI errors
+	data, err = (&sdk.Coin{Denom: "bar"}).Marshal()
+	require.NoError(t, err)
+	res := app.Query(abci.RequestQuery{Path: "/test.Query/Balance", Data: data})
+	require.False(t, res.IsOK())
+	require.Equal(t, sdkerrors.ErrUnknownRequest.ABCICode(), res.Code)
+
+	// so are invalid requests
+	res = app.Query(abci.RequestQuery{Path: "/test.Query/Balance", Data: []byte{0xff}})
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.Code)
+}
+
+func TestRegisterGRPCServer(t *testing.T) {
+	app := setupGRPCQueryApp(t)
+
+	server := &grpcServerMock{services: map[string]*grpc.ServiceDesc{}}
+	app.RegisterGRPCServer(server)
+	require.Len(t, server.services, 1)
+
+	sd := server.services["test.Query"]
+	require.NotNil(t, sd)
+	require.Len(t, sd.Methods, 1)
+	require.Equal(t, "Balance", sd.Methods[0].MethodName)
+
+	dec := func(msg interface{}) error {
+		*msg.(*sdk.Coin) = sdk.Coin{Denom: "foo"}
+		return nil
+	}
+
+	testCases := []struct {
+		height string
+		amount int64
+		code   codes.Code
+	}{
+		{"", 7, codes.OK},
+		{"1", 5, codes.OK},
+		{"2", 7, codes.OK},
+		{"3", 0, codes.InvalidArgument},
+		{"foo", 0, codes.InvalidArgument},
+	}
+
+	for _, tc := range testCases {
+		ctx := context.Background()
+		if tc.height != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(GRPCBlockHeightHeader, tc.height))
+		}
+
+		res, err := sd.Methods[0].Handler(testQueryServerImpl{}, ctx, dec, nil)
+		if tc.code != codes.OK {
+			require.Equal(t, tc.code, status.Code(err), "height %s", tc.height)
+			continue
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin("foo", tc.amount), *res.(*sdk.Coin), "height %s", tc.height)
+	}
+
+	// errors of the service are returned as gRPC status errors
+	decBar := func(msg interface{}) error {
+		*msg.(*sdk.Coin) = sdk.Coin{Denom: "bar"}
+		return nil
+	}
+	_, err := sd.Methods[0].Handler(testQueryServerImpl{}, context.Background(), decBar, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the queries may run concurrently with Commit
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 20; i++ {
+			_, err := sd.Methods[0].Handler(testQueryServerImpl{}, context.Background(), dec, nil)
+			require.NoError(t, err)
+		}
+	}()
+
+	for height := int64(3); height < 13; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+	<-done
+}
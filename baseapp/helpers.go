@@ -11,15 +11,18 @@ import (
 var isAlphaNumeric = regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString
 
 func (app *BaseApp) Check(tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
-	return app.runTx(runTxModeCheck, nil, tx)
+	gInfo, result, _, err := app.runTx(runTxModeCheck, nil, tx)
+	return gInfo, result, err
 }
 
 func (app *BaseApp) Simulate(txBytes []byte, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
-	return app.runTx(runTxModeSimulate, txBytes, tx)
+	gInfo, result, _, err := app.runTx(runTxModeSimulate, txBytes, tx)
+	return gInfo, result, err
 }
 
 func (app *BaseApp) Deliver(tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
-	return app.runTx(runTxModeDeliver, nil, tx)
+	gInfo, result, _, err := app.runTx(runTxModeDeliver, nil, tx)
+	return gInfo, result, err
 }
 
 // Context with current {check, deliver}State of the app used by tests.
@@ -0,0 +1,262 @@
+package baseapp
+
+import (
+	"container/heap"
+	"sort"
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MempoolTx is a tx accepted by CheckTx, along with the mempool hints its
+// AnteHandler set in the Context: its priority, its sender and its nonce
+// among the txs of its sender. Height is the height of the state the tx was
+// last checked against.
+type MempoolTx struct {
+	Tx        sdk.Tx
+	Bytes     []byte
+	GasWanted uint64
+	Priority  int64
+	Sender    sdk.AccAddress
+	Nonce     uint64
+	Height    int64
+}
+
+// Mempool defines an app-side mempool, which the BaseApp keeps in sync with
+// the txs accepted by CheckTx: a tx is inserted when it is checked, updated or
+// removed when it is rechecked and removed when it is delivered. As Tendermint
+// rechecks the txs of its mempool after each block, the BaseApp also removes on
+// Commit the txs which were not rechecked after the previous block, i.e. the
+// txs Tendermint dropped from its mempool. The app may use it to select the
+// txs to include in a block.
+//
+// NOTE: The BaseApp requires the recheck of Tendermint to be enabled when a
+// Mempool is set, otherwise the txs are removed after one block.
+type Mempool interface {
+	// Insert adds a tx to the mempool, replacing the tx of the same sender and
+	// nonce if any.
+	Insert(tx MempoolTx)
+
+	// Remove removes the tx of the given bytes from the mempool, if any.
+	Remove(txBytes []byte)
+
+	// Get returns the tx of the given bytes, if it is in the mempool.
+	Get(txBytes []byte) (MempoolTx, bool)
+
+	// Select returns the txs to include in a block, in the order to include
+	// them, whose total bytes and gas wanted are within the given limits, a
+	// negative limit meaning no limit.
+	Select(maxBytes, maxGas int64) []MempoolTx
+
+	// RemoveStale removes the txs last checked against the state of a height
+	// lower than the given height.
+	RemoveStale(height int64)
+
+	// Len returns the number of txs in the mempool.
+	Len() int
+}
+
+var _ Mempool = (*PriorityMempool)(nil)
+
+// PriorityMempool is a Mempool selecting the txs by decreasing priority, the
+// txs of a sender being selected in increasing nonce order. A tx whose nonce
+// does not follow the one of the previous tx of its sender is only selected
+// once the missing tx is inserted. Txs of the same priority are selected in
+// the order they were inserted. The txs without a sender are not ordered
+// among each other.
+type PriorityMempool struct {
+	mtx     sync.RWMutex
+	txs     map[string]*mempoolEntry   // by hash of the tx bytes
+	senders map[string][]*mempoolEntry // by sender, in nonce order
+	seq     uint64                     // insertion counter
+}
+
+type mempoolEntry struct {
+	MempoolTx
+
+	hash   string
+	sender string
+	seq    uint64
+}
+
+// NewPriorityMempool returns an empty PriorityMempool.
+func NewPriorityMempool() *PriorityMempool {
+	return &PriorityMempool{
+		txs:     make(map[string]*mempoolEntry),
+		senders: make(map[string][]*mempoolEntry),
+	}
+}
+
+// Insert implements the Mempool interface. Inserting a tx already in the
+// mempool updates it, e.g. with the priority of a recheck, while keeping its
+// insertion order.
+func (mp *PriorityMempool) Insert(tx MempoolTx) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	e := &mempoolEntry{MempoolTx: tx, hash: string(tmhash.Sum(tx.Bytes)), seq: mp.seq}
+	if old, ok := mp.txs[e.hash]; ok {
+		e.seq = old.seq
+		mp.remove(old)
+	} else {
+		mp.seq++
+	}
+
+	// a tx without a sender is keyed by its hash, which cannot collide with an
+	// address since their lengths differ
+	e.sender = string(tx.Sender)
+	if tx.Sender.Empty() {
+		e.sender = e.hash
+	}
+
+	queue := mp.senders[e.sender]
+	i := sort.Search(len(queue), func(i int) bool { return queue[i].Nonce >= tx.Nonce })
+
+	if i < len(queue) && queue[i].Nonce == tx.Nonce {
+		delete(mp.txs, queue[i].hash)
+		queue[i] = e
+	} else {
+		queue = append(queue, nil)
+		copy(queue[i+1:], queue[i:])
+		queue[i] = e
+	}
+
+	mp.senders[e.sender] = queue
+	mp.txs[e.hash] = e
+}
+
+// Remove implements the Mempool interface.
+func (mp *PriorityMempool) Remove(txBytes []byte) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if e, ok := mp.txs[string(tmhash.Sum(txBytes))]; ok {
+		mp.remove(e)
+	}
+}
+
+func (mp *PriorityMempool) remove(e *mempoolEntry) {
+	delete(mp.txs, e.hash)
+
+	queue := mp.senders[e.sender]
+	i := sort.Search(len(queue), func(i int) bool { return queue[i].Nonce >= e.Nonce })
+	if i == len(queue) || queue[i] != e {
+		return
+	}
+
+	if len(queue) == 1 {
+		delete(mp.senders, e.sender)
+		return
+	}
+
+	mp.senders[e.sender] = append(queue[:i:i], queue[i+1:]...)
+}
+
+// RemoveStale implements the Mempool interface.
+func (mp *PriorityMempool) RemoveStale(height int64) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	for _, e := range mp.txs {
+		if e.Height < height {
+			mp.remove(e)
+		}
+	}
+}
+
+// Get implements the Mempool interface.
+func (mp *PriorityMempool) Get(txBytes []byte) (MempoolTx, bool) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	e, ok := mp.txs[string(tmhash.Sum(txBytes))]
+	if !ok {
+		return MempoolTx{}, false
+	}
+
+	return e.MempoolTx, true
+}
+
+// Select implements the Mempool interface. A tx which does not fit within the
+// limits is skipped along with the next txs of its sender.
+func (mp *PriorityMempool) Select(maxBytes, maxGas int64) []MempoolTx {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	// the heap holds the next tx to select of each sender
+	heads := make(mempoolHeap, 0, len(mp.senders))
+	for _, queue := range mp.senders {
+		heads = append(heads, &mempoolCursor{queue: queue})
+	}
+	heap.Init(&heads)
+
+	var (
+		selected   []MempoolTx
+		bytes, gas int64
+	)
+
+	for heads.Len() > 0 {
+		cursor := heap.Pop(&heads).(*mempoolCursor)
+		e := cursor.entry()
+
+		txBytes, txGas := int64(len(e.Bytes)), int64(e.GasWanted)
+		if (maxBytes >= 0 && bytes+txBytes > maxBytes) || (maxGas >= 0 && gas+txGas > maxGas) {
+			continue
+		}
+
+		selected = append(selected, e.MempoolTx)
+		bytes += txBytes
+		gas += txGas
+
+		if next := cursor.i + 1; next < len(cursor.queue) && cursor.queue[next].Nonce == e.Nonce+1 {
+			cursor.i = next
+			heap.Push(&heads, cursor)
+		}
+	}
+
+	return selected
+}
+
+// Len implements the Mempool interface.
+func (mp *PriorityMempool) Len() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return len(mp.txs)
+}
+
+// mempoolCursor points to the next tx to select among the txs of a sender.
+type mempoolCursor struct {
+	queue []*mempoolEntry
+	i     int
+}
+
+func (c *mempoolCursor) entry() *mempoolEntry { return c.queue[c.i] }
+
+// mempoolHeap implements heap.Interface, ordering the cursors by decreasing
+// priority and then by insertion order of their tx.
+type mempoolHeap []*mempoolCursor
+
+func (h mempoolHeap) Len() int      { return len(h) }
+func (h mempoolHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h mempoolHeap) Less(i, j int) bool {
+	a, b := h[i].entry(), h[j].entry()
+	if a.Priority != b.Priority {
+		return a.Priority > b.Priority
+	}
+
+	return a.seq < b.seq
+}
+
+func (h *mempoolHeap) Push(x interface{}) { *h = append(*h, x.(*mempoolCursor)) }
+
+func (h *mempoolHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
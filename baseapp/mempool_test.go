@@ -0,0 +1,113 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newMempoolTx(sender string, nonce uint64, priority int64) MempoolTx {
+	var addr sdk.AccAddress
+	if sender != "" {
+		addr = sdk.AccAddress(fmt.Sprintf("%-20s", sender))
+	}
+
+	return MempoolTx{
+		Bytes:     []byte(fmt.Sprintf("%s-%d-%d", sender, nonce, priority)),
+		GasWanted: 10,
+		Priority:  priority,
+		Sender:    addr,
+		Nonce:     nonce,
+	}
+}
+
+func TestPriorityMempoolSelect(t *testing.T) {
+	mp := NewPriorityMempool()
+
+	// the txs of a sender are selected in nonce order, whatever their priority,
+	// and only up to a missing nonce
+	mp.Insert(newMempoolTx("alice", 1, 30))
+	mp.Insert(newMempoolTx("alice", 0, 10))
+	mp.Insert(newMempoolTx("alice", 3, 50))
+	mp.Insert(newMempoolTx("bob", 5, 20))
+	mp.Insert(newMempoolTx("bob", 6, 5))
+	mp.Insert(newMempoolTx("", 0, 15))
+	mp.Insert(newMempoolTx("", 0, 20))
+	require.Equal(t, 7, mp.Len())
+
+	expected := []MempoolTx{
+		newMempoolTx("bob", 5, 20),
+		newMempoolTx("", 0, 20),
+		newMempoolTx("", 0, 15),
+		newMempoolTx("alice", 0, 10),
+		newMempoolTx("alice", 1, 30),
+		newMempoolTx("bob", 6, 5),
+	}
+	require.Equal(t, expected, mp.Select(-1, -1))
+
+	// a tx over the limits is skipped along with the next txs of its sender
+	require.Equal(t, expected[:3], mp.Select(-1, 30))
+	require.Equal(t, []MempoolTx{expected[0], expected[1], expected[2], expected[5]}, mp.Select(25, -1))
+
+	// the missing tx of alice makes the next tx of alice selectable
+	mp.Insert(newMempoolTx("alice", 2, 1))
+	selected := mp.Select(-1, -1)
+	require.Len(t, selected, 8)
+	require.Equal(t, newMempoolTx("alice", 2, 1), selected[6])
+	require.Equal(t, newMempoolTx("alice", 3, 50), selected[7])
+}
+
+func TestPriorityMempoolInsertRemove(t *testing.T) {
+	mp := NewPriorityMempool()
+
+	first, second := newMempoolTx("alice", 0, 10), newMempoolTx("bob", 0, 10)
+	mp.Insert(first)
+	mp.Insert(second)
+
+	// inserting a tx again updates it while keeping its insertion order
+	first.GasWanted = 20
+	mp.Insert(first)
+	require.Equal(t, 2, mp.Len())
+	require.Equal(t, []MempoolTx{first, second}, mp.Select(-1, -1))
+
+	// a tx of the same sender and nonce replaces the tx
+	replacement := newMempoolTx("alice", 0, 30)
+	mp.Insert(replacement)
+	require.Equal(t, 2, mp.Len())
+	_, ok := mp.Get(first.Bytes)
+	require.False(t, ok)
+	tx, ok := mp.Get(replacement.Bytes)
+	require.True(t, ok)
+	require.Equal(t, replacement, tx)
+
+	mp.Remove(replacement.Bytes)
+	mp.Remove([]byte("unknown"))
+	require.Equal(t, 1, mp.Len())
+	require.Equal(t, []MempoolTx{second}, mp.Select(-1, -1))
+
+	mp.Remove(second.Bytes)
+	require.Equal(t, 0, mp.Len())
+	require.Empty(t, mp.Select(-1, -1))
+}
+
+func TestPriorityMempoolRemoveStale(t *testing.T) {
+	mp := NewPriorityMempool()
+
+	stale, checked, rechecked := newMempoolTx("alice", 0, 10), newMempoolTx("alice", 1, 10), newMempoolTx("bob", 0, 10)
+	stale.Height, checked.Height, rechecked.Height = 1, 2, 1
+	mp.Insert(stale)
+	mp.Insert(checked)
+	mp.Insert(rechecked)
+
+	rechecked.Height = 2
+	mp.Insert(rechecked)
+
+	mp.RemoveStale(2)
+	require.Equal(t, 2, mp.Len())
+	require.Equal(t, []MempoolTx{checked, rechecked}, mp.Select(-1, -1))
+	_, ok := mp.Get(stale.Bytes)
+	require.False(t, ok)
+}
@@ -0,0 +1,108 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgServiceHandler defines a function type which handles a Msg using a
+// protobuf Msg service.
+type MsgServiceHandler = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error)
+
+// MsgServiceRouter routes Msgs to the methods of the protobuf Msg services
+// registered on it, by the type URL of the Msgs.
+type MsgServiceRouter struct {
+	routes map[string]MsgServiceHandler
+}
+
+var _ sdk.GRPCServer = NewMsgServiceRouter()
+
+// errRequestType stops the execution of a method handler once the type of
+// its request is known.
+var errRequestType = errors.New("request type found")
+
+// NewMsgServiceRouter returns a reference to a new MsgServiceRouter.
+func NewMsgServiceRouter() *MsgServiceRouter {
+	return &MsgServiceRouter{
+		routes: map[string]MsgServiceHandler{},
+	}
+}
+
+// Handler returns the MsgServiceHandler for a given Msg or nil if no method of a
+// registered service accepts the type of the Msg.
+func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
+	typeURL := sdk.MsgTypeURL(msg)
+	if typeURL == "" {
+		return nil
+	}
+
+	return msr.routes[typeURL]
+}
+
+// RegisterService implements the GRPCServer interface. It adds a route for
+// each unary method of the service, keyed by the type URL of its request type,
+// which must be an sdk.Msg. The response of a method is encoded as the data of
+// the Result. It will panic if a Msg type has already been registered.
+func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	for _, method := range sd.Methods {
+		fqName := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		methodHandler := method.Handler
+
+		// the request type is found by calling the method handler with a
+		// decoder which stops it right away
+		var reqType reflect.Type
+		_, _ = methodHandler(nil, context.Background(), func(req interface{}) error {
+			reqType = reflect.TypeOf(req).Elem()
+			return errRequestType
+		}, nil)
+		if reqType == nil {
+			panic(fmt.Sprintf("could not find the request type of %s", fqName))
+		}
+
+		protoReq, ok := reflect.New(reqType).Interface().(proto.Message)
+		if !ok {
+			panic(fmt.Sprintf("request type %s of %s is not a protobuf message", reqType, fqName))
+		}
+		if _, ok := protoReq.(sdk.Msg); !ok {
+			panic(fmt.Sprintf("request type %s of %s does not implement sdk.Msg", reqType, fqName))
+		}
+
+		typeURL := "/" + proto.MessageName(protoReq)
+		if msr.routes[typeURL] != nil {
+			panic(fmt.Sprintf("msg service route for %s has already been registered", typeURL))
+		}
+
+		msr.routes[typeURL] = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), func(req interface{}) error {
+				// msgs registered with amino as values are routed to the
+				// pointer request types of the service
+				msgValue := reflect.ValueOf(msg)
+				if msgValue.Kind() == reflect.Ptr {
+					msgValue = msgValue.Elem()
+				}
+				reflect.ValueOf(req).Elem().Set(msgValue)
+				return nil
+			}, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			protoRes, ok := res.(codec.ProtoMarshaler)
+			if !ok {
+				return nil, fmt.Errorf("%T is not a protobuf message", res)
+			}
+
+			return sdk.WrapServiceResult(ctx, protoRes, nil)
+		}
+	}
+}
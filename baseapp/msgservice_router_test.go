@@ -0,0 +1,92 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// testBankMsgServer stores the amount of a MsgSend under its sender in capKey1
+// and rejects every MsgMultiSend.
+type testBankMsgServer struct{}
+
+func (testBankMsgServer) Send(goCtx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.KVStore(capKey1).Set(msg.FromAddress, []byte(msg.Amount.String()))
+	ctx.EventManager().EmitEvent(sdk.NewEvent("send"))
+
+	return &banktypes.MsgSendResponse{}, nil
+}
+
+func (testBankMsgServer) MultiSend(_ context.Context, _ *banktypes.MsgMultiSend) (*banktypes.MsgMultiSendResponse, error) {
+	return nil, sdkerrors.ErrUnauthorized
+}
+
+func TestMsgServiceRouter(t *testing.T) {
+	cdc := codec.New()
+	registerTestCodec(cdc)
+	banktypes.RegisterCodec(cdc)
+
+	routerOpt := func(bapp *BaseApp) {
+		banktypes.RegisterMsgService(bapp.MsgServiceRouter(), testBankMsgServer{})
+
+		// the legacy route of the Msg service msgs is not used anymore
+		bapp.Router().AddRoute(banktypes.RouterKey, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return nil, sdkerrors.ErrPanic
+		})
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx.KVStore(capKey1).Set([]byte("counter"), []byte("1"))
+			return &sdk.Result{}, nil
+		})
+	}
+
+	app := NewBaseApp(t.Name(), defaultLogger(), dbm.NewMemDB(), testTxDecoder(cdc), routerOpt)
+	app.MountStores(capKey1)
+	require.NoError(t, app.LoadLatestVersion(capKey1))
+
+	require.NotNil(t, app.msgServiceRouter.Handler(banktypes.MsgSend{}))
+	require.NotNil(t, app.msgServiceRouter.Handler(&banktypes.MsgMultiSend{}))
+	require.Nil(t, app.msgServiceRouter.Handler(msgCounter{}))
+	require.Panics(t, func() {
+		banktypes.RegisterMsgService(app.msgServiceRouter, testBankMsgServer{})
+	})
+	require.Panics(t, func() { app.MsgServiceRouter() })
+
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+
+	from, to := sdk.AccAddress("from"), sdk.AccAddress("to")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+
+	// Msg service msgs and legacy msgs can be mixed in a tx
+	tx := &txTest{Msgs: []sdk.Msg{banktypes.NewMsgSend(from, to, amount), msgCounter{Counter: 1}}}
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: cdc.MustMarshalBinaryBare(tx)})
+	require.True(t, res.IsOK(), res.Log)
+
+	store := app.deliverState.ctx.KVStore(capKey1)
+	require.Equal(t, []byte(amount.String()), store.Get(from))
+	require.Equal(t, []byte("1"), store.Get([]byte("counter")))
+
+	var sendEvents int
+	for _, event := range res.Events {
+		if event.Type == "send" {
+			sendEvents++
+		}
+	}
+	require.Equal(t, 1, sendEvents)
+
+	tx = &txTest{Msgs: []sdk.Msg{banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(from, amount)}, []banktypes.Output{banktypes.NewOutput(to, amount)},
+	)}}
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: cdc.MustMarshalBinaryBare(tx)})
+	require.False(t, res.IsOK())
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code)
+}
@@ -7,6 +7,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -44,6 +45,33 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetSnapshotStore sets the snapshot store.
+func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
+	return func(app *BaseApp) { app.setSnapshotStore(snapshotStore) }
+}
+
+// SetSnapshotInterval sets the snapshot interval.
+func SetSnapshotInterval(interval uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.setSnapshotInterval(interval) }
+}
+
+// SetSnapshotKeepRecent sets the recent snapshots to keep.
+func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
+	return func(app *BaseApp) { app.setSnapshotKeepRecent(keepRecent) }
+}
+
+// SetStreamingService sets a streaming service which is told about the state
+// changes and ABCI messages of every block.
+func SetStreamingService(s StreamingService) func(*BaseApp) {
+	return func(app *BaseApp) { app.setStreamingService(s) }
+}
+
+// SetMempool returns a BaseApp option function that sets the app-side mempool
+// kept in sync with the txs accepted by CheckTx.
+func SetMempool(mempool Mempool) func(*BaseApp) {
+	return func(app *BaseApp) { app.setMempool(mempool) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -143,3 +171,40 @@ func (app *BaseApp) SetRouter(router sdk.Router) {
 	}
 	app.router = router
 }
+
+// SetSnapshotStore sets the snapshot store used to take and restore state
+// sync snapshots of the CommitMultiStore.
+func (app *BaseApp) SetSnapshotStore(snapshotStore *snapshots.Store) {
+	if app.sealed {
+		panic("SetSnapshotStore() on sealed BaseApp")
+	}
+	app.setSnapshotStore(snapshotStore)
+}
+
+// SetSnapshotInterval sets the snapshot interval. A snapshot is taken at every
+// height that is a multiple of the interval, and 0 disables snapshots.
+func (app *BaseApp) SetSnapshotInterval(snapshotInterval uint64) {
+	if app.sealed {
+		panic("SetSnapshotInterval() on sealed BaseApp")
+	}
+	app.setSnapshotInterval(snapshotInterval)
+}
+
+// SetSnapshotKeepRecent sets the number of recent snapshots to keep, where 0
+// keeps all snapshots.
+func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
+	if app.sealed {
+		panic("SetSnapshotKeepRecent() on sealed BaseApp")
+	}
+	app.setSnapshotKeepRecent(snapshotKeepRecent)
+}
+
+// SetStreamingService registers a streaming service with the BaseApp. Its
+// WriteListeners are told about the state changes made in BeginBlock, DeliverTx
+// and EndBlock, and its ABCIListener hooks are called after each of them.
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	if app.sealed {
+		panic("SetStreamingService() on sealed BaseApp")
+	}
+	app.setStreamingService(s)
+}
@@ -0,0 +1,35 @@
+package baseapp
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIListener interface used to hook into the ABCI message processing of the BaseApp
+type ABCIListener interface {
+	// ListenBeginBlock updates the streaming service with the latest BeginBlock messages
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenEndBlock updates the steaming service with the latest EndBlock messages
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenDeliverTx updates the steaming service with the latest DeliverTx messages
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+}
+
+// StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
+type StreamingService interface {
+	// Listeners returns the streaming service's listeners for the BaseApp to register
+	Listeners() map[sdk.StoreKey][]sdk.WriteListener
+	// ABCIListener interface for hooking into the ABCI messages from inside the BaseApp
+	ABCIListener
+}
+
+// addDeliverStateListeners registers the WriteListeners of the streaming
+// services with the DeliverTx state. Writes made during BeginBlock, DeliverTx
+// and EndBlock are reported as they are written to the DeliverTx state, so
+// that they can be attributed to the ABCI message that caused them.
+func (app *BaseApp) addDeliverStateListeners() {
+	for key, listeners := range app.writeListeners {
+		app.deliverState.ms.AddListeners(key, listeners)
+	}
+}
@@ -38,6 +38,18 @@ const (
 	BroadcastAsync = "async"
 )
 
+const (
+	// SignModeDirect defines a sign mode signing over the protobuf encoded body
+	// and auth info of a tx.
+	SignModeDirect = "direct"
+	// SignModeLegacyAminoJSON defines a sign mode signing over the sorted amino
+	// JSON encoding of a tx.
+	SignModeLegacyAminoJSON = "amino-json"
+	// SignModeTextual defines a sign mode signing over a human-readable
+	// rendering of a tx, as shown by a Ledger device.
+	SignModeTextual = "textual"
+)
+
 // List of CLI flags
 const (
 	FlagHome               = tmcli.HomeFlag
@@ -54,6 +66,8 @@ const (
 	FlagMemo               = "memo"
 	FlagFees               = "fees"
 	FlagGasPrices          = "gas-prices"
+	FlagFeeGranter         = "fee-granter"
+	FlagSignMode           = "sign-mode"
 	FlagBroadcastMode      = "broadcast-mode"
 	FlagDryRun             = "dry-run"
 	FlagGenerateOnly       = "generate-only"
@@ -69,7 +83,11 @@ const (
 	FlagKeyringBackend     = "keyring-backend"
 	FlagPage               = "page"
 	FlagLimit              = "limit"
+	FlagPageKey            = "page-key"
+	FlagOffset             = "offset"
+	FlagCountTotal         = "count-total"
 	FlagUnsafeCORS         = "unsafe-cors"
+	FlagWSMaxSubscribers   = "ws-max-subscribers"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -111,6 +129,8 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().String(FlagMemo, "", "Memo to send along with transaction")
 		c.Flags().String(FlagFees, "", "Fees to pay along with transaction; eg: 10uatom")
 		c.Flags().String(FlagGasPrices, "", "Gas prices to determine the transaction fee (e.g. 10uatom)")
+		c.Flags().String(FlagFeeGranter, "", "Account paying the transaction fee under a fee allowance granted to the signer")
+		c.Flags().String(FlagSignMode, "", "Sign mode of the transaction (direct|amino-json|textual), defaulting to textual for Ledger keys if the app supports it and to amino-json otherwise; only supported by the commands building protobuf transactions")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
 		c.Flags().Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
@@ -139,6 +159,16 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 	return cmds
 }
 
+// AddPaginationFlagsToCmd adds the pagination flags to a command querying the
+// given kind of results.
+func AddPaginationFlagsToCmd(cmd *cobra.Command, query string) {
+	cmd.Flags().Uint64(FlagPage, 1, fmt.Sprintf("pagination page of %s to query for, which sets the offset to a multiple of the limit", query))
+	cmd.Flags().String(FlagPageKey, "", fmt.Sprintf("pagination key of %s to query for, as returned in the next_key of the previous page", query))
+	cmd.Flags().Uint64(FlagOffset, 0, fmt.Sprintf("pagination offset of %s to query for", query))
+	cmd.Flags().Uint64(FlagLimit, 100, fmt.Sprintf("pagination limit of %s to query for", query))
+	cmd.Flags().Bool(FlagCountTotal, false, fmt.Sprintf("count the total number of %s to query for", query))
+}
+
 // RegisterRestServerFlags registers the flags required for rest server
 func RegisterRestServerFlags(cmd *cobra.Command) *cobra.Command {
 	cmd = GetCommands(cmd)[0]
@@ -147,6 +177,7 @@ func RegisterRestServerFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.Flags().Uint(FlagRPCReadTimeout, 10, "The RPC read timeout (in seconds)")
 	cmd.Flags().Uint(FlagRPCWriteTimeout, 10, "The RPC write timeout (in seconds)")
 	cmd.Flags().Bool(FlagUnsafeCORS, false, "Allows CORS requests from all domains. For development purposes only, use it at your own risk.")
+	cmd.Flags().Uint(FlagWSMaxSubscribers, 100, "The number of maximum concurrent WebSocket subscribers (0 for no limit)")
 
 	return cmd
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -88,6 +89,10 @@ func ServeCommand(cdc *codec.Codec, registerRoutesFn func(*RestServer)) *cobra.C
 			rs := NewRestServer(cdc)
 
 			registerRoutesFn(rs)
+			rs.registerWebSocket(
+				uint(viper.GetInt(flags.FlagWSMaxSubscribers)),
+				viper.GetBool(flags.FlagUnsafeCORS),
+			)
 			rs.registerSwaggerUI()
 
 			// Start the rest server and return error if one exists
@@ -114,3 +119,22 @@ func (rs *RestServer) registerSwaggerUI() {
 	staticServer := http.FileServer(statikFS)
 	rs.Mux.PathPrefix("/").Handler(staticServer)
 }
+
+// registerWebSocket mounts the WebSocket endpoint streaming the txs matching
+// Tendermint event queries on /websocket. If cors is set, connections are
+// accepted from all origins.
+func (rs *RestServer) registerWebSocket(maxSubscribers uint, cors bool) {
+	node, err := rs.CliCtx.GetNode()
+	if err != nil {
+		rs.log.Error("WebSocket endpoint disabled", "err", err)
+		return
+	}
+
+	upgrader := websocket.Upgrader{}
+	if cors {
+		upgrader.CheckOrigin = func(*http.Request) bool { return true }
+	}
+
+	hub := newEventHub(rs.CliCtx.Codec, node, int(maxSubscribers), rs.log.With("module", "websocket"))
+	rs.Mux.HandleFunc("/websocket", hub.serveHTTP(upgrader))
+}
@@ -0,0 +1,501 @@
+package lcd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/tendermint/tendermint/libs/log"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/libs/service"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WebSocket request methods
+const (
+	WSMethodSubscribe      = "subscribe"
+	WSMethodUnsubscribe    = "unsubscribe"
+	WSMethodUnsubscribeAll = "unsubscribe_all"
+)
+
+const (
+	// WSMaxQueries is the maximum number of queries a WebSocket client can be
+	// subscribed to at once.
+	WSMaxQueries = 10
+
+	// wsSubscriberPrefix prefixes the subscriber of the Tendermint event
+	// subscriptions, which is distinct for each connection.
+	wsSubscriberPrefix = "rest-server"
+
+	wsSendBuffer   = 100
+	wsWriteTimeout = 10 * time.Second
+	wsPingPeriod   = 30 * time.Second
+	wsPongTimeout  = 2 * wsPingPeriod
+)
+
+// WSRequest defines a request sent by a client of the WebSocket endpoint to
+// manage its subscriptions. The query of the subscribe and unsubscribe methods
+// is a Tendermint event query on Tx events, e.g.
+// "tm.event='Tx' AND transfer.recipient='cosmos1...'".
+type WSRequest struct {
+	ID     string `json:"id"`
+	Method string `json:"method"`
+	Query  string `json:"query,omitempty"`
+}
+
+// WSResponse defines a message sent to a client of the WebSocket endpoint. It
+// is either the response to a WSRequest, with its ID and an error if the
+// request failed, or a tx matching a query the client is subscribed to, with
+// the query and the tx in the shape of an sdk.TxResponse.
+type WSResponse struct {
+	ID     string          `json:"id,omitempty"`
+	Query  string          `json:"query,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// wsNodeClient defines the part of the Tendermint RPC client used by the
+// WebSocket endpoint.
+type wsNodeClient interface {
+	rpcclient.EventsClient
+	Block(height *int64) (*ctypes.ResultBlock, error)
+}
+
+// eventHub proxies Tendermint event subscriptions to the clients of the
+// WebSocket endpoint. The node is subscribed once to each query, however many
+// clients are subscribed to it, and each event is decoded once for all of them.
+type eventHub struct {
+	cdc    *codec.Codec
+	node   wsNodeClient
+	logger log.Logger
+
+	maxSubscribers int
+
+	mtx         sync.Mutex
+	subscribers int
+	connections uint64 // connection counter, numbering the subscriber IDs
+	queries     map[string]*querySubscription
+
+	// the block time of the latest tx, as txs are delivered in block order
+	lastHeight    int64
+	lastBlockTime time.Time
+}
+
+// querySubscription holds the clients subscribed to a query, along with the
+// subscriber ID of the client which subscribed the node to it.
+type querySubscription struct {
+	subscriber string
+	clients    map[*wsClient]struct{}
+	done       chan struct{}
+}
+
+func newEventHub(cdc *codec.Codec, node wsNodeClient, maxSubscribers int, logger log.Logger) *eventHub {
+	return &eventHub{
+		cdc:            cdc,
+		node:           node,
+		logger:         logger,
+		maxSubscribers: maxSubscribers,
+		queries:        make(map[string]*querySubscription),
+	}
+}
+
+// serveHTTP upgrades a connection to the WebSocket protocol and serves the
+// requests of the client until the connection is closed. It fails with 503
+// Service Unavailable if the maximum number of subscribers is reached.
+func (h *eventHub) serveHTTP(upgrader websocket.Upgrader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !h.addSubscriber() {
+			http.Error(w, "too many WebSocket subscribers", http.StatusServiceUnavailable)
+			return
+		}
+		defer h.removeSubscriber()
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader has replied with an HTTP error
+			return
+		}
+
+		c := newWSClient(conn, h.nextSubscriberID())
+		go c.writeLoop()
+		c.readLoop(h)
+
+		h.unsubscribeAll(c)
+		c.close()
+	}
+}
+
+func (h *eventHub) addSubscriber() bool {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.maxSubscribers > 0 && h.subscribers >= h.maxSubscribers {
+		return false
+	}
+
+	h.subscribers++
+	return true
+}
+
+func (h *eventHub) removeSubscriber() {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.subscribers--
+}
+
+// nextSubscriberID returns the subscriber ID of a new connection, so that the
+// per-client subscription limit of the node applies to each connection rather
+// than to all of them.
+func (h *eventHub) nextSubscriberID() string {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.connections++
+	return fmt.Sprintf("%s-%d", wsSubscriberPrefix, h.connections)
+}
+
+// subscribe subscribes a client to a query, subscribing the node to it if the
+// client is the first one.
+func (h *eventHub) subscribe(c *wsClient, query string) error {
+	if err := validateWSQuery(query); err != nil {
+		return err
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if sub, ok := h.queries[query]; ok {
+		sub.clients[c] = struct{}{}
+		return nil
+	}
+
+	if svc, ok := h.node.(service.Service); ok && !svc.IsRunning() {
+		if err := svc.Start(); err != nil && !errors.Is(err, service.ErrAlreadyStarted) {
+			return fmt.Errorf("failed to connect to the node: %w", err)
+		}
+	}
+
+	events, err := h.node.Subscribe(context.Background(), c.subscriber, query, wsSendBuffer)
+	if err != nil {
+		return err
+	}
+
+	sub := &querySubscription{
+		subscriber: c.subscriber,
+		clients:    map[*wsClient]struct{}{c: {}},
+		done:       make(chan struct{}),
+	}
+	h.queries[query] = sub
+
+	go h.forwardEvents(query, sub, events)
+
+	return nil
+}
+
+// unsubscribe unsubscribes a client from a query, unsubscribing the node from
+// it if the client was the last one.
+func (h *eventHub) unsubscribe(c *wsClient, query string) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.removeClient(c, query)
+}
+
+// unsubscribeAll unsubscribes a client from all of its queries.
+func (h *eventHub) unsubscribeAll(c *wsClient) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	for query := range c.queries {
+		h.removeClient(c, query)
+	}
+}
+
+func (h *eventHub) removeClient(c *wsClient, query string) {
+	sub, ok := h.queries[query]
+	if !ok {
+		return
+	}
+
+	delete(sub.clients, c)
+	if len(sub.clients) > 0 {
+		return
+	}
+
+	close(sub.done)
+	delete(h.queries, query)
+
+	if err := h.node.Unsubscribe(context.Background(), sub.subscriber, query); err != nil {
+		h.logger.Error("failed to unsubscribe from the node", "query", query, "err", err)
+	}
+}
+
+// forwardEvents sends the events of a query to the clients subscribed to it.
+// A client which does not keep up with the events is disconnected, and so are
+// all the clients once the node cancels the subscription.
+func (h *eventHub) forwardEvents(query string, sub *querySubscription, events <-chan ctypes.ResultEvent) {
+	for {
+		select {
+		case <-sub.done:
+			return
+
+		case event, ok := <-events:
+			if !ok {
+				h.cancel(query, sub)
+				return
+			}
+
+			bz, err := h.formatEvent(query, event)
+			if err != nil {
+				h.logger.Error("failed to format event", "query", query, "err", err)
+				continue
+			}
+
+			h.mtx.Lock()
+			for c := range sub.clients {
+				select {
+				case c.send <- bz:
+				default:
+					h.logger.Info("disconnecting slow WebSocket subscriber", "query", query)
+					c.close()
+				}
+			}
+			h.mtx.Unlock()
+		}
+	}
+}
+
+// cancel drops a subscription the node has cancelled and disconnects its
+// clients, which may subscribe again on a new connection.
+func (h *eventHub) cancel(query string, sub *querySubscription) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.queries[query] == sub {
+		delete(h.queries, query)
+	}
+
+	h.logger.Info("node cancelled the subscription, disconnecting its WebSocket subscribers", "query", query)
+	for c := range sub.clients {
+		c.close()
+	}
+}
+
+// formatEvent encodes a Tx event into a WSResponse, with the tx in the same
+// JSON shape as the txs returned by the REST endpoints.
+func (h *eventHub) formatEvent(query string, event ctypes.ResultEvent) ([]byte, error) {
+	data, ok := event.Data.(tmtypes.EventDataTx)
+	if !ok {
+		return nil, fmt.Errorf("unexpected event data %T", event.Data)
+	}
+
+	var tx sdk.Tx
+	if err := h.cdc.UnmarshalBinaryBare(data.Tx, &tx); err != nil {
+		return nil, err
+	}
+
+	blockTime, err := h.blockTime(data.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	resTx := &ctypes.ResultTx{
+		Hash:     data.Tx.Hash(),
+		Height:   data.Height,
+		Index:    data.Index,
+		TxResult: data.Result,
+		Tx:       data.Tx,
+	}
+	txRes := sdk.NewResponseResultTx(resTx, tx, blockTime.Format(time.RFC3339))
+
+	result, err := h.cdc.MarshalJSON(txRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(WSResponse{Query: query, Result: result})
+}
+
+func (h *eventHub) blockTime(height int64) (time.Time, error) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if height == h.lastHeight {
+		return h.lastBlockTime, nil
+	}
+
+	block, err := h.node.Block(&height)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	h.lastHeight, h.lastBlockTime = height, block.Block.Time
+	return h.lastBlockTime, nil
+}
+
+// validateWSQuery checks that a query is a valid Tendermint event query on Tx
+// events.
+func validateWSQuery(query string) error {
+	q, err := tmquery.New(query)
+	if err != nil {
+		return fmt.Errorf("invalid query: %w", err)
+	}
+
+	conditions, err := q.Conditions()
+	if err != nil {
+		return fmt.Errorf("invalid query: %w", err)
+	}
+
+	for _, cond := range conditions {
+		if cond.CompositeKey == tmtypes.EventTypeKey && cond.Op == tmquery.OpEqual && cond.Operand == tmtypes.EventTx {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("query must select Tx events with %s='%s'", tmtypes.EventTypeKey, tmtypes.EventTx)
+}
+
+// wsClient is a client connected to the WebSocket endpoint.
+type wsClient struct {
+	conn       *websocket.Conn
+	subscriber string
+	send       chan []byte
+
+	// queries is only accessed by the read loop and, once it returns, by the
+	// unsubscription of the client
+	queries map[string]struct{}
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+func newWSClient(conn *websocket.Conn, subscriber string) *wsClient {
+	return &wsClient{
+		conn:       conn,
+		subscriber: subscriber,
+		send:       make(chan []byte, wsSendBuffer),
+		queries:    make(map[string]struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// close closes the connection, which stops the read and write loops.
+func (c *wsClient) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.conn.Close()
+	})
+}
+
+// readLoop handles the requests of the client until the connection is closed.
+func (c *wsClient) readLoop(h *eventHub) {
+	_ = c.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	})
+
+	for {
+		_, bz, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var req WSRequest
+		if err := json.Unmarshal(bz, &req); err != nil {
+			c.reply(WSResponse{Error: fmt.Sprintf("invalid request: %s", err)})
+			continue
+		}
+
+		res := WSResponse{ID: req.ID}
+		if err := c.handleRequest(h, req); err != nil {
+			res.Error = err.Error()
+		}
+		c.reply(res)
+	}
+}
+
+func (c *wsClient) handleRequest(h *eventHub, req WSRequest) error {
+	switch req.Method {
+	case WSMethodSubscribe:
+		if _, ok := c.queries[req.Query]; ok {
+			return fmt.Errorf("already subscribed to %s", req.Query)
+		}
+		if len(c.queries) >= WSMaxQueries {
+			return fmt.Errorf("cannot subscribe to more than %d queries", WSMaxQueries)
+		}
+		if err := h.subscribe(c, req.Query); err != nil {
+			return err
+		}
+		c.queries[req.Query] = struct{}{}
+
+	case WSMethodUnsubscribe:
+		if _, ok := c.queries[req.Query]; !ok {
+			return fmt.Errorf("not subscribed to %s", req.Query)
+		}
+		h.unsubscribe(c, req.Query)
+		delete(c.queries, req.Query)
+
+	case WSMethodUnsubscribeAll:
+		h.unsubscribeAll(c)
+		c.queries = make(map[string]struct{})
+
+	default:
+		return fmt.Errorf("unknown method %q", req.Method)
+	}
+
+	return nil
+}
+
+// reply queues a response to a request of the client, dropping the client if
+// its send buffer is full.
+func (c *wsClient) reply(res WSResponse) {
+	bz, err := json.Marshal(res)
+	if err != nil {
+		panic(err)
+	}
+
+	select {
+	case c.send <- bz:
+	default:
+		c.close()
+	}
+}
+
+// writeLoop writes the queued messages to the connection and pings the client
+// until the connection is closed.
+func (c *wsClient) writeLoop() {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+
+		case bz := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := c.conn.WriteMessage(websocket.TextMessage, bz); err != nil {
+				c.close()
+				return
+			}
+
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				c.close()
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package lcd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// nodeMock is a node client with a subscription per query, whose events are
+// published by the tests.
+type nodeMock struct {
+	mtx           sync.Mutex
+	subscriptions map[string]chan ctypes.ResultEvent
+	subscribers   map[string]string // by query
+	blockTime     time.Time
+}
+
+func newNodeMock() *nodeMock {
+	return &nodeMock{
+		subscriptions: make(map[string]chan ctypes.ResultEvent),
+		subscribers:   make(map[string]string),
+		blockTime:     time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func (n *nodeMock) Subscribe(_ context.Context, subscriber, query string, outCapacity ...int) (<-chan ctypes.ResultEvent, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	out := make(chan ctypes.ResultEvent, outCapacity[0])
+	n.subscriptions[query] = out
+	n.subscribers[query] = subscriber
+	return out, nil
+}
+
+func (n *nodeMock) Unsubscribe(_ context.Context, subscriber, query string) error {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	if n.subscribers[query] != subscriber {
+		return fmt.Errorf("%s is not subscribed to %s", subscriber, query)
+	}
+
+	delete(n.subscriptions, query)
+	delete(n.subscribers, query)
+	return nil
+}
+
+func (n *nodeMock) UnsubscribeAll(context.Context, string) error {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	n.subscriptions = make(map[string]chan ctypes.ResultEvent)
+	n.subscribers = make(map[string]string)
+	return nil
+}
+
+func (n *nodeMock) Block(height *int64) (*ctypes.ResultBlock, error) {
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: *height, Time: n.blockTime}}
+	return &ctypes.ResultBlock{Block: block}, nil
+}
+
+func (n *nodeMock) subscribed(query string) bool {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	_, ok := n.subscriptions[query]
+	return ok
+}
+
+func (n *nodeMock) subscriber(query string) string {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	return n.subscribers[query]
+}
+
+// cancel cancels a subscription, as the node does when the subscriber does not
+// keep up with the events.
+func (n *nodeMock) cancel(query string) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	close(n.subscriptions[query])
+	delete(n.subscriptions, query)
+	delete(n.subscribers, query)
+}
+
+func (n *nodeMock) publish(query string, data tmtypes.EventDataTx) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	n.subscriptions[query] <- ctypes.ResultEvent{Query: query, Data: data}
+}
+
+func setupWebSocket(t *testing.T, maxSubscribers int) (*codec.Codec, *nodeMock, string) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	authtypes.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	node := newNodeMock()
+	hub := newEventHub(cdc, node, maxSubscribers, log.NewNopLogger())
+
+	server := httptest.NewServer(hub.serveHTTP(websocket.Upgrader{}))
+	t.Cleanup(server.Close)
+
+	return cdc, node, "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func dialWebSocket(t *testing.T, url string) *websocket.Conn {
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func request(t *testing.T, conn *websocket.Conn, req WSRequest) WSResponse {
+	require.NoError(t, conn.WriteJSON(req))
+
+	var res WSResponse
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Equal(t, req.ID, res.ID)
+
+	return res
+}
+
+func TestWebSocketSubscribe(t *testing.T) {
+	cdc, node, url := setupWebSocket(t, 0)
+	conn := dialWebSocket(t, url)
+
+	query := "tm.event='Tx' AND transfer.recipient='cosmos1recipient'"
+	res := request(t, conn, WSRequest{ID: "1", Method: WSMethodSubscribe, Query: query})
+	require.Empty(t, res.Error)
+	require.True(t, node.subscribed(query))
+
+	res = request(t, conn, WSRequest{ID: "2", Method: WSMethodSubscribe, Query: query})
+	require.Contains(t, res.Error, "already subscribed")
+
+	tx := authtypes.NewStdTx(nil, authtypes.NewStdFee(50000, nil), nil, "memo")
+	txBytes := cdc.MustMarshalBinaryBare(tx)
+	node.publish(query, tmtypes.EventDataTx{TxResult: tmtypes.TxResult{
+		Height: 10,
+		Tx:     txBytes,
+		Result: abci.ResponseDeliverTx{GasWanted: 50000, GasUsed: 42000},
+	}})
+
+	var event WSResponse
+	require.NoError(t, conn.ReadJSON(&event))
+	require.Equal(t, query, event.Query)
+
+	var txRes sdk.TxResponse
+	require.NoError(t, cdc.UnmarshalJSON(event.Result, &txRes))
+	require.Equal(t, fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash()), txRes.TxHash)
+	require.Equal(t, int64(10), txRes.Height)
+	require.Equal(t, int64(42000), txRes.GasUsed)
+	require.Equal(t, "2020-04-01T12:00:00Z", txRes.Timestamp)
+	require.Equal(t, tx, txRes.Tx)
+
+	res = request(t, conn, WSRequest{ID: "3", Method: WSMethodUnsubscribe, Query: query})
+	require.Empty(t, res.Error)
+	require.False(t, node.subscribed(query))
+
+	res = request(t, conn, WSRequest{ID: "4", Method: WSMethodUnsubscribe, Query: query})
+	require.Contains(t, res.Error, "not subscribed")
+}
+
+func TestWebSocketSharedSubscription(t *testing.T) {
+	_, node, url := setupWebSocket(t, 0)
+	conn1 := dialWebSocket(t, url)
+	conn2 := dialWebSocket(t, url)
+
+	query := "tm.event='Tx'"
+	require.Empty(t, request(t, conn1, WSRequest{ID: "1", Method: WSMethodSubscribe, Query: query}).Error)
+	require.Empty(t, request(t, conn2, WSRequest{ID: "1", Method: WSMethodSubscribe, Query: query}).Error)
+
+	// the node stays subscribed until the last client leaves
+	require.Empty(t, request(t, conn1, WSRequest{ID: "2", Method: WSMethodUnsubscribeAll}).Error)
+	require.True(t, node.subscribed(query))
+
+	conn2.Close()
+	require.Eventually(t, func() bool { return !node.subscribed(query) }, time.Second, 10*time.Millisecond)
+}
+
+func TestWebSocketSubscriberPerConnection(t *testing.T) {
+	_, node, url := setupWebSocket(t, 0)
+	conn1 := dialWebSocket(t, url)
+	conn2 := dialWebSocket(t, url)
+
+	query1, query2 := "tm.event='Tx' AND message.sender='cosmos1a'", "tm.event='Tx' AND message.sender='cosmos1b'"
+	require.Empty(t, request(t, conn1, WSRequest{ID: "1", Method: WSMethodSubscribe, Query: query1}).Error)
+	require.Empty(t, request(t, conn2, WSRequest{ID: "1", Method: WSMethodSubscribe, Query: query2}).Error)
+	require.NotEqual(t, node.subscriber(query1), node.subscriber(query2))
+
+	require.Empty(t, request(t, conn1, WSRequest{ID: "2", Method: WSMethodUnsubscribe, Query: query1}).Error)
+	require.False(t, node.subscribed(query1))
+	require.True(t, node.subscribed(query2))
+}
+
+func TestWebSocketCancelledSubscription(t *testing.T) {
+	_, node, url := setupWebSocket(t, 0)
+	conn := dialWebSocket(t, url)
+
+	query := "tm.event='Tx'"
+	require.Empty(t, request(t, conn, WSRequest{ID: "1", Method: WSMethodSubscribe, Query: query}).Error)
+
+	// the client is disconnected once the node cancels the subscription
+	node.cancel(query)
+	_, _, err := conn.ReadMessage()
+	require.Error(t, err)
+
+	// and may subscribe again on a new connection
+	conn = dialWebSocket(t, url)
+	require.Empty(t, request(t, conn, WSRequest{ID: "1", Method: WSMethodSubscribe, Query: query}).Error)
+	require.True(t, node.subscribed(query))
+}
+
+func TestWebSocketInvalidRequests(t *testing.T) {
+	_, _, url := setupWebSocket(t, 0)
+	conn := dialWebSocket(t, url)
+
+	testCases := []struct {
+		name string
+		req  WSRequest
+	}{
+		{"unknown method", WSRequest{ID: "1", Method: "foo"}},
+		{"invalid query", WSRequest{ID: "2", Method: WSMethodSubscribe, Query: "tm.event=="}},
+		{"not a tx query", WSRequest{ID: "3", Method: WSMethodSubscribe, Query: "tm.event='NewBlock'"}},
+	}
+
+	for _, tc := range testCases {
+		res := request(t, conn, tc.req)
+		require.NotEmpty(t, res.Error, tc.name)
+	}
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("{")))
+	var res WSResponse
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Contains(t, res.Error, "invalid request")
+}
+
+func TestWebSocketMaxQueries(t *testing.T) {
+	_, _, url := setupWebSocket(t, 0)
+	conn := dialWebSocket(t, url)
+
+	for i := 0; i < WSMaxQueries; i++ {
+		query := fmt.Sprintf("tm.event='Tx' AND tx.height=%d", i)
+		require.Empty(t, request(t, conn, WSRequest{ID: "1", Method: WSMethodSubscribe, Query: query}).Error)
+	}
+
+	res := request(t, conn, WSRequest{ID: "2", Method: WSMethodSubscribe, Query: "tm.event='Tx' AND tx.height=100"})
+	require.Contains(t, res.Error, "cannot subscribe")
+}
+
+func TestWebSocketMaxSubscribers(t *testing.T) {
+	_, _, url := setupWebSocket(t, 1)
+	conn := dialWebSocket(t, url)
+
+	_, res, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Error(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+
+	// the subscriber slot is released when the connection is closed
+	conn.Close()
+	require.Eventually(t, func() bool {
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}, time.Second, 10*time.Millisecond)
+}
@@ -1,6 +1,7 @@
 package tx
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/spf13/viper"
@@ -8,6 +9,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // AccountRetriever defines the interfaces required for use by the Factory to
@@ -33,6 +36,8 @@ type Factory struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	signMode           types.SignMode
+	signModeHandler    signing.SignModeHandler
 }
 
 func NewFactoryFromCLI(input io.Reader) Factory {
@@ -60,6 +65,18 @@ func NewFactoryFromCLI(input io.Reader) Factory {
 	f = f.WithFees(viper.GetString(flags.FlagFees))
 	f = f.WithGasPrices(viper.GetString(flags.FlagGasPrices))
 
+	switch signMode := viper.GetString(flags.FlagSignMode); signMode {
+	case "":
+	case flags.SignModeDirect:
+		f = f.WithSignMode(types.SignModeDirect)
+	case flags.SignModeLegacyAminoJSON:
+		f = f.WithSignMode(types.SignModeLegacyAminoJSON)
+	case flags.SignModeTextual:
+		f = f.WithSignMode(types.SignModeTextual)
+	default:
+		panic(fmt.Errorf("invalid sign mode %s", signMode))
+	}
+
 	return f
 }
 
@@ -74,6 +91,20 @@ func (f Factory) Memo() string                       { return f.memo }
 func (f Factory) Fees() sdk.Coins                    { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins            { return f.gasPrices }
 func (f Factory) AccountRetriever() AccountRetriever { return f.accountRetriever }
+func (f Factory) SignMode() types.SignMode           { return f.signMode }
+
+// SignModeHandler returns the handler of the sign modes the Factory signs in,
+// which defaults to the SignModeHandler of its Generator.
+func (f Factory) SignModeHandler() signing.SignModeHandler {
+	switch {
+	case f.signModeHandler != nil:
+		return f.signModeHandler
+	case f.txGenerator != nil:
+		return f.txGenerator.SignModeHandler()
+	default:
+		return signing.DefaultSignModeHandler()
+	}
+}
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -155,6 +186,20 @@ func (f Factory) WithGasAdjustment(gasAdj float64) Factory {
 	return f
 }
 
+// WithSignMode returns a copy of the Factory with an updated sign mode. The
+// default mode of the SignModeHandler is used if it is unspecified.
+func (f Factory) WithSignMode(mode types.SignMode) Factory {
+	f.signMode = mode
+	return f
+}
+
+// WithSignModeHandler returns a copy of the Factory with an updated
+// SignModeHandler, which apps can use to sign in their own sign modes.
+func (f Factory) WithSignModeHandler(handler signing.SignModeHandler) Factory {
+	f.signModeHandler = handler
+	return f
+}
+
 // WithSimulateAndExecute returns a copy of the Factory with an updated gas
 // simulation value.
 func (f Factory) WithSimulateAndExecute(sim bool) Factory {
@@ -2,6 +2,8 @@ package tx
 
 import (
 	"bufio"
+	"bytes"
+	gocontext "context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -9,32 +11,38 @@ import (
 	"strings"
 
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	clientkeys "github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 type (
 	// Generator defines an interface a client can utilize to generate an
 	// application-defined concrete transaction type. The type returned must
-	// implement ClientTx.
+	// implement ClientTx. The Generator also provides the handler of the sign
+	// modes of the application, e.g. to sign in SIGN_MODE_TEXTUAL with the
+	// renderers of its modules.
 	Generator interface {
 		NewTx() ClientTx
+		SignModeHandler() signing.SignModeHandler
 	}
 
 	// ClientTx defines an interface which an application-defined concrete transaction
-	// type must implement. Namely, it must be able to set messages, generate
-	// signatures, and provide canonical bytes to sign over. The transaction must
-	// also know how to encode itself.
+	// type must implement. Namely, it must be able to set messages and generate
+	// signatures, and be signable by the sign mode handlers, which provide the
+	// bytes to sign over. The transaction must also know how to encode itself.
 	ClientTx interface {
-		sdk.Tx
+		signing.Tx
 		codec.ProtoMarshaler
 
 		SetMsgs(...sdk.Msg) error
@@ -42,14 +50,7 @@ type (
 		SetSignatures(...sdk.Signature)
 		GetFee() sdk.Fee
 		SetFee(sdk.Fee)
-		GetMemo() string
 		SetMemo(string)
-
-		// CanonicalSignBytes returns the canonical JSON bytes to sign over, given a
-		// chain ID, along with an account and sequence number. The JSON encoding
-		// ensures all field names adhere to their proto definition, default values
-		// are omitted, and follows the JSON Canonical Form.
-		CanonicalSignBytes(cid string, num, seq uint64) ([]byte, error)
 	}
 )
 
@@ -314,33 +315,145 @@ func PrepareFactory(ctx context.CLIContext, txf Factory) (Factory, error) {
 	return txf, nil
 }
 
-// Sign signs a given tx with the provided name and passphrase. If the Factory's
-// Keybase is not set, a new one will be created based on the client's backend.
-// The bytes signed over are canconical. The resulting signature will be set on
-// the transaction. Finally, the marshaled transaction is returned. An error is
-// returned upon failure.
+// Sign signs a given tx with the provided name and passphrase. The Factory's
+// Keybase must be set. The bytes signed over are returned by the Factory's
+// SignModeHandler for its sign mode, which defaults to SIGN_MODE_TEXTUAL for
+// Ledger keys, whose devices show its human-readable rendering, if the handler
+// supports it and to the default mode of the handler otherwise. The resulting
+// signature, along with its sign mode, will be set on the transaction at the
+// position of the key among the signers. Finally, the marshaled transaction is
+// returned. An error is returned upon failure.
+//
+// The other signers of a transaction with several signers must have their
+// signer infos set by SetSignerInfos before the first of them signs, as every
+// signer signs over the signer infos of all the signers in SIGN_MODE_DIRECT.
+// The sign mode set there for the key is then the one it signs in.
 //
 // Note, It is assumed the Factory has the necessary fields set that are required
-// by the CanonicalSignBytes call.
+// by the SignModeHandler, namely the chain ID, account number and sequence.
 func Sign(txf Factory, name, passphrase string, tx ClientTx) ([]byte, error) {
 	if txf.keybase == nil {
 		return nil, errors.New("keybase must be set prior to signing a transaction")
 	}
 
-	signBytes, err := tx.CanonicalSignBytes(txf.chainID, txf.accountNumber, txf.sequence)
+	key, err := txf.keybase.Key(name)
 	if err != nil {
 		return nil, err
 	}
 
-	sigBytes, pubkey, err := txf.keybase.Sign(name, signBytes)
+	signers := auth.StdTx{Msgs: tx.GetMsgs()}.GetSigners()
+	i := signerIndex(signers, key.GetAddress())
+	if i < 0 {
+		return nil, fmt.Errorf("%s is not a signer of the transaction", key.GetAddress())
+	}
+
+	sigs := tx.GetStdSignatures()
+	if len(sigs) != len(signers) {
+		sigs = make([]auth.StdSignature, len(signers))
+	}
+
+	signModeHandler := txf.SignModeHandler()
+	signMode := txf.signMode
+
+	// The signer info of the key is kept if it was set beforehand, as the other
+	// signers may have signed over it.
+	if len(sigs[i].PubKey) > 0 {
+		if !bytes.Equal(sigs[i].PubKey, key.GetPubKey().Bytes()) {
+			return nil, fmt.Errorf("the signer info of %s holds another public key", key.GetAddress())
+		}
+		if signMode != types.SignModeUnspecified && signMode != sigs[i].GetSignMode() {
+			return nil, fmt.Errorf("the signer info of %s holds the sign mode %s", key.GetAddress(), sigs[i].GetSignMode())
+		}
+
+		signMode = sigs[i].GetSignMode()
+	} else if signMode == types.SignModeUnspecified {
+		signMode = defaultSignMode(signModeHandler, key)
+	}
+
+	// The public key and sign mode are set before signing, as they are signed
+	// over in SignModeDirect.
+	sigs[i] = auth.StdSignature{PubKey: key.GetPubKey().Bytes(), SignMode: signMode}
+	setStdSignatures(tx, sigs)
+
+	signerData := signing.SignerData{
+		ChainID:       txf.chainID,
+		AccountNumber: txf.accountNumber,
+		Sequence:      txf.sequence,
+	}
+
+	signBytes, err := signing.GetSignBytesWithContext(gocontext.Background(), signModeHandler, signMode, signerData, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the keyring sends the sign bytes of Ledger keys, e.g. the TextualSignDoc of
+	// SIGN_MODE_TEXTUAL, to the device
+	sigs[i].Signature, _, err = txf.keybase.Sign(name, signBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	tx.SetSignatures(auth.NewStdSignature(pubkey, sigBytes))
+	setStdSignatures(tx, sigs)
 	return tx.Marshal()
 }
 
+// SetSignerInfos sets the public keys and sign modes of all the signers of a
+// transaction, in the order of its signers, without any signature. They must
+// be set before the first signer signs a transaction with several signers, as
+// every signer signs over all of them in SIGN_MODE_DIRECT.
+func SetSignerInfos(tx ClientTx, pubKeys []crypto.PubKey, signModes []types.SignMode) error {
+	signers := auth.StdTx{Msgs: tx.GetMsgs()}.GetSigners()
+	if len(pubKeys) != len(signers) || len(signModes) != len(signers) {
+		return fmt.Errorf(
+			"expected the public keys and sign modes of %d signers, got %d and %d", len(signers), len(pubKeys), len(signModes),
+		)
+	}
+
+	sigs := make([]auth.StdSignature, len(signers))
+	for i, pubKey := range pubKeys {
+		if !bytes.Equal(pubKey.Address(), signers[i]) {
+			return fmt.Errorf("public key %d does not match signer %s", i, signers[i])
+		}
+
+		sigs[i] = auth.StdSignature{PubKey: pubKey.Bytes(), SignMode: signModes[i]}
+	}
+
+	setStdSignatures(tx, sigs)
+	return nil
+}
+
+func setStdSignatures(tx ClientTx, sigs []auth.StdSignature) {
+	sdkSigs := make([]sdk.Signature, len(sigs))
+	for i, sig := range sigs {
+		sdkSigs[i] = sig
+	}
+
+	tx.SetSignatures(sdkSigs...)
+}
+
+func signerIndex(signers []sdk.AccAddress, addr sdk.AccAddress) int {
+	for i, signer := range signers {
+		if signer.Equals(addr) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// defaultSignMode returns the mode a key signs in when none is requested.
+func defaultSignMode(handler signing.SignModeHandler, key keyring.Info) types.SignMode {
+	if key.GetType() == keyring.TypeLedger {
+		for _, mode := range handler.Modes() {
+			if mode == types.SignModeTextual {
+				return mode
+			}
+		}
+	}
+
+	return handler.DefaultMode()
+}
+
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
 	GasEstimate uint64 `json:"gas_estimate" yaml:"gas_estimate"`
@@ -5,12 +5,17 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/std"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
@@ -105,3 +110,129 @@ func TestBuildUnsignedTx(t *testing.T) {
 	require.NotNil(t, tx)
 	require.Equal(t, []sdk.Signature{}, tx.GetSignatures())
 }
+
+func TestSign(t *testing.T) {
+	kr := keyring.NewInMemory()
+	info, _, err := kr.NewMnemonic("signer", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	msg := bank.NewMsgSend(info.GetAddress(), sdk.AccAddress("to"), nil)
+	txg := std.NewTxGenerator(signing.NewHandlerMap(
+		auth.SignModeLegacyAminoJSON,
+		append(signing.DefaultSignModeHandlers(), textual.NewSignModeHandler(textual.NewRegistry()))...,
+	))
+
+	testCases := []struct {
+		name     string
+		signMode auth.SignMode
+		expMode  auth.SignMode
+	}{
+		{"default sign mode", auth.SignModeUnspecified, auth.SignModeLegacyAminoJSON},
+		{"direct", auth.SignModeDirect, auth.SignModeDirect},
+		{"legacy amino json", auth.SignModeLegacyAminoJSON, auth.SignModeLegacyAminoJSON},
+		{"textual", auth.SignModeTextual, auth.SignModeTextual},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			txf := tx.Factory{}.
+				WithTxGenerator(txg).
+				WithKeybase(kr).
+				WithAccountNumber(50).
+				WithSequence(23).
+				WithFees("50stake").
+				WithMemo("memo").
+				WithChainID("test-chain").
+				WithSignMode(tc.signMode)
+
+			unsignedTx, err := tx.BuildUnsignedTx(txf, msg)
+			require.NoError(t, err)
+
+			bz, err := tx.Sign(txf, "signer", "", unsignedTx)
+			require.NoError(t, err)
+
+			signedTx := &std.Transaction{}
+			require.NoError(t, signedTx.Unmarshal(bz))
+
+			sigs := signedTx.GetStdSignatures()
+			require.Len(t, sigs, 1)
+			require.Equal(t, tc.expMode, sigs[0].SignMode)
+
+			signerData := signing.SignerData{ChainID: "test-chain", AccountNumber: 50, Sequence: 23}
+			signBytes, err := txf.SignModeHandler().GetSignBytes(tc.expMode, signerData, signedTx)
+			require.NoError(t, err)
+			require.True(t, info.GetPubKey().VerifyBytes(signBytes, sigs[0].Signature))
+		})
+	}
+}
+
+func TestSignMultipleSigners(t *testing.T) {
+	kr := keyring.NewInMemory()
+	info1, _, err := kr.NewMnemonic("signer1", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	info2, _, err := kr.NewMnemonic("signer2", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	msg := bank.NewMsgMultiSend(
+		[]bank.Input{bank.NewInput(info1.GetAddress(), coins), bank.NewInput(info2.GetAddress(), coins)},
+		[]bank.Output{bank.NewOutput(sdk.AccAddress("to"), coins.Add(coins...))},
+	)
+
+	txf := tx.Factory{}.
+		WithTxGenerator(std.NewTxGenerator(signing.DefaultSignModeHandler())).
+		WithKeybase(kr).
+		WithFees("50stake").
+		WithChainID("test-chain").
+		WithSignMode(auth.SignModeDirect)
+	txf1 := txf.WithAccountNumber(1).WithSequence(4)
+	txf2 := txf.WithAccountNumber(2).WithSequence(7)
+
+	unsignedTx, err := tx.BuildUnsignedTx(txf, msg)
+	require.NoError(t, err)
+
+	// the signer infos of the other signers must be set before signing
+	_, err = tx.Sign(txf1, "signer1", "", unsignedTx)
+	require.Error(t, err)
+
+	pubKeys := []crypto.PubKey{info1.GetPubKey(), info2.GetPubKey()}
+	require.Error(t, tx.SetSignerInfos(unsignedTx, pubKeys[:1], []auth.SignMode{auth.SignModeDirect}))
+	require.Error(t, tx.SetSignerInfos(
+		unsignedTx, []crypto.PubKey{info2.GetPubKey(), info1.GetPubKey()}, []auth.SignMode{auth.SignModeDirect, auth.SignModeDirect},
+	))
+	require.NoError(t, tx.SetSignerInfos(
+		unsignedTx, pubKeys, []auth.SignMode{auth.SignModeDirect, auth.SignModeLegacyAminoJSON},
+	))
+
+	// the sign mode of a signer is the one of its signer info
+	_, err = tx.Sign(txf2, "signer2", "", unsignedTx)
+	require.Error(t, err)
+
+	bz, err := tx.Sign(txf1, "signer1", "", unsignedTx)
+	require.NoError(t, err)
+
+	partiallySignedTx := &std.Transaction{}
+	require.NoError(t, partiallySignedTx.Unmarshal(bz))
+
+	bz, err = tx.Sign(txf2.WithSignMode(auth.SignModeUnspecified), "signer2", "", partiallySignedTx)
+	require.NoError(t, err)
+
+	signedTx := &std.Transaction{}
+	require.NoError(t, signedTx.Unmarshal(bz))
+
+	// both signatures are over the final tx
+	sigs := signedTx.GetStdSignatures()
+	require.Len(t, sigs, 2)
+	require.Equal(t, auth.SignModeDirect, sigs[0].SignMode)
+	require.Equal(t, auth.SignModeLegacyAminoJSON, sigs[1].SignMode)
+
+	for i, signerData := range []signing.SignerData{
+		{ChainID: "test-chain", AccountNumber: 1, Sequence: 4},
+		{ChainID: "test-chain", AccountNumber: 2, Sequence: 7},
+	} {
+		signBytes, err := txf.SignModeHandler().GetSignBytes(sigs[i].SignMode, signerData, signedTx)
+		require.NoError(t, err)
+		require.True(t, pubKeys[i].VerifyBytes(signBytes, sigs[i].Signature))
+	}
+}
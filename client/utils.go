@@ -1,5 +1,15 @@
 package client
 
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
 // Paginate returns the correct starting and ending index for a paginated query,
 // given that client provides a desired page and limit of objects and the handler
 // provides the total number of objects. If the start page is invalid, non-positive
@@ -28,3 +38,48 @@ func Paginate(numObjs, page, limit, defLimit int) (start, end int) {
 
 	return start, end
 }
+
+// ReadPageRequest reads the page request of a paginated query from the
+// pagination flags. The page key is base64 encoded, as the next_key of a page
+// response, and a page other than the first one sets the offset to a multiple
+// of the limit, which defaults to query.DefaultLimit and cannot exceed
+// query.MaxLimit.
+func ReadPageRequest() (*query.PageRequest, error) {
+	var (
+		pageKey    = viper.GetString(flags.FlagPageKey)
+		offset     = viper.GetUint64(flags.FlagOffset)
+		limit      = viper.GetUint64(flags.FlagLimit)
+		countTotal = viper.GetBool(flags.FlagCountTotal)
+		page       = viper.GetUint64(flags.FlagPage)
+	)
+
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	if limit > query.MaxLimit {
+		return nil, fmt.Errorf("--%s cannot exceed %d", flags.FlagLimit, query.MaxLimit)
+	}
+
+	if page > 1 {
+		if offset > 0 {
+			return nil, fmt.Errorf("--%s and --%s cannot be used together", flags.FlagPage, flags.FlagOffset)
+		}
+		offset = (page - 1) * limit
+	}
+
+	var key []byte
+	if pageKey != "" {
+		var err error
+		key, err = base64.StdEncoding.DecodeString(pageKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s %s: %w", flags.FlagPageKey, pageKey, err)
+		}
+	}
+
+	return &query.PageRequest{
+		Key:        key,
+		Offset:     offset,
+		Limit:      limit,
+		CountTotal: countTotal,
+	}, nil
+}
@@ -3,9 +3,13 @@ package client_test
 import (
 	"testing"
 
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func TestPaginate(t *testing.T) {
@@ -60,3 +64,49 @@ func TestPaginate(t *testing.T) {
 		})
 	}
 }
+
+func TestReadPageRequest(t *testing.T) {
+	defer viper.Reset()
+
+	cmd := &cobra.Command{}
+	flags.AddPaginationFlagsToCmd(cmd, "things")
+	require.NoError(t, viper.BindPFlags(cmd.Flags()))
+
+	req, err := client.ReadPageRequest()
+	require.NoError(t, err)
+	require.Equal(t, &query.PageRequest{Limit: 100}, req)
+
+	viper.Set(flags.FlagPage, 3)
+	viper.Set(flags.FlagLimit, 20)
+	viper.Set(flags.FlagCountTotal, true)
+	req, err = client.ReadPageRequest()
+	require.NoError(t, err)
+	require.Equal(t, &query.PageRequest{Offset: 40, Limit: 20, CountTotal: true}, req)
+
+	viper.Set(flags.FlagOffset, 10)
+	_, err = client.ReadPageRequest()
+	require.Error(t, err)
+
+	// the offset of a page is computed with the default limit
+	viper.Set(flags.FlagOffset, 0)
+	viper.Set(flags.FlagLimit, 0)
+	req, err = client.ReadPageRequest()
+	require.NoError(t, err)
+	require.Equal(t, &query.PageRequest{Offset: 2 * query.DefaultLimit, Limit: query.DefaultLimit, CountTotal: true}, req)
+
+	viper.Set(flags.FlagLimit, query.MaxLimit+1)
+	_, err = client.ReadPageRequest()
+	require.Error(t, err)
+	viper.Set(flags.FlagLimit, 20)
+
+	viper.Set(flags.FlagPage, 1)
+	viper.Set(flags.FlagOffset, 0)
+	viper.Set(flags.FlagPageKey, "AAE=")
+	req, err = client.ReadPageRequest()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 1}, req.Key)
+
+	viper.Set(flags.FlagPageKey, "not base64")
+	_, err = client.ReadPageRequest()
+	require.Error(t, err)
+}
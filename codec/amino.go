@@ -8,6 +8,8 @@ import (
 	amino "github.com/tendermint/go-amino"
 	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 )
 
 // Cdc defines a global generic sealed Amino codec to be used throughout sdk. It
@@ -31,9 +33,10 @@ func New() *Codec {
 }
 
 // RegisterCrypto registers all crypto dependency types with the provided Amino
-// codec.
+// codec, including the secp256r1 keys of the SDK.
 func RegisterCrypto(cdc *Codec) {
 	cryptoamino.RegisterAmino(cdc)
+	secp256r1.RegisterAmino(cdc)
 }
 
 // RegisterEvidences registers Tendermint evidence types with the provided Amino
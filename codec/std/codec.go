@@ -1,6 +1,8 @@
 package std
 
 import (
+	"reflect"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -162,6 +164,15 @@ func (c *Codec) MarshalProposal(p gov.Proposal) ([]byte, error) {
 		return nil, err
 	}
 
+	for _, msg := range p.Msgs {
+		m := Message{}
+		if err := m.SetMsg(msg); err != nil {
+			return nil, err
+		}
+
+		proposal.Msgs = append(proposal.Msgs, m)
+	}
+
 	return c.Marshaler.MarshalBinaryBare(proposal)
 }
 
@@ -174,12 +185,31 @@ func (c *Codec) UnmarshalProposal(bz []byte) (gov.Proposal, error) {
 		return gov.Proposal{}, err
 	}
 
+	var msgs []sdk.Msg
+	for _, m := range proposal.Msgs {
+		msgs = append(msgs, msgValue(m.GetMsg()))
+	}
+
 	return gov.Proposal{
 		Content:      proposal.Content.GetContent(),
 		ProposalBase: proposal.ProposalBase,
+		Msgs:         msgs,
 	}, nil
 }
 
+// msgValue returns the value pointed to by a Msg held by a Message, as the handlers
+// of the modules expect the concrete Msgs to be values.
+func msgValue(msg sdk.Msg) sdk.Msg {
+	v := reflect.ValueOf(msg)
+	if v.Kind() == reflect.Ptr {
+		if m, ok := v.Elem().Interface().(sdk.Msg); ok {
+			return m
+		}
+	}
+
+	return msg
+}
+
 // ----------------------------------------------------------------------------
 // necessary types and interfaces registered. This codec is provided to all the
 // modules the application depends on.
@@ -51,6 +51,8 @@ type Account struct {
 	//	*Account_DelayedVestingAccount
 	//	*Account_PeriodicVestingAccount
 	//	*Account_ModuleAccount
+	//	*Account_PermanentLockedAccount
+	//	*Account_ClawbackVestingAccount
 	Sum isAccount_Sum `protobuf_oneof:"sum"`
 }
 
@@ -108,12 +110,20 @@ type Account_PeriodicVestingAccount struct {
 type Account_ModuleAccount struct {
 	ModuleAccount *types2.ModuleAccount `protobuf:"bytes,5,opt,name=module_account,json=moduleAccount,proto3,oneof" json:"module_account,omitempty"`
 }
+type Account_PermanentLockedAccount struct {
+	PermanentLockedAccount *types1.PermanentLockedAccount `protobuf:"bytes,6,opt,name=permanent_locked_account,json=permanentLockedAccount,proto3,oneof" json:"permanent_locked_account,omitempty"`
+}
+type Account_ClawbackVestingAccount struct {
+	ClawbackVestingAccount *types1.ClawbackVestingAccount `protobuf:"bytes,7,opt,name=clawback_vesting_account,json=clawbackVestingAccount,proto3,oneof" json:"clawback_vesting_account,omitempty"`
+}
 
 func (*Account_BaseAccount) isAccount_Sum()              {}
 func (*Account_ContinuousVestingAccount) isAccount_Sum() {}
 func (*Account_DelayedVestingAccount) isAccount_Sum()    {}
 func (*Account_PeriodicVestingAccount) isAccount_Sum()   {}
 func (*Account_ModuleAccount) isAccount_Sum()            {}
+func (*Account_PermanentLockedAccount) isAccount_Sum()   {}
+func (*Account_ClawbackVestingAccount) isAccount_Sum()   {}
 
 func (m *Account) GetSum() isAccount_Sum {
 	if m != nil {
@@ -157,6 +167,20 @@ func (m *Account) GetModuleAccount() *types2.ModuleAccount {
 	return nil
 }
 
+func (m *Account) GetPermanentLockedAccount() *types1.PermanentLockedAccount {
+	if x, ok := m.GetSum().(*Account_PermanentLockedAccount); ok {
+		return x.PermanentLockedAccount
+	}
+	return nil
+}
+
+func (m *Account) GetClawbackVestingAccount() *types1.ClawbackVestingAccount {
+	if x, ok := m.GetSum().(*Account_ClawbackVestingAccount); ok {
+		return x.ClawbackVestingAccount
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Account) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -165,6 +189,8 @@ func (*Account) XXX_OneofWrappers() []interface{} {
 		(*Account_DelayedVestingAccount)(nil),
 		(*Account_PeriodicVestingAccount)(nil),
 		(*Account_ModuleAccount)(nil),
+		(*Account_PermanentLockedAccount)(nil),
+		(*Account_ClawbackVestingAccount)(nil),
 	}
 }
 
@@ -366,6 +392,9 @@ var xxx_messageInfo_MsgSubmitEvidence proto.InternalMessageInfo
 type MsgSubmitProposal struct {
 	types4.MsgSubmitProposalBase `protobuf:"bytes,1,opt,name=base,proto3,embedded=base" json:"base"`
 	Content                      *Content `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// msgs are executed in order, with the governance module account as signer,
+	// once the proposal passes.
+	Msgs []Message `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -405,7 +434,8 @@ var xxx_messageInfo_MsgSubmitProposal proto.InternalMessageInfo
 // proposals.
 type Proposal struct {
 	types4.ProposalBase `protobuf:"bytes,1,opt,name=base,proto3,embedded=base" json:"base"`
-	Content             Content `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	Content             Content   `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	Msgs                []Message `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -448,6 +478,13 @@ func (m *Proposal) GetContent() Content {
 	return Content{}
 }
 
+func (m *Proposal) GetMsgs() []Message {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
 // Content defines the application-level allowed Content to be included in a
 // governance proposal.
 type Content struct {
@@ -459,6 +496,7 @@ type Content struct {
 	//	*Content_SoftwareUpgrade
 	//	*Content_CancelSoftwareUpgrade
 	//	*Content_CommunityPoolSpend
+	//	*Content_SetDenomMetadata
 	Sum isContent_Sum `protobuf_oneof:"sum"`
 }
 
@@ -517,12 +555,16 @@ type Content_CancelSoftwareUpgrade struct {
 type Content_CommunityPoolSpend struct {
 	CommunityPoolSpend *types6.CommunityPoolSpendProposal `protobuf:"bytes,5,opt,name=community_pool_spend,json=communityPoolSpend,proto3,oneof" json:"community_pool_spend,omitempty"`
 }
+type Content_SetDenomMetadata struct {
+	SetDenomMetadata *types7.SetDenomMetadataProposal `protobuf:"bytes,6,opt,name=set_denom_metadata,json=setDenomMetadata,proto3,oneof" json:"set_denom_metadata,omitempty"`
+}
 
 func (*Content_Text) isContent_Sum()                  {}
 func (*Content_ParameterChange) isContent_Sum()       {}
 func (*Content_SoftwareUpgrade) isContent_Sum()       {}
 func (*Content_CancelSoftwareUpgrade) isContent_Sum() {}
 func (*Content_CommunityPoolSpend) isContent_Sum()    {}
+func (*Content_SetDenomMetadata) isContent_Sum()      {}
 
 func (m *Content) GetSum() isContent_Sum {
 	if m != nil {
@@ -566,6 +608,13 @@ func (m *Content) GetCommunityPoolSpend() *types6.CommunityPoolSpendProposal {
 	return nil
 }
 
+func (m *Content) GetSetDenomMetadata() *types7.SetDenomMetadataProposal {
+	if x, ok := m.GetSum().(*Content_SetDenomMetadata); ok {
+		return x.SetDenomMetadata
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Content) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -574,6 +623,7 @@ func (*Content) XXX_OneofWrappers() []interface{} {
 		(*Content_SoftwareUpgrade)(nil),
 		(*Content_CancelSoftwareUpgrade)(nil),
 		(*Content_CommunityPoolSpend)(nil),
+		(*Content_SetDenomMetadata)(nil),
 	}
 }
 
@@ -635,12 +685,19 @@ type Message struct {
 	//	*Message_MsgSubmitProposal
 	//	*Message_MsgVote
 	//	*Message_MsgDeposit
+	//	*Message_MsgVoteWeighted
 	//	*Message_MsgUnjail
 	//	*Message_MsgCreateValidator
 	//	*Message_MsgEditValidator
 	//	*Message_MsgDelegate
 	//	*Message_MsgBeginRedelegate
 	//	*Message_MsgUndelegate
+	//	*Message_MsgCreateVestingAccount
+	//	*Message_MsgCreatePermanentLockedAccount
+	//	*Message_MsgCreatePeriodicVestingAccount
+	//	*Message_MsgCreateClawbackVestingAccount
+	//	*Message_MsgClawback
+	//	*Message_MsgRotatePubKey
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -679,6 +736,7 @@ var xxx_messageInfo_Message proto.InternalMessageInfo
 
 type isMessage_Sum interface {
 	isMessage_Sum()
+	Equal(interface{}) bool
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
@@ -716,6 +774,9 @@ type Message_MsgVote struct {
 type Message_MsgDeposit struct {
 	MsgDeposit *types4.MsgDeposit `protobuf:"bytes,11,opt,name=msg_deposit,json=msgDeposit,proto3,oneof" json:"msg_deposit,omitempty"`
 }
+type Message_MsgVoteWeighted struct {
+	MsgVoteWeighted *types4.MsgVoteWeighted `protobuf:"bytes,18,opt,name=msg_vote_weighted,json=msgVoteWeighted,proto3,oneof" json:"msg_vote_weighted,omitempty"`
+}
 type Message_MsgUnjail struct {
 	MsgUnjail *types9.MsgUnjail `protobuf:"bytes,12,opt,name=msg_unjail,json=msgUnjail,proto3,oneof" json:"msg_unjail,omitempty"`
 }
@@ -734,24 +795,49 @@ type Message_MsgBeginRedelegate struct {
 type Message_MsgUndelegate struct {
 	MsgUndelegate *types10.MsgUndelegate `protobuf:"bytes,17,opt,name=msg_undelegate,json=msgUndelegate,proto3,oneof" json:"msg_undelegate,omitempty"`
 }
-
-func (*Message_MsgSend) isMessage_Sum()                        {}
-func (*Message_MsgMultiSend) isMessage_Sum()                   {}
-func (*Message_MsgVerifyInvariant) isMessage_Sum()             {}
-func (*Message_MsgSetWithdrawAddress) isMessage_Sum()          {}
-func (*Message_MsgWithdrawDelegatorReward) isMessage_Sum()     {}
-func (*Message_MsgWithdrawValidatorCommission) isMessage_Sum() {}
-func (*Message_MsgFundCommunityPool) isMessage_Sum()           {}
-func (*Message_MsgSubmitEvidence) isMessage_Sum()              {}
-func (*Message_MsgSubmitProposal) isMessage_Sum()              {}
-func (*Message_MsgVote) isMessage_Sum()                        {}
-func (*Message_MsgDeposit) isMessage_Sum()                     {}
-func (*Message_MsgUnjail) isMessage_Sum()                      {}
-func (*Message_MsgCreateValidator) isMessage_Sum()             {}
-func (*Message_MsgEditValidator) isMessage_Sum()               {}
-func (*Message_MsgDelegate) isMessage_Sum()                    {}
-func (*Message_MsgBeginRedelegate) isMessage_Sum()             {}
-func (*Message_MsgUndelegate) isMessage_Sum()                  {}
+type Message_MsgCreateVestingAccount struct {
+	MsgCreateVestingAccount *types1.MsgCreateVestingAccount `protobuf:"bytes,19,opt,name=msg_create_vesting_account,json=msgCreateVestingAccount,proto3,oneof" json:"msg_create_vesting_account,omitempty"`
+}
+type Message_MsgCreatePermanentLockedAccount struct {
+	MsgCreatePermanentLockedAccount *types1.MsgCreatePermanentLockedAccount `protobuf:"bytes,20,opt,name=msg_create_permanent_locked_account,json=msgCreatePermanentLockedAccount,proto3,oneof" json:"msg_create_permanent_locked_account,omitempty"`
+}
+type Message_MsgCreatePeriodicVestingAccount struct {
+	MsgCreatePeriodicVestingAccount *types1.MsgCreatePeriodicVestingAccount `protobuf:"bytes,21,opt,name=msg_create_periodic_vesting_account,json=msgCreatePeriodicVestingAccount,proto3,oneof" json:"msg_create_periodic_vesting_account,omitempty"`
+}
+type Message_MsgCreateClawbackVestingAccount struct {
+	MsgCreateClawbackVestingAccount *types1.MsgCreateClawbackVestingAccount `protobuf:"bytes,22,opt,name=msg_create_clawback_vesting_account,json=msgCreateClawbackVestingAccount,proto3,oneof" json:"msg_create_clawback_vesting_account,omitempty"`
+}
+type Message_MsgClawback struct {
+	MsgClawback *types1.MsgClawback `protobuf:"bytes,23,opt,name=msg_clawback,json=msgClawback,proto3,oneof" json:"msg_clawback,omitempty"`
+}
+type Message_MsgRotatePubKey struct {
+	MsgRotatePubKey *types.MsgRotatePubKey `protobuf:"bytes,24,opt,name=msg_rotate_pub_key,json=msgRotatePubKey,proto3,oneof" json:"msg_rotate_pub_key,omitempty"`
+}
+
+func (*Message_MsgSend) isMessage_Sum()                         {}
+func (*Message_MsgMultiSend) isMessage_Sum()                    {}
+func (*Message_MsgVerifyInvariant) isMessage_Sum()              {}
+func (*Message_MsgSetWithdrawAddress) isMessage_Sum()           {}
+func (*Message_MsgWithdrawDelegatorReward) isMessage_Sum()      {}
+func (*Message_MsgWithdrawValidatorCommission) isMessage_Sum()  {}
+func (*Message_MsgFundCommunityPool) isMessage_Sum()            {}
+func (*Message_MsgSubmitEvidence) isMessage_Sum()               {}
+func (*Message_MsgSubmitProposal) isMessage_Sum()               {}
+func (*Message_MsgVote) isMessage_Sum()                         {}
+func (*Message_MsgDeposit) isMessage_Sum()                      {}
+func (*Message_MsgVoteWeighted) isMessage_Sum()                 {}
+func (*Message_MsgUnjail) isMessage_Sum()                       {}
+func (*Message_MsgCreateValidator) isMessage_Sum()              {}
+func (*Message_MsgEditValidator) isMessage_Sum()                {}
+func (*Message_MsgDelegate) isMessage_Sum()                     {}
+func (*Message_MsgBeginRedelegate) isMessage_Sum()              {}
+func (*Message_MsgUndelegate) isMessage_Sum()                   {}
+func (*Message_MsgCreateVestingAccount) isMessage_Sum()         {}
+func (*Message_MsgCreatePermanentLockedAccount) isMessage_Sum() {}
+func (*Message_MsgCreatePeriodicVestingAccount) isMessage_Sum() {}
+func (*Message_MsgCreateClawbackVestingAccount) isMessage_Sum() {}
+func (*Message_MsgClawback) isMessage_Sum()                     {}
+func (*Message_MsgRotatePubKey) isMessage_Sum()                 {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -837,6 +923,13 @@ func (m *Message) GetMsgDeposit() *types4.MsgDeposit {
 	return nil
 }
 
+func (m *Message) GetMsgVoteWeighted() *types4.MsgVoteWeighted {
+	if x, ok := m.GetSum().(*Message_MsgVoteWeighted); ok {
+		return x.MsgVoteWeighted
+	}
+	return nil
+}
+
 func (m *Message) GetMsgUnjail() *types9.MsgUnjail {
 	if x, ok := m.GetSum().(*Message_MsgUnjail); ok {
 		return x.MsgUnjail
@@ -879,6 +972,48 @@ func (m *Message) GetMsgUndelegate() *types10.MsgUndelegate {
 	return nil
 }
 
+func (m *Message) GetMsgCreateVestingAccount() *types1.MsgCreateVestingAccount {
+	if x, ok := m.GetSum().(*Message_MsgCreateVestingAccount); ok {
+		return x.MsgCreateVestingAccount
+	}
+	return nil
+}
+
+func (m *Message) GetMsgCreatePermanentLockedAccount() *types1.MsgCreatePermanentLockedAccount {
+	if x, ok := m.GetSum().(*Message_MsgCreatePermanentLockedAccount); ok {
+		return x.MsgCreatePermanentLockedAccount
+	}
+	return nil
+}
+
+func (m *Message) GetMsgCreatePeriodicVestingAccount() *types1.MsgCreatePeriodicVestingAccount {
+	if x, ok := m.GetSum().(*Message_MsgCreatePeriodicVestingAccount); ok {
+		return x.MsgCreatePeriodicVestingAccount
+	}
+	return nil
+}
+
+func (m *Message) GetMsgCreateClawbackVestingAccount() *types1.MsgCreateClawbackVestingAccount {
+	if x, ok := m.GetSum().(*Message_MsgCreateClawbackVestingAccount); ok {
+		return x.MsgCreateClawbackVestingAccount
+	}
+	return nil
+}
+
+func (m *Message) GetMsgClawback() *types1.MsgClawback {
+	if x, ok := m.GetSum().(*Message_MsgClawback); ok {
+		return x.MsgClawback
+	}
+	return nil
+}
+
+func (m *Message) GetMsgRotatePubKey() *types.MsgRotatePubKey {
+	if x, ok := m.GetSum().(*Message_MsgRotatePubKey); ok {
+		return x.MsgRotatePubKey
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -893,12 +1028,19 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_MsgSubmitProposal)(nil),
 		(*Message_MsgVote)(nil),
 		(*Message_MsgDeposit)(nil),
+		(*Message_MsgVoteWeighted)(nil),
 		(*Message_MsgUnjail)(nil),
 		(*Message_MsgCreateValidator)(nil),
 		(*Message_MsgEditValidator)(nil),
 		(*Message_MsgDelegate)(nil),
 		(*Message_MsgBeginRedelegate)(nil),
 		(*Message_MsgUndelegate)(nil),
+		(*Message_MsgCreateVestingAccount)(nil),
+		(*Message_MsgCreatePermanentLockedAccount)(nil),
+		(*Message_MsgCreatePeriodicVestingAccount)(nil),
+		(*Message_MsgCreateClawbackVestingAccount)(nil),
+		(*Message_MsgClawback)(nil),
+		(*Message_MsgRotatePubKey)(nil),
 	}
 }
 
@@ -965,100 +1107,116 @@ func init() {
 func init() { proto.RegisterFile("codec/std/codec.proto", fileDescriptor_daf09dc2dfa19bb4) }
 
 var fileDescriptor_daf09dc2dfa19bb4 = []byte{
-	// 1479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0xd4, 0x46,
-	0x1b, 0xf7, 0xc2, 0x92, 0x84, 0x49, 0x80, 0x30, 0x2f, 0xbc, 0x59, 0xe5, 0x85, 0x4d, 0x08, 0xbc,
-	0xa8, 0x05, 0x65, 0xcd, 0x47, 0x5b, 0x60, 0xd5, 0x0a, 0xf2, 0x01, 0x5a, 0xaa, 0xa6, 0x45, 0x0e,
-	0xa4, 0x6a, 0x45, 0x6b, 0xcd, 0x7a, 0x06, 0x67, 0x9a, 0xb5, 0xc7, 0xf5, 0x8c, 0x37, 0x9b, 0x43,
-	0xef, 0x6d, 0xa5, 0x4a, 0x55, 0xfb, 0x0f, 0xa0, 0xf6, 0xd8, 0x2b, 0xc7, 0x9e, 0x2b, 0xc4, 0x89,
-	0x63, 0x4f, 0xa8, 0x82, 0x4b, 0xd5, 0xbf, 0xa2, 0x9a, 0x0f, 0x7b, 0xed, 0x5d, 0xef, 0x86, 0x1e,
-	0x7a, 0x89, 0x3c, 0xf3, 0x3c, 0xbf, 0xdf, 0xf3, 0xf3, 0xcc, 0xf3, 0xe1, 0x0d, 0x38, 0xe9, 0x31,
-	0x4c, 0x3c, 0x9b, 0x0b, 0x6c, 0xab, 0xa7, 0x46, 0x14, 0x33, 0xc1, 0xe0, 0x9c, 0xc7, 0x78, 0xc0,
-	0xb8, 0xcb, 0xf1, 0x4e, 0x43, 0xef, 0x73, 0x81, 0x1b, 0xdd, 0xcb, 0xf3, 0x17, 0xc5, 0x36, 0x8d,
-	0xb1, 0x1b, 0xa1, 0x58, 0xec, 0xd9, 0xca, 0xd7, 0xd6, 0xae, 0xcb, 0xf9, 0x85, 0x66, 0x99, 0x3f,
-	0x3f, 0xec, 0xec, 0x33, 0x9f, 0xf5, 0x9f, 0x8c, 0x5f, 0xad, 0x67, 0xa3, 0x44, 0x6c, 0xdb, 0x62,
-	0x2f, 0x22, 0x5c, 0xff, 0x35, 0x96, 0x45, 0x63, 0xe9, 0x12, 0x2e, 0x68, 0xe8, 0x97, 0x78, 0xd4,
-	0x7a, 0x76, 0x1b, 0x85, 0x3b, 0x25, 0x96, 0xf9, 0x9e, 0xed, 0xc5, 0x94, 0x53, 0x5e, 0xce, 0x8b,
-	0x29, 0x17, 0x31, 0x6d, 0x27, 0x82, 0xb2, 0xb0, 0x1c, 0xcd, 0x93, 0x28, 0xea, 0xec, 0x95, 0xd8,
-	0x4e, 0xf5, 0x6c, 0xd2, 0xa5, 0x98, 0x84, 0x1e, 0x29, 0xb1, 0xce, 0xf5, 0x6c, 0x9f, 0x75, 0xcb,
-	0x61, 0xbc, 0x83, 0xf8, 0x76, 0xf9, 0x8b, 0xfc, 0xaf, 0x67, 0x73, 0x81, 0x76, 0xca, 0x8d, 0x67,
-	0x7b, 0x76, 0x84, 0x62, 0x14, 0xa4, 0xef, 0x12, 0xc5, 0x2c, 0x62, 0x1c, 0x75, 0x06, 0x19, 0x92,
-	0xc8, 0x8f, 0x11, 0x2e, 0x51, 0xb5, 0xf4, 0x6b, 0x15, 0x4c, 0xae, 0x78, 0x1e, 0x4b, 0x42, 0x01,
-	0xef, 0x80, 0x99, 0x36, 0xe2, 0xc4, 0x45, 0x7a, 0x5d, 0xab, 0x2c, 0x56, 0xde, 0x98, 0xbe, 0x72,
-	0xa6, 0x91, 0xbb, 0xf4, 0x5e, 0x43, 0x9e, 0x7b, 0xa3, 0x7b, 0xb9, 0xb1, 0x8a, 0x38, 0x31, 0xc0,
-	0x96, 0xe5, 0x4c, 0xb7, 0xfb, 0x4b, 0xd8, 0x05, 0xf3, 0x1e, 0x0b, 0x05, 0x0d, 0x13, 0x96, 0x70,
-	0xd7, 0xdc, 0x51, 0xc6, 0x7a, 0x40, 0xb1, 0xbe, 0x53, 0xc6, 0xaa, 0x3d, 0x25, 0xfb, 0x5a, 0x86,
-	0xdf, 0xd2, 0x9b, 0xfd, 0x50, 0x35, 0x6f, 0x84, 0x0d, 0x06, 0x60, 0x0e, 0x93, 0x0e, 0xda, 0x23,
-	0x78, 0x28, 0xe8, 0x41, 0x15, 0xf4, 0xea, 0xf8, 0xa0, 0xeb, 0x1a, 0x3c, 0x14, 0xf1, 0x24, 0x2e,
-	0x33, 0xc0, 0x08, 0xd4, 0x22, 0x12, 0x53, 0x86, 0xa9, 0x37, 0x14, 0xaf, 0xaa, 0xe2, 0xbd, 0x35,
-	0x3e, 0xde, 0x3d, 0x83, 0x1e, 0x0a, 0xf8, 0xdf, 0xa8, 0xd4, 0x02, 0x3f, 0x04, 0x47, 0x03, 0x86,
-	0x93, 0x4e, 0xff, 0x8a, 0x0e, 0xa9, 0x38, 0xff, 0x2f, 0xc6, 0xd1, 0x09, 0x2a, 0x23, 0x6c, 0x28,
-	0xef, 0x3e, 0xf1, 0x91, 0x20, 0xbf, 0xd1, 0xbc, 0xf1, 0xec, 0xc9, 0xf2, 0xdb, 0x17, 0x7c, 0x2a,
-	0xb6, 0x93, 0x76, 0xc3, 0x63, 0x81, 0x29, 0xd3, 0xb4, 0x74, 0x39, 0xde, 0xb1, 0x4d, 0xa1, 0x91,
-	0x5e, 0xc4, 0x62, 0x41, 0x70, 0xc3, 0x40, 0x57, 0x0f, 0x81, 0x83, 0x3c, 0x09, 0x96, 0xbe, 0xad,
-	0x80, 0x89, 0x4d, 0x15, 0x0e, 0x5e, 0x07, 0x13, 0x3a, 0xb0, 0xc9, 0x9b, 0xfa, 0x28, 0x51, 0xda,
-	0xbf, 0x65, 0x39, 0xc6, 0xbf, 0x79, 0xf3, 0xcf, 0xc7, 0x0b, 0x95, 0x67, 0x4f, 0x96, 0xaf, 0xed,
-	0x27, 0xc5, 0x54, 0x5e, 0x26, 0x46, 0x33, 0xdd, 0x4d, 0xc5, 0xfc, 0x54, 0x01, 0x53, 0xb7, 0x4d,
-	0x01, 0xc2, 0x0f, 0xc0, 0x0c, 0xf9, 0x32, 0xa1, 0x5d, 0xe6, 0x21, 0x59, 0xca, 0x46, 0xd4, 0xf9,
-	0xa2, 0xa8, 0xb4, 0x5c, 0xa5, 0xac, 0xdb, 0x39, 0xef, 0x96, 0xe5, 0x14, 0xd0, 0xcd, 0x15, 0x23,
-	0xf1, 0xc6, 0x3e, 0x0a, 0xb3, 0xfa, 0xcf, 0x34, 0xa6, 0x82, 0x52, 0x91, 0xbf, 0x54, 0xc0, 0xf1,
-	0x0d, 0xee, 0x6f, 0x26, 0xed, 0x80, 0x8a, 0x4c, 0xed, 0x06, 0xa8, 0xca, 0x0a, 0x32, 0x2a, 0xed,
-	0xd1, 0x2a, 0x87, 0xa0, 0xb2, 0x0e, 0x57, 0xa7, 0x9e, 0xbe, 0x58, 0xb0, 0x9e, 0xbf, 0x58, 0xa8,
-	0x38, 0x8a, 0x06, 0xbe, 0x07, 0xa6, 0x52, 0x90, 0xa9, 0xb7, 0x42, 0x15, 0xe7, 0x5b, 0x77, 0x26,
-	0xd0, 0xc9, 0x20, 0xcd, 0xa9, 0xaf, 0x1f, 0x2f, 0x58, 0xf2, 0x8d, 0x97, 0x7e, 0xce, 0xab, 0xbd,
-	0x67, 0xba, 0x0b, 0x6c, 0x15, 0xd4, 0x5e, 0x28, 0xaa, 0xf5, 0x59, 0xb7, 0x20, 0x34, 0x45, 0x95,
-	0x0a, 0x6d, 0x82, 0x49, 0x59, 0xce, 0x24, 0xeb, 0x0b, 0x8b, 0x23, 0x75, 0xae, 0x69, 0x3f, 0x27,
-	0x05, 0xe4, 0x54, 0xfe, 0x58, 0x01, 0x53, 0x99, 0xb8, 0x9b, 0x05, 0x71, 0x67, 0x4a, 0xc5, 0x8d,
-	0xd5, 0x74, 0xeb, 0x1f, 0x6b, 0x5a, 0xad, 0x4a, 0x8a, 0xbe, 0xb2, 0xaa, 0x52, 0xf5, 0xb8, 0x0a,
-	0x26, 0x8d, 0x03, 0xbc, 0x06, 0xaa, 0x82, 0xf4, 0xc4, 0x58, 0x51, 0xf7, 0x49, 0x2f, 0x3b, 0xac,
-	0x96, 0xe5, 0x28, 0x00, 0x7c, 0x08, 0x66, 0x55, 0x87, 0x27, 0x82, 0xc4, 0xae, 0xb7, 0x8d, 0x42,
-	0x3f, 0xbd, 0xd1, 0x81, 0x24, 0xd1, 0x73, 0x40, 0xbd, 0x5c, 0xea, 0xbf, 0xa6, 0xdc, 0x73, 0x94,
-	0xc7, 0xa2, 0xa2, 0x09, 0x7e, 0x06, 0x66, 0x39, 0x7b, 0x24, 0x76, 0x51, 0x4c, 0x5c, 0x33, 0x23,
-	0x4c, 0xab, 0xbc, 0x54, 0x64, 0x37, 0x46, 0x55, 0xbe, 0x06, 0xf0, 0x40, 0x6f, 0xe5, 0xe9, 0x79,
-	0xd1, 0x04, 0x23, 0x30, 0xe7, 0xa1, 0xd0, 0x23, 0x1d, 0x77, 0x28, 0x4a, 0xb5, 0x6c, 0x0a, 0xe4,
-	0xa2, 0xac, 0x29, 0xdc, 0xe8, 0x58, 0x27, 0xbd, 0x32, 0x07, 0xd8, 0x01, 0x27, 0x3c, 0x16, 0x04,
-	0x49, 0x48, 0xc5, 0x9e, 0x1b, 0x31, 0xd6, 0x71, 0x79, 0x44, 0x42, 0x6c, 0xfa, 0xe4, 0xf5, 0x62,
-	0xb8, 0xfc, 0xa8, 0xd7, 0xb7, 0x69, 0x90, 0xf7, 0x18, 0xeb, 0x6c, 0x4a, 0x5c, 0x2e, 0x20, 0xf4,
-	0x86, 0xac, 0xcd, 0xeb, 0xa6, 0x2b, 0x5c, 0xda, 0xa7, 0x2b, 0x64, 0x73, 0x3f, 0x4b, 0x18, 0xd3,
-	0x0c, 0x7e, 0xa8, 0x80, 0xe9, 0xfb, 0x31, 0x0a, 0x39, 0xf2, 0xa4, 0x08, 0xb8, 0x52, 0xc8, 0xdd,
-	0x85, 0xf2, 0xc9, 0xbb, 0x29, 0xf0, 0xfd, 0x9e, 0xca, 0xdc, 0x99, 0x34, 0x73, 0xff, 0x92, 0xe9,
-	0x97, 0x56, 0x54, 0x35, 0xe0, 0x3e, 0xaf, 0x1d, 0x58, 0x3c, 0x38, 0x36, 0x75, 0x37, 0x08, 0xe7,
-	0xc8, 0x27, 0x26, 0x75, 0x15, 0xa6, 0x59, 0x95, 0x15, 0xb5, 0xf4, 0xdb, 0x0c, 0x98, 0x34, 0x56,
-	0xd8, 0x04, 0x53, 0x01, 0xf7, 0x5d, 0x2e, 0xcf, 0x50, 0x8b, 0x3a, 0x5d, 0x14, 0x25, 0x3f, 0xb2,
-	0xd2, 0x72, 0x27, 0x21, 0x6e, 0x59, 0xce, 0x64, 0xa0, 0x1f, 0xe1, 0xfb, 0xe0, 0xa8, 0xc4, 0x06,
-	0x49, 0x47, 0x50, 0xcd, 0xa0, 0x13, 0x77, 0x69, 0x24, 0xc3, 0x86, 0x74, 0x35, 0x34, 0x33, 0x41,
-	0x6e, 0x0d, 0x3f, 0x07, 0x27, 0x24, 0x57, 0x97, 0xc4, 0xf4, 0xd1, 0x9e, 0x4b, 0xc3, 0x2e, 0x8a,
-	0x29, 0xca, 0xe6, 0xfa, 0x40, 0x07, 0xd2, 0x9f, 0x77, 0x86, 0x73, 0x4b, 0x41, 0xee, 0xa6, 0x08,
-	0x79, 0x93, 0xc1, 0xd0, 0x2e, 0x0c, 0x41, 0x4d, 0xbf, 0xa7, 0x70, 0x77, 0xa9, 0xd8, 0xc6, 0x31,
-	0xda, 0x75, 0x11, 0xc6, 0x31, 0xe1, 0xdc, 0xa4, 0xea, 0xd5, 0xf1, 0xb9, 0xa3, 0xde, 0x5f, 0x7c,
-	0x6c, 0xb0, 0x2b, 0x1a, 0x2a, 0xf3, 0x34, 0x28, 0x33, 0xc0, 0xaf, 0xc0, 0x69, 0x19, 0x2f, 0x8b,
-	0x85, 0x49, 0x87, 0xf8, 0x48, 0xb0, 0xd8, 0x8d, 0xc9, 0x2e, 0x8a, 0x5f, 0x33, 0x61, 0x37, 0xb8,
-	0x9f, 0x12, 0xaf, 0xa7, 0x04, 0x8e, 0xc2, 0xb7, 0x2c, 0x67, 0x3e, 0x18, 0x69, 0x85, 0xdf, 0x54,
-	0xc0, 0x99, 0x42, 0xfc, 0x2e, 0xea, 0x50, 0xac, 0xe2, 0xcb, 0x34, 0xa7, 0x9c, 0xcb, 0x91, 0x39,
-	0xa1, 0x34, 0xbc, 0xfb, 0xda, 0x1a, 0xb6, 0x52, 0x92, 0xb5, 0x8c, 0xa3, 0x65, 0x39, 0xf5, 0x60,
-	0xac, 0x07, 0xdc, 0x01, 0x73, 0x52, 0xca, 0xa3, 0x24, 0xc4, 0x6e, 0xb1, 0x76, 0x6b, 0x93, 0x4a,
-	0xc0, 0x95, 0x7d, 0x05, 0xdc, 0x49, 0x42, 0x5c, 0x28, 0xde, 0x96, 0xe5, 0xc8, 0x7c, 0x19, 0xda,
-	0x87, 0x0f, 0xc1, 0x7f, 0xd4, 0x3d, 0xab, 0xc9, 0xe4, 0x66, 0x33, 0x72, 0x6a, 0x38, 0x8d, 0x8a,
-	0xc5, 0x32, 0x38, 0x75, 0x5b, 0x96, 0x73, 0x3c, 0x18, 0x9a, 0xe2, 0x45, 0xf6, 0xf4, 0x63, 0xbc,
-	0x76, 0xf8, 0x75, 0xd9, 0x73, 0xed, 0xa6, 0xcf, 0x9e, 0x0d, 0xb6, 0x1b, 0xba, 0x16, 0xbb, 0x4c,
-	0x90, 0x1a, 0x50, 0x94, 0xa7, 0x46, 0x4d, 0xde, 0x2d, 0x26, 0x88, 0x29, 0x45, 0xf9, 0x08, 0x57,
-	0xc1, 0xb4, 0x84, 0x62, 0x12, 0x31, 0x4e, 0x45, 0x6d, 0xba, 0xac, 0xbd, 0xf4, 0xd1, 0xeb, 0xda,
-	0xad, 0x65, 0x39, 0x20, 0xc8, 0x56, 0x70, 0x1d, 0xc8, 0x95, 0x9b, 0x84, 0x5f, 0x20, 0xda, 0xa9,
-	0xcd, 0x28, 0x8a, 0xb3, 0x03, 0xdf, 0x78, 0xe6, 0x67, 0x8c, 0xe1, 0x79, 0xa0, 0x5c, 0x5b, 0x96,
-	0x73, 0x38, 0x48, 0x17, 0xd0, 0xd5, 0x85, 0xec, 0xc5, 0x04, 0x09, 0xd2, 0x4f, 0xbb, 0xda, 0x11,
-	0xc5, 0x77, 0x71, 0x80, 0x4f, 0xff, 0xf0, 0x31, 0x74, 0x6b, 0x0a, 0x93, 0xa5, 0x90, 0xa9, 0xe4,
-	0x81, 0x5d, 0xf8, 0x09, 0x90, 0xbb, 0x2e, 0xc1, 0x54, 0xe4, 0xe8, 0x8f, 0x2a, 0xfa, 0x37, 0xc7,
-	0xd1, 0xdf, 0xc6, 0x54, 0xe4, 0xc9, 0x67, 0x83, 0x81, 0x3d, 0x78, 0x17, 0xcc, 0xe8, 0x53, 0x54,
-	0xc5, 0x44, 0x6a, 0xc7, 0x14, 0xe9, 0xb9, 0x71, 0xa4, 0xa6, 0xf0, 0xe4, 0x65, 0x4c, 0x07, 0xfd,
-	0x65, 0x7a, 0x0c, 0x6d, 0xe2, 0xd3, 0xd0, 0x8d, 0x49, 0x46, 0x39, 0xbb, 0xff, 0x31, 0xac, 0x4a,
-	0x8c, 0x93, 0x41, 0xcc, 0x31, 0x0c, 0xec, 0xc2, 0x8f, 0x74, 0xf3, 0x4d, 0xc2, 0x8c, 0xfa, 0x78,
-	0xd9, 0x07, 0x70, 0x91, 0xfa, 0x41, 0x98, 0x63, 0x3d, 0x12, 0xe4, 0x37, 0x9a, 0x17, 0x9e, 0x3d,
-	0x59, 0x3e, 0x3f, 0x76, 0xce, 0xe9, 0x09, 0x27, 0x15, 0x9a, 0xe9, 0xf6, 0x5d, 0x05, 0x4c, 0x6e,
-	0x52, 0x3f, 0x5c, 0x67, 0x1e, 0xbc, 0x53, 0x98, 0x6c, 0xe7, 0x46, 0x4e, 0x36, 0xe3, 0xff, 0x6f,
-	0x8c, 0xb7, 0xd5, 0x5b, 0x4f, 0x5f, 0xd6, 0x2b, 0xcf, 0x5f, 0xd6, 0x2b, 0x7f, 0xbc, 0xac, 0x57,
-	0xbe, 0x7f, 0x55, 0xb7, 0x9e, 0xbf, 0xaa, 0x5b, 0xbf, 0xbf, 0xaa, 0x5b, 0x9f, 0x8e, 0x7f, 0xb1,
-	0xec, 0x7f, 0x21, 0xed, 0x09, 0xf5, 0xa3, 0xf9, 0xea, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb6,
-	0xc7, 0x0e, 0x8b, 0x1f, 0x11, 0x00, 0x00,
+	// 1732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0x5f, 0xda, 0xb2, 0xa4, 0x8c, 0x64, 0x47, 0x9a, 0xd8, 0xd1, 0x42, 0x4d, 0x44, 0x5b, 0x4e,
+	0x8d, 0x36, 0x81, 0xc9, 0xfc, 0x69, 0x1a, 0x9b, 0x68, 0x90, 0x58, 0x92, 0x0d, 0xba, 0x0d, 0x53,
+	0x63, 0x65, 0x2b, 0x68, 0x91, 0x66, 0x31, 0xdc, 0x19, 0xaf, 0xb6, 0xe4, 0xec, 0x6c, 0x77, 0x66,
+	0x29, 0xf2, 0x50, 0xa0, 0xc7, 0xb6, 0x40, 0x80, 0xa2, 0x9f, 0xc0, 0xe8, 0xb5, 0xd7, 0x7c, 0x88,
+	0x20, 0x97, 0xfa, 0xd8, 0x53, 0x5a, 0xd8, 0x97, 0x22, 0x9f, 0xa2, 0x98, 0x3f, 0xbb, 0xdc, 0x5d,
+	0x0e, 0x29, 0xb9, 0x68, 0x2e, 0x04, 0x67, 0xde, 0xfb, 0xfd, 0xde, 0x7b, 0x33, 0xf3, 0xde, 0xbc,
+	0x59, 0x70, 0x25, 0x60, 0x98, 0x04, 0x6d, 0x2e, 0x70, 0x5b, 0xfd, 0x6b, 0x25, 0x29, 0x13, 0x0c,
+	0x6e, 0x05, 0x8c, 0x53, 0xc6, 0x7d, 0x8e, 0x07, 0x2d, 0x3d, 0xcf, 0x05, 0x6e, 0x8d, 0xde, 0xd9,
+	0x7e, 0x4b, 0x1c, 0x47, 0x29, 0xf6, 0x13, 0x94, 0x8a, 0x49, 0x5b, 0xe9, 0xb6, 0xb5, 0xea, 0xcd,
+	0xf2, 0x40, 0xb3, 0x6c, 0xdf, 0x98, 0x55, 0x0e, 0x59, 0xc8, 0xa6, 0xff, 0x8c, 0x9e, 0x3b, 0x6e,
+	0xa3, 0x4c, 0x1c, 0xb7, 0xc5, 0x24, 0x21, 0x5c, 0xff, 0x1a, 0xc9, 0x55, 0x23, 0x19, 0x11, 0x2e,
+	0xa2, 0x38, 0xb4, 0x68, 0xb8, 0xe3, 0x76, 0x1f, 0xc5, 0x03, 0x8b, 0x64, 0x7b, 0xdc, 0x0e, 0xd2,
+	0x88, 0x47, 0xdc, 0xce, 0x8b, 0x23, 0x2e, 0xd2, 0xa8, 0x9f, 0x89, 0x88, 0xc5, 0x76, 0x34, 0xcf,
+	0x92, 0x64, 0x38, 0xb1, 0xc8, 0x5e, 0x1b, 0xb7, 0xc9, 0x28, 0xc2, 0x24, 0x0e, 0x88, 0x45, 0xba,
+	0x35, 0x6e, 0x87, 0x6c, 0x64, 0x87, 0xf1, 0x21, 0xe2, 0xc7, 0xf6, 0x40, 0x7e, 0x30, 0x6e, 0x73,
+	0x81, 0x06, 0x76, 0xe1, 0xf5, 0x71, 0x3b, 0x41, 0x29, 0xa2, 0x79, 0x2c, 0x49, 0xca, 0x12, 0xc6,
+	0xd1, 0xb0, 0xce, 0x90, 0x25, 0x61, 0x8a, 0xb0, 0xc5, 0xab, 0xdd, 0x27, 0xcb, 0x60, 0xe5, 0x4e,
+	0x10, 0xb0, 0x2c, 0x16, 0xf0, 0x1e, 0x58, 0xef, 0x23, 0x4e, 0x7c, 0xa4, 0xc7, 0x6e, 0xe3, 0x6a,
+	0xe3, 0x47, 0x6b, 0xef, 0x5e, 0x6b, 0x95, 0x36, 0x7d, 0xdc, 0x92, 0xeb, 0xde, 0x1a, 0xbd, 0xd3,
+	0xda, 0x43, 0x9c, 0x18, 0x60, 0xd7, 0xf1, 0xd6, 0xfa, 0xd3, 0x21, 0x1c, 0x81, 0xed, 0x80, 0xc5,
+	0x22, 0x8a, 0x33, 0x96, 0x71, 0xdf, 0xec, 0x51, 0xc1, 0x7a, 0x4e, 0xb1, 0xfe, 0xd4, 0xc6, 0xaa,
+	0x35, 0x25, 0xfb, 0x7e, 0x81, 0x3f, 0xd2, 0x93, 0x53, 0x53, 0x6e, 0x30, 0x47, 0x06, 0x29, 0xd8,
+	0xc2, 0x64, 0x88, 0x26, 0x04, 0xcf, 0x18, 0x3d, 0xaf, 0x8c, 0xbe, 0xb7, 0xd8, 0xe8, 0x81, 0x06,
+	0xcf, 0x58, 0xbc, 0x82, 0x6d, 0x02, 0x98, 0x00, 0x37, 0x21, 0x69, 0xc4, 0x70, 0x14, 0xcc, 0xd8,
+	0x5b, 0x52, 0xf6, 0x7e, 0xb2, 0xd8, 0xde, 0x03, 0x83, 0x9e, 0x31, 0xf8, 0x6a, 0x62, 0x95, 0xc0,
+	0x4f, 0xc1, 0x25, 0xca, 0x70, 0x36, 0x9c, 0x6e, 0xd1, 0x05, 0x65, 0xe7, 0x87, 0x55, 0x3b, 0xfa,
+	0x80, 0x4a, 0x0b, 0x3d, 0xa5, 0x3d, 0x25, 0xbe, 0x48, 0xcb, 0x13, 0x26, 0x02, 0x8a, 0x62, 0x12,
+	0x0b, 0x7f, 0xc8, 0x82, 0x01, 0xc1, 0x05, 0xf3, 0xf2, 0x19, 0x23, 0xd0, 0xe8, 0x4f, 0x14, 0xb8,
+	0x1a, 0x81, 0x45, 0x22, 0x2d, 0x06, 0x43, 0x74, 0xd2, 0x47, 0xc1, 0x60, 0x66, 0xcd, 0x56, 0xce,
+	0x62, 0x71, 0xdf, 0xa0, 0x67, 0xd7, 0x2c, 0xb0, 0x4a, 0x3a, 0xb7, 0xbf, 0xf9, 0xea, 0xe6, 0xfb,
+	0x6f, 0x86, 0x91, 0x38, 0xce, 0xfa, 0xad, 0x80, 0x51, 0x53, 0x8a, 0xf2, 0xf2, 0xc4, 0xf1, 0xa0,
+	0x6d, 0x8a, 0x09, 0x19, 0x27, 0x2c, 0x15, 0x04, 0xb7, 0x0c, 0x74, 0xef, 0x02, 0x38, 0xcf, 0x33,
+	0xba, 0xfb, 0xe7, 0x06, 0x58, 0x3e, 0x54, 0x4b, 0x0a, 0x6f, 0x81, 0x65, 0xbd, 0xb8, 0x26, 0x37,
+	0x76, 0xe6, 0x2d, 0xbc, 0xd6, 0xef, 0x3a, 0x9e, 0xd1, 0xef, 0x7c, 0xf4, 0x9f, 0x27, 0xcd, 0xc6,
+	0x37, 0x5f, 0xdd, 0xfc, 0xe0, 0x34, 0x57, 0x4c, 0x75, 0x29, 0x9c, 0xd1, 0x4c, 0xf7, 0x73, 0x67,
+	0xfe, 0xd6, 0x00, 0xab, 0x77, 0x4d, 0x91, 0x81, 0x9f, 0x80, 0x75, 0xf2, 0xbb, 0x2c, 0x1a, 0xb1,
+	0x00, 0xc9, 0x72, 0x65, 0x9c, 0xba, 0x51, 0x75, 0x2a, 0x2f, 0x49, 0xd2, 0xad, 0xbb, 0x25, 0xed,
+	0xae, 0xe3, 0x55, 0xd0, 0x9d, 0x3b, 0xc6, 0xc5, 0xdb, 0xa7, 0x78, 0x58, 0xd4, 0xb8, 0xc2, 0xc7,
+	0xdc, 0xa1, 0xdc, 0xc9, 0xbf, 0x37, 0xc0, 0x66, 0x8f, 0x87, 0x87, 0x59, 0x9f, 0x46, 0xa2, 0xf0,
+	0xb6, 0x07, 0x96, 0x64, 0x95, 0x30, 0x5e, 0xb6, 0xe7, 0x7b, 0x39, 0x03, 0x95, 0xb5, 0x66, 0x6f,
+	0xf5, 0xeb, 0x6f, 0x9b, 0xce, 0xd3, 0x6f, 0x9b, 0x0d, 0x4f, 0xd1, 0xc0, 0x0f, 0xc1, 0x6a, 0x0e,
+	0x72, 0xcf, 0xcd, 0x56, 0xaa, 0xf2, 0xf5, 0x54, 0x38, 0xe8, 0x15, 0x90, 0xce, 0xea, 0x1f, 0x9f,
+	0x34, 0x1d, 0x19, 0xf1, 0xee, 0xbf, 0xca, 0xde, 0x3e, 0x30, 0x15, 0x14, 0x76, 0x2b, 0xde, 0xbe,
+	0x59, 0xf5, 0x36, 0x64, 0xa3, 0x8a, 0xa3, 0x39, 0xca, 0xea, 0x68, 0x07, 0xac, 0xc8, 0x92, 0x45,
+	0x8a, 0xda, 0x77, 0x75, 0xae, 0x9f, 0xfb, 0x5a, 0xcf, 0xcb, 0x01, 0xb0, 0x03, 0x96, 0x28, 0x0f,
+	0xb9, 0x7b, 0xfe, 0xea, 0xf9, 0x85, 0xc0, 0x1e, 0xe1, 0x1c, 0x85, 0x64, 0x6f, 0x49, 0xda, 0xf6,
+	0x14, 0xa6, 0x14, 0xe1, 0x3f, 0x1a, 0x60, 0xb5, 0x08, 0xec, 0xa3, 0x4a, 0x60, 0xd7, 0xac, 0x81,
+	0x2d, 0x8c, 0xe7, 0xe3, 0x17, 0x8e, 0xc7, 0xb8, 0xf5, 0x7f, 0x89, 0x6a, 0x49, 0x45, 0xf4, 0x87,
+	0x0b, 0x60, 0xc5, 0x90, 0xc3, 0x0f, 0xc0, 0x92, 0x20, 0x63, 0xb1, 0x30, 0xa0, 0x87, 0x64, 0x5c,
+	0x6c, 0x52, 0xd7, 0xf1, 0x14, 0x00, 0x7e, 0x0e, 0x36, 0xd4, 0xed, 0x49, 0x04, 0x49, 0xfd, 0xe0,
+	0x18, 0xc5, 0x61, 0x7e, 0x92, 0x6a, 0x87, 0x53, 0x69, 0x71, 0xb5, 0x30, 0xb9, 0xfe, 0xbe, 0x52,
+	0x2f, 0x51, 0xbe, 0x9c, 0x54, 0x45, 0xf0, 0x37, 0x60, 0x83, 0xb3, 0xc7, 0xe2, 0x04, 0xa5, 0xc4,
+	0x37, 0xf7, 0xaf, 0xb9, 0x86, 0xde, 0xae, 0xb2, 0x1b, 0xa1, 0x2a, 0x1b, 0x06, 0xf0, 0x48, 0x4f,
+	0x95, 0xe9, 0x79, 0x55, 0x04, 0x13, 0xb0, 0x15, 0xa0, 0x38, 0x20, 0x43, 0x7f, 0xc6, 0xca, 0x92,
+	0xed, 0x86, 0x2d, 0x59, 0xd9, 0x57, 0xb8, 0xf9, 0xb6, 0xae, 0x04, 0x36, 0x05, 0x38, 0x04, 0x97,
+	0x03, 0x46, 0x69, 0x16, 0x47, 0x62, 0xe2, 0x27, 0x8c, 0x0d, 0x7d, 0x9e, 0x90, 0x18, 0x9b, 0x3b,
+	0xe8, 0x56, 0xd5, 0x5c, 0xb9, 0x8d, 0xd2, 0x27, 0xc1, 0x20, 0x1f, 0x30, 0x36, 0x3c, 0x94, 0xb8,
+	0x92, 0x41, 0x18, 0xcc, 0x48, 0xe1, 0x17, 0x00, 0x72, 0x22, 0x7c, 0x4c, 0x62, 0x46, 0x7d, 0x4a,
+	0x04, 0xc2, 0x48, 0x20, 0x73, 0x2b, 0xb5, 0xaa, 0xb6, 0x64, 0xa3, 0xa7, 0x56, 0x8f, 0x88, 0x03,
+	0xa9, 0xde, 0x33, 0xda, 0x25, 0x0b, 0x1b, 0xbc, 0x26, 0xeb, 0xdc, 0x32, 0xd5, 0xee, 0xed, 0x53,
+	0xaa, 0x5d, 0xd1, 0xb3, 0x15, 0x87, 0xd9, 0x14, 0xb9, 0xbf, 0x36, 0xc0, 0xda, 0xc3, 0x14, 0xc5,
+	0x1c, 0x05, 0x32, 0x48, 0x78, 0xa7, 0x92, 0x57, 0x4d, 0x7b, 0xd7, 0x74, 0x28, 0xf0, 0xc3, 0xb1,
+	0xca, 0xaa, 0xf5, 0x3c, 0xab, 0xbe, 0x53, 0x67, 0xdb, 0x54, 0x0a, 0x9d, 0x17, 0xe7, 0xfe, 0x97,
+	0xbc, 0x90, 0xd9, 0xbe, 0xfb, 0x1d, 0x04, 0x2b, 0x46, 0x0a, 0x3b, 0x60, 0x95, 0xf2, 0xd0, 0xe7,
+	0x72, 0x8f, 0xb4, 0x53, 0xaf, 0xdb, 0xd7, 0x4d, 0x96, 0x31, 0x12, 0xe3, 0xae, 0xe3, 0xad, 0x50,
+	0xfd, 0x17, 0xfe, 0x1c, 0x5c, 0x92, 0x58, 0x9a, 0x0d, 0x45, 0xa4, 0x19, 0x74, 0x62, 0xec, 0xce,
+	0x65, 0xe8, 0x49, 0x55, 0x43, 0xb3, 0x4e, 0x4b, 0x63, 0xf8, 0x05, 0xb8, 0x2c, 0xb9, 0x46, 0x24,
+	0x8d, 0x1e, 0x4f, 0xfc, 0x28, 0x1e, 0xa1, 0x34, 0x42, 0x45, 0x4f, 0x56, 0xab, 0xac, 0xba, 0x35,
+	0x37, 0x9c, 0x47, 0x0a, 0x72, 0x3f, 0x47, 0xc8, 0x93, 0x42, 0x67, 0x66, 0x61, 0x0c, 0x5c, 0x1d,
+	0xa7, 0xf0, 0x4f, 0x22, 0x71, 0x8c, 0x53, 0x74, 0xe2, 0x23, 0x8c, 0x53, 0xc2, 0xb9, 0xbb, 0x64,
+	0xeb, 0xfb, 0xea, 0x67, 0x53, 0xc5, 0x2f, 0x3e, 0x33, 0xd8, 0x3b, 0x1a, 0x2a, 0xf3, 0x80, 0xda,
+	0x04, 0xf0, 0xf7, 0xe0, 0x75, 0x69, 0xaf, 0xb0, 0x85, 0xc9, 0x90, 0x84, 0x48, 0xb0, 0xd4, 0x4f,
+	0xc9, 0x09, 0x4a, 0xcf, 0x98, 0x10, 0x3d, 0x1e, 0xe6, 0xc4, 0x07, 0x39, 0x81, 0xa7, 0xf0, 0x5d,
+	0xc7, 0xdb, 0xa6, 0x73, 0xa5, 0xf0, 0x4f, 0x0d, 0x70, 0xad, 0x62, 0x7f, 0x84, 0x86, 0x11, 0x56,
+	0xf6, 0x65, 0x1a, 0x45, 0x9c, 0xcb, 0x56, 0x40, 0x27, 0xca, 0xcf, 0xce, 0xec, 0xc3, 0x51, 0x4e,
+	0xb2, 0x5f, 0x70, 0x74, 0x1d, 0x6f, 0x87, 0x2e, 0xd4, 0x80, 0x03, 0xb0, 0x25, 0x5d, 0x79, 0x9c,
+	0xc5, 0xd8, 0xaf, 0xd6, 0x06, 0xd3, 0xcd, 0xbd, 0x7b, 0xaa, 0x03, 0xf7, 0xb2, 0x18, 0x57, 0x8a,
+	0x43, 0xd7, 0xf1, 0x2e, 0x53, 0xcb, 0x3c, 0xfc, 0x1c, 0xbc, 0xa2, 0xf6, 0x59, 0xdd, 0xb8, 0x7e,
+	0x71, 0xf7, 0xaf, 0xce, 0x1e, 0xa3, 0x6a, 0xb2, 0xd4, 0xbb, 0x89, 0xae, 0xe3, 0x6d, 0xd2, 0xfa,
+	0x64, 0x8d, 0x3d, 0x7f, 0x48, 0xb9, 0x2f, 0x9d, 0x95, 0xbd, 0x54, 0x6c, 0x36, 0x69, 0x7d, 0x12,
+	0xde, 0xd6, 0xb9, 0x38, 0x62, 0x82, 0xb8, 0x40, 0x51, 0xbe, 0x36, 0xaf, 0xa3, 0x38, 0x62, 0x82,
+	0x98, 0x54, 0x94, 0x7f, 0xe1, 0x1e, 0x58, 0x93, 0x50, 0x4c, 0x12, 0xc6, 0x23, 0xe1, 0xae, 0xd9,
+	0xca, 0xcb, 0x14, 0x7d, 0xa0, 0xd5, 0xba, 0x8e, 0x07, 0x68, 0x31, 0x82, 0x1e, 0xd8, 0xcc, 0xcd,
+	0xfb, 0x27, 0x24, 0x0a, 0x8f, 0x05, 0xc1, 0x2e, 0x54, 0x4c, 0x6f, 0x2c, 0xf2, 0xe3, 0x33, 0xa3,
+	0x2b, 0x2f, 0x20, 0x5a, 0x9d, 0x82, 0x07, 0x40, 0x5a, 0xf0, 0xb3, 0xf8, 0xb7, 0x28, 0x1a, 0xba,
+	0xeb, 0x8a, 0xec, 0x7a, 0x95, 0x2c, 0x7f, 0xd6, 0x1a, 0xc6, 0x47, 0x4a, 0xb5, 0xeb, 0x78, 0x2f,
+	0xd1, 0x7c, 0x00, 0x7d, 0x5d, 0x1c, 0x82, 0x94, 0x20, 0x41, 0xa6, 0x47, 0xd9, 0xbd, 0xa8, 0xf8,
+	0xde, 0xaa, 0xf1, 0xe9, 0x87, 0xb0, 0xa1, 0xdb, 0x57, 0x98, 0xe2, 0x58, 0x9a, 0xea, 0x50, 0x9b,
+	0x85, 0xbf, 0x02, 0x72, 0xd6, 0x27, 0x38, 0x12, 0x25, 0xfa, 0x4b, 0x8a, 0xfe, 0xc7, 0x8b, 0xe8,
+	0xef, 0xe2, 0x48, 0x94, 0xc9, 0x37, 0x68, 0x6d, 0x0e, 0xde, 0x07, 0xeb, 0x7a, 0x67, 0x54, 0x82,
+	0x12, 0xf7, 0x65, 0xdb, 0x82, 0x56, 0x49, 0x4d, 0x32, 0xcb, 0x0d, 0x5e, 0xa3, 0xd3, 0x61, 0xbe,
+	0x0c, 0x7d, 0x12, 0x46, 0xb1, 0x9f, 0x92, 0x82, 0x72, 0xe3, 0xf4, 0x65, 0xd8, 0x93, 0x18, 0xaf,
+	0x80, 0x98, 0x65, 0xa8, 0xcd, 0xc2, 0x5f, 0xea, 0x82, 0x9e, 0xc5, 0x05, 0xf5, 0xa6, 0xed, 0xb1,
+	0x50, 0xa5, 0x7e, 0x14, 0x97, 0x58, 0x2f, 0xd2, 0xf2, 0x04, 0x14, 0x60, 0xbb, 0xbc, 0x71, 0xb5,
+	0xb7, 0xdc, 0x2b, 0x8a, 0xfc, 0xfd, 0xc5, 0x6f, 0xb9, 0xe9, 0x1e, 0xd6, 0x1f, 0x73, 0x5b, 0xd4,
+	0x2e, 0x82, 0x5f, 0x36, 0xc0, 0xf5, 0x92, 0xd9, 0xb9, 0xaf, 0xd7, 0xcb, 0xca, 0xfe, 0x87, 0x67,
+	0xb4, 0x3f, 0xf7, 0x19, 0xdb, 0xa4, 0x8b, 0x55, 0x2c, 0xfe, 0xd8, 0xbf, 0x07, 0x5c, 0x79, 0x51,
+	0x7f, 0xec, 0x1f, 0x06, 0x9a, 0x74, 0xb1, 0x4a, 0xdd, 0x9f, 0xb9, 0x6f, 0xed, 0x57, 0x5f, 0xc8,
+	0x9f, 0xb9, 0x8f, 0xee, 0x26, 0x5d, 0xac, 0x02, 0x3f, 0xd5, 0x29, 0x92, 0xfb, 0xe1, 0x6e, 0xd9,
+	0xf2, 0xce, 0x66, 0xd7, 0x00, 0x4c, 0x9e, 0xe4, 0x43, 0xf8, 0x50, 0x67, 0x73, 0xca, 0x84, 0x5a,
+	0xee, 0xac, 0xef, 0x0f, 0xc8, 0xc4, 0x75, 0x6d, 0x5f, 0x41, 0xf2, 0x96, 0xab, 0xc7, 0x43, 0x4f,
+	0xa9, 0x3f, 0xc8, 0xfa, 0xbf, 0x20, 0x13, 0x53, 0xca, 0xca, 0x53, 0x9d, 0x96, 0xe9, 0x05, 0x6f,
+	0x2c, 0xec, 0x05, 0x75, 0x17, 0x28, 0x33, 0xce, 0x74, 0x80, 0x5f, 0x36, 0xc0, 0xca, 0x61, 0x14,
+	0xc6, 0x07, 0x2c, 0x80, 0xf7, 0x2a, 0xdd, 0xdf, 0x1b, 0x73, 0xbb, 0x3f, 0xa3, 0xff, 0x7d, 0xb4,
+	0x80, 0x7b, 0x1f, 0x7f, 0xfd, 0x6c, 0xa7, 0xf1, 0xf4, 0xd9, 0x4e, 0xe3, 0xdf, 0xcf, 0x76, 0x1a,
+	0x7f, 0x79, 0xbe, 0xe3, 0x3c, 0x7d, 0xbe, 0xe3, 0xfc, 0xf3, 0xf9, 0x8e, 0xf3, 0xeb, 0xc5, 0x81,
+	0x15, 0xdf, 0x7a, 0xfb, 0xcb, 0xea, 0xa3, 0xe0, 0x7b, 0xff, 0x1d, 0x00, 0x1e, 0x6d, 0xeb, 0x23,
+	0xff, 0x15, 0x00, 0x00,
 }
 
 func (this *Supply) Equal(that interface{}) bool {
@@ -1221,6 +1379,14 @@ func (this *MsgSubmitProposal) Equal(that interface{}) bool {
 	if !this.Content.Equal(that1.Content) {
 		return false
 	}
+	if len(this.Msgs) != len(that1.Msgs) {
+		return false
+	}
+	for i := range this.Msgs {
+		if !this.Msgs[i].Equal(&that1.Msgs[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Proposal) Equal(that interface{}) bool {
@@ -1248,6 +1414,14 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.Content.Equal(&that1.Content) {
 		return false
 	}
+	if len(this.Msgs) != len(that1.Msgs) {
+		return false
+	}
+	for i := range this.Msgs {
+		if !this.Msgs[i].Equal(&that1.Msgs[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Content) Equal(that interface{}) bool {
@@ -1400,118 +1574,763 @@ func (this *Content_CommunityPoolSpend) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Account) GetAccount() github_com_cosmos_cosmos_sdk_x_auth_exported.Account {
-	if x := this.GetBaseAccount(); x != nil {
-		return x
-	}
-	if x := this.GetContinuousVestingAccount(); x != nil {
-		return x
+func (this *Content_SetDenomMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if x := this.GetDelayedVestingAccount(); x != nil {
-		return x
+
+	that1, ok := that.(*Content_SetDenomMetadata)
+	if !ok {
+		that2, ok := that.(Content_SetDenomMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if x := this.GetPeriodicVestingAccount(); x != nil {
-		return x
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if x := this.GetModuleAccount(); x != nil {
-		return x
+	if !this.SetDenomMetadata.Equal(that1.SetDenomMetadata) {
+		return false
 	}
-	return nil
+	return true
 }
+func (this *Message) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (this *Account) SetAccount(value github_com_cosmos_cosmos_sdk_x_auth_exported.Account) error {
-	if value == nil {
-		this.Sum = nil
-		return nil
+	that1, ok := that.(*Message)
+	if !ok {
+		that2, ok := that.(Message)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	switch vt := value.(type) {
-	case *types.BaseAccount:
-		this.Sum = &Account_BaseAccount{vt}
-		return nil
-	case *types1.ContinuousVestingAccount:
-		this.Sum = &Account_ContinuousVestingAccount{vt}
-		return nil
-	case *types1.DelayedVestingAccount:
-		this.Sum = &Account_DelayedVestingAccount{vt}
-		return nil
-	case *types1.PeriodicVestingAccount:
-		this.Sum = &Account_PeriodicVestingAccount{vt}
-		return nil
-	case *types2.ModuleAccount:
-		this.Sum = &Account_ModuleAccount{vt}
-		return nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return fmt.Errorf("can't encode value of type %T as message Account", value)
-}
-
-func (this *Supply) GetSupplyI() github_com_cosmos_cosmos_sdk_x_supply_exported.SupplyI {
-	if x := this.GetSupply(); x != nil {
-		return x
+	if that1.Sum == nil {
+		if this.Sum != nil {
+			return false
+		}
+	} else if this.Sum == nil {
+		return false
+	} else if !this.Sum.Equal(that1.Sum) {
+		return false
 	}
-	return nil
+	return true
 }
+func (this *Message_MsgSend) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (this *Supply) SetSupplyI(value github_com_cosmos_cosmos_sdk_x_supply_exported.SupplyI) error {
-	if value == nil {
-		this.Sum = nil
-		return nil
+	that1, ok := that.(*Message_MsgSend)
+	if !ok {
+		that2, ok := that.(Message_MsgSend)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	switch vt := value.(type) {
-	case *types2.Supply:
-		this.Sum = &Supply_Supply{vt}
-		return nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return fmt.Errorf("can't encode value of type %T as message Supply", value)
-}
-
-func (this *Evidence) GetEvidence() github_com_cosmos_cosmos_sdk_x_evidence_exported.Evidence {
-	if x := this.GetEquivocation(); x != nil {
-		return x
+	if !this.MsgSend.Equal(that1.MsgSend) {
+		return false
 	}
-	return nil
+	return true
 }
+func (this *Message_MsgMultiSend) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (this *Evidence) SetEvidence(value github_com_cosmos_cosmos_sdk_x_evidence_exported.Evidence) error {
-	if value == nil {
-		this.Sum = nil
-		return nil
+	that1, ok := that.(*Message_MsgMultiSend)
+	if !ok {
+		that2, ok := that.(Message_MsgMultiSend)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	switch vt := value.(type) {
-	case *types3.Equivocation:
-		this.Sum = &Evidence_Equivocation{vt}
-		return nil
-	case types3.Equivocation:
-		this.Sum = &Evidence_Equivocation{&vt}
-		return nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return fmt.Errorf("can't encode value of type %T as message Evidence", value)
+	if !this.MsgMultiSend.Equal(that1.MsgMultiSend) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgVerifyInvariant) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (this *Content) GetContent() github_com_cosmos_cosmos_sdk_x_gov_types.Content {
-	if x := this.GetText(); x != nil {
-		return x
+	that1, ok := that.(*Message_MsgVerifyInvariant)
+	if !ok {
+		that2, ok := that.(Message_MsgVerifyInvariant)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if x := this.GetParameterChange(); x != nil {
-		return x
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if x := this.GetSoftwareUpgrade(); x != nil {
-		return x
+	if !this.MsgVerifyInvariant.Equal(that1.MsgVerifyInvariant) {
+		return false
 	}
-	if x := this.GetCancelSoftwareUpgrade(); x != nil {
-		return x
+	return true
+}
+func (this *Message_MsgSetWithdrawAddress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if x := this.GetCommunityPoolSpend(); x != nil {
-		return x
+
+	that1, ok := that.(*Message_MsgSetWithdrawAddress)
+	if !ok {
+		that2, ok := that.(Message_MsgSetWithdrawAddress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgSetWithdrawAddress.Equal(that1.MsgSetWithdrawAddress) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgWithdrawDelegatorReward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (this *Content) SetContent(value github_com_cosmos_cosmos_sdk_x_gov_types.Content) error {
-	if value == nil {
-		this.Sum = nil
-		return nil
+	that1, ok := that.(*Message_MsgWithdrawDelegatorReward)
+	if !ok {
+		that2, ok := that.(Message_MsgWithdrawDelegatorReward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	switch vt := value.(type) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgWithdrawDelegatorReward.Equal(that1.MsgWithdrawDelegatorReward) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgWithdrawValidatorCommission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgWithdrawValidatorCommission)
+	if !ok {
+		that2, ok := that.(Message_MsgWithdrawValidatorCommission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgWithdrawValidatorCommission.Equal(that1.MsgWithdrawValidatorCommission) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgFundCommunityPool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgFundCommunityPool)
+	if !ok {
+		that2, ok := that.(Message_MsgFundCommunityPool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgFundCommunityPool.Equal(that1.MsgFundCommunityPool) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgSubmitEvidence) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgSubmitEvidence)
+	if !ok {
+		that2, ok := that.(Message_MsgSubmitEvidence)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgSubmitEvidence.Equal(that1.MsgSubmitEvidence) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgSubmitProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgSubmitProposal)
+	if !ok {
+		that2, ok := that.(Message_MsgSubmitProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgSubmitProposal.Equal(that1.MsgSubmitProposal) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgVote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgVote)
+	if !ok {
+		that2, ok := that.(Message_MsgVote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgVote.Equal(that1.MsgVote) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgDeposit)
+	if !ok {
+		that2, ok := that.(Message_MsgDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgDeposit.Equal(that1.MsgDeposit) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgVoteWeighted) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgVoteWeighted)
+	if !ok {
+		that2, ok := that.(Message_MsgVoteWeighted)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgVoteWeighted.Equal(that1.MsgVoteWeighted) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgUnjail) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgUnjail)
+	if !ok {
+		that2, ok := that.(Message_MsgUnjail)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgUnjail.Equal(that1.MsgUnjail) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgCreateValidator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgCreateValidator)
+	if !ok {
+		that2, ok := that.(Message_MsgCreateValidator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgCreateValidator.Equal(that1.MsgCreateValidator) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgEditValidator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgEditValidator)
+	if !ok {
+		that2, ok := that.(Message_MsgEditValidator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgEditValidator.Equal(that1.MsgEditValidator) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgDelegate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgDelegate)
+	if !ok {
+		that2, ok := that.(Message_MsgDelegate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgDelegate.Equal(that1.MsgDelegate) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgBeginRedelegate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgBeginRedelegate)
+	if !ok {
+		that2, ok := that.(Message_MsgBeginRedelegate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgBeginRedelegate.Equal(that1.MsgBeginRedelegate) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgUndelegate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgUndelegate)
+	if !ok {
+		that2, ok := that.(Message_MsgUndelegate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgUndelegate.Equal(that1.MsgUndelegate) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgCreateVestingAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgCreateVestingAccount)
+	if !ok {
+		that2, ok := that.(Message_MsgCreateVestingAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgCreateVestingAccount.Equal(that1.MsgCreateVestingAccount) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgCreatePermanentLockedAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgCreatePermanentLockedAccount)
+	if !ok {
+		that2, ok := that.(Message_MsgCreatePermanentLockedAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgCreatePermanentLockedAccount.Equal(that1.MsgCreatePermanentLockedAccount) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgCreatePeriodicVestingAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgCreatePeriodicVestingAccount)
+	if !ok {
+		that2, ok := that.(Message_MsgCreatePeriodicVestingAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgCreatePeriodicVestingAccount.Equal(that1.MsgCreatePeriodicVestingAccount) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgCreateClawbackVestingAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgCreateClawbackVestingAccount)
+	if !ok {
+		that2, ok := that.(Message_MsgCreateClawbackVestingAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgCreateClawbackVestingAccount.Equal(that1.MsgCreateClawbackVestingAccount) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgClawback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgClawback)
+	if !ok {
+		that2, ok := that.(Message_MsgClawback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgClawback.Equal(that1.MsgClawback) {
+		return false
+	}
+	return true
+}
+func (this *Message_MsgRotatePubKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MsgRotatePubKey)
+	if !ok {
+		that2, ok := that.(Message_MsgRotatePubKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgRotatePubKey.Equal(that1.MsgRotatePubKey) {
+		return false
+	}
+	return true
+}
+func (this *Account) GetAccount() github_com_cosmos_cosmos_sdk_x_auth_exported.Account {
+	if x := this.GetBaseAccount(); x != nil {
+		return x
+	}
+	if x := this.GetContinuousVestingAccount(); x != nil {
+		return x
+	}
+	if x := this.GetDelayedVestingAccount(); x != nil {
+		return x
+	}
+	if x := this.GetPeriodicVestingAccount(); x != nil {
+		return x
+	}
+	if x := this.GetModuleAccount(); x != nil {
+		return x
+	}
+	if x := this.GetPermanentLockedAccount(); x != nil {
+		return x
+	}
+	if x := this.GetClawbackVestingAccount(); x != nil {
+		return x
+	}
+	return nil
+}
+
+func (this *Account) SetAccount(value github_com_cosmos_cosmos_sdk_x_auth_exported.Account) error {
+	if value == nil {
+		this.Sum = nil
+		return nil
+	}
+	switch vt := value.(type) {
+	case *types.BaseAccount:
+		this.Sum = &Account_BaseAccount{vt}
+		return nil
+	case *types1.ContinuousVestingAccount:
+		this.Sum = &Account_ContinuousVestingAccount{vt}
+		return nil
+	case *types1.DelayedVestingAccount:
+		this.Sum = &Account_DelayedVestingAccount{vt}
+		return nil
+	case *types1.PeriodicVestingAccount:
+		this.Sum = &Account_PeriodicVestingAccount{vt}
+		return nil
+	case *types2.ModuleAccount:
+		this.Sum = &Account_ModuleAccount{vt}
+		return nil
+	case *types1.PermanentLockedAccount:
+		this.Sum = &Account_PermanentLockedAccount{vt}
+		return nil
+	case *types1.ClawbackVestingAccount:
+		this.Sum = &Account_ClawbackVestingAccount{vt}
+		return nil
+	}
+	return fmt.Errorf("can't encode value of type %T as message Account", value)
+}
+
+func (this *Supply) GetSupplyI() github_com_cosmos_cosmos_sdk_x_supply_exported.SupplyI {
+	if x := this.GetSupply(); x != nil {
+		return x
+	}
+	return nil
+}
+
+func (this *Supply) SetSupplyI(value github_com_cosmos_cosmos_sdk_x_supply_exported.SupplyI) error {
+	if value == nil {
+		this.Sum = nil
+		return nil
+	}
+	switch vt := value.(type) {
+	case *types2.Supply:
+		this.Sum = &Supply_Supply{vt}
+		return nil
+	}
+	return fmt.Errorf("can't encode value of type %T as message Supply", value)
+}
+
+func (this *Evidence) GetEvidence() github_com_cosmos_cosmos_sdk_x_evidence_exported.Evidence {
+	if x := this.GetEquivocation(); x != nil {
+		return x
+	}
+	return nil
+}
+
+func (this *Evidence) SetEvidence(value github_com_cosmos_cosmos_sdk_x_evidence_exported.Evidence) error {
+	if value == nil {
+		this.Sum = nil
+		return nil
+	}
+	switch vt := value.(type) {
+	case *types3.Equivocation:
+		this.Sum = &Evidence_Equivocation{vt}
+		return nil
+	case types3.Equivocation:
+		this.Sum = &Evidence_Equivocation{&vt}
+		return nil
+	}
+	return fmt.Errorf("can't encode value of type %T as message Evidence", value)
+}
+
+func (this *Content) GetContent() github_com_cosmos_cosmos_sdk_x_gov_types.Content {
+	if x := this.GetText(); x != nil {
+		return x
+	}
+	if x := this.GetParameterChange(); x != nil {
+		return x
+	}
+	if x := this.GetSoftwareUpgrade(); x != nil {
+		return x
+	}
+	if x := this.GetCancelSoftwareUpgrade(); x != nil {
+		return x
+	}
+	if x := this.GetCommunityPoolSpend(); x != nil {
+		return x
+	}
+	if x := this.GetSetDenomMetadata(); x != nil {
+		return x
+	}
+	return nil
+}
+
+func (this *Content) SetContent(value github_com_cosmos_cosmos_sdk_x_gov_types.Content) error {
+	if value == nil {
+		this.Sum = nil
+		return nil
+	}
+	switch vt := value.(type) {
 	case *types4.TextProposal:
 		this.Sum = &Content_Text{vt}
 		return nil
@@ -1542,6 +2361,12 @@ func (this *Content) SetContent(value github_com_cosmos_cosmos_sdk_x_gov_types.C
 	case types6.CommunityPoolSpendProposal:
 		this.Sum = &Content_CommunityPoolSpend{&vt}
 		return nil
+	case *types7.SetDenomMetadataProposal:
+		this.Sum = &Content_SetDenomMetadata{vt}
+		return nil
+	case types7.SetDenomMetadataProposal:
+		this.Sum = &Content_SetDenomMetadata{&vt}
+		return nil
 	}
 	return fmt.Errorf("can't encode value of type %T as message Content", value)
 }
@@ -1580,6 +2405,9 @@ func (this *Message) GetMsg() github_com_cosmos_cosmos_sdk_types.Msg {
 	if x := this.GetMsgDeposit(); x != nil {
 		return x
 	}
+	if x := this.GetMsgVoteWeighted(); x != nil {
+		return x
+	}
 	if x := this.GetMsgUnjail(); x != nil {
 		return x
 	}
@@ -1598,6 +2426,24 @@ func (this *Message) GetMsg() github_com_cosmos_cosmos_sdk_types.Msg {
 	if x := this.GetMsgUndelegate(); x != nil {
 		return x
 	}
+	if x := this.GetMsgCreateVestingAccount(); x != nil {
+		return x
+	}
+	if x := this.GetMsgCreatePermanentLockedAccount(); x != nil {
+		return x
+	}
+	if x := this.GetMsgCreatePeriodicVestingAccount(); x != nil {
+		return x
+	}
+	if x := this.GetMsgCreateClawbackVestingAccount(); x != nil {
+		return x
+	}
+	if x := this.GetMsgClawback(); x != nil {
+		return x
+	}
+	if x := this.GetMsgRotatePubKey(); x != nil {
+		return x
+	}
 	return nil
 }
 
@@ -1673,6 +2519,12 @@ func (this *Message) SetMsg(value github_com_cosmos_cosmos_sdk_types.Msg) error
 	case types4.MsgDeposit:
 		this.Sum = &Message_MsgDeposit{&vt}
 		return nil
+	case *types4.MsgVoteWeighted:
+		this.Sum = &Message_MsgVoteWeighted{vt}
+		return nil
+	case types4.MsgVoteWeighted:
+		this.Sum = &Message_MsgVoteWeighted{&vt}
+		return nil
 	case *types9.MsgUnjail:
 		this.Sum = &Message_MsgUnjail{vt}
 		return nil
@@ -1709,6 +2561,42 @@ func (this *Message) SetMsg(value github_com_cosmos_cosmos_sdk_types.Msg) error
 	case types10.MsgUndelegate:
 		this.Sum = &Message_MsgUndelegate{&vt}
 		return nil
+	case *types1.MsgCreateVestingAccount:
+		this.Sum = &Message_MsgCreateVestingAccount{vt}
+		return nil
+	case types1.MsgCreateVestingAccount:
+		this.Sum = &Message_MsgCreateVestingAccount{&vt}
+		return nil
+	case *types1.MsgCreatePermanentLockedAccount:
+		this.Sum = &Message_MsgCreatePermanentLockedAccount{vt}
+		return nil
+	case types1.MsgCreatePermanentLockedAccount:
+		this.Sum = &Message_MsgCreatePermanentLockedAccount{&vt}
+		return nil
+	case *types1.MsgCreatePeriodicVestingAccount:
+		this.Sum = &Message_MsgCreatePeriodicVestingAccount{vt}
+		return nil
+	case types1.MsgCreatePeriodicVestingAccount:
+		this.Sum = &Message_MsgCreatePeriodicVestingAccount{&vt}
+		return nil
+	case *types1.MsgCreateClawbackVestingAccount:
+		this.Sum = &Message_MsgCreateClawbackVestingAccount{vt}
+		return nil
+	case types1.MsgCreateClawbackVestingAccount:
+		this.Sum = &Message_MsgCreateClawbackVestingAccount{&vt}
+		return nil
+	case *types1.MsgClawback:
+		this.Sum = &Message_MsgClawback{vt}
+		return nil
+	case types1.MsgClawback:
+		this.Sum = &Message_MsgClawback{&vt}
+		return nil
+	case *types.MsgRotatePubKey:
+		this.Sum = &Message_MsgRotatePubKey{vt}
+		return nil
+	case types.MsgRotatePubKey:
+		this.Sum = &Message_MsgRotatePubKey{&vt}
+		return nil
 	}
 	return fmt.Errorf("can't encode value of type %T as message Message", value)
 }
@@ -1850,6 +2738,48 @@ func (m *Account_ModuleAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Account_PermanentLockedAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Account_PermanentLockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PermanentLockedAccount != nil {
+		{
+			size, err := m.PermanentLockedAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Account_ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Account_ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ClawbackVestingAccount != nil {
+		{
+			size, err := m.ClawbackVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Supply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2021,6 +2951,20 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCodec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Content != nil {
 		{
 			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
@@ -2066,6 +3010,20 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCodec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2226,6 +3184,27 @@ func (m *Content_CommunityPoolSpend) MarshalToSizedBuffer(dAtA []byte) (int, err
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Content_SetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Content_SetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetDenomMetadata != nil {
+		{
+			size, err := m.SetDenomMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Transaction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2583,11 +3562,168 @@ func (m *Message_MsgEditValidator) MarshalTo(dAtA []byte) (int, error) {
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message_MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgEditValidator != nil {
+		{
+			size, err := m.MsgEditValidator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_MsgDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgDelegate != nil {
+		{
+			size, err := m.MsgDelegate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_MsgBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MsgBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgBeginRedelegate != nil {
+		{
+			size, err := m.MsgBeginRedelegate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgUndelegate != nil {
+		{
+			size, err := m.MsgUndelegate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_MsgVoteWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgVoteWeighted != nil {
+		{
+			size, err := m.MsgVoteWeighted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_MsgCreateVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MsgCreateVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgCreateVestingAccount != nil {
+		{
+			size, err := m.MsgCreateVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_MsgCreatePermanentLockedAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MsgCreatePermanentLockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgCreatePermanentLockedAccount != nil {
+		{
+			size, err := m.MsgCreatePermanentLockedAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_MsgCreatePeriodicVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MsgCreatePeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgEditValidator != nil {
+	if m.MsgCreatePeriodicVestingAccount != nil {
 		{
-			size, err := m.MsgEditValidator.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MsgCreatePeriodicVestingAccount.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2595,20 +3731,22 @@ func (m *Message_MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgDelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *Message_MsgCreateClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message_MsgCreateClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgDelegate != nil {
+	if m.MsgCreateClawbackVestingAccount != nil {
 		{
-			size, err := m.MsgDelegate.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MsgCreateClawbackVestingAccount.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2616,20 +3754,22 @@ func (m *Message_MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x7a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *Message_MsgClawback) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message_MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgBeginRedelegate != nil {
+	if m.MsgClawback != nil {
 		{
-			size, err := m.MsgBeginRedelegate.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MsgClawback.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2639,20 +3779,20 @@ func (m *Message_MsgBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0xba
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *Message_MsgRotatePubKey) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message_MsgRotatePubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgUndelegate != nil {
+	if m.MsgRotatePubKey != nil {
 		{
-			size, err := m.MsgUndelegate.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MsgRotatePubKey.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2662,7 +3802,7 @@ func (m *Message_MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0xc2
 	}
 	return len(dAtA) - i, nil
 }
@@ -2796,6 +3936,30 @@ func (m *Account_ModuleAccount) Size() (n int) {
 	}
 	return n
 }
+func (m *Account_PermanentLockedAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PermanentLockedAccount != nil {
+		l = m.PermanentLockedAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClawbackVestingAccount != nil {
+		l = m.ClawbackVestingAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *Supply) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2871,6 +4035,12 @@ func (m *MsgSubmitProposal) Size() (n int) {
 		l = m.Content.Size()
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2884,6 +4054,12 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovCodec(uint64(l))
 	l = m.Content.Size()
 	n += 1 + l + sovCodec(uint64(l))
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2959,6 +4135,18 @@ func (m *Content_CommunityPoolSpend) Size() (n int) {
 	}
 	return n
 }
+func (m *Content_SetDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetDenomMetadata != nil {
+		l = m.SetDenomMetadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *Transaction) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3192,6 +4380,90 @@ func (m *Message_MsgUndelegate) Size() (n int) {
 	}
 	return n
 }
+func (m *Message_MsgVoteWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgVoteWeighted != nil {
+		l = m.MsgVoteWeighted.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgCreateVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgCreateVestingAccount != nil {
+		l = m.MsgCreateVestingAccount.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgCreatePermanentLockedAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgCreatePermanentLockedAccount != nil {
+		l = m.MsgCreatePermanentLockedAccount.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgCreatePeriodicVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgCreatePeriodicVestingAccount != nil {
+		l = m.MsgCreatePeriodicVestingAccount.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgCreateClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgCreateClawbackVestingAccount != nil {
+		l = m.MsgCreateClawbackVestingAccount.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgClawback != nil {
+		l = m.MsgClawback.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgRotatePubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgRotatePubKey != nil {
+		l = m.MsgRotatePubKey.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *SignDoc) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3419,6 +4691,76 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Account_ModuleAccount{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermanentLockedAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.PermanentLockedAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_PermanentLockedAccount{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.ClawbackVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_ClawbackVestingAccount{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -3839,6 +5181,40 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, Message{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -3925,9 +5301,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3954,7 +5363,8 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, Message{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4186,6 +5596,41 @@ func (m *Content) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Content_CommunityPoolSpend{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetDenomMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types7.SetDenomMetadataProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Content_SetDenomMetadata{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -4954,6 +6399,251 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_MsgUndelegate{v}
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgVoteWeighted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types4.MsgVoteWeighted{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_MsgVoteWeighted{v}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCreateVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.MsgCreateVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_MsgCreateVestingAccount{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCreatePermanentLockedAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.MsgCreatePermanentLockedAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_MsgCreatePermanentLockedAccount{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCreatePeriodicVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.MsgCreatePeriodicVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_MsgCreatePeriodicVestingAccount{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCreateClawbackVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.MsgCreateClawbackVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_MsgCreateClawbackVestingAccount{v}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgClawback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.MsgClawback{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_MsgClawback{v}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgRotatePubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.MsgRotatePubKey{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_MsgRotatePubKey{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -0,0 +1,35 @@
+package std_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+)
+
+func TestMakeCodecVestingAccounts(t *testing.T) {
+	// the vesting accounts are registered even without the vesting module
+	cdc := std.MakeCodec(module.NewBasicManager(auth.AppModuleBasic{}))
+
+	baseAcc := auth.NewBaseAccountWithAddress(sdk.AccAddress("addr"))
+	var acc authexported.Account = vesting.NewContinuousVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 0, 100)
+
+	bz, err := cdc.MarshalBinaryBare(acc)
+	require.NoError(t, err)
+
+	var decoded authexported.Account
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &decoded))
+	require.Equal(t, acc, decoded)
+
+	// and once when the vesting module is included along with its msgs
+	cdc = std.MakeCodec(module.NewBasicManager(auth.AppModuleBasic{}, vesting.AppModuleBasic{}))
+	bz, err = cdc.MarshalJSON(vesting.MsgCreateVestingAccount{})
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "cosmos-sdk/MsgCreateVestingAccount")
+}
@@ -74,7 +74,41 @@ func (msg MsgSubmitProposal) ValidateBasic() error {
 	if err := msg.Content.GetContent().ValidateBasic(); err != nil {
 		return err
 	}
+	if err := gov.ValidateProposalMsgs(msg.GetMsgs()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetMsgs returns the msgs of the proposal as a slice of sdk.Msg.
+func (msg MsgSubmitProposal) GetMsgs() []sdk.Msg {
+	if len(msg.Msgs) == 0 {
+		return nil
+	}
+
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, m := range msg.Msgs {
+		msgs[i] = msgValue(m.GetMsg())
+	}
+
+	return msgs
+}
+
+// SetMsgs sets the msgs of the proposal. It will overwrite any existing msgs
+// set.
+func (msg *MsgSubmitProposal) SetMsgs(sdkMsgs ...sdk.Msg) error {
+	msgs := make([]Message, len(sdkMsgs))
+	for i, sdkMsg := range sdkMsgs {
+		m := &Message{}
+		if err := m.SetMsg(sdkMsg); err != nil {
+			return err
+		}
+
+		msgs[i] = *m
+	}
 
+	msg.Msgs = msgs
 	return nil
 }
 
@@ -82,3 +116,4 @@ func (msg MsgSubmitProposal) ValidateBasic() error {
 func (msg MsgSubmitProposal) GetContent() gov.Content      { return msg.Content.GetContent() }
 func (msg MsgSubmitProposal) GetInitialDeposit() sdk.Coins { return msg.InitialDeposit }
 func (msg MsgSubmitProposal) GetProposer() sdk.AccAddress  { return msg.Proposer }
+func (msg MsgSubmitProposal) GetExpedited() bool           { return msg.Expedited }
@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/codec/std"
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 )
@@ -40,3 +42,36 @@ func TestNewNewMsgSubmitProposal(t *testing.T) {
 	require.Equal(t, msg.GetInitialDeposit(), d)
 	require.NoError(t, msg.ValidateBasic())
 }
+
+func TestMsgSubmitProposalMsgs(t *testing.T) {
+	p := sdk.AccAddress("foo")
+	d := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	c := gov.TextProposal{Title: "title", Description: "description"}
+	send := bank.NewMsgSend(sdk.AccAddress("gov"), sdk.AccAddress("bar"), d)
+
+	msg, err := std.NewMsgSubmitProposal(c, d, p)
+	require.NoError(t, err)
+	require.Empty(t, msg.GetMsgs())
+
+	require.NoError(t, msg.SetMsgs(send))
+	require.Equal(t, []sdk.Msg{send}, msg.GetMsgs())
+	require.NoError(t, msg.ValidateBasic())
+
+	require.NoError(t, msg.SetMsgs(bank.NewMsgSend(sdk.AccAddress("gov"), sdk.AccAddress("bar"), nil)))
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMarshalProposalMsgs(t *testing.T) {
+	cdc := std.NewAppCodec(std.MakeCodec(simapp.ModuleBasics))
+	send := bank.NewMsgSend(sdk.AccAddress("gov"), sdk.AccAddress("bar"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+	c := gov.TextProposal{Title: "title", Description: "description"}
+	proposal := gov.NewProposal(&c, []sdk.Msg{send}, 1, time.Now().UTC(), time.Now().UTC(), false)
+
+	bz, err := cdc.MarshalProposal(proposal)
+	require.NoError(t, err)
+
+	decoded, err := cdc.UnmarshalProposal(bz)
+	require.NoError(t, err)
+	require.True(t, proposal.Equal(decoded))
+	require.Equal(t, []sdk.Msg{send}, decoded.Msgs)
+}
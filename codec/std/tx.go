@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
 var (
@@ -15,13 +16,31 @@ var (
 
 // TxGenerator defines a transaction generator that allows clients to construct
 // transactions.
-type TxGenerator struct{}
+type TxGenerator struct {
+	signModeHandler signing.SignModeHandler
+}
+
+// NewTxGenerator returns a TxGenerator providing the given SignModeHandler. The
+// zero TxGenerator provides the DefaultSignModeHandler of the auth module.
+func NewTxGenerator(signModeHandler signing.SignModeHandler) TxGenerator {
+	return TxGenerator{signModeHandler: signModeHandler}
+}
 
 // NewTx returns a reference to an empty Transaction type.
 func (TxGenerator) NewTx() clientx.ClientTx {
 	return &Transaction{}
 }
 
+// SignModeHandler returns the handler of the sign modes clients sign the
+// transactions in.
+func (g TxGenerator) SignModeHandler() signing.SignModeHandler {
+	if g.signModeHandler == nil {
+		return signing.DefaultSignModeHandler()
+	}
+
+	return g.signModeHandler
+}
+
 func NewTransaction(fee auth.StdFee, memo string, sdkMsgs []sdk.Msg) (*Transaction, error) {
 	tx := &Transaction{
 		StdTxBase: auth.NewStdTxBase(fee, nil, memo),
@@ -119,12 +138,22 @@ func (tx Transaction) GetSignatures() []sdk.Signature {
 	return sdkSigs
 }
 
+// GetStdSignatures returns the transaction's signatures along with their sign
+// modes.
+func (tx Transaction) GetStdSignatures() []auth.StdSignature {
+	return tx.Signatures
+}
+
 // SetSignatures sets the transaction's signatures. It will overwrite any
-// existing signatures set.
+// existing signatures set. The sign mode of a StdSignature is kept.
 func (tx *Transaction) SetSignatures(sdkSigs ...sdk.Signature) {
 	sigs := make([]auth.StdSignature, len(sdkSigs))
 	for i, sig := range sdkSigs {
-		if sig != nil {
+		switch sig := sig.(type) {
+		case nil:
+		case auth.StdSignature:
+			sigs[i] = sig
+		default:
 			sigs[i] = auth.NewStdSignature(sig.GetPubKey(), sig.GetSignature())
 		}
 	}
@@ -137,6 +166,11 @@ func (tx Transaction) GetFee() sdk.Fee {
 	return tx.Fee
 }
 
+// GetStdFee returns the transaction's fee as a StdFee.
+func (tx Transaction) GetStdFee() auth.StdFee {
+	return tx.Fee
+}
+
 // SetFee sets the transaction's fee. It will overwrite any existing fee set.
 func (tx *Transaction) SetFee(fee sdk.Fee) {
 	tx.Fee = auth.NewStdFee(fee.GetGas(), fee.GetAmount())
@@ -4,6 +4,8 @@ import (
 	"github.com/cosmos/go-bip39"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 )
 
 // PubKeyType defines an algorithm to derive key-pairs which can be used for cryptographic signing.
@@ -14,6 +16,9 @@ const (
 	MultiType = PubKeyType("multi")
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
+	// Secp256r1Type uses the NIST P-256 ECDSA parameters, supported by the secure
+	// enclaves of mobile devices.
+	Secp256r1Type = PubKeyType("secp256r1")
 	// Ed25519Type represents the Ed25519Type signature system.
 	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519Type = PubKeyType("ed25519")
@@ -24,6 +29,8 @@ const (
 var (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = secp256k1Algo{}
+	// Secp256r1 uses the NIST P-256 ECDSA parameters.
+	Secp256r1 = secp256r1Algo{}
 )
 
 type DeriveFn func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
@@ -66,3 +73,37 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return secp256k1.PrivKeySecp256k1(bzArr)
 	}
 }
+
+type secp256r1Algo struct {
+}
+
+func (s secp256r1Algo) Name() PubKeyType {
+	return Secp256r1Type
+}
+
+// Derive derives and returns the secp256r1 private key for the given seed and HD path,
+// following SLIP-0010 for the NIST P-256 curve.
+func (s secp256r1Algo) Derive() DeriveFn {
+	return func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		masterPriv, ch := ComputeMastersFromSeedSecp256r1(seed)
+		if len(hdPath) == 0 {
+			return masterPriv[:], nil
+		}
+		derivedKey, err := DerivePrivateKeyForPathSecp256r1(masterPriv, ch, hdPath)
+		return derivedKey[:], err
+	}
+}
+
+// Generate generates a secp256r1 private key from the given bytes.
+func (s secp256r1Algo) Generate() GenerateFn {
+	return func(bz []byte) crypto.PrivKey {
+		var bzArr [secp256r1.PrivKeySize]byte
+		copy(bzArr[:], bz)
+		return secp256r1.PrivKeySecp256r1(bzArr)
+	}
+}
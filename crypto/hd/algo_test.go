@@ -6,11 +6,36 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestDefaults(t *testing.T) {
 	require.Equal(t, hd.PubKeyType("multi"), hd.MultiType)
 	require.Equal(t, hd.PubKeyType("secp256k1"), hd.Secp256k1Type)
+	require.Equal(t, hd.PubKeyType("secp256r1"), hd.Secp256r1Type)
 	require.Equal(t, hd.PubKeyType("ed25519"), hd.Ed25519Type)
 	require.Equal(t, hd.PubKeyType("sr25519"), hd.Sr25519Type)
 }
+
+func TestSecp256r1Algo(t *testing.T) {
+	mnemonic := "barrel original fuel morning among eternal " +
+		"filter ball stove pluck matrix mechanic"
+
+	require.Equal(t, hd.Secp256r1Type, hd.Secp256r1.Name())
+
+	derived, err := hd.Secp256r1.Derive()(mnemonic, "", types.FullFundraiserPath)
+	require.NoError(t, err)
+
+	privKey := hd.Secp256r1.Generate()(derived)
+	require.IsType(t, secp256r1.PrivKeySecp256r1{}, privKey)
+	require.IsType(t, secp256r1.PubKeySecp256r1{}, privKey.PubKey())
+
+	// the keys of the two curves differ for the same mnemonic and path
+	derivedSecp256k1, err := hd.Secp256k1.Derive()(mnemonic, "", types.FullFundraiserPath)
+	require.NoError(t, err)
+	require.NotEqual(t, derivedSecp256k1, derived)
+
+	_, err = hd.Secp256r1.Derive()("invalid mnemonic", "", types.FullFundraiserPath)
+	require.Error(t, err)
+}
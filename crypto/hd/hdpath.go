@@ -1,6 +1,7 @@
 package hd
 
 import (
+	"crypto/elliptic"
 	"crypto/hmac"
 	"crypto/sha512"
 	"encoding/binary"
@@ -11,6 +12,8 @@ import (
 	"strings"
 
 	"github.com/btcsuite/btcd/btcec"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 )
 
 // BIP44Params wraps BIP 44 params (5 level BIP 32 path).
@@ -156,9 +159,39 @@ func ComputeMastersFromSeed(seed []byte) (secret [32]byte, chainCode [32]byte) {
 	return
 }
 
+// ComputeMastersFromSeedSecp256r1 returns the secp256r1 master secret and chain code of the seed,
+// following SLIP-0010 for the NIST P-256 curve.
+func ComputeMastersFromSeedSecp256r1(seed []byte) (secret [32]byte, chainCode [32]byte) {
+	masterSecret := []byte("Nist256p1 seed")
+	data := seed
+	for {
+		secret, chainCode = i64(masterSecret, data)
+		if isValidScalar(secret[:], elliptic.P256().Params().N) {
+			return
+		}
+
+		// the master secret is invalid, the HMAC is computed again over itself
+		data = append(secret[:], chainCode[:]...)
+	}
+}
+
 // DerivePrivateKeyForPath derives the private key by following the BIP 32/44 path from privKeyBytes,
 // using the given chainCode.
 func DerivePrivateKeyForPath(privKeyBytes [32]byte, chainCode [32]byte, path string) ([32]byte, error) {
+	return derivePrivateKeyForPath(privKeyBytes, chainCode, path, derivePrivateKey)
+}
+
+// DerivePrivateKeyForPathSecp256r1 derives the secp256r1 private key by following the BIP 32/44 path
+// from privKeyBytes, using the given chainCode, as specified by SLIP-0010 for the NIST P-256 curve.
+func DerivePrivateKeyForPathSecp256r1(privKeyBytes [32]byte, chainCode [32]byte, path string) ([32]byte, error) {
+	return derivePrivateKeyForPath(privKeyBytes, chainCode, path, derivePrivateKeySecp256r1)
+}
+
+func derivePrivateKeyForPath(
+	privKeyBytes [32]byte, chainCode [32]byte, path string,
+	derive func(privKeyBytes [32]byte, chainCode [32]byte, index uint32, harden bool) ([32]byte, [32]byte),
+) ([32]byte, error) {
+
 	data := privKeyBytes
 	parts := strings.Split(path, "/")
 	for _, part := range parts {
@@ -175,12 +208,12 @@ func DerivePrivateKeyForPath(privKeyBytes [32]byte, chainCode [32]byte, path str
 		if idx < 0 {
 			return [32]byte{}, errors.New("invalid BIP 32 path: index negative ot too large")
 		}
-		data, chainCode = derivePrivateKey(data, chainCode, uint32(idx), harden)
+		data, chainCode = derive(data, chainCode, uint32(idx), harden)
 	}
 	var derivedKey [32]byte
 	n := copy(derivedKey[:], data[:])
 	if n != 32 || len(data) != 32 {
-		return [32]byte{}, fmt.Errorf("expected a (secp256k1) key of length 32, got length: %v", len(data))
+		return [32]byte{}, fmt.Errorf("expected a key of length 32, got length: %v", len(data))
 	}
 
 	return derivedKey, nil
@@ -214,17 +247,57 @@ func derivePrivateKey(privKeyBytes [32]byte, chainCode [32]byte, index uint32, h
 	return x, chainCode2
 }
 
-// modular big endian addition
-func addScalars(a []byte, b []byte) [32]byte {
+// derivePrivateKeySecp256r1 derives the secp256r1 private key with index and chainCode as specified
+// by SLIP-0010, which derives the key of the next index data when the derived key is invalid.
+// If harden is true, the derivation is 'hardened'.
+// It returns the new private key and new chain code.
+func derivePrivateKeySecp256r1(privKeyBytes [32]byte, chainCode [32]byte, index uint32, harden bool) ([32]byte, [32]byte) {
+	n := elliptic.P256().Params().N
+
+	var data []byte
+	if harden {
+		index |= 0x80000000
+		data = append([]byte{byte(0)}, privKeyBytes[:]...)
+	} else {
+		pubKey := secp256r1.PrivKeySecp256r1(privKeyBytes).PubKey().(secp256r1.PubKeySecp256r1)
+		data = pubKey[:]
+	}
+	data = append(data, uint32ToBytes(index)...)
+
+	for {
+		il, ir := i64(chainCode[:], data)
+		if isValidScalar(il[:], n) {
+			if x := addScalarsMod(privKeyBytes[:], il[:], n); isValidScalar(x[:], n) {
+				return x, ir
+			}
+		}
+
+		data = append(append([]byte{byte(1)}, ir[:]...), uint32ToBytes(index)...)
+	}
+}
+
+// isValidScalar returns whether the big endian scalar is in [1, n-1].
+func isValidScalar(a []byte, n *big.Int) bool {
+	aInt := new(big.Int).SetBytes(a)
+	return aInt.Sign() > 0 && aInt.Cmp(n) < 0
+}
+
+// modular big endian addition, modulo n
+func addScalarsMod(a []byte, b []byte, n *big.Int) [32]byte {
 	aInt := new(big.Int).SetBytes(a)
 	bInt := new(big.Int).SetBytes(b)
 	sInt := new(big.Int).Add(aInt, bInt)
-	x := sInt.Mod(sInt, btcec.S256().N).Bytes()
+	x := sInt.Mod(sInt, n).Bytes()
 	x2 := [32]byte{}
 	copy(x2[32-len(x):], x)
 	return x2
 }
 
+// modular big endian addition
+func addScalars(a []byte, b []byte) [32]byte {
+	return addScalarsMod(a, b, btcec.S256().N)
+}
+
 func uint32ToBytes(i uint32) []byte {
 	b := [4]byte{}
 	binary.BigEndian.PutUint32(b[:], i)
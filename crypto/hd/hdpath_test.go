@@ -213,3 +213,42 @@ func TestCreateHDPath(t *testing.T) {
 		})
 	}
 }
+
+// Test vectors of SLIP-0010 for the NIST P-256 curve.
+func TestDerivePrivateKeyForPathSecp256r1(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	master, ch := hd.ComputeMastersFromSeedSecp256r1(seed)
+	require.Equal(t, "612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2", hex.EncodeToString(master[:]))
+	require.Equal(t, "beeb672fe4621673f722f38529c07392fecaa61015c80c34f29ce8b41b3cb6ea", hex.EncodeToString(ch[:]))
+
+	testCases := []struct {
+		path    string
+		privKey string
+	}{
+		{"0'", "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c"},
+		{"0'/1", "284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129"},
+		{"0'/1/2'", "694596e8a54f252c960eb771a3c41e7e32496d03b954aeb90f61635b8e092aa7"},
+		{"0'/1/2'/2", "5996c37fd3dd2679039b23ed6f70b506c6b56b3cb5e424681fb0fa64caf82aaa"},
+		{"0'/1/2'/2/1000000000", "21c4f269ef0a5fd1badf47eeacebeeaa3de22eb8e5b0adcd0f27dd99d34d0119"},
+		// the derivation of the key of the second index is retried
+		{"28578'", "06f0db126f023755d0b8d86d4591718a5210dd8d024e3e14b6159d63f53aa669"},
+		{"28578'/33941", "092154eed4af83e078ff9b84322015aefe5769e31270f62c3f66c33888335f3a"},
+	}
+	for _, tc := range testCases {
+		priv, err := hd.DerivePrivateKeyForPathSecp256r1(master, ch, tc.path)
+		require.NoError(t, err, tc.path)
+		require.Equal(t, tc.privKey, hex.EncodeToString(priv[:]), tc.path)
+	}
+
+	_, err = hd.DerivePrivateKeyForPathSecp256r1(master, ch, "X/0'")
+	require.Error(t, err)
+
+	// the master key of the seed is retried
+	seed, err = hex.DecodeString("a7305bc8df8d0951f0cb224c0e95d7707cbdf2c6ce7e8d481fec69c7ff5e9446")
+	require.NoError(t, err)
+
+	master, _ = hd.ComputeMastersFromSeedSecp256r1(seed)
+	require.Equal(t, "3b8c18469a4634517d6d0b65448f8e6c62091b45540a1743c5846be55d47d88f", hex.EncodeToString(master[:]))
+}
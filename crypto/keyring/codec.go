@@ -1,8 +1,6 @@
 package keyring
 
 import (
-	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
-
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 )
@@ -12,7 +10,7 @@ var CryptoCdc *codec.Codec
 
 func init() {
 	CryptoCdc = codec.New()
-	cryptoAmino.RegisterAmino(CryptoCdc)
+	codec.RegisterCrypto(CryptoCdc)
 	RegisterCodec(CryptoCdc)
 	CryptoCdc.Seal()
 }
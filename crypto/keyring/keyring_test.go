@@ -15,6 +15,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/tests"
 	"github.com/cosmos/cosmos-sdk/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -629,6 +630,30 @@ func TestInMemoryExportImportPrivKey(t *testing.T) {
 	require.True(t, priv1.GetPubKey().Equals(priv2.GetPubKey()))
 }
 
+func TestInMemorySecp256r1(t *testing.T) {
+	kb := NewInMemory()
+
+	info, _, err := kb.NewMnemonic("john", English, types.FullFundraiserPath, hd.Secp256r1)
+	require.NoError(t, err)
+	require.Equal(t, hd.Secp256r1Type, info.GetAlgo())
+	require.IsType(t, secp256r1.PubKeySecp256r1{}, info.GetPubKey())
+
+	msg := []byte("some message")
+	sig, pub, err := kb.Sign("john", msg)
+	require.NoError(t, err)
+	require.True(t, info.GetPubKey().Equals(pub))
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	armored, err := kb.ExportPrivKeyArmor("john", "secretcpw")
+	require.NoError(t, err)
+	require.NoError(t, kb.Delete("john"))
+	require.NoError(t, kb.ImportPrivKey("john", armored, "secretcpw"))
+
+	imported, err := kb.Key("john")
+	require.NoError(t, err)
+	require.True(t, info.GetPubKey().Equals(imported.GetPubKey()))
+}
+
 func TestInMemoryExportImportPubKey(t *testing.T) {
 	// make the storage with reasonable defaults
 	cstore := NewInMemory()
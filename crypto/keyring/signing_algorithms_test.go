@@ -25,6 +25,13 @@ func TestNewSigningAlgoByString(t *testing.T) {
 			hd.Secp256k1,
 			nil,
 		},
+		{
+			"supported secp256r1 algorithm",
+			"secp256r1",
+			true,
+			hd.Secp256r1,
+			nil,
+		},
 		{
 			"not supported",
 			"notsupportedalgo",
@@ -38,7 +45,8 @@ func TestNewSigningAlgoByString(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			algorithm, err := NewSigningAlgoFromString(tt.algoStr)
 			if tt.isSupported {
-				require.Equal(t, hd.Secp256k1, algorithm)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedAlgo, algorithm)
 			} else {
 				require.EqualError(t, err, tt.expectedErr.Error())
 			}
@@ -0,0 +1,248 @@
+// Package secp256r1 implements the ECDSA keys of the NIST P-256 curve, also
+// known as secp256r1 or prime256v1, which are the keys supported by the secure
+// enclaves of mobile devices and by WebAuthn authenticators.
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+)
+
+const (
+	PrivKeyAminoName = "cosmos-sdk/PrivKeySecp256r1"
+	PubKeyAminoName  = "cosmos-sdk/PubKeySecp256r1"
+
+	// PrivKeySize is the size, in bytes, of a private key, the big-endian
+	// encoding of its scalar.
+	PrivKeySize = 32
+	// PubKeySize is the size, in bytes, of a public key in SEC 1 compressed
+	// form.
+	PubKeySize = 33
+	// SignatureSize is the size, in bytes, of a signature of the form R || S.
+	SignatureSize = 64
+)
+
+var (
+	cdc = amino.NewCodec()
+
+	// halfN is used to reject malleable signatures, which are not in lower-S
+	// form.
+	halfN = new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+)
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	RegisterAmino(cdc)
+
+	// register the keys on the codec of Tendermint, which decodes the public
+	// keys of the bech32 encoding and the private keys of the keyring
+	cryptoamino.RegisterKeyType(PubKeySecp256r1{}, PubKeyAminoName)
+	cryptoamino.RegisterKeyType(PrivKeySecp256r1{}, PrivKeyAminoName)
+}
+
+// RegisterAmino registers the secp256r1 keys on the given amino codec, on which
+// the crypto.PubKey and crypto.PrivKey interfaces must be registered.
+func RegisterAmino(cdc *amino.Codec) {
+	cdc.RegisterConcrete(PubKeySecp256r1{}, PubKeyAminoName, nil)
+	cdc.RegisterConcrete(PrivKeySecp256r1{}, PrivKeyAminoName, nil)
+}
+
+//-------------------------------------
+
+var _ crypto.PrivKey = PrivKeySecp256r1{}
+
+// PrivKeySecp256r1 implements crypto.PrivKey.
+type PrivKeySecp256r1 [PrivKeySize]byte
+
+// GenPrivKey generates a new private key with the randomness of crypto/rand.
+func GenPrivKey() PrivKeySecp256r1 {
+	return genPrivKey(rand.Reader)
+}
+
+func genPrivKey(rand io.Reader) PrivKeySecp256r1 {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand)
+	if err != nil {
+		panic(err)
+	}
+
+	var privKey PrivKeySecp256r1
+	d := priv.D.Bytes()
+	copy(privKey[PrivKeySize-len(d):], d)
+
+	return privKey
+}
+
+// Bytes marshals the private key using amino encoding.
+func (privKey PrivKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign creates an ECDSA signature on curve P-256, using SHA256 on the msg. The
+// returned signature is of the form R || S, in lower-S form.
+func (privKey PrivKeySecp256r1) Sign(msg []byte) ([]byte, error) {
+	priv, err := privKey.toECDSA()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, priv, hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Cmp(halfN) > 0 {
+		s = new(big.Int).Sub(elliptic.P256().Params().N, s)
+	}
+
+	sig := make([]byte, SignatureSize)
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+	copy(sig[32-len(rBytes):32], rBytes)
+	copy(sig[SignatureSize-len(sBytes):], sBytes)
+
+	return sig, nil
+}
+
+// PubKey returns the public key of the private key in compressed form. It
+// panics if the private key is not a valid scalar of the curve.
+func (privKey PrivKeySecp256r1) PubKey() crypto.PubKey {
+	priv, err := privKey.toECDSA()
+	if err != nil {
+		panic(err)
+	}
+
+	return compress(priv.X, priv.Y)
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKeySecp256r1) Equals(other crypto.PrivKey) bool {
+	if otherKey, ok := other.(PrivKeySecp256r1); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherKey[:]) == 1
+	}
+
+	return false
+}
+
+// toECDSA returns the ECDSA private key, failing if its scalar is not in
+// [1, N-1].
+func (privKey PrivKeySecp256r1) toECDSA() (*ecdsa.PrivateKey, error) {
+	curve := elliptic.P256()
+
+	d := new(big.Int).SetBytes(privKey[:])
+	if d.Sign() == 0 || d.Cmp(curve.Params().N) >= 0 {
+		return nil, fmt.Errorf("invalid secp256r1 private key")
+	}
+
+	priv := &ecdsa.PrivateKey{D: d}
+	priv.Curve = curve
+	priv.X, priv.Y = curve.ScalarBaseMult(privKey[:])
+
+	return priv, nil
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKeySecp256r1{}
+
+// PubKeySecp256r1 implements crypto.PubKey. It is the SEC 1 compressed form of
+// the point: a 0x02 or 0x03 prefix for the parity of Y, followed by X.
+type PubKeySecp256r1 [PubKeySize]byte
+
+// Address returns the truncated SHA256 hash of the public key.
+func (pubKey PubKeySecp256r1) Address() crypto.Address {
+	return crypto.AddressHash(pubKey[:])
+}
+
+// Bytes marshals the public key using amino encoding.
+func (pubKey PubKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(pubKey)
+}
+
+// VerifyBytes verifies a signature of the form R || S. It rejects the
+// signatures which are not in lower-S form.
+func (pubKey PubKeySecp256r1) VerifyBytes(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	x, y, ok := decompress(pubKey)
+	if !ok {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(halfN) > 0 {
+		return false
+	}
+
+	hash := sha256.Sum256(msg)
+	return ecdsa.Verify(&ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, hash[:], r, s)
+}
+
+func (pubKey PubKeySecp256r1) String() string {
+	return fmt.Sprintf("PubKeySecp256r1{%X}", pubKey[:])
+}
+
+// Equals returns whether the other public key is the same secp256r1 key.
+func (pubKey PubKeySecp256r1) Equals(other crypto.PubKey) bool {
+	if otherKey, ok := other.(PubKeySecp256r1); ok {
+		return bytes.Equal(pubKey[:], otherKey[:])
+	}
+
+	return false
+}
+
+// compress returns the SEC 1 compressed form of a point of the curve.
+func compress(x, y *big.Int) PubKeySecp256r1 {
+	var pubKey PubKeySecp256r1
+	pubKey[0] = 0x02 | byte(y.Bit(0))
+
+	xBytes := x.Bytes()
+	copy(pubKey[PubKeySize-len(xBytes):], xBytes)
+
+	return pubKey
+}
+
+// decompress returns the point of a compressed public key, solving
+// y² = x³ - 3x + b for the Y of the parity given by its prefix.
+func decompress(pubKey PubKeySecp256r1) (x, y *big.Int, ok bool) {
+	params := elliptic.P256().Params()
+	if pubKey[0] != 0x02 && pubKey[0] != 0x03 {
+		return nil, nil, false
+	}
+
+	x = new(big.Int).SetBytes(pubKey[1:])
+	if x.Cmp(params.P) >= 0 {
+		return nil, nil, false
+	}
+
+	x3 := new(big.Int).Exp(x, big.NewInt(3), params.P)
+	threeX := new(big.Int).Mul(x, big.NewInt(3))
+	y2 := new(big.Int).Sub(x3, threeX)
+	y2.Add(y2, params.B)
+	y2.Mod(y2, params.P)
+
+	y = new(big.Int).ModSqrt(y2, params.P)
+	if y == nil {
+		return nil, nil, false
+	}
+	if y.Bit(0) != uint(pubKey[0]&1) {
+		y.Sub(params.P, y)
+	}
+
+	return x, y, true
+}
@@ -0,0 +1,135 @@
+package secp256r1_test
+
+import (
+	"crypto/elliptic"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	msg := []byte("hello world")
+
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, secp256r1.SignatureSize)
+	require.True(t, pubKey.VerifyBytes(msg, sig))
+
+	// a signature over another message or by another key is rejected
+	require.False(t, pubKey.VerifyBytes([]byte("hello"), sig))
+	require.False(t, secp256r1.GenPrivKey().PubKey().VerifyBytes(msg, sig))
+	require.False(t, pubKey.VerifyBytes(msg, sig[:32]))
+
+	// the malleated signature, in higher-S form, is rejected
+	n := elliptic.P256().Params().N
+	s := new(big.Int).Sub(n, new(big.Int).SetBytes(sig[32:]))
+	malleated := make([]byte, secp256r1.SignatureSize)
+	copy(malleated, sig[:32])
+	sBytes := s.Bytes()
+	copy(malleated[secp256r1.SignatureSize-len(sBytes):], sBytes)
+	require.False(t, pubKey.VerifyBytes(msg, malleated))
+}
+
+func TestPubKey(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey, ok := privKey.PubKey().(secp256r1.PubKeySecp256r1)
+	require.True(t, ok)
+
+	x, y := elliptic.P256().ScalarBaseMult(privKey[:])
+	var expected secp256r1.PubKeySecp256r1
+	expected[0] = 0x02 | byte(y.Bit(0))
+	xBytes := x.Bytes()
+	copy(expected[secp256r1.PubKeySize-len(xBytes):], xBytes)
+
+	require.Equal(t, expected, pubKey)
+	require.Len(t, pubKey.Address(), crypto.AddressSize)
+	require.True(t, pubKey.Equals(privKey.PubKey()))
+	require.False(t, pubKey.Equals(secp256r1.GenPrivKey().PubKey()))
+	require.True(t, privKey.Equals(privKey))
+	require.False(t, privKey.Equals(secp256r1.GenPrivKey()))
+
+	// a public key which is not a point of the curve verifies no signature
+	var invalid secp256r1.PubKeySecp256r1
+	invalid[0] = 0x04
+	sig, err := privKey.Sign([]byte("msg"))
+	require.NoError(t, err)
+	require.False(t, invalid.VerifyBytes([]byte("msg"), sig))
+}
+
+func TestInvalidPrivKey(t *testing.T) {
+	var privKey secp256r1.PrivKeySecp256r1
+
+	_, err := privKey.Sign([]byte("msg"))
+	require.Error(t, err)
+	require.Panics(t, func() { privKey.PubKey() })
+}
+
+func TestAminoEncoding(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+
+	var decodedPubKey crypto.PubKey
+	require.NoError(t, cdc.UnmarshalBinaryBare(pubKey.Bytes(), &decodedPubKey))
+	require.Equal(t, pubKey, decodedPubKey)
+
+	var decodedPrivKey crypto.PrivKey
+	require.NoError(t, cdc.UnmarshalBinaryBare(privKey.Bytes(), &decodedPrivKey))
+	require.Equal(t, privKey, decodedPrivKey)
+
+	// the keys are registered on the codec of Tendermint too
+	decodedPubKey, err := cryptoamino.PubKeyFromBytes(pubKey.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, pubKey, decodedPubKey)
+
+	decodedPrivKey, err = cryptoamino.PrivKeyFromBytes(privKey.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, privKey, decodedPrivKey)
+}
+
+func TestBech32(t *testing.T) {
+	pubKey := secp256r1.GenPrivKey().PubKey()
+
+	bech32PubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+	require.NoError(t, err)
+
+	decoded, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, bech32PubKey)
+	require.NoError(t, err)
+	require.Equal(t, pubKey, decoded)
+}
+
+func BenchmarkVerifyBytes(b *testing.B) {
+	msg := []byte("hello world")
+
+	benchmarks := []struct {
+		name    string
+		privKey crypto.PrivKey
+	}{
+		{"secp256r1", secp256r1.GenPrivKey()},
+		{"secp256k1", secp256k1.GenPrivKey()},
+	}
+	for _, bm := range benchmarks {
+		bm := bm
+		sig, err := bm.privKey.Sign(msg)
+		require.NoError(b, err)
+		pubKey := bm.privKey.PubKey()
+
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				pubKey.VerifyBytes(msg, sig)
+			}
+		})
+	}
+}
@@ -90,7 +90,9 @@ func (pkl PrivKeyLedgerSecp256k1) PubKey() tmcrypto.PubKey {
 	return pkl.CachedPubKey
 }
 
-// Sign returns a secp256k1 signature for the corresponding message
+// Sign returns a secp256k1 signature for the corresponding message, i.e. the
+// sign bytes of the sign mode of the tx, such as the encoded TextualSignDoc of
+// SIGN_MODE_TEXTUAL, whose screens the device shows.
 func (pkl PrivKeyLedgerSecp256k1) Sign(message []byte) ([]byte, error) {
 	device, err := getLedgerDevice()
 	if err != nil {
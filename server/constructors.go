@@ -11,6 +11,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -30,6 +31,20 @@ func openDB(rootDir string) (dbm.DB, error) {
 	return db, err
 }
 
+// GetSnapshotStore opens the state sync snapshot store located in the data
+// directory of the given root directory. Applications should pass it to
+// BaseApp via baseapp.SetSnapshotStore along with the snapshot flags, see
+// GetSnapshotOptionsFromFlags.
+func GetSnapshotStore(rootDir string) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(rootDir, "data", "snapshots")
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile != "" {
 		w, err = os.OpenFile(
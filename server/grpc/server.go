@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Application defines the interface of an application whose gRPC query
+// services can be served by a gRPC server, such as BaseApp.
+type Application interface {
+	RegisterGRPCServer(server sdk.GRPCServer)
+}
+
+// StartGRPCServer starts a gRPC server listening on the given address and
+// serving the gRPC query services of the application. The messages are encoded
+// with their gogoproto generated methods.
+func StartGRPCServer(app Application, address string) (*grpc.Server, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcSrv := grpc.NewServer(grpc.CustomCodec(Codec{}))
+	app.RegisterGRPCServer(grpcSrv)
+
+	// Serve only returns once the server is stopped, as the listener is open
+	go grpcSrv.Serve(listener) // nolint: errcheck
+
+	return grpcSrv, nil
+}
+
+// Codec implements the gRPC codec of the messages served by StartGRPCServer.
+// Clients may use it with the grpc.ForceCodec call option.
+type Codec struct{}
+
+// Marshal implements the grpc.Codec interface.
+func (Codec) Marshal(v interface{}) ([]byte, error) {
+	msg, ok := v.(codec.ProtoMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a protobuf message", v)
+	}
+	return msg.Marshal()
+}
+
+// Unmarshal implements the grpc.Codec interface.
+func (Codec) Unmarshal(data []byte, v interface{}) error {
+	msg, ok := v.(codec.ProtoMarshaler)
+	if !ok {
+		return fmt.Errorf("%T is not a protobuf message", v)
+	}
+	return msg.Unmarshal(data)
+}
+
+// Name implements the encoding.Codec interface.
+func (Codec) Name() string { return "proto" }
+
+// String implements the grpc.Codec interface.
+func (Codec) String() string { return "proto" }
@@ -0,0 +1,71 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func freeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return listener.Addr().String()
+}
+
+func TestGRPCServer(t *testing.T) {
+	_, _, addr := authtypes.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	app := simapp.SetupWithGenesisAccounts(
+		authexported.GenesisAccounts{&authtypes.BaseAccount{Address: addr}},
+		banktypes.Balance{Address: addr, Coins: coins},
+	)
+
+	address := freeAddress(t)
+	grpcSrv, err := servergrpc.StartGRPCServer(app, address)
+	require.NoError(t, err)
+	defer grpcSrv.Stop()
+
+	conn, err := grpc.Dial(
+		address,
+		grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(servergrpc.Codec{})),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	bankClient := banktypes.NewQueryClient(conn)
+	res, err := bankClient.AllBalances(context.Background(), &banktypes.QueryAllBalancesRequest{Address: addr})
+	require.NoError(t, err)
+	require.Equal(t, coins, res.Balances)
+
+	// the committed state of a given height can be queried
+	ctx := metadata.AppendToOutgoingContext(context.Background(), baseapp.GRPCBlockHeightHeader, "1")
+	res, err = bankClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: addr})
+	require.NoError(t, err)
+	require.Equal(t, coins, res.Balances)
+
+	ctx = metadata.AppendToOutgoingContext(context.Background(), baseapp.GRPCBlockHeightHeader, "100")
+	_, err = bankClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: addr})
+	require.Error(t, err)
+
+	authClient := authtypes.NewQueryClient(conn)
+	paramsRes, err := authClient.Params(context.Background(), &authtypes.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, authtypes.DefaultParams(), paramsRes.Params)
+
+	_, err = authClient.Account(context.Background(), &authtypes.QueryAccountRequest{})
+	require.Error(t, err)
+}
@@ -13,6 +13,7 @@ import (
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -28,8 +29,14 @@ func NewApp(rootDir string, logger log.Logger) (abci.Application, error) {
 	// Capabilities key to access the main KVStore.
 	capKeyMainStore := sdk.NewKVStoreKey(bam.MainStoreKey)
 
+	// Enable the state sync snapshots configured by the start command flags.
+	snapshotOpts, err := server.GetSnapshotOptionsFromFlags(rootDir)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create BaseApp.
-	baseApp := bam.NewBaseApp("kvstore", logger, db, decodeTx)
+	baseApp := bam.NewBaseApp("kvstore", logger, db, decodeTx, snapshotOpts...)
 
 	// Set mounts for BaseApp's MultiStore.
 	baseApp.MountStores(capKeyMainStore)
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// GetSnapshotOptionsFromFlags parses start command flags and returns the BaseApp options
+// enabling state sync snapshots, opening the snapshot store in the data directory of the
+// given root directory. No options are returned if snapshots are disabled.
+// App creators must pass the options to their BaseApp for snapshots to be taken.
+func GetSnapshotOptionsFromFlags(rootDir string) ([]func(*baseapp.BaseApp), error) {
+	interval := viper.GetUint64(FlagStateSyncSnapshotInterval)
+	if interval == 0 {
+		return nil, nil
+	}
+
+	snapshotStore, err := GetSnapshotStore(rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return []func(*baseapp.BaseApp){
+		baseapp.SetSnapshotStore(snapshotStore),
+		baseapp.SetSnapshotInterval(interval),
+		baseapp.SetSnapshotKeepRecent(viper.GetUint32(FlagStateSyncSnapshotKeepRecent)),
+	}, nil
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/tests"
+)
+
+func TestGetSnapshotOptionsFromFlags(t *testing.T) {
+	dir, cleanup := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+	t.Cleanup(viper.Reset)
+
+	// snapshots are disabled by default
+	opts, err := GetSnapshotOptionsFromFlags(dir)
+	require.NoError(t, err)
+	require.Empty(t, opts)
+
+	viper.Set(FlagStateSyncSnapshotInterval, 1000)
+	viper.Set(FlagStateSyncSnapshotKeepRecent, 3)
+
+	opts, err = GetSnapshotOptionsFromFlags(dir)
+	require.NoError(t, err)
+	require.Len(t, opts, 3)
+
+	// the snapshot manager of the app is set, so it can list its snapshots
+	app := baseapp.NewBaseApp("test", log.NewNopLogger(), dbm.NewMemDB(), nil, opts...)
+	snapshots, err := app.ListSnapshots()
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+}
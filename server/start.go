@@ -16,6 +16,9 @@ import (
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
+	"google.golang.org/grpc"
+
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 )
 
 // Tendermint full-node start flags
@@ -32,6 +35,12 @@ const (
 	FlagHaltTime             = "halt-time"
 	FlagInterBlockCache      = "inter-block-cache"
 	FlagUnsafeSkipUpgrades   = "unsafe-skip-upgrades"
+
+	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+
+	FlagGRPCEnable  = "grpc.enable"
+	FlagGRPCAddress = "grpc.address"
 )
 
 var (
@@ -43,6 +52,14 @@ var (
 		"'--%s' and '--%s' must be set together",
 		flagPruningSnapshotEvery, flagPruningKeepEvery,
 	)
+	errSnapshotIntervalWithoutSnapshotEvery = fmt.Errorf(
+		"'--%s' requires a pruning strategy that keeps snapshot heights on disk",
+		FlagStateSyncSnapshotInterval,
+	)
+	errSnapshotIntervalNotMultiple = fmt.Errorf(
+		"'--%s' must be a multiple of the pruning snapshot-every interval",
+		FlagStateSyncSnapshotInterval,
+	)
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -68,11 +85,25 @@ the halt-height or if the current block time is greater than or equal to the hal
 node will attempt to gracefully shutdown and the block will not be committed. In addition, the node
 will not be able to commit subsequent blocks.
 
+State sync snapshots of the application state can be enabled via the '--state-sync.snapshot-interval'
+flag, which defines the block interval between snapshots. Since snapshots are taken asynchronously
+from committed heights, the interval must be a multiple of the pruning snapshot-every interval so that
+the height is kept on disk. Old snapshots are pruned according to '--state-sync.snapshot-keep-recent'.
+The application creator must pass the options of GetSnapshotOptionsFromFlags to its BaseApp.
+
+An in-process gRPC server serving the gRPC query services of the application can be enabled via the
+'--grpc.enable' flag. It listens on the address given by '--grpc.address' and is only started along
+with Tendermint.
+
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
 `,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return checkPruningParams()
+			if err := checkPruningParams(); err != nil {
+				return err
+			}
+
+			return checkSnapshotParams()
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !viper.GetBool(flagWithTendermint) {
@@ -103,6 +134,10 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
+	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval (0 disables snapshots)")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshots to keep (0 keeps all)")
+	cmd.Flags().Bool(FlagGRPCEnable, false, "Enable the gRPC server serving the gRPC query services of the application")
+	cmd.Flags().String(FlagGRPCAddress, "0.0.0.0:9090", "The gRPC server address to listen on")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -130,6 +165,26 @@ func checkPruningParams() error {
 	return nil
 }
 
+// checkSnapshotParams checks that the state sync snapshot interval is compatible
+// with the pruning options, such that every snapshot height is kept on disk.
+func checkSnapshotParams() error {
+	interval := viper.GetUint64(FlagStateSyncSnapshotInterval)
+	if interval == 0 {
+		return nil
+	}
+
+	pruningOpts := GetPruningOptionsFromFlags()
+	if pruningOpts.SnapshotEvery <= 0 {
+		return errSnapshotIntervalWithoutSnapshotEvery
+	}
+
+	if interval%uint64(pruningOpts.SnapshotEvery) != 0 {
+		return errSnapshotIntervalNotMultiple
+	}
+
+	return nil
+}
+
 func startStandAlone(ctx *Context, appCreator AppCreator) error {
 	addr := viper.GetString(flagAddress)
 	home := viper.GetString("home")
@@ -213,6 +268,21 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 		return nil, err
 	}
 
+	var grpcSrv *grpc.Server
+	if viper.GetBool(FlagGRPCEnable) {
+		grpcApp, ok := app.(servergrpc.Application)
+		if !ok {
+			return nil, fmt.Errorf("application does not support gRPC query services")
+		}
+
+		grpcSrv, err = servergrpc.StartGRPCServer(grpcApp, viper.GetString(FlagGRPCAddress))
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.Logger.Info("started gRPC server", "address", viper.GetString(FlagGRPCAddress))
+	}
+
 	var cpuProfileCleanup func()
 
 	if cpuProfile := viper.GetString(flagCPUProfile); cpuProfile != "" {
@@ -238,6 +308,10 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 			_ = tmNode.Stop()
 		}
 
+		if grpcSrv != nil {
+			grpcSrv.Stop()
+		}
+
 		if cpuProfileCleanup != nil {
 			cpuProfileCleanup()
 		}
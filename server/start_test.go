@@ -83,6 +83,35 @@ func TestPruningOptions(t *testing.T) {
 			returnsErr:  false,
 			expectedErr: nil,
 		},
+		{
+			name: "snapshot interval multiple of snapshot-every",
+			paramInit: func() {
+				viper.Set(flagPruningSnapshotEvery, 1000)
+				viper.Set(flagPruningKeepEvery, 100)
+				viper.Set(FlagStateSyncSnapshotInterval, 2000)
+			},
+			returnsErr:  false,
+			expectedErr: nil,
+		},
+		{
+			name: "snapshot interval not multiple of snapshot-every",
+			paramInit: func() {
+				viper.Set(flagPruningSnapshotEvery, 1000)
+				viper.Set(flagPruningKeepEvery, 100)
+				viper.Set(FlagStateSyncSnapshotInterval, 1500)
+			},
+			returnsErr:  true,
+			expectedErr: errSnapshotIntervalNotMultiple,
+		},
+		{
+			name: "snapshot interval with pruning everything",
+			paramInit: func() {
+				viper.Set(flagPruning, "everything")
+				viper.Set(FlagStateSyncSnapshotInterval, 1000)
+			},
+			returnsErr:  true,
+			expectedErr: errSnapshotIntervalWithoutSnapshotEvery,
+		},
 	}
 
 	for _, tt := range tests {
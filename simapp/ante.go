@@ -0,0 +1,42 @@
+package simapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantante "github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarketante "github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+	ibcante "github.com/cosmos/cosmos-sdk/x/ibc/ante"
+	ibckeeper "github.com/cosmos/cosmos-sdk/x/ibc/keeper"
+)
+
+// NewAnteHandler returns the AnteHandler of the SimApp. It is the AnteHandler
+// of the feegrant module, deducting the fees from the fee granter of the tx if
+// one is set, which then enforces the base fee of the fee market module on the
+// deducted fees. With the zero base fee of the default genesis, the fees are
+// distributed as without the fee market module.
+func NewAnteHandler(
+	ak auth.AccountKeeper, supplyKeeper authtypes.SupplyKeeper, feeGrantKeeper feegrant.Keeper,
+	feeMarketKeeper feemarket.Keeper, ibcKeeper ibckeeper.Keeper,
+	sigGasConsumer ante.SignatureVerificationGasConsumer, signModeHandler signing.SignModeHandler,
+) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewMempoolFeeDecorator(),
+		ante.NewValidateBasicDecorator(),
+		ante.NewValidateMemoDecorator(ak),
+		ante.NewConsumeGasForTxSizeDecorator(ak),
+		ante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(ak),
+		feegrantante.NewDeductGrantedFeeDecorator(ak, supplyKeeper, feeGrantKeeper),
+		feemarketante.NewBaseFeeDecorator(feeMarketKeeper), // BaseFeeDecorator must be called after the fees are deducted
+		ante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		ante.NewSigVerificationDecorator(ak, signModeHandler),
+		ante.NewIncrementSequenceDecorator(ak),
+		ibcante.NewProofVerificationDecorator(ibcKeeper.ClientKeeper, ibcKeeper.ChannelKeeper), // innermost AnteDecorator
+	)
+}
@@ -35,10 +35,17 @@ func TestSimGenesisAccountValidate(t *testing.T) {
 			false,
 		},
 		{
-			"invalid basic account with mismatching address/pubkey",
+			"valid basic account with rotated pubkey",
 			simapp.SimGenesisAccount{
 				BaseAccount: authtypes.NewBaseAccount(addr, secp256k1.GenPrivKey().PubKey(), 0, 0),
 			},
+			false,
+		},
+		{
+			"invalid basic account pubkey",
+			simapp.SimGenesisAccount{
+				BaseAccount: &authtypes.BaseAccount{Address: addr, PubKey: []byte{0x1}},
+			},
 			true,
 		},
 		{
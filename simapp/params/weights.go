@@ -2,20 +2,37 @@ package params
 
 // Default simulation operation weights for messages and gov proposals
 const (
-	DefaultWeightMsgSend                        int = 100
-	DefaultWeightMsgMultiSend                   int = 10
-	DefaultWeightMsgSetWithdrawAddress          int = 50
-	DefaultWeightMsgWithdrawDelegationReward    int = 50
-	DefaultWeightMsgWithdrawValidatorCommission int = 50
-	DefaultWeightMsgFundCommunityPool           int = 50
-	DefaultWeightMsgDeposit                     int = 100
-	DefaultWeightMsgVote                        int = 67
-	DefaultWeightMsgUnjail                      int = 100
-	DefaultWeightMsgCreateValidator             int = 100
-	DefaultWeightMsgEditValidator               int = 5
-	DefaultWeightMsgDelegate                    int = 100
-	DefaultWeightMsgUndelegate                  int = 100
-	DefaultWeightMsgBeginRedelegate             int = 100
+	DefaultWeightMsgSend                         int = 100
+	DefaultWeightMsgMultiSend                    int = 10
+	DefaultWeightMsgSetWithdrawAddress           int = 50
+	DefaultWeightMsgWithdrawDelegationReward     int = 50
+	DefaultWeightMsgWithdrawValidatorCommission  int = 50
+	DefaultWeightMsgFundCommunityPool            int = 50
+	DefaultWeightMsgDeposit                      int = 100
+	DefaultWeightMsgVote                         int = 67
+	DefaultWeightMsgVoteWeighted                 int = 33
+	DefaultWeightMsgUnjail                       int = 100
+	DefaultWeightMsgCreateValidator              int = 100
+	DefaultWeightMsgEditValidator                int = 5
+	DefaultWeightMsgDelegate                     int = 100
+	DefaultWeightMsgUndelegate                   int = 100
+	DefaultWeightMsgBeginRedelegate              int = 100
+	DefaultWeightMsgGrantFeeAllowance            int = 100
+	DefaultWeightMsgRevokeFeeAllowance           int = 50
+	DefaultWeightMsgGrantAuthorization           int = 100
+	DefaultWeightMsgRevokeAuthorization          int = 50
+	DefaultWeightMsgExec                         int = 100
+	DefaultWeightMsgCreateGroup                  int = 100
+	DefaultWeightMsgUpdateGroupMembers           int = 20
+	DefaultWeightMsgCreateGroupPolicy            int = 50
+	DefaultWeightMsgSubmitGroupProposal          int = 100
+	DefaultWeightMsgGroupVote                    int = 100
+	DefaultWeightMsgGroupExec                    int = 50
+	DefaultWeightMsgCreateVestingAccount         int = 50
+	DefaultWeightMsgCreatePermanentLockedAccount int = 20
+	DefaultWeightMsgCreatePeriodicVestingAccount int = 20
+	DefaultWeightMsgCreateClawbackVestingAccount int = 20
+	DefaultWeightMsgClawback                     int = 10
 
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
@@ -0,0 +1,34 @@
+package simapp
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecstd "github.com/cosmos/cosmos-sdk/codec/std"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankutils "github.com/cosmos/cosmos-sdk/x/bank/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// NewSignModeHandler returns the handler of the sign modes of the SimApp. The
+// transactions may also be signed in SIGN_MODE_TEXTUAL, rendering the values
+// with the renderers registered by the modules, the coins in the display unit
+// of the denomination metadata the given query returns. The chain reads the
+// metadata from its state and the clients query it from the node.
+func NewSignModeHandler(denomMetadataQueryFn bank.DenomMetadataQueryFn) signing.SignModeHandler {
+	textualRegistry := textual.NewRegistry()
+	bank.RegisterTextualRenderers(textualRegistry, denomMetadataQueryFn)
+	gov.RegisterTextualRenderers(textualRegistry)
+
+	return signing.NewHandlerMap(
+		auth.SignModeLegacyAminoJSON,
+		append(signing.DefaultSignModeHandlers(), textual.NewSignModeHandler(textualRegistry))...,
+	)
+}
+
+// NewTxGenerator returns the Generator the SimApp clients build their
+// transactions with, signing them in the sign modes of NewSignModeHandler.
+func NewTxGenerator(m codec.Marshaler) codecstd.TxGenerator {
+	return codecstd.NewTxGenerator(NewSignModeHandler(bankutils.NewDenomMetadataQueryFn(m)))
+}
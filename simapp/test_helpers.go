@@ -60,7 +60,7 @@ func SetupWithGenesisAccounts(genAccs []authexported.GenesisAccount, balances ..
 	authGenesis := auth.NewGenesisState(auth.DefaultParams(), genAccs)
 	genesisState[auth.ModuleName] = app.Codec().MustMarshalJSON(authGenesis)
 
-	bankGenesis := bank.NewGenesisState(bank.DefaultGenesisState().SendEnabled, balances)
+	bankGenesis := bank.NewGenesisState(bank.DefaultGenesisState().Params, balances, []bank.Metadata{})
 	genesisState[bank.ModuleName] = app.Codec().MustMarshalJSON(bankGenesis)
 
 	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), genesisState)
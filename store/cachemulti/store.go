@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -25,6 +26,8 @@ type Store struct {
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CacheMultiStore = Store{}
@@ -42,6 +45,7 @@ func NewFromKVStore(
 		keys:         keys,
 		traceWriter:  traceWriter,
 		traceContext: traceContext,
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 
 	for key, store := range stores {
@@ -68,7 +72,13 @@ func NewStore(
 func newCacheMultiStoreFromCMS(cms Store) Store {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range cms.stores {
-		stores[k] = v
+		// writes flushed from the new cache must be reported to the listeners
+		// of this store
+		if cms.ListeningEnabled(k) {
+			stores[k] = listenkv.NewStore(v.(types.KVStore), k, cms.listeners[k])
+		} else {
+			stores[k] = v
+		}
 	}
 
 	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
@@ -102,6 +112,18 @@ func (cms Store) TracingEnabled() bool {
 	return cms.traceWriter != nil
 }
 
+// ListeningEnabled returns if listening is enabled for a specific KVStore
+func (cms Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(cms.listeners[key]) != 0
+}
+
+// AddListeners adds listeners for a specific KVStore. Writes made to the
+// KVStore, either directly or by writing a cache-wrapped MultiStore created
+// from this one, are reported to the listeners.
+func (cms Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	cms.listeners[key] = append(cms.listeners[key], listeners...)
+}
+
 // GetStoreType returns the type of the store.
 func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -141,14 +163,21 @@ func (cms Store) CacheMultiStoreWithVersion(_ int64) (types.CacheMultiStore, err
 
 // GetStore returns an underlying Store by key.
 func (cms Store) GetStore(key types.StoreKey) types.Store {
+	if cms.ListeningEnabled(key) {
+		return cms.GetKVStore(key)
+	}
 	return cms.stores[key].(types.Store)
 }
 
-// GetKVStore returns an underlying KVStore by key.
+// GetKVStore returns an underlying KVStore by key. If listening is enabled for
+// the KVStore, it is wrapped in a listenkv Store reporting to its listeners.
 func (cms Store) GetKVStore(key types.StoreKey) types.KVStore {
 	store := cms.stores[key]
 	if key == nil {
 		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
 	}
+	if cms.ListeningEnabled(key) {
+		return listenkv.NewStore(store.(types.KVStore), key, cms.listeners[key])
+	}
 	return store.(types.KVStore)
 }
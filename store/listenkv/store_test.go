@@ -0,0 +1,145 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+var kvPairs = []types.KVPair{
+	{Key: keyFmt(1), Value: valFmt(1)},
+	{Key: keyFmt(2), Value: valFmt(2)},
+	{Key: keyFmt(3), Value: valFmt(3)},
+}
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+var testCdc = codec.New()
+
+func newListenKVStore(w io.Writer) *listenkv.Store {
+	store := newEmptyListenKVStore(w)
+
+	for _, kvPair := range kvPairs {
+		store.Set(kvPair.Key, kvPair.Value)
+	}
+
+	return store
+}
+
+func newEmptyListenKVStore(w io.Writer) *listenkv.Store {
+	listener := types.NewStoreKVPairWriteListener(w, testCdc)
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func readKVPairs(t *testing.T, buf *bytes.Buffer) []types.StoreKVPair {
+	var pairs []types.StoreKVPair
+	for buf.Len() > 0 {
+		var pair types.StoreKVPair
+		_, err := testCdc.UnmarshalBinaryLengthPrefixedReader(buf, &pair, 0)
+		require.NoError(t, err)
+		pairs = append(pairs, pair)
+	}
+	return pairs
+}
+
+func TestListenKVStoreGet(t *testing.T) {
+	var buf bytes.Buffer
+	store := newListenKVStore(&buf)
+	buf.Reset()
+
+	for _, kvPair := range kvPairs {
+		require.Equal(t, kvPair.Value, store.Get(kvPair.Key))
+	}
+	require.Nil(t, store.Get(keyFmt(9)))
+
+	// reads are not reported to the listeners
+	require.Zero(t, buf.Len())
+}
+
+func TestListenKVStoreSet(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+
+	for _, kvPair := range kvPairs {
+		store.Set(kvPair.Key, kvPair.Value)
+	}
+
+	expected := make([]types.StoreKVPair, len(kvPairs))
+	for i, kvPair := range kvPairs {
+		expected[i] = types.StoreKVPair{StoreKey: testStoreKey.Name(), Key: kvPair.Key, Value: kvPair.Value}
+	}
+	require.Equal(t, expected, readKVPairs(t, &buf))
+
+	require.Panics(t, func() { store.Set(nil, []byte("value")) }, "setting a nil key should panic")
+	require.Zero(t, buf.Len())
+}
+
+func TestListenKVStoreDelete(t *testing.T) {
+	var buf bytes.Buffer
+	store := newListenKVStore(&buf)
+	buf.Reset()
+
+	store.Delete(kvPairs[0].Key)
+	require.False(t, store.Has(kvPairs[0].Key))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: kvPairs[0].Key, Delete: true},
+	}, readKVPairs(t, &buf))
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set(kvPairs[0].Key, kvPairs[0].Value)
+	cache.Set(kvPairs[1].Key, kvPairs[1].Value)
+	cache.Delete(kvPairs[1].Key)
+
+	// nothing is reported until the cache is written
+	require.Zero(t, buf.Len())
+	require.False(t, store.Has(kvPairs[0].Key))
+
+	cache.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: kvPairs[0].Key, Value: kvPairs[0].Value},
+		{StoreKey: testStoreKey.Name(), Key: kvPairs[1].Key, Delete: true},
+	}, readKVPairs(t, &buf))
+	require.Equal(t, kvPairs[0].Value, store.Get(kvPairs[0].Key))
+}
+
+func TestListenKVStoreIterator(t *testing.T) {
+	var buf bytes.Buffer
+	store := newListenKVStore(&buf)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	i := 0
+	for ; iterator.Valid(); iterator.Next() {
+		require.Equal(t, kvPairs[i].Key, iterator.Key())
+		require.Equal(t, kvPairs[i].Value, iterator.Value())
+		i++
+	}
+	require.Equal(t, len(kvPairs), i)
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := newEmptyListenKVStore(nil)
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
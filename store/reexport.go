@@ -17,6 +17,8 @@ type (
 	KVStore          = types.KVStore
 	KVPair           = types.KVPair
 	Iterator         = types.Iterator
+	WriteListener    = types.WriteListener
+	StoreKVPair      = types.StoreKVPair
 	CacheKVStore     = types.CacheKVStore
 	CommitKVStore    = types.CommitKVStore
 	CacheWrapper     = types.CacheWrapper
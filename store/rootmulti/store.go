@@ -1,12 +1,16 @@
 package rootmulti
 
 import (
+	"bufio"
+	"compress/zlib"
 	"fmt"
 	"io"
+	"math"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
-
+	iavltree "github.com/tendermint/iavl"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/crypto/tmhash"
@@ -16,6 +20,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -25,6 +32,11 @@ import (
 const (
 	latestVersionKey = "s/latest"
 	commitInfoKeyFmt = "s/%d" // s/<version>
+
+	// Do not change chunk size without new snapshot format (must be uniform across nodes)
+	snapshotChunkSize   = uint64(10e6)
+	snapshotBufferSize  = int(snapshotChunkSize)
+	snapshotMaxItemSize = int64(64e6) // SDK has no key/value size limit, so we set an arbitrary limit
 )
 
 var cdc = codec.New()
@@ -45,6 +57,8 @@ type Store struct {
 	traceContext types.TraceContext
 
 	interBlockCache types.MultiStorePersistentCache
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CommitMultiStore = (*Store)(nil)
@@ -61,6 +75,7 @@ func NewStore(db dbm.DB) *Store {
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:   make(map[string]types.StoreKey),
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 }
 
@@ -282,6 +297,19 @@ func (rs *Store) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// ListeningEnabled returns if listening is enabled for a specific KVStore
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(rs.listeners[key]) != 0
+}
+
+// AddListeners adds listeners for a specific KVStore. Listeners are told about
+// every write made to the KVStore, including the writes of cache-wrapped
+// MultiStores when they are written, i.e. the state changes committed to the
+// KVStore.
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	rs.listeners[key] = append(rs.listeners[key], listeners...)
+}
+
 //----------------------------------------
 // +CommitStore
 
@@ -328,7 +356,11 @@ func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
-		stores[k] = v
+		if rs.ListeningEnabled(k) {
+			stores[k] = listenkv.NewStore(v, k, rs.listeners[k])
+		} else {
+			stores[k] = v
+		}
 	}
 
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
@@ -381,7 +413,8 @@ func (rs *Store) GetStore(key types.StoreKey) types.Store {
 
 // GetKVStore returns a mounted KVStore for a given StoreKey. If tracing is
 // enabled on the KVStore, a wrapped TraceKVStore will be returned with the root
-// store's tracer, otherwise, the original KVStore will be returned.
+// store's tracer, otherwise, the original KVStore will be returned. If listening
+// is enabled, the KVStore is also wrapped in a listenkv Store.
 //
 // NOTE: The returned KVStore may be wrapped in an inter-block cache if it is
 // set on the root store.
@@ -391,6 +424,9 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
 	}
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
 
 	return store
 }
@@ -486,18 +522,327 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 	return storeName, subpath, nil
 }
 
-//----------------------------------------
-// Note: why do we use key and params.key in different places. Seems like there should be only one key used.
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
-	var db dbm.DB
+//---------------------- Snapshotting ------------------
+
+// namedStore is an IAVL store along with its mount name, used when exporting
+// stores in a deterministic order.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
+// Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
+// identical across nodes such that chunks from different sources fit together. If the output for a
+// given format changes (at the byte level), the snapshot format must be bumped.
+func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	if format != snapshottypes.CurrentFormat {
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return nil, sdkerrors.Wrap(snapshottypes.ErrInvalidSnapshotVersion, "cannot snapshot height 0")
+	}
+	if height > uint64(rs.LastCommitID().Version) {
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrInvalidSnapshotVersion,
+			"cannot snapshot future height %v", height)
+	}
+
+	// Collect stores to snapshot (only IAVL stores are supported)
+	stores := []namedStore{}
+	for key := range rs.stores {
+		switch store := rs.GetCommitKVStore(key).(type) {
+		case *iavl.Store:
+			stores = append(stores, namedStore{name: key.Name(), Store: store})
+		case *transient.Store:
+			// Non-persisted stores shouldn't be snapshotted
+			continue
+		default:
+			return nil, errors.Errorf(
+				"don't know how to snapshot store %q of type %T", key.Name(), store)
+		}
+	}
+	sort.Slice(stores, func(i, j int) bool {
+		return strings.Compare(stores[i].name, stores[j].name) == -1
+	})
+
+	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
+	ch := make(chan io.ReadCloser)
+	go func() {
+		// Set up a stream pipeline to serialize snapshot nodes:
+		// ExportNode -> delimited amino -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+		chunkWriter := snapshots.NewChunkWriter(ch, snapshotChunkSize)
+		bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
+		zWriter, err := zlib.NewWriterLevel(bufWriter, 7)
+		if err != nil {
+			chunkWriter.CloseWithError(errors.Wrap(err, "zlib failure"))
+			return
+		}
+
+		err = rs.exportStores(zWriter, height, stores)
+		if err == nil {
+			err = zWriter.Close()
+		}
+		if err == nil {
+			err = bufWriter.Flush()
+		}
+		if err != nil {
+			chunkWriter.CloseWithError(err)
+			return
+		}
+		chunkWriter.Close()
+	}()
+
+	return ch, nil
+}
+
+// exportStores writes all given IAVL stores at the given height to w as a
+// stream of length-prefixed snapshot items.
+func (rs *Store) exportStores(w io.Writer, height uint64, stores []namedStore) error {
+	for _, store := range stores {
+		exporter, err := store.Export(int64(height))
+		if err != nil {
+			return err
+		}
+
+		_, err = snapshottypes.SnapshotCdc.MarshalBinaryLengthPrefixedWriter(w, snapshottypes.SnapshotItem{
+			Store: &snapshottypes.SnapshotStoreItem{Name: store.name},
+		})
+		if err != nil {
+			exporter.Close()
+			return err
+		}
+
+		for {
+			node, err := exporter.Next()
+			if err == iavltree.ExportDone {
+				break
+			} else if err != nil {
+				exporter.Close()
+				return err
+			}
+
+			_, err = snapshottypes.SnapshotCdc.MarshalBinaryLengthPrefixedWriter(w, snapshottypes.SnapshotItem{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
+				},
+			})
+			if err != nil {
+				exporter.Close()
+				return err
+			}
+		}
+
+		exporter.Close()
+	}
+
+	return nil
+}
+
+// Restore implements snapshottypes.Snapshotter. The restored stores are committed
+// at the snapshot height, and the multistore is reloaded at that height once the
+// restore completes.
+func (rs *Store) Restore(
+	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
+) error {
+	if format != snapshottypes.CurrentFormat {
+		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return sdkerrors.Wrap(snapshottypes.ErrInvalidSnapshotVersion, "cannot restore snapshot at height 0")
+	}
+	if height > uint64(math.MaxInt64) {
+		return sdkerrors.Wrapf(snapshottypes.ErrInvalidSnapshotVersion,
+			"snapshot height %v cannot exceed %v", height, int64(math.MaxInt64))
+	}
+
+	// Signal readiness. Must be done before the readers below are set up, since the zlib
+	// reader reads from the stream on initialization, potentially causing deadlocks.
+	if ready != nil {
+		close(ready)
+	}
+
+	// Set up a restore stream pipeline
+	// chan io.ReadCloser -> chunkReader -> zlib -> delimited amino -> ExportNode
+	chunkReader := snapshots.NewChunkReader(chunks)
+	defer chunkReader.Close()
+	zReader, err := zlib.NewReader(chunkReader)
+	if err != nil {
+		return errors.Wrap(err, "zlib failure")
+	}
+	defer zReader.Close()
+
+	var importer *iavltree.Importer
+	defer func() {
+		if importer != nil {
+			importer.Close()
+		}
+	}()
+
+	for {
+		item := snapshottypes.SnapshotItem{}
+		_, err := snapshottypes.SnapshotCdc.UnmarshalBinaryLengthPrefixedReader(zReader, &item, snapshotMaxItemSize)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return errors.Wrap(err, "invalid amino item")
+		}
+
+		switch {
+		case item.Store != nil:
+			if importer != nil {
+				err = importer.Commit()
+				if err != nil {
+					return errors.Wrap(err, "IAVL commit failed")
+				}
+				importer.Close()
+			}
+			store, ok := rs.getStoreByName(item.Store.Name).(*iavl.Store)
+			if !ok || store == nil {
+				return errors.Errorf("cannot import into non-IAVL store %q", item.Store.Name)
+			}
+			importer, err = store.Import(int64(height))
+			if err != nil {
+				return errors.Wrap(err, "import failed")
+			}
+
+		case item.IAVL != nil:
+			if importer == nil {
+				return errors.New("received IAVL node item before store item")
+			}
+			if item.IAVL.Height > math.MaxInt8 {
+				return errors.Errorf("node height %v cannot exceed %v", item.IAVL.Height, math.MaxInt8)
+			}
+			node := &iavltree.ExportNode{
+				Key:     item.IAVL.Key,
+				Value:   item.IAVL.Value,
+				Height:  int8(item.IAVL.Height),
+				Version: item.IAVL.Version,
+			}
+			// Amino does not differentiate between []byte{} and nil, but fortunately IAVL does
+			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+			if node.Key == nil {
+				node.Key = []byte{}
+			}
+			if node.Height == 0 && node.Value == nil {
+				node.Value = []byte{}
+			}
+			err := importer.Add(node)
+			if err != nil {
+				return errors.Wrap(err, "IAVL node import failed")
+			}
+
+		default:
+			return errors.New("unknown snapshot item")
+		}
+	}
+
+	if importer != nil {
+		err := importer.Commit()
+		if err != nil {
+			return errors.Wrap(err, "IAVL commit failed")
+		}
+		importer.Close()
+		importer = nil
+	}
+
+	flushCommitInfo(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+	return rs.LoadLatestVersion()
+}
+
+// RollbackRestore implements CommitMultiStore. Since a snapshot can only be
+// restored into empty stores, the stores are emptied along with the commit
+// info of the restored version, and the multistore is reloaded at version 0.
+func (rs *Store) RollbackRestore(height uint64) error {
+	if latest := getLatestVersion(rs.db); latest != int64(height) {
+		return fmt.Errorf("cannot roll back the restore at height %d, the latest version is %d", height, latest)
+	}
+
+	for key, params := range rs.storesParams {
+		if params.typ != types.StoreTypeIAVL {
+			continue
+		}
+
+		if err := deleteDBData(rs.storeDB(params)); err != nil {
+			return errors.Wrapf(err, "failed to delete store %s", key.Name())
+		}
+	}
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+
+	batch.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, height)))
+	batch.Delete([]byte(latestVersionKey))
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	if rs.interBlockCache != nil {
+		rs.interBlockCache.Reset()
+	}
+
+	return rs.LoadVersion(0)
+}
+
+// deleteDBData deletes all the data of a database.
+func deleteDBData(db dbm.DB) error {
+	var keys [][]byte
+	itr, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	itr.Close()
+
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		batch.Delete(key)
+	}
+
+	return batch.WriteSync()
+}
 
+// buildCommitInfo builds a commitInfo for the given version from the last
+// commit IDs of all persisted stores.
+func (rs *Store) buildCommitInfo(version int64) commitInfo {
+	storeInfos := make([]storeInfo, 0, len(rs.stores))
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeTransient {
+			continue
+		}
+
+		si := storeInfo{}
+		si.Name = key.Name()
+		si.Core.CommitID = store.LastCommitID()
+		storeInfos = append(storeInfos, si)
+	}
+
+	return commitInfo{
+		Version:    version,
+		StoreInfos: storeInfos,
+	}
+}
+
+// storeDB returns the database holding the data of a store.
+func (rs *Store) storeDB(params storeParams) dbm.DB {
 	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	}
 
+	prefix := "s/k:" + params.key.Name() + "/"
+	return dbm.NewPrefixDB(rs.db, []byte(prefix))
+}
+
+//----------------------------------------
+// Note: why do we use key and params.key in different places. Seems like there should be only one key used.
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
+	db := rs.storeDB(params)
+
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
@@ -1,15 +1,19 @@
 package rootmulti
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -443,6 +447,152 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, v2, qres.Value)
 }
 
+func TestMultistoreSnapshotRestore(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndData(dbm.NewMemDB(), 4)
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+	require.EqualValues(t, 3, version)
+
+	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	ready := make(chan struct{})
+	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, ready)
+	require.NoError(t, err)
+	_, ok := <-ready
+	require.False(t, ok)
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for key, sourceStore := range source.stores {
+		targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
+		switch sourceStore.GetStoreType() {
+		case types.StoreTypeTransient:
+			assert.False(t, targetStore.Iterator(nil, nil).Valid(),
+				"transient store %v not empty", key.Name())
+		default:
+			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+		}
+	}
+}
+
+func TestMultistoreSnapshot_Errors(t *testing.T) {
+	store := newMultiStoreWithMixedMountsAndData(dbm.NewMemDB(), 4)
+
+	testcases := map[string]struct {
+		height     uint64
+		format     uint32
+		expectType error
+	}{
+		"0 height":       {0, snapshottypes.CurrentFormat, nil},
+		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
+		"unknown height": {9, snapshottypes.CurrentFormat, nil},
+		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, err := store.Snapshot(tc.height, tc.format)
+			require.Error(t, err)
+			if tc.expectType != nil {
+				assert.True(t, errors.Is(err, tc.expectType))
+			}
+		})
+	}
+}
+
+func TestMultistoreRestore_Errors(t *testing.T) {
+	store := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+
+	testcases := map[string]struct {
+		height     uint64
+		format     uint32
+		expectType error
+	}{
+		"0 height":       {0, snapshottypes.CurrentFormat, nil},
+		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
+		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := store.Restore(tc.height, tc.format, nil, nil)
+			require.Error(t, err)
+			if tc.expectType != nil {
+				assert.True(t, errors.Is(err, tc.expectType))
+			}
+		})
+	}
+}
+
+func TestMultiStoreListeners(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key1 := ms.keysByName["store1"]
+	key2 := ms.keysByName["store2"]
+	require.False(t, ms.ListeningEnabled(key1))
+
+	var buf bytes.Buffer
+	listener := types.NewStoreKVPairWriteListener(&buf, cdc)
+	ms.AddListeners(key1, []types.WriteListener{listener})
+	require.True(t, ms.ListeningEnabled(key1))
+	require.False(t, ms.ListeningEnabled(key2))
+
+	readPairs := func() []types.StoreKVPair {
+		var pairs []types.StoreKVPair
+		for buf.Len() > 0 {
+			var pair types.StoreKVPair
+			_, err := cdc.UnmarshalBinaryLengthPrefixedReader(&buf, &pair, 0)
+			require.NoError(t, err)
+			pairs = append(pairs, pair)
+		}
+		return pairs
+	}
+
+	// writes to a cache-wrapped multistore are only reported once written to
+	// the root multistore
+	cacheMulti := ms.CacheMultiStore()
+	require.False(t, cacheMulti.ListeningEnabled(key1))
+	cacheMulti.GetKVStore(key1).Set([]byte("k1"), []byte("v1"))
+	cacheMulti.GetKVStore(key2).Set([]byte("k2"), []byte("v2"))
+	require.Zero(t, buf.Len())
+
+	cacheMulti.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("k1"), Value: []byte("v1")},
+	}, readPairs())
+
+	// listeners of a cache-wrapped multistore are told about writes made to
+	// it directly and about writes of the nested caches
+	var cacheBuf bytes.Buffer
+	cacheMulti = ms.CacheMultiStore()
+	cacheMulti.AddListeners(key2, []types.WriteListener{types.NewStoreKVPairWriteListener(&cacheBuf, cdc)})
+	cacheMulti.GetKVStore(key2).Set([]byte("k3"), []byte("v3"))
+
+	nested := cacheMulti.CacheMultiStore()
+	nested.GetKVStore(key2).Delete([]byte("k3"))
+	nested.GetKVStore(key1).Delete([]byte("k1"))
+	require.False(t, nested.ListeningEnabled(key2))
+
+	var pair types.StoreKVPair
+	_, err := cdc.UnmarshalBinaryLengthPrefixedReader(&cacheBuf, &pair, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.StoreKVPair{StoreKey: "store2", Key: []byte("k3"), Value: []byte("v3")}, pair)
+	require.Zero(t, cacheBuf.Len())
+
+	nested.Write()
+	_, err = cdc.UnmarshalBinaryLengthPrefixedReader(&cacheBuf, &pair, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.StoreKVPair{StoreKey: "store2", Key: []byte("k3"), Delete: true}, pair)
+	require.Zero(t, cacheBuf.Len())
+	require.Zero(t, buf.Len())
+
+	cacheMulti.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("k1"), Delete: true},
+	}, readPairs())
+}
+
 //-----------------------------------------------------------------------
 // utils
 
@@ -457,6 +607,67 @@ func newMultiStoreWithMounts(db dbm.DB, pruningOpts types.PruningOptions) *Store
 	return store
 }
 
+func newMultiStoreWithMixedMounts(db dbm.DB) *Store {
+	store := NewStore(db)
+	store.MountStoreWithDB(types.NewKVStoreKey("iavl1"), types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(types.NewKVStoreKey("iavl2"), types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(types.NewKVStoreKey("iavl3"), types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(types.NewTransientStoreKey("trans1"), types.StoreTypeTransient, nil)
+	if err := store.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	return store
+}
+
+func newMultiStoreWithMixedMountsAndData(db dbm.DB, keys int) *Store {
+	store := newMultiStoreWithMixedMounts(db)
+	store1 := store.getStoreByName("iavl1").(types.CommitKVStore)
+	store2 := store.getStoreByName("iavl2").(types.CommitKVStore)
+	trans1 := store.getStoreByName("trans1").(types.CommitKVStore)
+
+	for version := 1; version <= 3; version++ {
+		for i := 0; i < keys; i++ {
+			key := []byte(fmt.Sprintf("key%v-%v", version, i))
+			value := []byte(fmt.Sprintf("value%v-%v", version, i))
+			store1.Set(key, value)
+			if i%2 == 0 {
+				store2.Set(key, value)
+			}
+			trans1.Set(key, value)
+		}
+		// overwrite and delete some keys from earlier versions
+		if version > 1 {
+			store1.Set([]byte("key1-0"), []byte(fmt.Sprintf("updated%v", version)))
+			store2.Delete([]byte("key1-2"))
+		}
+		store.Commit()
+	}
+
+	return store
+}
+
+func assertStoresEqual(t *testing.T, expect, actual types.CommitKVStore, msgAndArgs ...interface{}) {
+	assert.Equal(t, expect.LastCommitID(), actual.LastCommitID())
+	expectIter := expect.Iterator(nil, nil)
+	defer expectIter.Close()
+	expectMap := map[string][]byte{}
+	for ; expectIter.Valid(); expectIter.Next() {
+		expectMap[string(expectIter.Key())] = expectIter.Value()
+	}
+	require.NoError(t, expectIter.Error())
+
+	actualIter := actual.Iterator(nil, nil)
+	defer actualIter.Close()
+	actualMap := map[string][]byte{}
+	for ; actualIter.Valid(); actualIter.Next() {
+		actualMap[string(actualIter.Key())] = actualIter.Value()
+	}
+	require.NoError(t, actualIter.Error())
+
+	assert.Equal(t, expectMap, actualMap, msgAndArgs...)
+}
+
 func newMultiStoreWithModifiedMounts(db dbm.DB, pruningOpts types.PruningOptions) (*Store, *types.StoreUpgrades) {
 	store := NewStore(db)
 	store.pruningOpts = pruningOpts
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CurrentFormat is the currently used format for snapshots. Snapshots using the same format
+// must be identical across all nodes for a given height, so this must be bumped when the binary
+// snapshot output changes.
+const CurrentFormat uint32 = 1
+
+// SnapshotCdc is the codec used to encode snapshot metadata and snapshot items.
+var SnapshotCdc = codec.New()
+
+// Snapshot contains metadata about a state snapshot taken at a given height.
+type Snapshot struct {
+	Height   uint64   `json:"height" yaml:"height"`
+	Format   uint32   `json:"format" yaml:"format"`
+	Chunks   uint32   `json:"chunks" yaml:"chunks"`
+	Hash     []byte   `json:"hash" yaml:"hash"`
+	Metadata Metadata `json:"metadata" yaml:"metadata"`
+}
+
+// Metadata contains opaque metadata for a snapshot. It currently holds the
+// SHA-256 hash of every chunk, such that each chunk can be verified as soon as
+// it is received during a restore.
+type Metadata struct {
+	ChunkHashes [][]byte `json:"chunk_hashes" yaml:"chunk_hashes"`
+}
+
+// Validate performs a basic validation of the snapshot metadata.
+func (s Snapshot) Validate() error {
+	if s.Height == 0 {
+		return sdkerrors.Wrap(ErrInvalidMetadata, "snapshot height cannot be 0")
+	}
+	if s.Chunks == 0 {
+		return sdkerrors.Wrap(ErrInvalidMetadata, "snapshot must contain at least one chunk")
+	}
+	if len(s.Hash) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMetadata, "snapshot hash cannot be empty")
+	}
+	if len(s.Metadata.ChunkHashes) != int(s.Chunks) {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "snapshot has %d chunk hashes, but %d chunks",
+			len(s.Metadata.ChunkHashes), s.Chunks)
+	}
+
+	return nil
+}
+
+// SnapshotItem is an item contained in a multistore snapshot. Exactly one of
+// its fields is set.
+type SnapshotItem struct {
+	Store *SnapshotStoreItem `json:"store,omitempty"`
+	IAVL  *SnapshotIAVLItem  `json:"iavl,omitempty"`
+}
+
+// SnapshotStoreItem contains metadata about a snapshotted store. All following
+// IAVL items belong to this store until the next store item.
+type SnapshotStoreItem struct {
+	Name string `json:"name"`
+}
+
+// SnapshotIAVLItem is an exported IAVL node.
+type SnapshotIAVLItem struct {
+	Key     []byte `json:"key"`
+	Value   []byte `json:"value"`
+	Version int64  `json:"version"`
+	Height  int32  `json:"height"`
+}
@@ -0,0 +1,177 @@
+package file
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+The naming schema and data format for the files this service writes out to is as such:
+
+After every `BeginBlock` request a new file is created with the name `block-{N}-begin`, where N is the block number.
+All subsequent state changes are written out to this file until the first `DeliverTx` request is received. At the head of these files,
+the length-prefixed protobuf encoded `BeginBlock` request is written, and the response is written at the tail.
+
+After every `DeliverTx` request a new file is created with the name `block-{N}-tx-{M}` where N is the block number and M
+is the tx number in the block (i.e. 0, 1, 2...). All subsequent state changes are written out to this file until the next
+`DeliverTx` request is received or an `EndBlock` request is received. At the head of these files, the length-prefixed protobuf
+encoded `DeliverTx` request is written, and the response is written at the tail.
+
+After every `EndBlock` request a new file is created with the name `block-{N}-end`, where N is the block number. All
+subsequent state changes are written out to this file until the next `BeginBlock` request is received. At the head of these files,
+the length-prefixed protobuf encoded `EndBlock` request is written, and the response is written at the tail.
+
+State changes are written as length-prefixed amino encoded types.StoreKVPair records.
+*/
+
+var _ baseapp.StreamingService = (*StreamingService)(nil)
+
+// StreamingService is a concrete implementation of baseapp.StreamingService
+// that writes state changes out to files, with one set of files per block.
+type StreamingService struct {
+	listeners  map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	filePrefix string                                   // optional prefix for each of the generated files
+	writeDir   string                                   // directory to write files into
+
+	mtx      sync.Mutex
+	stateBuf bytes.Buffer // length-prefixed state changes received since the last ABCI message
+	txIndex  int64        // index of the next tx in the current block
+}
+
+// NewStreamingService creates a new StreamingService for the provided
+// writeDir, (optional) filePrefix, and storeKeys. The write directory must
+// exist and be writable.
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, cdc *codec.Codec) (*StreamingService, error) {
+	if err := isDirWriteable(writeDir); err != nil {
+		return nil, err
+	}
+
+	s := &StreamingService{
+		listeners:  make(map[types.StoreKey][]types.WriteListener, len(storeKeys)),
+		filePrefix: filePrefix,
+		writeDir:   writeDir,
+	}
+	// a single listener writes the state changes of all the KVStores into the
+	// buffer, which is flushed to a file after every ABCI message
+	listener := types.NewStoreKVPairWriteListener(lockedWriter{s}, cdc)
+	for _, key := range storeKeys {
+		s.listeners[key] = append(s.listeners[key], listener)
+	}
+
+	return s, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+func (s *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return s.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface. It writes out
+// the received BeginBlock request and response along with the state changes
+// made during BeginBlock.
+func (s *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	s.mtx.Lock()
+	s.txIndex = 0
+	s.mtx.Unlock()
+
+	name := fmt.Sprintf("block-%d-begin", req.Header.Height)
+	return s.writeFile(name, &req, &res)
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface. It writes out
+// the received DeliverTx request and response along with the state changes
+// made by the tx.
+func (s *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	s.mtx.Lock()
+	index := s.txIndex
+	s.txIndex++
+	s.mtx.Unlock()
+
+	name := fmt.Sprintf("block-%d-tx-%d", ctx.BlockHeight(), index)
+	return s.writeFile(name, &req, &res)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface. It writes out
+// the received EndBlock request and response along with the state changes
+// made during EndBlock.
+func (s *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	name := fmt.Sprintf("block-%d-end", req.Height)
+	return s.writeFile(name, &req, &res)
+}
+
+// writeFile writes the length-prefixed request, the buffered state changes and
+// the length-prefixed response to a new file, and resets the buffer.
+func (s *StreamingService) writeFile(name string, req, res proto.Message) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	defer s.stateBuf.Reset()
+
+	reqBz, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	resBz, err := proto.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	writeLengthPrefixed(&buf, reqBz)
+	buf.Write(s.stateBuf.Bytes())
+	writeLengthPrefixed(&buf, resBz)
+
+	if s.filePrefix != "" {
+		name = fmt.Sprintf("%s-%s", s.filePrefix, name)
+	}
+	path := filepath.Join(s.writeDir, name)
+	if err := ioutil.WriteFile(path, buf.Bytes(), 0600); err != nil {
+		return errors.Wrapf(err, "failed to write streaming file %q", path)
+	}
+
+	return nil
+}
+
+// writeLengthPrefixed writes bz prefixed with its uvarint encoded length,
+// matching the amino length-prefixed encoding of the state changes.
+func writeLengthPrefixed(buf *bytes.Buffer, bz []byte) {
+	var prefix [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(prefix[:], uint64(len(bz)))
+	buf.Write(prefix[:n])
+	buf.Write(bz)
+}
+
+// lockedWriter writes state changes into the buffer of the service.
+type lockedWriter struct {
+	s *StreamingService
+}
+
+// Write implements io.Writer.
+func (w lockedWriter) Write(p []byte) (int, error) {
+	w.s.mtx.Lock()
+	defer w.s.mtx.Unlock()
+	return w.s.stateBuf.Write(p)
+}
+
+// isDirWriteable checks if dir is writable by writing and removing a file
+// to dir. It returns nil if dir is writable.
+func isDirWriteable(dir string) error {
+	f := filepath.Join(dir, ".touch")
+	if err := ioutil.WriteFile(f, []byte(""), 0600); err != nil {
+		return err
+	}
+	return os.Remove(f)
+}
@@ -0,0 +1,151 @@
+package file
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	testCdc = codec.New()
+
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+	mockStoreKey3 = sdk.NewKVStoreKey("mockStore3")
+
+	testPrefix = "testPrefix"
+)
+
+func setupService(t *testing.T) (*StreamingService, string, func()) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+
+	service, err := NewStreamingService(dir, testPrefix, []types.StoreKey{mockStoreKey1, mockStoreKey2}, testCdc)
+	require.NoError(t, err)
+
+	return service, dir, func() { os.RemoveAll(dir) }
+}
+
+// readFile splits a file written by the service into the request, the state
+// changes and the response.
+func readFile(t *testing.T, path string, req, res proto.Message) []types.StoreKVPair {
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	buf := bytes.NewBuffer(bz)
+
+	readMessage := func(msg proto.Message) {
+		size, err := binary.ReadUvarint(buf)
+		require.NoError(t, err)
+		require.NoError(t, proto.Unmarshal(buf.Next(int(size)), msg))
+	}
+
+	readMessage(req)
+
+	var pairs []types.StoreKVPair
+	for {
+		// the response is the last length-prefixed record in the file
+		rest := buf.Bytes()
+		size, n := binary.Uvarint(rest)
+		require.True(t, n > 0)
+		if n+int(size) == len(rest) {
+			break
+		}
+
+		var pair types.StoreKVPair
+		_, err := testCdc.UnmarshalBinaryLengthPrefixedReader(buf, &pair, 0)
+		require.NoError(t, err)
+		pairs = append(pairs, pair)
+	}
+
+	readMessage(res)
+	require.Zero(t, buf.Len())
+	return pairs
+}
+
+func TestNewStreamingService(t *testing.T) {
+	service, _, cleanup := setupService(t)
+	defer cleanup()
+
+	listeners := service.Listeners()
+	require.Len(t, listeners, 2)
+	require.Len(t, listeners[mockStoreKey1], 1)
+	require.Len(t, listeners[mockStoreKey2], 1)
+	require.Empty(t, listeners[mockStoreKey3])
+
+	_, err := NewStreamingService(filepath.Join(os.TempDir(), "does", "not", "exist"), "", nil, testCdc)
+	require.Error(t, err)
+}
+
+func TestStreamingService(t *testing.T) {
+	service, dir, cleanup := setupService(t)
+	defer cleanup()
+
+	ctx := sdk.NewContext(nil, abci.Header{Height: 1}, false, log.NewNopLogger())
+	listener1 := service.Listeners()[mockStoreKey1][0]
+	listener2 := service.Listeners()[mockStoreKey2][0]
+
+	// BeginBlock
+	require.NoError(t, listener1.OnWrite(mockStoreKey1, []byte("k1"), []byte("v1"), false))
+	require.NoError(t, listener2.OnWrite(mockStoreKey2, []byte("k2"), nil, true))
+	beginReq := abci.RequestBeginBlock{Header: abci.Header{Height: 1}}
+	beginRes := abci.ResponseBeginBlock{Events: []abci.Event{{Type: "begin"}}}
+	require.NoError(t, service.ListenBeginBlock(ctx, beginReq, beginRes))
+
+	var gotBeginReq abci.RequestBeginBlock
+	var gotBeginRes abci.ResponseBeginBlock
+	pairs := readFile(t, filepath.Join(dir, testPrefix+"-block-1-begin"), &gotBeginReq, &gotBeginRes)
+	require.Equal(t, beginReq.Header.Height, gotBeginReq.Header.Height)
+	require.Equal(t, beginRes, gotBeginRes)
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: []byte("k1"), Value: []byte("v1")},
+		{StoreKey: mockStoreKey2.Name(), Key: []byte("k2"), Delete: true},
+	}, pairs)
+
+	// DeliverTx
+	for i, tx := range [][]byte{[]byte("tx0"), []byte("tx1")} {
+		require.NoError(t, listener1.OnWrite(mockStoreKey1, tx, tx, false))
+		res := abci.ResponseDeliverTx{Code: uint32(i)}
+		require.NoError(t, service.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: tx}, res))
+	}
+	for i, tx := range [][]byte{[]byte("tx0"), []byte("tx1")} {
+		var gotReq abci.RequestDeliverTx
+		var gotRes abci.ResponseDeliverTx
+		name := testPrefix + "-block-1-tx-" + string(rune('0'+i))
+		pairs = readFile(t, filepath.Join(dir, name), &gotReq, &gotRes)
+		require.Equal(t, tx, gotReq.Tx)
+		require.Equal(t, uint32(i), gotRes.Code)
+		require.Equal(t, []types.StoreKVPair{
+			{StoreKey: mockStoreKey1.Name(), Key: tx, Value: tx},
+		}, pairs)
+	}
+
+	// EndBlock without state changes
+	endRes := abci.ResponseEndBlock{Events: []abci.Event{{Type: "end"}}}
+	require.NoError(t, service.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 1}, endRes))
+
+	var gotEndReq abci.RequestEndBlock
+	var gotEndRes abci.ResponseEndBlock
+	pairs = readFile(t, filepath.Join(dir, testPrefix+"-block-1-end"), &gotEndReq, &gotEndRes)
+	require.Equal(t, int64(1), gotEndReq.Height)
+	require.Equal(t, endRes, gotEndRes)
+	require.Empty(t, pairs)
+
+	// the tx index is reset on the next block
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: abci.Header{Height: 2}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, service.ListenDeliverTx(ctx, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{}))
+	_, err := os.Stat(filepath.Join(dir, testPrefix+"-block-2-tx-0"))
+	require.NoError(t, err)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// WriteListener interface for streaming data out from a listenkv.Store
+type WriteListener interface {
+	// if value is nil then it was deleted
+	// storeKey indicates the source KVStore, to facilitate using the same WriteListener across separate KVStores
+	// delete bool indicates if it was a delete; true: delete, false: set
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
+
+// StoreKVPair is a KVStore KVPair used for listening to state changes (Sets and Deletes).
+// It optionally includes the StoreKey for the originating KVStore and a Boolean flag to
+// distinguish between Sets and Deletes.
+type StoreKVPair struct {
+	StoreKey string `json:"store_key" yaml:"store_key"` // the store key for the KVStore this pair originates from
+	Delete   bool   `json:"delete" yaml:"delete"`       // true indicates a delete operation, false indicates a set operation
+	Key      []byte `json:"key" yaml:"key"`
+	Value    []byte `json:"value" yaml:"value"`
+}
+
+// StoreKVPairWriteListener is used to configure listening to a KVStore by
+// writing out length-prefixed amino encoded StoreKVPairs to an underlying
+// io.Writer.
+type StoreKVPairWriteListener struct {
+	writer io.Writer
+	cdc    *codec.Codec
+}
+
+// NewStoreKVPairWriteListener creates a StoreKVPairWriteListener with a
+// provided io.Writer and codec.
+func NewStoreKVPairWriteListener(w io.Writer, cdc *codec.Codec) *StoreKVPairWriteListener {
+	return &StoreKVPairWriteListener{
+		writer: w,
+		cdc:    cdc,
+	}
+}
+
+// OnWrite satisfies the WriteListener interface by writing length-prefixed
+// amino encoded StoreKVPairs.
+func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	kvPair := StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Key:      key,
+		Value:    value,
+		Delete:   delete,
+	}
+
+	_, err := wl.cdc.MarshalBinaryLengthPrefixedWriter(wl.writer, kvPair)
+	return err
+}
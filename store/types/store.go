@@ -7,6 +7,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmkv "github.com/tendermint/tendermint/libs/kv"
 	dbm "github.com/tendermint/tm-db"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/store/snapshots/types"
 )
 
 type Store interface { //nolint
@@ -116,6 +118,14 @@ type MultiStore interface { //nolint
 	// implied that the caller should update the context when necessary between
 	// tracing operations. The modified MultiStore is returned.
 	SetTracingContext(TraceContext) MultiStore
+
+	// ListeningEnabled returns if listening is enabled for the KVStore
+	// belonging to the provided StoreKey.
+	ListeningEnabled(key StoreKey) bool
+
+	// AddListeners adds WriteListeners for the KVStore belonging to the
+	// provided StoreKey. They are appended to the current list of listeners.
+	AddListeners(key StoreKey, listeners []WriteListener)
 }
 
 // From MultiStore.CacheMultiStore()....
@@ -128,6 +138,12 @@ type CacheMultiStore interface {
 type CommitMultiStore interface {
 	Committer
 	MultiStore
+	snapshottypes.Snapshotter
+
+	// RollbackRestore removes the state restored from a snapshot at the given
+	// height, e.g. when it does not match the app hash of the snapshot, the
+	// multistore being reset to the empty state it was restored into.
+	RollbackRestore(height uint64) error
 
 	// Mount a store of type using the given db.
 	// If db == nil, the new store will use the CommitMultiStore db.
@@ -329,6 +329,24 @@ func (coins Coins) SafeSub(coinsB Coins) (Coins, bool) {
 	return diff, diff.IsAnyNegative()
 }
 
+// Min returns the minimum amount of each denom of coins and coinsB, a denom
+// missing from either of them being at zero amount.
+//
+// e.g.
+// {2A, 3B}.Min{1B, 4C} = {1B}
+// {2A, 3B}.Min{} = {}
+func (coins Coins) Min(coinsB Coins) Coins {
+	min := Coins{}
+	for _, coin := range coins {
+		amt := MinInt(coin.Amount, coinsB.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			min = append(min, NewCoin(coin.Denom, amt))
+		}
+	}
+
+	return min
+}
+
 // IsAllGT returns true if for every denom in coinsB,
 // the denom is present at a greater amount in coins.
 func (coins Coins) IsAllGT(coinsB Coins) bool {
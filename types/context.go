@@ -35,6 +35,9 @@ type Context struct {
 	minGasPrice   DecCoins
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
+	priority      int64      // mempool priority of the tx, set in CheckTx
+	txSender      AccAddress // sender of the tx, whose nonce orders its txs
+	txNonce       uint64     // nonce of the tx among the txs of its sender
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -55,6 +58,9 @@ func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) Priority() int64             { return c.priority }
+func (c Context) TxSender() AccAddress        { return c.txSender }
+func (c Context) TxNonce() uint64             { return c.txNonce }
 
 // clone the header before returning
 func (c Context) BlockHeader() abci.Header {
@@ -179,6 +185,21 @@ func (c Context) WithEventManager(em *EventManager) Context {
 	return c
 }
 
+// WithPriority returns a Context with the mempool priority of the tx, the
+// AnteHandler setting it in CheckTx.
+func (c Context) WithPriority(priority int64) Context {
+	c.priority = priority
+	return c
+}
+
+// WithTxSender returns a Context with the sender of the tx and its nonce, by
+// which the mempool orders the txs of a sender.
+func (c Context) WithTxSender(sender AccAddress, nonce uint64) Context {
+	c.txSender = sender
+	c.txNonce = nonce
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
@@ -225,3 +246,23 @@ func (c Context) CacheContext() (cc Context, writeCache func()) {
 	cc = c.WithMultiStore(cms).WithEventManager(NewEventManager())
 	return cc, cms.Write
 }
+
+// ----------------------------------------------------------------------------
+// Go context
+// ----------------------------------------------------------------------------
+
+type sdkContextKeyType string
+
+const sdkContextKey sdkContextKeyType = "sdk-context"
+
+// WrapSDKContext returns a Go context.Context holding the given Context, so
+// that it can be passed to the handlers of gRPC services.
+func WrapSDKContext(ctx Context) context.Context {
+	return context.WithValue(ctx.ctx, sdkContextKey, ctx)
+}
+
+// UnwrapSDKContext returns the Context held by a Go context.Context returned
+// by WrapSDKContext. It panics if the context.Context holds no Context.
+func UnwrapSDKContext(goCtx context.Context) Context {
+	return goCtx.Value(sdkContextKey).(Context)
+}
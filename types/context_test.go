@@ -231,3 +231,14 @@ func TestContextHeaderClone(t *testing.T) {
 		})
 	}
 }
+
+func TestWrapSDKContext(t *testing.T) {
+	ctx := types.NewContext(nil, abci.Header{Height: 5}, false, nil).WithValue("key", "value")
+
+	goCtx := types.WrapSDKContext(ctx)
+	require.Equal(t, "value", goCtx.Value("key"))
+
+	unwrapped := types.UnwrapSDKContext(goCtx)
+	require.Equal(t, int64(5), unwrapped.BlockHeight())
+	require.Equal(t, ctx.Context(), unwrapped.Context())
+}
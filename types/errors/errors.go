@@ -88,6 +88,10 @@ var (
 	// ErrWrongPassword defines an error when the key password is invalid.
 	ErrWrongPassword = Register(RootCodespace, 23, "invalid account password")
 
+	// ErrConflict defines an error when an operation conflicts with another
+	// one that is already in progress or has already been performed.
+	ErrConflict = Register(RootCodespace, 24, "conflict")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = Register(UndefinedCodespace, 111222, "panic")
@@ -133,11 +133,14 @@ func toBytes(i interface{}) []byte {
 // Common event types and attribute keys
 var (
 	EventTypeMessage = "message"
-
-	AttributeKeyAction = "action"
-	AttributeKeyModule = "module"
-	AttributeKeySender = "sender"
-	AttributeKeyAmount = "amount"
+	EventTypeMempool = "mempool"
+
+	AttributeKeyAction   = "action"
+	AttributeKeyModule   = "module"
+	AttributeKeySender   = "sender"
+	AttributeKeyAmount   = "amount"
+	AttributeKeyPriority = "priority"
+	AttributeKeyNonce    = "nonce"
 )
 
 type (
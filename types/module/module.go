@@ -149,6 +149,19 @@ type AppModule interface {
 	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
 }
 
+// AppModuleMsgService is an optional extension of AppModule for modules
+// exposing a protobuf Msg service. BaseApp routes the Msgs accepted by the
+// service to it instead of the handler of the module route.
+type AppModuleMsgService interface {
+	RegisterMsgService(sdk.GRPCServer)
+}
+
+// AppModuleQueryService is an optional extension of AppModule for modules
+// exposing a gRPC query service.
+type AppModuleQueryService interface {
+	RegisterQueryService(sdk.GRPCServer)
+}
+
 //___________________________
 
 // GenesisOnlyAppModule is an AppModule that only has import/export functionality
@@ -256,6 +269,26 @@ func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter)
 	}
 }
 
+// RegisterMsgServices registers the Msg services of the modules implementing
+// AppModuleMsgService
+func (m *Manager) RegisterMsgServices(server sdk.GRPCServer) {
+	for _, module := range m.Modules {
+		if ms, ok := module.(AppModuleMsgService); ok {
+			ms.RegisterMsgService(server)
+		}
+	}
+}
+
+// RegisterQueryServices registers the gRPC query services of the modules
+// implementing AppModuleQueryService
+func (m *Manager) RegisterQueryServices(server sdk.GRPCServer) {
+	for _, module := range m.Modules {
+		if qs, ok := module.(AppModuleQueryService); ok {
+			qs.RegisterQueryService(server)
+		}
+	}
+}
+
 // InitGenesis performs init genesis functionality for modules
 func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
 	var validatorUpdates []abci.ValidatorUpdate
@@ -0,0 +1,103 @@
+package query
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DefaultLimit is the number of results returned in a page if no limit is set
+	DefaultLimit = 100
+
+	// MaxLimit is the maximum number of results returned in a page, a greater
+	// limit being lowered to it
+	MaxLimit = 1000
+)
+
+// Paginate iterates over the entries of a prefix store in ascending key order
+// and calls onResult on each entry of the page requested by req. A nil request
+// returns the first page. The keys passed to onResult and returned in the
+// response are relative to the prefix of the store.
+func Paginate(
+	prefixStore types.KVStore, req *PageRequest,
+	onResult func(key, value []byte) error,
+) (*PageResponse, error) {
+	return FilteredPaginate(prefixStore, req, func(key, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			if err := onResult(key, value); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+}
+
+// FilteredPaginate iterates over the entries of a prefix store in ascending
+// key order and paginates the entries matched by onResult. onResult is called
+// on every entry it iterates over, but must only collect the matched entries
+// for which accumulate is true, as the other ones are either skipped by the
+// offset, or only looked up to find the next key and count the total.
+func FilteredPaginate(
+	prefixStore types.KVStore, req *PageRequest,
+	onResult func(key, value []byte, accumulate bool) (bool, error),
+) (*PageResponse, error) {
+	if req == nil {
+		req = &PageRequest{}
+	}
+
+	if len(req.Key) > 0 && req.Offset > 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid page request, either offset or key is expected, got both")
+	}
+
+	limit := req.Limit
+	switch {
+	case limit == 0:
+		limit = DefaultLimit
+	case limit > MaxLimit:
+		limit = MaxLimit
+	}
+
+	// the end of the page must not overflow
+	if req.Offset > math.MaxUint64-limit {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid page request, offset %d is too large", req.Offset)
+	}
+
+	// the total can only be counted if the iteration starts at the first entry
+	countTotal := req.CountTotal && len(req.Key) == 0
+	end := req.Offset + limit
+
+	iterator := prefixStore.Iterator(req.Key, nil)
+	defer iterator.Close()
+
+	var (
+		numHits uint64
+		nextKey []byte
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		accumulate := numHits >= req.Offset && numHits < end
+
+		matched, err := onResult(iterator.Key(), iterator.Value(), accumulate)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			continue
+		}
+
+		numHits++
+		if numHits == end+1 {
+			nextKey = iterator.Key()
+			if !countTotal {
+				break
+			}
+		}
+	}
+
+	res := &PageResponse{NextKey: nextKey}
+	if countTotal {
+		res.Total = numHits
+	}
+	return res, nil
+}
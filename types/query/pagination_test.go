@@ -0,0 +1,152 @@
+package query_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func setupStore(numEntries int) types.KVStore {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	// entries outside of the prefix must not be iterated over
+	store.Set([]byte("a"), []byte("a"))
+	store.Set([]byte("c"), []byte("c"))
+
+	prefixStore := prefix.NewStore(store, []byte("b"))
+	for i := 0; i < numEntries; i++ {
+		prefixStore.Set([]byte(fmt.Sprintf("%03d", i)), []byte(fmt.Sprintf("%d", i)))
+	}
+	return prefixStore
+}
+
+func paginate(t *testing.T, store types.KVStore, req *query.PageRequest) ([]string, *query.PageResponse) {
+	var values []string
+	res, err := query.Paginate(store, req, func(key, value []byte) error {
+		values = append(values, string(value))
+		return nil
+	})
+	require.NoError(t, err)
+	return values, res
+}
+
+func TestPaginate(t *testing.T) {
+	store := setupStore(250)
+
+	// the first page holds the default limit of entries
+	values, res := paginate(t, store, nil)
+	require.Len(t, values, query.DefaultLimit)
+	require.Equal(t, "0", values[0])
+	require.Equal(t, []byte("100"), res.NextKey)
+	require.Zero(t, res.Total)
+
+	// iterate over all the entries with the next keys
+	var all []string
+	req := &query.PageRequest{Limit: 60, CountTotal: true}
+	for i := 0; ; i++ {
+		values, res = paginate(t, store, req)
+		all = append(all, values...)
+		if i == 0 {
+			require.Equal(t, uint64(250), res.Total)
+		} else {
+			require.Zero(t, res.Total, "the total is only counted from the first entry")
+		}
+		if res.NextKey == nil {
+			break
+		}
+		require.Len(t, values, 60)
+		req = &query.PageRequest{Key: res.NextKey, Limit: 60, CountTotal: true}
+	}
+	require.Len(t, all, 250)
+	for i, v := range all {
+		require.Equal(t, fmt.Sprintf("%d", i), v)
+	}
+
+	// pages by offset
+	values, res = paginate(t, store, &query.PageRequest{Offset: 200, Limit: 30, CountTotal: true})
+	require.Len(t, values, 30)
+	require.Equal(t, "200", values[0])
+	require.Equal(t, []byte("230"), res.NextKey)
+	require.Equal(t, uint64(250), res.Total)
+
+	values, res = paginate(t, store, &query.PageRequest{Offset: 230, Limit: 30})
+	require.Len(t, values, 20)
+	require.Nil(t, res.NextKey)
+
+	values, res = paginate(t, store, &query.PageRequest{Offset: 300})
+	require.Empty(t, values)
+	require.Nil(t, res.NextKey)
+
+	// the limit is capped
+	values, res = paginate(t, setupStore(query.MaxLimit+10), &query.PageRequest{Limit: query.MaxLimit + 1})
+	require.Len(t, values, query.MaxLimit)
+	require.NotNil(t, res.NextKey)
+
+	// a page which ends with the last entry has no next key
+	_, res = paginate(t, store, &query.PageRequest{Offset: 200, Limit: 50})
+	require.Nil(t, res.NextKey)
+
+	_, err := query.Paginate(store, &query.PageRequest{Key: []byte("100"), Offset: 1}, func(key, value []byte) error {
+		return nil
+	})
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err))
+
+	// an offset whose page would end past the largest offset is rejected
+	_, err = query.Paginate(store, &query.PageRequest{Offset: math.MaxUint64 - 5, Limit: 10}, func(key, value []byte) error {
+		return nil
+	})
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err))
+
+	errStop := errors.New("stop")
+	_, err = query.Paginate(store, nil, func(key, value []byte) error {
+		return errStop
+	})
+	require.Equal(t, errStop, err)
+}
+
+func TestFilteredPaginate(t *testing.T) {
+	store := setupStore(100)
+
+	// only the even entries are matched
+	filter := func(values *[]string) func(key, value []byte, accumulate bool) (bool, error) {
+		return func(key, value []byte, accumulate bool) (bool, error) {
+			if key[len(key)-1]%2 != 0 {
+				return false, nil
+			}
+			if accumulate {
+				*values = append(*values, string(value))
+			}
+			return true, nil
+		}
+	}
+
+	var values []string
+	res, err := query.FilteredPaginate(store, &query.PageRequest{Offset: 10, Limit: 5, CountTotal: true}, filter(&values))
+	require.NoError(t, err)
+	require.Equal(t, []string{"20", "22", "24", "26", "28"}, values)
+	require.Equal(t, []byte("030"), res.NextKey)
+	require.Equal(t, uint64(50), res.Total)
+
+	values = nil
+	res, err = query.FilteredPaginate(store, &query.PageRequest{Key: res.NextKey, Limit: 100}, filter(&values))
+	require.NoError(t, err)
+	require.Len(t, values, 35)
+	require.Equal(t, "30", values[0])
+	require.Nil(t, res.NextKey)
+
+	// the next key is the next matched entry
+	values = nil
+	res, err = query.FilteredPaginate(store, &query.PageRequest{Key: []byte("095"), Limit: 1}, filter(&values))
+	require.NoError(t, err)
+	require.Equal(t, []string{"96"}, values)
+	require.Equal(t, []byte("098"), res.NextKey)
+}
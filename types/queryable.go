@@ -2,8 +2,15 @@ package types
 
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
 )
 
 // Querier defines a function type that a module querier must implement to handle
 // custom client queries.
 type Querier = func(ctx Context, path []string, req abci.RequestQuery) ([]byte, error)
+
+// GRPCServer defines the interface of the servers gRPC services can be
+// registered on, such as a *grpc.Server or the gRPC query router of BaseApp.
+type GRPCServer interface {
+	RegisterService(sd *grpc.ServiceDesc, ss interface{})
+}
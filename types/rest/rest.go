@@ -3,6 +3,7 @@
 package rest
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 const (
@@ -413,6 +415,56 @@ func ParseHTTPArgs(r *http.Request) (tags []string, page, limit int, err error)
 	return ParseHTTPArgsWithLimit(r, DefaultLimit)
 }
 
+// ParsePageRequest parses the page request of a paginated query from the
+// page_key, offset, limit and count_total query parameters of the request. The
+// page_key is base64 encoded, as the next_key of a page response, and the page
+// query parameter sets the offset to a multiple of the limit.
+func ParsePageRequest(r *http.Request) (*query.PageRequest, error) {
+	req := &query.PageRequest{CountTotal: ParseQueryParamBool(r, "count_total")}
+
+	if v := r.FormValue("page_key"); v != "" {
+		key, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page_key %s: %w", v, err)
+		}
+		req.Key = key
+	}
+
+	for _, p := range []struct {
+		name  string
+		value *uint64
+	}{
+		{"offset", &req.Offset},
+		{"limit", &req.Limit},
+	} {
+		if v := r.FormValue(p.name); v != "" {
+			n, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %s: %w", p.name, v, err)
+			}
+			*p.value = n
+		}
+	}
+
+	if v := r.FormValue("page"); v != "" {
+		page, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page %s: %w", v, err)
+		}
+		if page > 1 {
+			if req.Offset > 0 {
+				return nil, errors.New("page and offset cannot be used together")
+			}
+			if req.Limit == 0 {
+				req.Limit = query.DefaultLimit
+			}
+			req.Offset = (page - 1) * req.Limit
+		}
+	}
+
+	return req, nil
+}
+
 // ParseQueryParamBool parses the given param to a boolean. It returns false by
 // default if the string is not parseable to bool.
 func ParseQueryParamBool(r *http.Request, param string) bool {
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func TestBaseReq_Sanitize(t *testing.T) {
@@ -306,6 +307,38 @@ func TestParseQueryParamBool(t *testing.T) {
 	require.False(t, ParseQueryParamBool(req, ""))
 }
 
+func TestParsePageRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		req    *query.PageRequest
+		err    bool
+	}{
+		{"no params", "/", &query.PageRequest{}, false},
+		{"key", "/?page_key=AAE%3D&limit=5", &query.PageRequest{Key: []byte{0, 1}, Limit: 5}, false},
+		{"offset", "/?offset=10&limit=5&count_total=true", &query.PageRequest{Offset: 10, Limit: 5, CountTotal: true}, false},
+		{"page", "/?page=3&limit=5", &query.PageRequest{Offset: 10, Limit: 5}, false},
+		{"page with default limit", "/?page=2", &query.PageRequest{Offset: query.DefaultLimit, Limit: query.DefaultLimit}, false},
+		{"first page", "/?page=1", &query.PageRequest{}, false},
+
+		{"invalid key", "/?page_key=%21", nil, true},
+		{"invalid limit", "/?limit=-1", nil, true},
+		{"page and offset", "/?page=2&offset=5", nil, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := ParsePageRequest(httptest.NewRequest("GET", tt.target, nil))
+			if tt.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.req, req)
+			}
+		})
+	}
+}
+
 func TestPostProcessResponseBare(t *testing.T) {
 	t.Parallel()
 
@@ -24,6 +24,25 @@ func (r Result) String() string {
 	return string(bz)
 }
 
+// WrapServiceResult wraps the response of a protobuf Msg service method into a
+// Result, with the response encoded as data along with the events emitted
+// on the Context.
+func WrapServiceResult(ctx Context, res codec.ProtoMarshaler, err error) (*Result, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	if res != nil {
+		data, err = res.Marshal()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Result{Data: data, Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 func (r Result) GetEvents() Events {
 	events := make(Events, len(r.Events))
 	for i, e := range r.Events {
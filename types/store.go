@@ -24,6 +24,8 @@ type (
 	MultiStorePersistentCache = types.MultiStorePersistentCache
 	KVStore                   = types.KVStore
 	Iterator                  = types.Iterator
+	WriteListener             = types.WriteListener
+	StoreKVPair               = types.StoreKVPair
 )
 
 // StoreDecoderRegistry defines each of the modules store decoders. Used for ImportExport
@@ -2,7 +2,9 @@ package types
 
 import (
 	"encoding/json"
+	"reflect"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/crypto"
 )
 
@@ -62,6 +64,28 @@ type TxDecoder func(txBytes []byte) (Tx, error)
 // TxEncoder marshals transaction to bytes
 type TxEncoder func(tx Tx) ([]byte, error)
 
+// MsgTypeURL returns the type URL of a Msg, i.e. "/" followed by the fully
+// qualified name of its protobuf message type, or an empty string if the Msg is
+// not a protobuf message. Msgs registered with amino as values are supported.
+func MsgTypeURL(msg Msg) string {
+	protoMsg, ok := msg.(proto.Message)
+	if !ok {
+		ptr := reflect.New(reflect.TypeOf(msg))
+		ptr.Elem().Set(reflect.ValueOf(msg))
+
+		if protoMsg, ok = ptr.Interface().(proto.Message); !ok {
+			return ""
+		}
+	}
+
+	name := proto.MessageName(protoMsg)
+	if name == "" {
+		return ""
+	}
+
+	return "/" + name
+}
+
 //__________________________________________________________
 
 var _ Msg = (*TestMsg)(nil)
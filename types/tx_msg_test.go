@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestTestMsg(t *testing.T) {
@@ -21,3 +22,11 @@ func TestTestMsg(t *testing.T) {
 	require.NotPanics(t, func() { msg.GetSignBytes() })
 	require.Equal(t, []sdk.AccAddress{accAddr}, msg.GetSigners())
 }
+
+func TestMsgTypeURL(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "/cosmos_sdk.x.bank.v1.MsgSend", sdk.MsgTypeURL(banktypes.MsgSend{}))
+	require.Equal(t, "/cosmos_sdk.x.bank.v1.MsgSend", sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	require.Equal(t, "", sdk.MsgTypeURL(sdk.NewTestMsg()))
+}
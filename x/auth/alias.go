@@ -14,15 +14,24 @@ const (
 	StoreKey                      = types.StoreKey
 	FeeCollectorName              = types.FeeCollectorName
 	QuerierRoute                  = types.QuerierRoute
+	RouterKey                     = types.RouterKey
+	AttributeValueCategory        = types.AttributeValueCategory
+	TypeMsgRotatePubKey           = types.TypeMsgRotatePubKey
 	DefaultParamspace             = types.DefaultParamspace
 	DefaultMaxMemoCharacters      = types.DefaultMaxMemoCharacters
 	DefaultTxSigLimit             = types.DefaultTxSigLimit
 	DefaultTxSizeCostPerByte      = types.DefaultTxSizeCostPerByte
 	DefaultSigVerifyCostED25519   = types.DefaultSigVerifyCostED25519
 	DefaultSigVerifyCostSecp256k1 = types.DefaultSigVerifyCostSecp256k1
+	DefaultSigVerifyCostSecp256r1 = types.DefaultSigVerifyCostSecp256r1
 	QueryAccount                  = types.QueryAccount
+	QueryAccounts                 = types.QueryAccounts
 	QueryParams                   = types.QueryParams
 	MaxGasWanted                  = types.MaxGasWanted
+	SignModeUnspecified           = types.SignModeUnspecified
+	SignModeDirect                = types.SignModeDirect
+	SignModeTextual               = types.SignModeTextual
+	SignModeLegacyAminoJSON       = types.SignModeLegacyAminoJSON
 )
 
 var (
@@ -34,11 +43,13 @@ var (
 	SetGasMeter                       = ante.SetGasMeter
 	NewAccountKeeper                  = keeper.NewAccountKeeper
 	NewQuerier                        = keeper.NewQuerier
+	NewQueryServer                    = keeper.NewQueryServer
 	NewBaseAccount                    = types.NewBaseAccount
 	ProtoBaseAccount                  = types.ProtoBaseAccount
 	NewBaseAccountWithAddress         = types.NewBaseAccountWithAddress
 	NewAccountRetriever               = types.NewAccountRetriever
 	RegisterCodec                     = types.RegisterCodec
+	RegisterQueryService              = types.RegisterQueryService
 	NewGenesisState                   = types.NewGenesisState
 	DefaultGenesisState               = types.DefaultGenesisState
 	ValidateGenesis                   = types.ValidateGenesis
@@ -48,10 +59,15 @@ var (
 	ParamKeyTable                     = types.ParamKeyTable
 	DefaultParams                     = types.DefaultParams
 	NewQueryAccountParams             = types.NewQueryAccountParams
+	NewQueryAccountsParams            = types.NewQueryAccountsParams
+	NewQueryAccountsResult            = types.NewQueryAccountsResult
 	NewStdTx                          = types.NewStdTx
 	CountSubKeys                      = types.CountSubKeys
 	NewStdFee                         = types.NewStdFee
 	StdSignBytes                      = types.StdSignBytes
+	DirectSignBytes                   = types.DirectSignBytes
+	NewTxBody                         = types.NewTxBody
+	NewAuthInfo                       = types.NewAuthInfo
 	DefaultTxDecoder                  = types.DefaultTxDecoder
 	DefaultTxEncoder                  = types.DefaultTxEncoder
 	NewTxBuilder                      = types.NewTxBuilder
@@ -62,6 +78,8 @@ var (
 	NewStdSignature                   = types.NewStdSignature
 	NewStdTxBase                      = types.NewStdTxBase
 	NewStdSignDocBase                 = types.NewStdSignDocBase
+	NewMsgRotatePubKey                = types.NewMsgRotatePubKey
+	RegisterMsgService                = types.RegisterMsgService
 
 	// variable aliases
 	ModuleCdc                 = types.ModuleCdc
@@ -72,6 +90,8 @@ var (
 	KeyTxSizeCostPerByte      = types.KeyTxSizeCostPerByte
 	KeySigVerifyCostED25519   = types.KeySigVerifyCostED25519
 	KeySigVerifyCostSecp256k1 = types.KeySigVerifyCostSecp256k1
+	KeySigVerifyCostSecp256r1 = types.KeySigVerifyCostSecp256r1
+	ErrorUnsupportedSignMode  = types.ErrorUnsupportedSignMode
 )
 
 type (
@@ -83,6 +103,8 @@ type (
 	GenesisState                     = types.GenesisState
 	Params                           = types.Params
 	QueryAccountParams               = types.QueryAccountParams
+	QueryAccountsParams              = types.QueryAccountsParams
+	QueryAccountsResult              = types.QueryAccountsResult
 	StdSignMsg                       = types.StdSignMsg
 	StdTx                            = types.StdTx
 	StdFee                           = types.StdFee
@@ -93,4 +115,12 @@ type (
 	Codec                            = types.Codec
 	StdSignDocBase                   = types.StdSignDocBase
 	StdTxBase                        = types.StdTxBase
+	SignMode                         = types.SignMode
+	TxBody                           = types.TxBody
+	EncodedMsg                       = types.EncodedMsg
+	AuthInfo                         = types.AuthInfo
+	SignerInfo                       = types.SignerInfo
+	DirectSignDoc                    = types.DirectSignDoc
+	MsgRotatePubKey                  = types.MsgRotatePubKey
+	MsgServer                        = types.MsgServer
 )
@@ -2,6 +2,7 @@ package ante
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
@@ -26,6 +27,7 @@ type FeeTx interface {
 // MempoolFeeDecorator will check if the transaction's fee is at least as large
 // as the local validator's minimum gasFee (defined in validator config).
 // If fee is too low, decorator returns error and tx is rejected from mempool.
+// It also sets the mempool priority of the tx to its DefaultTxPriority.
 // Note this only applies when ctx.CheckTx = true
 // If fee is high enough or not CheckTx, then call next AnteHandler
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
@@ -63,6 +65,56 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 			}
 		}
+
+		ctx = ctx.WithPriority(DefaultTxPriority(ctx, feeTx))
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// TxPriorityFn returns the mempool priority of a tx, the txs of higher priority
+// being included first in the blocks.
+type TxPriorityFn func(ctx sdk.Context, tx FeeTx) int64
+
+// DefaultTxPriority returns the effective gas price of a tx, i.e. its fee per
+// unit of gas, in millionths of the fee denomination, truncated. As the gas
+// prices of several denominations cannot be compared without an exchange rate,
+// a fee of several denominations has no priority: the chains accepting such
+// fees set their own TxPriorityFn with a TxPriorityDecorator.
+func DefaultTxPriority(_ sdk.Context, tx FeeTx) int64 {
+	gas, fee := tx.GetGas(), tx.GetFee()
+	if gas == 0 || gas > math.MaxInt64 || len(fee) != 1 {
+		return 0
+	}
+
+	gasPrice := fee[0].Amount.ToDec().QuoInt64(int64(gas)).MulInt64(1_000_000).TruncateInt()
+	if !gasPrice.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return gasPrice.Int64()
+}
+
+// TxPriorityDecorator sets the mempool priority of the tx with its TxPriorityFn
+// in CheckTx, overriding the DefaultTxPriority set by the MempoolFeeDecorator,
+// which must run before it.
+// CONTRACT: Tx must implement FeeTx to use TxPriorityDecorator
+type TxPriorityDecorator struct {
+	priorityFn TxPriorityFn
+}
+
+func NewTxPriorityDecorator(priorityFn TxPriorityFn) TxPriorityDecorator {
+	return TxPriorityDecorator{priorityFn: priorityFn}
+}
+
+func (tpd TxPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if ctx.IsCheckTx() && !simulate {
+		ctx = ctx.WithPriority(tpd.priorityFn(ctx, feeTx))
 	}
 
 	return next(ctx, tx, simulate)
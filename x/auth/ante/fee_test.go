@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -56,8 +57,54 @@ func TestEnsureMempoolFees(t *testing.T) {
 	lowGasPrice := []sdk.DecCoin{atomPrice}
 	ctx = ctx.WithMinGasPrices(lowGasPrice)
 
-	_, err = antehandler(ctx, tx, false)
+	newCtx, err := antehandler(ctx, tx, false)
 	require.Nil(t, err, "Decorator should not have errored on fee higher than local gasPrice")
+
+	// the priority of the tx is its gas price of 150atom / 100000 gas
+	require.Equal(t, int64(1500), newCtx.Priority())
+}
+
+func TestTxPriority(t *testing.T) {
+	_, ctx := createTestApp(true)
+	ctx = ctx.WithIsCheckTx(true)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	testCases := []struct {
+		fee      types.StdFee
+		priority int64
+	}{
+		{types.NewStdFee(100000, sdk.NewCoins()), 0},
+		{types.NewStdFee(0, sdk.NewCoins(sdk.NewInt64Coin("atom", 150))), 0},
+		{types.NewStdFee(3, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))), 333333},
+		{types.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 50))), 0},
+		{types.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))), 500},
+		{types.NewStdFee(1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewIntWithDecimal(1, 30)))), math.MaxInt64},
+	}
+
+	for i, tc := range testCases {
+		tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, tc.fee)
+		require.Equal(t, tc.priority, ante.DefaultTxPriority(ctx, tx.(ante.FeeTx)), "tc #%d", i)
+	}
+
+	// a TxPriorityDecorator overrides the default priority in CheckTx only
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, types.NewTestStdFee())
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewMempoolFeeDecorator(),
+		ante.NewTxPriorityDecorator(func(ctx sdk.Context, tx ante.FeeTx) int64 {
+			return int64(tx.GetGas())
+		}),
+	)
+
+	newCtx, err := antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(100000), newCtx.Priority())
+
+	newCtx, err = antehandler(ctx.WithIsCheckTx(false), tx, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), newCtx.Priority())
 }
 
 func TestDeductFees(t *testing.T) {
@@ -10,10 +10,12 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -42,7 +44,7 @@ type SigVerifiableTx interface {
 	GetSignatures() [][]byte
 	GetSigners() []sdk.AccAddress
 	GetPubKeys() []crypto.PubKey // If signer already has pubkey in context, this list will have nil in its place
-	GetSignBytes(ctx sdk.Context, acc exported.Account) []byte
+	GetSignModes() []types.SignMode
 }
 
 // SetPubKeyDecorator sets PubKeys in context for any signer which does not already have pubkey set
@@ -68,6 +70,16 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	signers := sigTx.GetSigners()
 
 	for i, pk := range pubkeys {
+		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
+		if err != nil {
+			return ctx, err
+		}
+		// accounts supplying their own authentication logic are given the
+		// pubkey when their signature is verified
+		if _, ok := acc.(exported.Authenticator); ok {
+			continue
+		}
+
 		// PublicKey was omitted from slice since it has already been set in context
 		if pk == nil {
 			if !simulate {
@@ -75,20 +87,22 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
+
+		// account already has pubkey set, which may have been rotated, no need
+		// to reset. Only make check if simulate=false
+		if accPubKey := acc.GetPubKey(); accPubKey != nil {
+			if !simulate && !accPubKey.Equals(pk) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+					"pubKey does not match the pubKey of signer %s with signer index: %d", signers[i], i)
+			}
+			continue
+		}
+
 		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
 			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
 				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
 		}
 
-		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
-		if err != nil {
-			return ctx, err
-		}
-		// account already has pubkey set,no need to reset
-		if acc.GetPubKey() != nil {
-			continue
-		}
 		err = acc.SetPubKey(pk)
 		if err != nil {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
@@ -133,6 +147,11 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		if err != nil {
 			return ctx, err
 		}
+		// accounts supplying their own authentication logic consume the gas
+		// of the verification of their signature themselves
+		if _, ok := signerAcc.(exported.Authenticator); ok {
+			continue
+		}
 		pubKey := signerAcc.GetPubKey()
 
 		if simulate && pubKey == nil {
@@ -154,17 +173,23 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 }
 
 // Verify all signatures for a tx and return an error if any are invalid. Note,
-// the SigVerificationDecorator decorator will not get executed on ReCheck.
+// the SigVerificationDecorator decorator will not get executed on ReCheck. The
+// bytes each signature is over are returned by the SignModeHandler for the mode
+// of the signature, which is given the wrapped sdk.Context if it is a
+// SignModeHandlerWithContext. The accounts implementing exported.Authenticator
+// verify their signature themselves.
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
-	ak keeper.AccountKeeper
+	ak              keeper.AccountKeeper
+	signModeHandler signing.SignModeHandler
 }
 
-func NewSigVerificationDecorator(ak keeper.AccountKeeper) SigVerificationDecorator {
+func NewSigVerificationDecorator(ak keeper.AccountKeeper, signModeHandler signing.SignModeHandler) SigVerificationDecorator {
 	return SigVerificationDecorator{
-		ak: ak,
+		ak:              ak,
+		signModeHandler: signModeHandler,
 	}
 }
 
@@ -181,6 +206,8 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	// stdSigs contains the sequence number, account number, and signatures.
 	// When simulating, this would just be a 0-length slice.
 	sigs := sigTx.GetSignatures()
+	signModes := sigTx.GetSignModes()
+	pubKeys := sigTx.GetPubKeys()
 
 	// stdSigs contains the sequence number, account number, and signatures.
 	// When simulating, this would just be a 0-length slice.
@@ -198,8 +225,23 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return ctx, err
 		}
 
-		// retrieve signBytes of tx
-		signBytes := sigTx.GetSignBytes(ctx, signerAccs[i])
+		// accounts supplying their own authentication logic verify their
+		// signature themselves, given the pubkey set on the tx if any
+		if authenticator, ok := signerAccs[i].(exported.Authenticator); ok {
+			var signBytes []byte
+			if !simulate {
+				signBytes, err = svd.getSignBytes(ctx, tx, signModes[i], signerAccs[i])
+				if err != nil {
+					return ctx, err
+				}
+			}
+
+			if err := authenticator.Authenticate(ctx, pubKeys[i], signBytes, sig, simulate); err != nil {
+				return ctx, err
+			}
+
+			continue
+		}
 
 		// retrieve pubkey
 		pubKey := signerAccs[i].GetPubKey()
@@ -207,8 +249,18 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
+		if simulate {
+			continue
+		}
+
+		// retrieve signBytes of tx for the sign mode of the signature
+		signBytes, err := svd.getSignBytes(ctx, tx, signModes[i], signerAccs[i])
+		if err != nil {
+			return ctx, err
+		}
+
 		// verify signature
-		if !simulate && !pubKey.VerifyBytes(signBytes, sig) {
+		if !pubKey.VerifyBytes(signBytes, sig) {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed; verify correct account sequence and chain-id")
 		}
 	}
@@ -216,6 +268,17 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
+// getSignBytes returns the bytes the signature of a signer is over for its sign
+// mode, which are returned by the SignModeHandler.
+func (svd SigVerificationDecorator) getSignBytes(
+	ctx sdk.Context, tx sdk.Tx, signMode types.SignMode, acc exported.Account,
+) ([]byte, error) {
+
+	return signing.GetSignBytesWithContext(
+		sdk.WrapSDKContext(ctx), svd.signModeHandler, signMode, getSignerData(ctx, acc), tx,
+	)
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is no need to execute IncrementSequenceDecorator on RecheckTX since
@@ -246,9 +309,14 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
-	// increment sequence of all signers
-	for _, addr := range sigTx.GetSigners() {
+	// increment sequence of all signers, the first signer being the sender of
+	// the tx and its sequence the nonce ordering its txs in the mempool
+	for i, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(ctx, addr)
+		if i == 0 {
+			ctx = ctx.WithTxSender(addr, acc.GetSequence())
+		}
+
 		if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
 			panic(err)
 		}
@@ -310,6 +378,10 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 		return nil
 
+	case secp256r1.PubKeySecp256r1:
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1, "ante verify: secp256r1")
+		return nil
+
 	case multisig.PubKeyMultisigThreshold:
 		var multisignature multisig.Multisignature
 		codec.Cdc.MustUnmarshalBinaryBare(sig, &multisignature)
@@ -338,6 +410,21 @@ func ConsumeMultisignatureVerificationGas(
 	}
 }
 
+// getSignerData returns the data of a signer which its signature is over. The
+// account number is omitted at genesis, where accounts are not numbered yet.
+func getSignerData(ctx sdk.Context, acc exported.Account) signing.SignerData {
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+
+	return signing.SignerData{
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      acc.GetSequence(),
+	}
+}
+
 // GetSignerAcc returns an account for a given address that is expected to sign
 // a transaction.
 func GetSignerAcc(ctx sdk.Context, ak keeper.AccountKeeper, addr sdk.AccAddress) (exported.Account, error) {
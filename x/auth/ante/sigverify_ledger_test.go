@@ -0,0 +1,76 @@
+//+build ledger test_ledger_mock
+
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	codecstd "github.com/cosmos/cosmos-sdk/codec/std"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestSigVerificationLedgerTextual(t *testing.T) {
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1).WithChainID("test-chain")
+
+	kr := keyring.NewInMemory()
+	info, err := kr.SaveLedgerKey("ledger", hd.Secp256k1, "cosmos", 118, 0, 0)
+	if err != nil {
+		require.Equal(t, "ledger nano S: support for ledger devices is not available in this executable", err.Error())
+		t.Skip("ledger nano S: support for ledger devices is not available in this executable")
+		return
+	}
+	require.Equal(t, keyring.TypeLedger, info.GetType())
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, info.GetAddress())
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	metadata := bank.NewMetadata(
+		"The native staking token", "atom", "katom", bank.NewDenomUnit("atom", 0), bank.NewDenomUnit("katom", 3),
+	)
+	app.BankKeeper.SetDenomMetaData(ctx, metadata)
+
+	msg := bank.NewMsgSend(info.GetAddress(), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("atom", 1500)))
+	chainHandler := simapp.NewSignModeHandler(bank.NewDenomMetadataQueryFn(app.BankKeeper))
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(app.AccountKeeper), ante.NewSigVerificationDecorator(app.AccountKeeper, chainHandler),
+	)
+
+	// the Ledger key signs in SIGN_MODE_TEXTUAL without requesting it
+	queryFn := func(context.Context, string) (bank.Metadata, bool, error) { return metadata, true, nil }
+	txf := clienttx.Factory{}.
+		WithTxGenerator(codecstd.NewTxGenerator(simapp.NewSignModeHandler(queryFn))).
+		WithKeybase(kr).
+		WithChainID(ctx.ChainID()).
+		WithAccountNumber(acc.GetAccountNumber()).
+		WithSequence(acc.GetSequence()).
+		WithFees("10atom").
+		WithGas(200000)
+
+	unsignedTx, err := clienttx.BuildUnsignedTx(txf, msg)
+	require.NoError(t, err)
+
+	bz, err := clienttx.Sign(txf, "ledger", "", unsignedTx)
+	require.NoError(t, err)
+
+	signedTx := &codecstd.Transaction{}
+	require.NoError(t, signedTx.Unmarshal(bz))
+
+	sigs := signedTx.GetStdSignatures()
+	require.Len(t, sigs, 1)
+	require.Equal(t, types.SignModeTextual, sigs[0].SignMode)
+
+	tx := types.NewStdTx(signedTx.GetMsgs(), signedTx.GetStdFee(), sigs, signedTx.GetMemo())
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+}
@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -10,9 +11,22 @@ import (
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecstd "github.com/cosmos/cosmos-sdk/codec/std"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
 func TestSetPubKey(t *testing.T) {
@@ -55,6 +69,39 @@ func TestSetPubKey(t *testing.T) {
 	}
 }
 
+func TestSetPubKeyRotated(t *testing.T) {
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	priv2, pub2, _ := types.KeyTestPubAddr()
+
+	// the pubkey of the account is rotated away from the one of its address
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc.SetPubKey(pub2))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	fee := types.NewTestStdFee()
+
+	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper, signing.DefaultSignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	tx := types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv2}, []uint64{0}, []uint64{0}, fee)
+	_, err := antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// the key of the address no longer authenticates the account
+	tx = types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}, fee)
+	_, err = antehandler(ctx, tx, false)
+	require.True(t, sdkerrors.ErrInvalidPubKey.Is(err))
+
+	pk, err := app.AccountKeeper.GetPubKey(ctx, addr1)
+	require.NoError(t, err)
+	require.Equal(t, pub2, pk)
+}
+
 func TestConsumeSignatureVerificationGas(t *testing.T) {
 	params := types.DefaultParams()
 	msg := []byte{1, 2, 3, 4}
@@ -82,6 +129,7 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}{
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256k1, false},
+		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, secp256r1.GenPrivKey().PubKey(), params}, params.SigVerifyCostSecp256r1, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1.Marshal(), multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -125,7 +173,7 @@ func TestSigVerification(t *testing.T) {
 	fee := types.NewTestStdFee()
 
 	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
-	svd := ante.NewSigVerificationDecorator(app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper, signing.DefaultSignModeHandler())
 	antehandler := sdk.ChainAnteDecorators(spkd, svd)
 
 	type testCase struct {
@@ -159,6 +207,209 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+func TestSigVerificationSignModes(t *testing.T) {
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	priv2, _, addr2 := types.KeyTestPubAddr()
+
+	addrs := []sdk.AccAddress{addr1, addr2}
+	msgs := make([]sdk.Msg, len(addrs))
+	for i, addr := range addrs {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		require.NoError(t, acc.SetAccountNumber(uint64(i)))
+		app.AccountKeeper.SetAccount(ctx, acc)
+		msgs[i] = bank.NewMsgSend(addr, addrs[(i+1)%len(addrs)], sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	}
+
+	privs := []crypto.PrivKey{priv1, priv2}
+	accNums := []uint64{0, 1}
+	seqs := []uint64{0, 0}
+	fee := types.NewTestStdFee()
+
+	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
+
+	// the coins are rendered with the metadata of their denomination in
+	// SignModeTextual, which the chain reads from its state
+	app.BankKeeper.SetDenomMetaData(ctx, bank.NewMetadata(
+		"The native staking token", "atom", "katom", bank.NewDenomUnit("atom", 0), bank.NewDenomUnit("katom", 3),
+	))
+	newTextualHandler := func(queryFn bank.DenomMetadataQueryFn) textual.SignModeHandler {
+		registry := textual.NewRegistry()
+		bank.RegisterTextualRenderers(registry, queryFn)
+		return textual.NewSignModeHandler(registry)
+	}
+	newTextualTx := func(handler textual.SignModeHandler) sdk.Tx {
+		tx := types.NewTestTxWithSignMode(ctx, msgs, privs, accNums, seqs, fee, types.SignModeTextual).(types.StdTx)
+		for i, priv := range privs {
+			data := signing.SignerData{ChainID: ctx.ChainID(), AccountNumber: accNums[i], Sequence: seqs[i]}
+			signBytes, err := handler.GetSignBytesWithContext(sdk.WrapSDKContext(ctx), types.SignModeTextual, data, tx)
+			require.NoError(t, err)
+
+			tx.Signatures[i].Signature, err = priv.Sign(signBytes)
+			require.NoError(t, err)
+		}
+
+		return tx
+	}
+	chainTextualHandler := signing.NewHandlerMap(
+		types.SignModeLegacyAminoJSON,
+		append(signing.DefaultSignModeHandlers(), newTextualHandler(bank.NewDenomMetadataQueryFn(app.BankKeeper)))...,
+	)
+
+	testCases := []struct {
+		name      string
+		tx        func() sdk.Tx
+		handler   signing.SignModeHandler
+		shouldErr bool
+	}{
+		{
+			"textual",
+			func() sdk.Tx {
+				return newTextualTx(newTextualHandler(bank.NewDenomMetadataQueryFn(app.BankKeeper)))
+			},
+			chainTextualHandler,
+			false,
+		},
+		{
+			"textual signature rendered without the denomination metadata",
+			func() sdk.Tx {
+				return newTextualTx(newTextualHandler(func(context.Context, string) (bank.Metadata, bool, error) {
+					return bank.Metadata{}, false, nil
+				}))
+			},
+			chainTextualHandler,
+			true,
+		},
+		{
+			"direct",
+			func() sdk.Tx {
+				return types.NewTestTxWithSignMode(ctx, msgs, privs, accNums, seqs, fee, types.SignModeDirect)
+			},
+			signing.DefaultSignModeHandler(),
+			false,
+		},
+		{
+			"legacy amino json",
+			func() sdk.Tx {
+				return types.NewTestTxWithSignMode(ctx, msgs, privs, accNums, seqs, fee, types.SignModeLegacyAminoJSON)
+			},
+			signing.DefaultSignModeHandler(),
+			false,
+		},
+		{
+			"direct signature verified as legacy amino json",
+			func() sdk.Tx {
+				tx := types.NewTestTxWithSignMode(ctx, msgs, privs, accNums, seqs, fee, types.SignModeDirect).(types.StdTx)
+				tx.Signatures[1].SignMode = types.SignModeLegacyAminoJSON
+				return tx
+			},
+			signing.DefaultSignModeHandler(),
+			true,
+		},
+		{
+			"unsupported sign mode",
+			func() sdk.Tx {
+				return types.NewTestTxWithSignMode(ctx, msgs, privs, accNums, seqs, fee, types.SignModeDirect)
+			},
+			signing.NewHandlerMap(types.SignModeLegacyAminoJSON, signing.LegacyAminoJSONHandler{}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			svd := ante.NewSigVerificationDecorator(app.AccountKeeper, tc.handler)
+			antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+			_, err := antehandler(cacheCtx, tc.tx(), false)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSigVerificationClientTextual(t *testing.T) {
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1).WithChainID("test-chain")
+
+	kr := keyring.NewInMemory()
+	info, _, err := kr.NewMnemonic("signer", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, info.GetAddress())
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	metadata := bank.NewMetadata(
+		"The native staking token", "atom", "katom", bank.NewDenomUnit("atom", 0), bank.NewDenomUnit("katom", 3),
+	)
+	app.BankKeeper.SetDenomMetaData(ctx, metadata)
+
+	msg := bank.NewMsgSend(info.GetAddress(), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("atom", 1500)))
+	chainHandler := simapp.NewSignModeHandler(bank.NewDenomMetadataQueryFn(app.BankKeeper))
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(app.AccountKeeper), ante.NewSigVerificationDecorator(app.AccountKeeper, chainHandler),
+	)
+
+	// the client queries the denomination metadata from the node, which the
+	// query functions stand for
+	testCases := []struct {
+		name      string
+		queryFn   bank.DenomMetadataQueryFn
+		shouldErr bool
+	}{
+		{
+			"metadata of the node",
+			func(context.Context, string) (bank.Metadata, bool, error) { return metadata, true, nil },
+			false,
+		},
+		{
+			"metadata missing on the client",
+			func(context.Context, string) (bank.Metadata, bool, error) { return bank.Metadata{}, false, nil },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			txf := clienttx.Factory{}.
+				WithTxGenerator(codecstd.NewTxGenerator(simapp.NewSignModeHandler(tc.queryFn))).
+				WithKeybase(kr).
+				WithChainID(ctx.ChainID()).
+				WithAccountNumber(acc.GetAccountNumber()).
+				WithSequence(acc.GetSequence()).
+				WithFees("10atom").
+				WithGas(200000).
+				WithSignMode(types.SignModeTextual)
+
+			unsignedTx, err := clienttx.BuildUnsignedTx(txf, msg)
+			require.NoError(t, err)
+
+			bz, err := clienttx.Sign(txf, "signer", "", unsignedTx)
+			require.NoError(t, err)
+
+			signedTx := &codecstd.Transaction{}
+			require.NoError(t, signedTx.Unmarshal(bz))
+
+			tx := types.NewStdTx(signedTx.GetMsgs(), signedTx.GetStdFee(), signedTx.GetStdSignatures(), signedTx.GetMemo())
+			cacheCtx, _ := ctx.CacheContext()
+			_, err = antehandler(cacheCtx, tx, false)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
@@ -202,7 +453,7 @@ func runSigDecorators(t *testing.T, params types.Params, multisig bool, privs ..
 
 	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
 	svgc := ante.NewSigGasConsumeDecorator(app.AccountKeeper, ante.DefaultSigVerificationGasConsumer)
-	svd := ante.NewSigVerificationDecorator(app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper, signing.DefaultSignModeHandler())
 	antehandler := sdk.ChainAnteDecorators(spkd, svgc, svd)
 
 	// Determine gas consumption of antehandler with default params
@@ -248,4 +499,125 @@ func TestIncrementSequenceDecorator(t *testing.T) {
 		require.NoError(t, err, "unexpected error; tc #%d, %v", i, tc)
 		require.Equal(t, tc.expectedSeq, app.AccountKeeper.GetAccount(ctx, addr).GetSequence())
 	}
+
+	// the sender of the tx is its first signer, with its sequence as the nonce
+	newCtx, err := antehandler(ctx.WithIsCheckTx(true), tx, false)
+	require.NoError(t, err)
+	require.Equal(t, addr, newCtx.TxSender())
+	require.Equal(t, uint64(2), newCtx.TxNonce())
+}
+
+// multiKeyAccount is an account authenticated by a signature of any of its
+// keys, which supplies its own authentication logic.
+type multiKeyAccount struct {
+	*types.BaseAccount
+
+	Keys []crypto.PubKey
+}
+
+var _ exported.Authenticator = multiKeyAccount{}
+
+func (acc multiKeyAccount) Authenticate(ctx sdk.Context, _ crypto.PubKey, signBytes, sig []byte, simulate bool) error {
+	ctx.GasMeter().ConsumeGas(types.DefaultSigVerifyCostSecp256k1, "ante verify: multi key account")
+	if simulate {
+		return nil
+	}
+
+	for _, key := range acc.Keys {
+		if key.VerifyBytes(signBytes, sig) {
+			return nil
+		}
+	}
+
+	return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed")
+}
+
+// authenticatorCodec serializes the accounts with amino, which is aware of the
+// multiKeyAccount type.
+type authenticatorCodec struct {
+	codec.Marshaler
+
+	amino *codec.Codec
+}
+
+func newAuthenticatorCodec() authenticatorCodec {
+	amino := codec.New()
+	types.RegisterCodec(amino)
+	codec.RegisterCrypto(amino)
+	amino.RegisterConcrete(&multiKeyAccount{}, "cosmos-sdk/MultiKeyAccount", nil)
+
+	return authenticatorCodec{Marshaler: codec.NewHybridCodec(amino), amino: amino}
+}
+
+func (c authenticatorCodec) MarshalAccount(acc exported.Account) ([]byte, error) {
+	return c.amino.MarshalBinaryBare(acc)
+}
+
+func (c authenticatorCodec) UnmarshalAccount(bz []byte) (acc exported.Account, err error) {
+	err = c.amino.UnmarshalBinaryBare(bz, &acc)
+	return acc, err
+}
+
+func (c authenticatorCodec) MarshalAccountJSON(acc exported.Account) ([]byte, error) {
+	return c.amino.MarshalJSON(acc)
+}
+
+func (c authenticatorCodec) UnmarshalAccountJSON(bz []byte) (acc exported.Account, err error) {
+	err = c.amino.UnmarshalJSON(bz, &acc)
+	return acc, err
+}
+
+func TestSigVerificationAuthenticator(t *testing.T) {
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+
+	ak := keeper.NewAccountKeeper(
+		newAuthenticatorCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), types.ProtoBaseAccount,
+	)
+
+	_, _, addr := types.KeyTestPubAddr()
+	priv1, pub1, _ := types.KeyTestPubAddr()
+	priv2, pub2, _ := types.KeyTestPubAddr()
+	priv3, _, _ := types.KeyTestPubAddr()
+
+	acc := &multiKeyAccount{BaseAccount: types.NewBaseAccountWithAddress(addr), Keys: []crypto.PubKey{pub1, pub2}}
+	ak.SetAccount(ctx, acc)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr)}
+	fee := types.NewTestStdFee()
+
+	spkd := ante.NewSetPubKeyDecorator(ak)
+	sgcd := ante.NewSigGasConsumeDecorator(ak, ante.DefaultSigVerificationGasConsumer)
+	svd := ante.NewSigVerificationDecorator(ak, signing.DefaultSignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, sgcd, svd)
+
+	testCases := []struct {
+		name     string
+		priv     crypto.PrivKey
+		simulate bool
+		expErr   bool
+	}{
+		{"first key", priv1, false, false},
+		{"second key", priv2, false, false},
+		{"unknown key", priv3, false, true},
+		{"simulate", priv3, true, false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			tx := types.NewTestTx(ctx, msgs, []crypto.PrivKey{tc.priv}, []uint64{0}, []uint64{0}, fee)
+
+			_, err := antehandler(ctx, tx, tc.simulate)
+			if tc.expErr {
+				require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			// the account consumes the gas of the verification and keeps no pubkey
+			require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), types.DefaultSigVerifyCostSecp256k1)
+			require.Nil(t, ak.GetAccount(ctx, addr).GetPubKey())
+		})
+	}
 }
@@ -37,6 +37,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.AddCommand(
 		GetAccountCmd(cdc),
+		GetAccountsCmd(cdc),
 		QueryParamsCmd(cdc),
 	)
 
@@ -102,6 +103,55 @@ func GetAccountCmd(cdc *codec.Codec) *cobra.Command {
 	return flags.GetCommands(cmd)[0]
 }
 
+// GetAccountsCmd returns a query command that will display a page of all the
+// accounts.
+func GetAccountsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Short: "Query for all paginated accounts",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all paginated accounts, in address order:
+
+Example:
+$ %s query auth accounts --limit=100
+$ %s query auth accounts --page-key=FNzJ3s7cFzG7Ts2aYSZ7aQaN8Dw= --limit=100
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pageReq, err := client.ReadPageRequest()
+			if err != nil {
+				return err
+			}
+
+			bz, err := authclient.Codec.MarshalJSON(types.NewQueryAccountsParams(pageReq))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccounts)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var accounts types.QueryAccountsResult
+			if err := authclient.Codec.UnmarshalJSON(res, &accounts); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(accounts)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "accounts")
+	return flags.GetCommands(cmd)[0]
+}
+
 // QueryTxsByEventsCmd returns a command to search through transactions by events.
 func QueryTxsByEventsCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
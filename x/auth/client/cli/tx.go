@@ -1,10 +1,19 @@
 package cli
 
 import (
+	"bufio"
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -21,5 +30,46 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetMultiSignCommand(cdc),
 		GetSignCommand(cdc),
 	)
+	txCmd.AddCommand(flags.PostCommands(
+		GetCmdRotatePubKey(cdc),
+	)...)
 	return txCmd
 }
+
+// GetCmdRotatePubKey returns a CLI command handler for creating a
+// MsgRotatePubKey transaction.
+func GetCmdRotatePubKey(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate-pubkey [new_pubkey]",
+		Short: "Replace the public key of the signer account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the public key of the signer account with a new one, given in bech32. The
+address of the account is kept, and the transactions of the account must then be signed with the
+key of the new public key.
+
+Example:
+$ %s tx %s rotate-pubkey cosmospub1addwnpep.. --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := types.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			newPubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRotatePubKey(cliCtx.GetFromAddress(), newPubKey)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -8,11 +8,16 @@ import (
 
 // REST query and parameter values
 const (
-	MethodGet = "GET"
+	MethodGet  = "GET"
+	MethodPost = "POST"
 )
 
 // RegisterRoutes registers the auth module REST routes.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
+	r.HandleFunc(
+		"/auth/accounts", QueryAccountsRequestHandlerFn(cliCtx),
+	).Methods(MethodGet)
+
 	r.HandleFunc(
 		"/auth/accounts/{address}", QueryAccountRequestHandlerFn(storeName, cliCtx),
 	).Methods(MethodGet)
@@ -21,6 +26,10 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		"/auth/params",
 		queryParamsHandler(cliCtx),
 	).Methods(MethodGet)
+
+	r.HandleFunc(
+		"/auth/accounts/{address}/pub_key", RotatePubKeyRequestHandlerFn(cliCtx),
+	).Methods(MethodPost)
 }
 
 // RegisterTxRoutes registers all transaction routes on the provided router.
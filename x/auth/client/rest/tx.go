@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RotatePubKeyReq defines the properties of a rotate pubkey request's body.
+type RotatePubKeyReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	NewPubKey string       `json:"new_pub_key" yaml:"new_pub_key"`
+}
+
+// RotatePubKeyRequestHandlerFn returns a handler generating a MsgRotatePubKey
+// transaction replacing the pubkey of the account given by the path with the
+// bech32 pubkey of the request.
+func RotatePubKeyRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		var req RotatePubKeyReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if !fromAddr.Equals(addr) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own account address")
+			return
+		}
+
+		newPubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, req.NewPubKey)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgRotatePubKey(addr, newPubKey)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		client.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
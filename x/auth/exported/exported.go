@@ -9,7 +9,9 @@ import (
 // Account is an interface used to store coins at a given address within state.
 // It presumes a notion of sequence numbers for replay protection,
 // a notion of account numbers for replay protection for previously pruned accounts,
-// and a pubkey for authentication purposes.
+// and a pubkey for authentication purposes, which may differ from the key the
+// address was derived from once it has been rotated. Account types may supply
+// their own authentication logic by implementing Authenticator.
 //
 // Many complex conditions can be used in the concrete struct which implements Account.
 type Account interface {
@@ -29,6 +31,19 @@ type Account interface {
 	String() string
 }
 
+// Authenticator defines an interface account types can implement to supply
+// their own authentication logic, such as multisig key rotation, session keys
+// or keys of other algorithms. The ante handler calls it instead of checking
+// that the public key set on a transaction matches the account and verifying
+// the signature against the public key of the account.
+type Authenticator interface {
+	// Authenticate verifies a signature of the account over the sign bytes,
+	// given along with the public key set on the transaction, if any, and
+	// consumes the gas of the verification. When simulating, the signature is
+	// not verified and only the gas is consumed.
+	Authenticate(ctx sdk.Context, pubKey crypto.PubKey, signBytes, sig []byte, simulate bool) error
+}
+
 // GenesisAccounts defines a slice of GenesisAccount objects
 type GenesisAccounts []GenesisAccount
 
@@ -0,0 +1,26 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewHandler returns a handler for "auth" type messages. It is kept for the
+// legacy route of the module and dispatches to the auth Msg service.
+func NewHandler(ak AccountKeeper) sdk.Handler {
+	msgServer := NewMsgServerImpl(ak)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgRotatePubKey:
+			res, err := msgServer.RotatePubKey(sdk.WrapSDKContext(ctx), &msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -47,6 +49,27 @@ func (ak AccountKeeper) GetAllAccounts(ctx sdk.Context) (accounts []exported.Acc
 	return accounts
 }
 
+// GetAccountsPaginated returns a page of the accounts in the accountKeeper, in
+// address order.
+func (ak AccountKeeper) GetAccountsPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]exported.Account, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(ak.key), types.AddressStoreKeyPrefix)
+
+	var accounts []exported.Account
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		account, err := ak.cdc.UnmarshalAccount(value)
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, account)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, pageRes, nil
+}
+
 // SetAccount implements sdk.AccountKeeper.
 func (ak AccountKeeper) SetAccount(ctx sdk.Context, acc exported.Account) {
 	addr := acc.GetAddress()
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type queryServer struct {
+	k AccountKeeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the auth gRPC query service
+// backed by the given AccountKeeper.
+func NewQueryServer(k AccountKeeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Account implements the Query/Account gRPC method. The account is encoded with
+// the Codec of the AccountKeeper.
+func (q queryServer) Account(goCtx context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
+	if req.Address.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account := q.k.GetAccount(ctx, req.Address)
+	if account == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", req.Address)
+	}
+
+	bz, err := q.k.cdc.MarshalAccount(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountResponse{Account: bz}, nil
+}
+
+// Accounts implements the Query/Accounts gRPC method. The accounts are encoded
+// with the Codec of the AccountKeeper.
+func (q queryServer) Accounts(goCtx context.Context, req *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accounts, pageRes, err := q.k.GetAccountsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	res := &types.QueryAccountsResponse{Accounts: make([][]byte, len(accounts)), Pagination: pageRes}
+	for i, account := range accounts {
+		bz, err := q.k.cdc.MarshalAccount(account)
+		if err != nil {
+			return nil, err
+		}
+		res.Accounts[i] = bz
+	}
+
+	return res, nil
+}
+
+// Params implements the Query/Params gRPC method.
+func (q queryServer) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: q.k.GetParams(ctx)}, nil
+}
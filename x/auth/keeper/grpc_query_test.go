@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codecstd "github.com/cosmos/cosmos-sdk/codec/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keep "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestQueryServerAccount(t *testing.T) {
+	app, ctx := createTestApp(true)
+	appCodec := codecstd.NewAppCodec(app.Codec())
+	queryServer := keep.NewQueryServer(app.AccountKeeper)
+
+	_, err := queryServer.Account(sdk.WrapSDKContext(ctx), &types.QueryAccountRequest{})
+	require.Error(t, err)
+
+	_, _, addr := types.KeyTestPubAddr()
+	req := &types.QueryAccountRequest{Address: addr}
+	_, err = queryServer.Account(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err)
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	res, err := queryServer.Account(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+
+	account, err := appCodec.UnmarshalAccount(res.Account)
+	require.NoError(t, err)
+	require.Equal(t, acc, account)
+}
+
+func TestQueryServerAccounts(t *testing.T) {
+	app, ctx := createTestApp(true)
+	appCodec := codecstd.NewAppCodec(app.Codec())
+	queryServer := keep.NewQueryServer(app.AccountKeeper)
+
+	res, err := queryServer.Accounts(sdk.WrapSDKContext(ctx), &types.QueryAccountsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Accounts)
+
+	_, _, addr := types.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	res, err = queryServer.Accounts(sdk.WrapSDKContext(ctx), &types.QueryAccountsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, uint64(1), res.Pagination.Total)
+
+	account, err := appCodec.UnmarshalAccount(res.Accounts[0])
+	require.NoError(t, err)
+	require.Equal(t, acc, account)
+}
+
+func TestQueryServerParams(t *testing.T) {
+	app, ctx := createTestApp(true)
+	queryServer := keep.NewQueryServer(app.AccountKeeper)
+
+	res, err := queryServer.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, app.AccountKeeper.GetParams(ctx), res.Params)
+}
@@ -5,8 +5,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 func TestAccountMapperGetSet(t *testing.T) {
@@ -81,3 +83,23 @@ func TestGetSetParams(t *testing.T) {
 	actualParams := app.AccountKeeper.GetParams(ctx)
 	require.Equal(t, params, actualParams)
 }
+
+func TestMigrateSigVerifyCostSecp256r1(t *testing.T) {
+	app, ctx := createTestApp(true)
+	params := types.DefaultParams()
+	params.SigVerifyCostSecp256r1 = 1500
+	app.AccountKeeper.SetParams(ctx, params)
+
+	// remove the parameter as in the params of a chain which predate it
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.DefaultParamspace+"/"))
+	store.Delete(types.KeySigVerifyCostSecp256r1)
+	require.Panics(t, func() { app.AccountKeeper.GetParams(ctx) })
+
+	app.AccountKeeper.MigrateSigVerifyCostSecp256r1(ctx)
+	require.Equal(t, types.DefaultSigVerifyCostSecp256r1, app.AccountKeeper.GetParams(ctx).SigVerifyCostSecp256r1)
+
+	// migrating again leaves the parameter untouched
+	app.AccountKeeper.SetParams(ctx, params)
+	app.AccountKeeper.MigrateSigVerifyCostSecp256r1(ctx)
+	require.Equal(t, params, app.AccountKeeper.GetParams(ctx))
+}
@@ -15,3 +15,14 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	ak.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// MigrateSigVerifyCostSecp256r1 sets the SigVerifyCostSecp256r1 parameter to
+// its default on a chain whose params predate it. It is meant to be called from
+// an x/upgrade handler, and does nothing if the parameter is already set.
+func (ak AccountKeeper) MigrateSigVerifyCostSecp256r1(ctx sdk.Context) {
+	if ak.paramSubspace.Has(ctx, types.KeySigVerifyCostSecp256r1) {
+		return
+	}
+
+	ak.paramSubspace.Set(ctx, types.KeySigVerifyCostSecp256r1, types.DefaultSigVerifyCostSecp256r1)
+}
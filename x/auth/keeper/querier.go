@@ -16,6 +16,9 @@ func NewQuerier(k AccountKeeper) sdk.Querier {
 		case types.QueryAccount:
 			return queryAccount(ctx, req, k)
 
+		case types.QueryAccounts:
+			return queryAccounts(ctx, req, k)
+
 		case types.QueryParams:
 			return queryParams(ctx, k)
 
@@ -44,6 +47,25 @@ func queryAccount(ctx sdk.Context, req abci.RequestQuery, k AccountKeeper) ([]by
 	return bz, nil
 }
 
+func queryAccounts(ctx sdk.Context, req abci.RequestQuery, k AccountKeeper) ([]byte, error) {
+	var params types.QueryAccountsParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	accounts, pageRes, err := k.GetAccountsPaginated(ctx, params.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, types.NewQueryAccountsResult(accounts, pageRes))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryParams(ctx sdk.Context, k AccountKeeper) ([]byte, error) {
 	params := k.GetParams(ctx)
 
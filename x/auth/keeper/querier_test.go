@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
@@ -8,6 +9,8 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	keep "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -61,3 +64,41 @@ func TestQueryAccount(t *testing.T) {
 	err2 := cdc.UnmarshalJSON(res, &account)
 	require.Nil(t, err2)
 }
+
+func TestQueryAccounts(t *testing.T) {
+	app, ctx := createTestApp(true)
+	cdc := app.Codec()
+	querier := keep.NewQuerier(app.AccountKeeper)
+
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(bytes.Repeat([]byte{byte(i + 1)}, sdk.AddrLen))
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrs[i]))
+	}
+
+	queryAccounts := func(pagination *query.PageRequest) types.QueryAccountsResult {
+		req := abci.RequestQuery{
+			Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccounts),
+			Data: cdc.MustMarshalJSON(types.NewQueryAccountsParams(pagination)),
+		}
+		bz, err := querier(ctx, []string{types.QueryAccounts}, req)
+		require.NoError(t, err)
+
+		var res types.QueryAccountsResult
+		require.NoError(t, cdc.UnmarshalJSON(bz, &res))
+		return res
+	}
+
+	// the accounts are returned in address order
+	res := queryAccounts(&query.PageRequest{Limit: 2, CountTotal: true})
+	require.Len(t, res.Accounts, 2)
+	require.Equal(t, addrs[0], res.Accounts[0].GetAddress())
+	require.Equal(t, addrs[1], res.Accounts[1].GetAddress())
+	require.Equal(t, addrs[2].Bytes(), res.Pagination.NextKey)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res = queryAccounts(&query.PageRequest{Key: res.Pagination.NextKey, Limit: 2})
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, addrs[2], res.Accounts[0].GetAddress())
+	require.Nil(t, res.Pagination.NextKey)
+}
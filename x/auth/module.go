@@ -98,10 +98,14 @@ func (AppModule) Name() string {
 func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
 // Route returns the message routing key for the auth module.
-func (AppModule) Route() string { return "" }
+func (AppModule) Route() string {
+	return RouterKey
+}
 
 // NewHandler returns an sdk.Handler for the auth module.
-func (AppModule) NewHandler() sdk.Handler { return nil }
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.accountKeeper)
+}
 
 // QuerierRoute returns the auth module's querier route name.
 func (AppModule) QuerierRoute() string {
@@ -113,6 +117,16 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.accountKeeper)
 }
 
+// RegisterQueryService registers the gRPC query service of the auth module.
+func (am AppModule) RegisterQueryService(server sdk.GRPCServer) {
+	RegisterQueryService(server, NewQueryServer(am.accountKeeper))
+}
+
+// RegisterMsgService registers the Msg service of the auth module.
+func (am AppModule) RegisterMsgService(server sdk.GRPCServer) {
+	RegisterMsgService(server, NewMsgServerImpl(am.accountKeeper))
+}
+
 // InitGenesis performs genesis initialization for the auth module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
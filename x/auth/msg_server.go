@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type msgServer struct {
+	ak AccountKeeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the auth Msg service backed by
+// the given account keeper.
+func NewMsgServerImpl(ak AccountKeeper) types.MsgServer {
+	return msgServer{ak: ak}
+}
+
+// RotatePubKey implements the Msg/RotatePubKey method. The new pubkey replaces
+// the pubkey of the account, which the signatures of its next transactions are
+// verified against, while its address is kept.
+func (s msgServer) RotatePubKey(goCtx context.Context, msg *types.MsgRotatePubKey) (*types.MsgRotatePubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acc := s.ak.GetAccount(ctx, msg.Address)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	newPubKey, err := msg.GetNewPubKey()
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	if err := acc.SetPubKey(newPubKey); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	s.ak.SetAccount(ctx, acc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	)
+
+	return &types.MsgRotatePubKeyResponse{}, nil
+}
@@ -0,0 +1,68 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type MsgServerTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+}
+
+func (suite *MsgServerTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	suite.msgServer = auth.NewMsgServerImpl(suite.app.AccountKeeper)
+}
+
+func (suite *MsgServerTestSuite) TestRotatePubKey() {
+	app, ctx := suite.app, suite.ctx
+	_, pubKey, addr := types.KeyTestPubAddr()
+	_, newPubKey, _ := types.KeyTestPubAddr()
+
+	// the account must exist
+	msg := types.NewMsgRotatePubKey(addr, newPubKey)
+	_, err := suite.msgServer.RotatePubKey(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().Error(err)
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(pubKey))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	_, err = suite.msgServer.RotatePubKey(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+
+	acc = app.AccountKeeper.GetAccount(ctx, addr)
+	suite.Require().True(newPubKey.Equals(acc.GetPubKey()))
+	suite.Require().Equal(addr, acc.GetAddress())
+	suite.Require().NoError(acc.(*types.BaseAccount).Validate())
+}
+
+func (suite *MsgServerTestSuite) TestHandler() {
+	_, _, addr := types.KeyTestPubAddr()
+	_, newPubKey, _ := types.KeyTestPubAddr()
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr))
+
+	handler := auth.NewHandler(suite.app.AccountKeeper)
+	res, err := handler(suite.ctx, types.NewMsgRotatePubKey(addr, newPubKey))
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(res.Events)
+
+	_, err = handler(suite.ctx, sdk.NewTestMsg(addr))
+	suite.Require().Error(err)
+}
+
+func TestMsgServerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgServerTestSuite))
+}
@@ -0,0 +1,41 @@
+package signing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ SignModeHandler = DirectHandler{}
+
+// DirectHandler is the SignModeHandler of SignModeDirect, which signs over the
+// protobuf encoded body and auth info of a transaction.
+type DirectHandler struct{}
+
+// DefaultMode implements the SignModeHandler interface.
+func (DirectHandler) DefaultMode() types.SignMode {
+	return types.SignModeDirect
+}
+
+// Modes implements the SignModeHandler interface.
+func (DirectHandler) Modes() []types.SignMode {
+	return []types.SignMode{types.SignModeDirect}
+}
+
+// GetSignBytes implements the SignModeHandler interface, returning the encoded
+// DirectSignDoc of the transaction.
+func (DirectHandler) GetSignBytes(mode types.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != types.SignModeDirect {
+		return nil, sdkerrors.Wrapf(types.ErrorUnsupportedSignMode, "expected %s, got %s", types.SignModeDirect, mode)
+	}
+
+	sigTx, ok := tx.(Tx)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "invalid transaction type %T", tx)
+	}
+
+	return types.DirectSignBytes(
+		data.ChainID, data.AccountNumber, data.Sequence,
+		sigTx.GetStdFee(), sigTx.GetMsgs(), sigTx.GetMemo(), sigTx.GetStdSignatures(),
+	)
+}
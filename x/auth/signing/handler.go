@@ -0,0 +1,67 @@
+package signing
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SignModeHandler defines the interface of a handler of one or several sign
+// modes, which returns the bytes a signer signs over in a given mode.
+type SignModeHandler interface {
+	// DefaultMode returns the mode clients sign in when none is requested.
+	DefaultMode() types.SignMode
+
+	// Modes returns the modes supported by the handler.
+	Modes() []types.SignMode
+
+	// GetSignBytes returns the bytes to sign over for the given mode, signer
+	// data and transaction.
+	GetSignBytes(mode types.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
+}
+
+// SignModeHandlerWithContext defines a SignModeHandler whose sign bytes depend
+// on data which is not part of the transaction, such as the denomination
+// metadata coins are rendered with in SignModeTextual, which it reads from the
+// given context. The sdk.Context of the chain is wrapped in the context when
+// verifying signatures.
+type SignModeHandlerWithContext interface {
+	SignModeHandler
+
+	// GetSignBytesWithContext returns the bytes to sign over for the given
+	// mode, signer data and transaction, reading any other data from the
+	// context.
+	GetSignBytesWithContext(ctx context.Context, mode types.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
+}
+
+// GetSignBytesWithContext returns the sign bytes of the handler, passing it the
+// context if it is a SignModeHandlerWithContext.
+func GetSignBytesWithContext(
+	ctx context.Context, handler SignModeHandler, mode types.SignMode, data SignerData, tx sdk.Tx,
+) ([]byte, error) {
+
+	if handler, ok := handler.(SignModeHandlerWithContext); ok {
+		return handler.GetSignBytesWithContext(ctx, mode, data, tx)
+	}
+
+	return handler.GetSignBytes(mode, data, tx)
+}
+
+// SignerData defines the data of a signer, besides the transaction itself,
+// which its signature is over.
+type SignerData struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+}
+
+// Tx defines a transaction which can be signed over by the handlers of this
+// package.
+type Tx interface {
+	sdk.Tx
+
+	GetMemo() string
+	GetStdFee() types.StdFee
+	GetStdSignatures() []types.StdSignature
+}
@@ -0,0 +1,95 @@
+package signing
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ SignModeHandlerWithContext = (*HandlerMap)(nil)
+
+// HandlerMap is a SignModeHandler which dispatches each mode to the handler
+// supporting it, acting as the registry of the sign modes of an app.
+type HandlerMap struct {
+	defaultMode types.SignMode
+	modes       []types.SignMode
+	handlers    map[types.SignMode]SignModeHandler
+}
+
+// NewHandlerMap returns a HandlerMap of the given handlers, clients signing in
+// the given default mode when none is requested. It panics if several handlers
+// support the same mode or if none supports the default mode.
+func NewHandlerMap(defaultMode types.SignMode, handlers ...SignModeHandler) *HandlerMap {
+	h := &HandlerMap{
+		defaultMode: defaultMode,
+		handlers:    make(map[types.SignMode]SignModeHandler),
+	}
+
+	for _, handler := range handlers {
+		for _, mode := range handler.Modes() {
+			if _, ok := h.handlers[mode]; ok {
+				panic(fmt.Sprintf("duplicate handler for sign mode %s", mode))
+			}
+
+			h.handlers[mode] = handler
+			h.modes = append(h.modes, mode)
+		}
+	}
+
+	if _, ok := h.handlers[defaultMode]; !ok {
+		panic(fmt.Sprintf("no handler for the default sign mode %s", defaultMode))
+	}
+
+	return h
+}
+
+// DefaultSignModeHandler returns the HandlerMap of the handlers of this
+// package, clients signing in SignModeLegacyAminoJSON by default.
+func DefaultSignModeHandler() *HandlerMap {
+	return NewHandlerMap(types.SignModeLegacyAminoJSON, DefaultSignModeHandlers()...)
+}
+
+// DefaultSignModeHandlers returns the handlers of this package, for apps to
+// extend with their own handlers.
+func DefaultSignModeHandlers() []SignModeHandler {
+	return []SignModeHandler{DirectHandler{}, LegacyAminoJSONHandler{}}
+}
+
+// DefaultMode implements the SignModeHandler interface.
+func (h *HandlerMap) DefaultMode() types.SignMode {
+	return h.defaultMode
+}
+
+// Modes implements the SignModeHandler interface.
+func (h *HandlerMap) Modes() []types.SignMode {
+	return h.modes
+}
+
+// GetSignBytes implements the SignModeHandler interface, returning the sign
+// bytes of the handler supporting the mode.
+func (h *HandlerMap) GetSignBytes(mode types.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	handler, ok := h.handlers[mode]
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrorUnsupportedSignMode, "%s", mode)
+	}
+
+	return handler.GetSignBytes(mode, data, tx)
+}
+
+// GetSignBytesWithContext implements the SignModeHandlerWithContext interface,
+// returning the sign bytes of the handler supporting the mode, which is passed
+// the context if it is a SignModeHandlerWithContext.
+func (h *HandlerMap) GetSignBytesWithContext(
+	ctx context.Context, mode types.SignMode, data SignerData, tx sdk.Tx,
+) ([]byte, error) {
+
+	handler, ok := h.handlers[mode]
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrorUnsupportedSignMode, "%s", mode)
+	}
+
+	return GetSignBytesWithContext(ctx, handler, mode, data, tx)
+}
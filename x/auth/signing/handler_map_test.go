@@ -0,0 +1,94 @@
+package signing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestHandlerMap(t *testing.T) {
+	require.Panics(t, func() {
+		signing.NewHandlerMap(types.SignModeLegacyAminoJSON, signing.LegacyAminoJSONHandler{}, signing.LegacyAminoJSONHandler{})
+	})
+	require.Panics(t, func() {
+		signing.NewHandlerMap(types.SignModeDirect, signing.LegacyAminoJSONHandler{})
+	})
+
+	handler := signing.DefaultSignModeHandler()
+	require.Equal(t, types.SignModeLegacyAminoJSON, handler.DefaultMode())
+	require.Equal(t, []types.SignMode{types.SignModeDirect, types.SignModeLegacyAminoJSON}, handler.Modes())
+
+	_, _, from := types.KeyTestPubAddr()
+	_, pub, to := types.KeyTestPubAddr()
+	msgs := []sdk.Msg{bank.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))}
+	fee := types.NewTestStdFee()
+	sigs := []types.StdSignature{{PubKey: pub.Bytes(), SignMode: types.SignModeDirect}}
+	tx := types.NewStdTx(msgs, fee, sigs, "memo")
+	data := signing.SignerData{ChainID: "test-chain", AccountNumber: 3, Sequence: 7}
+
+	bz, err := handler.GetSignBytes(types.SignModeLegacyAminoJSON, data, tx)
+	require.NoError(t, err)
+	require.Equal(t, types.StdSignBytes("test-chain", 3, 7, fee, msgs, "memo"), bz)
+
+	bz, err = handler.GetSignBytes(types.SignModeDirect, data, tx)
+	require.NoError(t, err)
+	expected, err := types.DirectSignBytes("test-chain", 3, 7, fee, msgs, "memo", sigs)
+	require.NoError(t, err)
+	require.Equal(t, expected, bz)
+
+	// the sign modes of the signatures are signed over in SIGN_MODE_DIRECT
+	tx.Signatures[0].SignMode = types.SignModeLegacyAminoJSON
+	bz2, err := handler.GetSignBytes(types.SignModeDirect, data, tx)
+	require.NoError(t, err)
+	require.NotEqual(t, bz, bz2)
+
+	_, err = handler.GetSignBytes(types.SignModeTextual, data, tx)
+	require.True(t, types.ErrorUnsupportedSignMode.Is(err))
+}
+
+func TestDirectHandler(t *testing.T) {
+	_, pub, addr := types.KeyTestPubAddr()
+	data := signing.SignerData{ChainID: "test-chain"}
+	sigs := []types.StdSignature{{PubKey: pub.Bytes(), SignMode: types.SignModeDirect}}
+
+	// the messages must be protobuf messages
+	tx := types.NewStdTx([]sdk.Msg{types.NewTestMsg(addr)}, types.NewTestStdFee(), sigs, "")
+	_, err := signing.DirectHandler{}.GetSignBytes(types.SignModeDirect, data, tx)
+	require.Error(t, err)
+
+	tx = types.NewStdTx([]sdk.Msg{bank.NewMsgSend(addr, addr, nil)}, types.NewTestStdFee(), sigs, "")
+	_, err = signing.DirectHandler{}.GetSignBytes(types.SignModeLegacyAminoJSON, data, tx)
+	require.Error(t, err)
+
+	bz, err := signing.DirectHandler{}.GetSignBytes(types.SignModeDirect, data, tx)
+	require.NoError(t, err)
+
+	var signDoc types.DirectSignDoc
+	require.NoError(t, signDoc.Unmarshal(bz))
+	require.Equal(t, "test-chain", signDoc.ChainID)
+
+	var body types.TxBody
+	require.NoError(t, body.Unmarshal(signDoc.BodyBytes))
+	require.Len(t, body.Msgs, 1)
+	require.Equal(t, "/cosmos_sdk.x.bank.v1.MsgSend", body.Msgs[0].TypeURL)
+
+	// the signer infos of all the signers must be set
+	_, _, addr2 := types.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	msg := bank.NewMsgMultiSend(
+		[]bank.Input{bank.NewInput(addr, coins), bank.NewInput(addr2, coins)},
+		[]bank.Output{bank.NewOutput(addr, coins.Add(coins...))},
+	)
+	tx = types.NewStdTx([]sdk.Msg{msg}, types.NewTestStdFee(), sigs, "")
+	_, err = signing.DirectHandler{}.GetSignBytes(types.SignModeDirect, data, tx)
+	require.Error(t, err)
+
+	tx = types.NewStdTx([]sdk.Msg{bank.NewMsgSend(addr, addr, nil)}, types.NewTestStdFee(), []types.StdSignature{{}}, "")
+	_, err = signing.DirectHandler{}.GetSignBytes(types.SignModeDirect, data, tx)
+	require.Error(t, err)
+}
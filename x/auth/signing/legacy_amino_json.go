@@ -0,0 +1,43 @@
+package signing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ SignModeHandler = LegacyAminoJSONHandler{}
+
+// LegacyAminoJSONHandler is the SignModeHandler of SignModeLegacyAminoJSON,
+// which signs over the sorted amino JSON encoding of the StdSignDoc of a
+// transaction.
+type LegacyAminoJSONHandler struct{}
+
+// DefaultMode implements the SignModeHandler interface.
+func (LegacyAminoJSONHandler) DefaultMode() types.SignMode {
+	return types.SignModeLegacyAminoJSON
+}
+
+// Modes implements the SignModeHandler interface.
+func (LegacyAminoJSONHandler) Modes() []types.SignMode {
+	return []types.SignMode{types.SignModeLegacyAminoJSON}
+}
+
+// GetSignBytes implements the SignModeHandler interface, returning the
+// StdSignBytes of the transaction.
+func (LegacyAminoJSONHandler) GetSignBytes(mode types.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != types.SignModeLegacyAminoJSON {
+		return nil, sdkerrors.Wrapf(
+			types.ErrorUnsupportedSignMode, "expected %s, got %s", types.SignModeLegacyAminoJSON, mode,
+		)
+	}
+
+	sigTx, ok := tx.(Tx)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "invalid transaction type %T", tx)
+	}
+
+	return types.StdSignBytes(
+		data.ChainID, data.AccountNumber, data.Sequence, sigTx.GetStdFee(), sigTx.GetMsgs(), sigTx.GetMemo(),
+	), nil
+}
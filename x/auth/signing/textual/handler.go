@@ -0,0 +1,123 @@
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ signing.SignModeHandlerWithContext = SignModeHandler{}
+
+// SignModeHandler is the SignModeHandler of SignModeTextual, which signs over
+// the screens of a human-readable rendering of a transaction, as a hardware
+// wallet shows them, encoded as a TextualSignDoc. The values of the messages
+// and fee are rendered by the renderers of the Registry.
+type SignModeHandler struct {
+	registry *Registry
+}
+
+// NewSignModeHandler returns the SignModeHandler of SignModeTextual rendering
+// the values with the given Registry.
+func NewSignModeHandler(registry *Registry) SignModeHandler {
+	return SignModeHandler{registry: registry}
+}
+
+// DefaultMode implements the SignModeHandler interface.
+func (SignModeHandler) DefaultMode() types.SignMode {
+	return types.SignModeTextual
+}
+
+// Modes implements the SignModeHandler interface.
+func (SignModeHandler) Modes() []types.SignMode {
+	return []types.SignMode{types.SignModeTextual}
+}
+
+// GetSignBytes implements the SignModeHandler interface, rendering the values
+// with an empty context.
+func (h SignModeHandler) GetSignBytes(mode types.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	return h.GetSignBytesWithContext(context.Background(), mode, data, tx)
+}
+
+// GetSignBytesWithContext implements the SignModeHandlerWithContext interface,
+// returning the encoded TextualSignDoc of the screens of the transaction.
+func (h SignModeHandler) GetSignBytesWithContext(
+	ctx context.Context, mode types.SignMode, data signing.SignerData, tx sdk.Tx,
+) ([]byte, error) {
+
+	if mode != types.SignModeTextual {
+		return nil, sdkerrors.Wrapf(types.ErrorUnsupportedSignMode, "expected %s, got %s", types.SignModeTextual, mode)
+	}
+
+	sigTx, ok := tx.(signing.Tx)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "invalid transaction type %T", tx)
+	}
+
+	screens, err := h.Screens(ctx, data, sigTx)
+	if err != nil {
+		return nil, err
+	}
+
+	signDoc := types.TextualSignDoc{Screens: screens}
+	return signDoc.Marshal()
+}
+
+// Screens returns the screens of the rendering of a transaction: the signer
+// data, the messages, the memo and the fee. The gas limit and the hash of the
+// StdSignBytes of the transaction, which makes the signature cover any data
+// the rendering omits, are shown in expert mode only.
+func (h SignModeHandler) Screens(ctx context.Context, data signing.SignerData, tx signing.Tx) ([]types.TextualScreen, error) {
+	msgs := tx.GetMsgs()
+	fee := tx.GetStdFee()
+
+	screens := []types.TextualScreen{
+		{Title: "Chain id", Content: data.ChainID},
+		{Title: "Account number", Content: strconv.FormatUint(data.AccountNumber, 10)},
+		{Title: "Sequence", Content: strconv.FormatUint(data.Sequence, 10)},
+		{Content: fmt.Sprintf("This transaction has %s", pluralize(len(msgs), "message"))},
+	}
+
+	for i, msg := range msgs {
+		msgScreens, err := h.registry.Render(ctx, fmt.Sprintf("Message (%d/%d)", i+1, len(msgs)), msg, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		screens = append(screens, msgScreens...)
+	}
+
+	screens = append(screens, types.TextualScreen{Content: "End of messages"})
+
+	if memo := tx.GetMemo(); memo != "" {
+		screens = append(screens, types.TextualScreen{Title: "Memo", Content: memo})
+	}
+
+	feeScreens, err := h.registry.Render(ctx, "Fees", fee.Amount, 0)
+	if err != nil {
+		return nil, err
+	}
+	screens = append(screens, feeScreens...)
+
+	if !fee.Granter.Empty() {
+		granterScreens, err := h.registry.Render(ctx, "Fee granter", fee.Granter, 0)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, granterScreens...)
+	}
+
+	hash := sha256.Sum256(types.StdSignBytes(
+		data.ChainID, data.AccountNumber, data.Sequence, fee, msgs, tx.GetMemo(),
+	))
+
+	return append(screens,
+		types.TextualScreen{Title: "Gas limit", Content: strconv.FormatUint(fee.Gas, 10), Expert: true},
+		types.TextualScreen{Title: "Hash of raw bytes", Content: fmt.Sprintf("%X", hash), Expert: true},
+	), nil
+}
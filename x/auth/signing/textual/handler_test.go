@@ -0,0 +1,147 @@
+package textual_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+func TestSignModeHandler(t *testing.T) {
+	from := sdk.AccAddress([]byte("from________________"))
+	to := sdk.AccAddress([]byte("to__________________"))
+	msgs := []sdk.Msg{
+		bank.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000))),
+		gov.NewMsgVote(from, 5, gov.OptionYes),
+	}
+	fee := types.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000)))
+	tx := types.NewStdTx(msgs, fee, nil, "memo")
+	data := signing.SignerData{ChainID: "test-chain", AccountNumber: 3, Sequence: 7}
+
+	registry := textual.NewRegistry()
+	bank.RegisterTextualRenderers(registry, func(context.Context, string) (bank.Metadata, bool, error) {
+		return bank.NewMetadata("", "uatom", "atom", bank.NewDenomUnit("uatom", 0), bank.NewDenomUnit("atom", 6)), true, nil
+	})
+	gov.RegisterTextualRenderers(registry)
+	handler := textual.NewSignModeHandler(registry)
+
+	screens, err := handler.Screens(context.Background(), data, tx)
+	require.NoError(t, err)
+
+	hash := screens[len(screens)-1]
+	require.Equal(t, "Hash of raw bytes", hash.Title)
+	require.True(t, hash.Expert)
+	require.Equal(t, []types.TextualScreen{
+		{Title: "Chain id", Content: "test-chain"},
+		{Title: "Account number", Content: "3"},
+		{Title: "Sequence", Content: "7"},
+		{Content: "This transaction has 2 messages"},
+		{Title: "Message (1/2)", Content: "MsgSend"},
+		{Title: "From address", Content: from.String(), Indent: 1},
+		{Title: "To address", Content: to.String(), Indent: 1},
+		{Title: "Amount", Content: "1.5 atom", Indent: 1},
+		{Title: "Message (2/2)", Content: "MsgVote"},
+		{Title: "Proposal id", Content: "5", Indent: 1},
+		{Title: "Voter", Content: from.String(), Indent: 1},
+		{Title: "Option", Content: "Yes", Indent: 1},
+		{Content: "End of messages"},
+		{Title: "Memo", Content: "memo"},
+		{Title: "Fees", Content: "0.002 atom"},
+		{Title: "Gas limit", Content: "200000", Expert: true},
+		hash,
+	}, screens)
+
+	bz, err := handler.GetSignBytes(types.SignModeTextual, data, tx)
+	require.NoError(t, err)
+
+	var signDoc types.TextualSignDoc
+	require.NoError(t, signDoc.Unmarshal(bz))
+	require.Equal(t, screens, signDoc.Screens)
+
+	// the raw bytes of the transaction are signed over in expert mode
+	tx.Fee.Gas++
+	bz2, err := handler.GetSignBytes(types.SignModeTextual, data, tx)
+	require.NoError(t, err)
+	require.NotEqual(t, bz, bz2)
+
+	_, err = handler.GetSignBytes(types.SignModeDirect, data, tx)
+	require.True(t, types.ErrorUnsupportedSignMode.Is(err))
+
+	// the errors of the renderers fail the rendering
+	registry = textual.NewRegistry()
+	bank.RegisterTextualRenderers(registry, func(context.Context, string) (bank.Metadata, bool, error) {
+		return bank.Metadata{}, false, errors.New("query failed")
+	})
+	_, err = textual.NewSignModeHandler(registry).GetSignBytes(types.SignModeTextual, data, tx)
+	require.Error(t, err)
+}
+
+type testValue struct {
+	Embedded
+
+	Name     string `json:"name"`
+	Enabled  bool
+	Data     []byte
+	Elems    []testElem `json:"elems,omitempty"`
+	Optional *testElem
+	Hidden   string `json:"-"`
+	hidden   string
+}
+
+type Embedded struct {
+	Time time.Time `json:"time"`
+}
+
+type testElem struct {
+	Amount sdk.Int `json:"amount"`
+}
+
+func TestRegistry(t *testing.T) {
+	registry := textual.NewRegistry()
+	v := testValue{
+		Embedded: Embedded{Time: time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)},
+		Name:     "test",
+		Enabled:  true,
+		Data:     []byte{0xab, 0x01},
+		Elems:    []testElem{{sdk.NewInt(1)}, {sdk.NewInt(2)}},
+		Hidden:   "hidden",
+		hidden:   "hidden",
+	}
+
+	screens, err := registry.Render(context.Background(), "Value", v, 1)
+	require.NoError(t, err)
+	require.Equal(t, []types.TextualScreen{
+		{Title: "Value", Content: "testValue", Indent: 1},
+		{Title: "Time", Content: "2020-05-01T12:00:00Z", Indent: 2},
+		{Title: "Name", Content: "test", Indent: 2},
+		{Title: "Enabled", Content: "true", Indent: 2},
+		{Title: "Data", Content: "AB01", Indent: 2},
+		{Title: "Elems", Content: "2 elements", Indent: 2},
+		{Title: "Elems (1/2)", Content: "testElem", Indent: 3},
+		{Title: "Amount", Content: "1", Indent: 4},
+		{Title: "Elems (2/2)", Content: "testElem", Indent: 3},
+		{Title: "Amount", Content: "2", Indent: 4},
+		{Title: "Optional", Content: "None", Indent: 2},
+	}, screens)
+
+	// the registered renderers replace the rendering from the kind
+	registry.Register(testElem{}, func(_ context.Context, v interface{}) (string, error) {
+		return fmt.Sprintf("%s units", v.(testElem).Amount), nil
+	})
+	screens, err = registry.Render(context.Background(), "Elem", &testElem{sdk.NewInt(3)}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []types.TextualScreen{{Title: "Elem", Content: "3 units"}}, screens)
+
+	_, err = registry.Render(context.Background(), "Map", map[string]string{}, 0)
+	require.Error(t, err)
+}
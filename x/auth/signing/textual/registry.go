@@ -0,0 +1,230 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ValueRenderer renders a value of the type it is registered for into the
+// content of a single screen. It is given the context the sign bytes are
+// computed with, to read any data the rendering depends on.
+type ValueRenderer func(ctx context.Context, v interface{}) (string, error)
+
+// Registry holds the ValueRenderers of the types which are not rendered from
+// their kind, e.g. the addresses rendered in bech32. Modules register the
+// renderers of their own types, such as the coins rendered with the metadata
+// of their denomination.
+type Registry struct {
+	renderers map[reflect.Type]ValueRenderer
+}
+
+// NewRegistry returns a Registry holding the renderers of the addresses,
+// numbers, coins and times of the types package, which modules may replace.
+func NewRegistry() *Registry {
+	r := &Registry{renderers: make(map[reflect.Type]ValueRenderer)}
+
+	for _, v := range []interface{}{
+		sdk.AccAddress{}, sdk.ValAddress{}, sdk.ConsAddress{},
+		sdk.Int{}, sdk.Uint{}, sdk.Dec{},
+		sdk.Coin{}, sdk.Coins{}, sdk.DecCoin{}, sdk.DecCoins{},
+		time.Duration(0),
+	} {
+		r.Register(v, renderStringer)
+	}
+
+	r.Register(time.Time{}, renderTime)
+
+	return r
+}
+
+// Register registers the renderer of the type of the given value, replacing
+// any renderer previously registered for the type.
+func (r *Registry) Register(v interface{}, renderer ValueRenderer) {
+	r.renderers[reflect.TypeOf(v)] = renderer
+}
+
+// Render renders a value into screens under the given title and indentation.
+// A value of a type with a registered renderer is rendered into a single
+// screen. Otherwise, a struct is rendered into a screen showing its type
+// followed by the screens of its fields, indented under it, and a slice into a
+// screen showing its length followed by the screens of its elements.
+func (r *Registry) Render(ctx context.Context, title string, v interface{}, indent uint32) ([]types.TextualScreen, error) {
+	return r.render(ctx, title, reflect.ValueOf(v), indent)
+}
+
+func (r *Registry) render(ctx context.Context, title string, v reflect.Value, indent uint32) ([]types.TextualScreen, error) {
+	screen := func(content string) []types.TextualScreen {
+		return []types.TextualScreen{{Title: title, Content: content, Indent: indent}}
+	}
+
+	if !v.IsValid() {
+		return screen("None"), nil
+	}
+
+	if renderer, ok := r.renderers[v.Type()]; ok && v.CanInterface() {
+		content, err := renderer(ctx, v.Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		return screen(content), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return screen("None"), nil
+		}
+
+		return r.render(ctx, title, v.Elem(), indent)
+
+	case reflect.Bool:
+		return screen(strconv.FormatBool(v.Bool())), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return screen(strconv.FormatInt(v.Int(), 10)), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return screen(strconv.FormatUint(v.Uint(), 10)), nil
+
+	case reflect.String:
+		return screen(v.String()), nil
+
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, v.Len())
+			for i := range bz {
+				bz[i] = byte(v.Index(i).Uint())
+			}
+
+			return screen(fmt.Sprintf("%X", bz)), nil
+		}
+
+		return r.renderList(ctx, title, v, indent)
+
+	case reflect.Struct:
+		screens := screen(v.Type().Name())
+		fields, err := r.renderFields(ctx, v, indent+1)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(screens, fields...), nil
+
+	default:
+		return nil, fmt.Errorf("cannot render %s of unsupported type %s", title, v.Type())
+	}
+}
+
+// renderList renders the elements of a slice or an array, titled by their
+// position, under a screen showing the number of elements.
+func (r *Registry) renderList(ctx context.Context, title string, v reflect.Value, indent uint32) ([]types.TextualScreen, error) {
+	n := v.Len()
+	if n == 0 {
+		return []types.TextualScreen{{Title: title, Content: "None", Indent: indent}}, nil
+	}
+
+	screens := []types.TextualScreen{{Title: title, Content: pluralize(n, "element"), Indent: indent}}
+	for i := 0; i < n; i++ {
+		elem, err := r.render(ctx, fmt.Sprintf("%s (%d/%d)", title, i+1, n), v.Index(i), indent+1)
+		if err != nil {
+			return nil, err
+		}
+
+		screens = append(screens, elem...)
+	}
+
+	return screens, nil
+}
+
+// renderFields renders the exported fields of a struct, the fields of its
+// embedded structs being rendered as its own fields.
+func (r *Registry) renderFields(ctx context.Context, v reflect.Value, indent uint32) ([]types.TextualScreen, error) {
+	var screens []types.TextualScreen
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.PkgPath != "" || strings.HasPrefix(field.Name, "XXX_") {
+			continue
+		}
+
+		name := field.Name
+		if tag := field.Tag.Get("json"); tag != "" {
+			if tag == "-" {
+				continue
+			}
+			if tagName := strings.Split(tag, ",")[0]; tagName != "" {
+				name = tagName
+			}
+		}
+
+		fieldValue := v.Field(i)
+		if field.Anonymous && fieldValue.Kind() == reflect.Struct {
+			if _, ok := r.renderers[field.Type]; !ok {
+				fields, err := r.renderFields(ctx, fieldValue, indent)
+				if err != nil {
+					return nil, err
+				}
+
+				screens = append(screens, fields...)
+				continue
+			}
+		}
+
+		fieldScreens, err := r.render(ctx, fieldTitle(name), fieldValue, indent)
+		if err != nil {
+			return nil, err
+		}
+
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+// fieldTitle returns the title of the screen of a field from its name, e.g.
+// "From address" for from_address or FromAddress.
+func fieldTitle(name string) string {
+	var words []rune
+	for i, c := range name {
+		switch {
+		case c == '_':
+			c = ' '
+		case i > 0 && unicode.IsUpper(c) && unicode.IsLower(rune(name[i-1])):
+			words = append(words, ' ')
+		}
+
+		words = append(words, unicode.ToLower(c))
+	}
+
+	title := string(words)
+	if title == "" {
+		return title
+	}
+
+	return strings.ToUpper(title[:1]) + title[1:]
+}
+
+func pluralize(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+func renderStringer(_ context.Context, v interface{}) (string, error) {
+	return v.(fmt.Stringer).String(), nil
+}
+
+func renderTime(_ context.Context, v interface{}) (string, error) {
+	return v.(time.Time).UTC().Format(time.RFC3339Nano), nil
+}
@@ -1,9 +1,9 @@
 package types
 
 import (
-	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/tendermint/tendermint/crypto"
 	yaml "gopkg.in/yaml.v2"
@@ -97,11 +97,14 @@ func (acc *BaseAccount) SetSequence(seq uint64) error {
 	return nil
 }
 
-// Validate checks for errors on the account fields
+// Validate checks for errors on the account fields. The pubkey of the account
+// need not be the one its address is derived from, as it may have been rotated.
 func (acc BaseAccount) Validate() error {
-	if len(acc.PubKey) != 0 && acc.Address != nil &&
-		!bytes.Equal(acc.GetPubKey().Address().Bytes(), acc.Address.Bytes()) {
-		return errors.New("account address and pubkey address do not match")
+	if len(acc.PubKey) != 0 {
+		var pk crypto.PubKey
+		if err := amino.UnmarshalBinaryBare(acc.PubKey, &pk); err != nil {
+			return fmt.Errorf("invalid account pubkey: %w", err)
+		}
 	}
 
 	return nil
@@ -93,8 +93,13 @@ func TestGenesisAccountValidate(t *testing.T) {
 			false,
 		},
 		{
-			"invalid base valid account",
+			"valid base account with rotated pubkey",
 			types.NewBaseAccount(addr, secp256k1.GenPrivKey().PubKey(), 0, 0),
+			false,
+		},
+		{
+			"invalid base account pubkey",
+			&types.BaseAccount{Address: addr, PubKey: []byte{0x1}},
 			true,
 		},
 	}
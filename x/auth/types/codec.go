@@ -24,6 +24,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*exported.Account)(nil), nil)
 	cdc.RegisterConcrete(&BaseAccount{}, "cosmos-sdk/Account", nil)
 	cdc.RegisterConcrete(StdTx{}, "cosmos-sdk/StdTx", nil)
+	cdc.RegisterConcrete(MsgRotatePubKey{}, "cosmos-sdk/MsgRotatePubKey", nil)
 }
 
 // RegisterKeyTypeCodec registers an external concrete type defined in
@@ -7,4 +7,5 @@ import (
 var (
 	ErrorInvalidSigner        = sdkerrors.Register(ModuleName, 2, "tx intended signer does not match the given signer")
 	ErrorInvalidGasAdjustment = sdkerrors.Register(ModuleName, 3, "invalid gas adjustment")
+	ErrorUnsupportedSignMode  = sdkerrors.Register(ModuleName, 4, "unsupported sign mode")
 )
@@ -14,8 +14,14 @@ const (
 	// FeeCollectorName the root string for the fee collector account address
 	FeeCollectorName = "fee_collector"
 
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
 	// QuerierRoute is the querier route for auth
 	QuerierRoute = ModuleName
+
+	// AttributeValueCategory defines the module category of the auth events
+	AttributeValueCategory = ModuleName
 )
 
 var (
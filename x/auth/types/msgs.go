@@ -0,0 +1,105 @@
+package types
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// auth message types
+const (
+	TypeMsgRotatePubKey = "rotate_pub_key"
+)
+
+var _ sdk.Msg = MsgRotatePubKey{}
+
+// NewMsgRotatePubKey returns a new MsgRotatePubKey.
+func NewMsgRotatePubKey(addr sdk.AccAddress, newPubKey crypto.PubKey) MsgRotatePubKey {
+	msg := MsgRotatePubKey{Address: addr}
+	if newPubKey != nil {
+		msg.NewPubKey = newPubKey.Bytes()
+	}
+
+	return msg
+}
+
+// Route returns the message route for a MsgRotatePubKey.
+func (msg MsgRotatePubKey) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgRotatePubKey.
+func (msg MsgRotatePubKey) Type() string { return TypeMsgRotatePubKey }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRotatePubKey) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing address")
+	}
+	if len(msg.NewPubKey) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing new pubkey")
+	}
+	pk, err := msg.GetNewPubKey()
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	return validateNewPubKey(pk)
+}
+
+// validateNewPubKey checks that the txs of an account can be signed with a
+// pubkey once rotated to it, i.e. that it is accepted by the
+// DefaultSigVerificationGasConsumer of the ante package: a secp256k1 or
+// secp256r1 pubkey, or a multisig pubkey whose threshold can be met by its
+// accepted pubkeys.
+func validateNewPubKey(pk crypto.PubKey) error {
+	switch pk := pk.(type) {
+	case secp256k1.PubKeySecp256k1, secp256r1.PubKeySecp256r1:
+		return nil
+
+	case multisig.PubKeyMultisigThreshold:
+		accepted := 0
+		for _, subKey := range pk.PubKeys {
+			if validateNewPubKey(subKey) == nil {
+				accepted++
+			}
+		}
+
+		if pk.K == 0 || int(pk.K) > accepted {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidPubKey, "threshold %d of multisig pubkey cannot be met by its %d supported pubkeys", pk.K, accepted,
+			)
+		}
+
+		return nil
+
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unsupported pubkey type: %T", pk)
+	}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgRotatePubKey.
+func (msg MsgRotatePubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgRotatePubKey, which is the
+// account whose pubkey is rotated.
+func (msg MsgRotatePubKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// GetNewPubKey returns the decoded new pubkey of a MsgRotatePubKey.
+func (msg MsgRotatePubKey) GetNewPubKey() (pk crypto.PubKey, err error) {
+	err = amino.UnmarshalBinaryBare(msg.NewPubKey, &pk)
+	return pk, err
+}
+
+// RegisterMsgService registers the Msg service of the module on a gRPC server,
+// such as the Msg service router of BaseApp.
+func RegisterMsgService(server sdk.GRPCServer, srv MsgServer) {
+	server.RegisterService(&_Msg_serviceDesc, srv)
+}
@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMsgRotatePubKeyValidateBasic(t *testing.T) {
+	_, _, addr := types.KeyTestPubAddr()
+	_, newPubKey, _ := types.KeyTestPubAddr()
+	_, otherPubKey, _ := types.KeyTestPubAddr()
+	r1PubKey := secp256r1.GenPrivKey().PubKey()
+	edPubKey := ed25519.GenPrivKey().PubKey()
+
+	testCases := []struct {
+		name   string
+		msg    types.MsgRotatePubKey
+		expErr bool
+	}{
+		{"valid", types.NewMsgRotatePubKey(addr, newPubKey), false},
+		{"valid secp256r1", types.NewMsgRotatePubKey(addr, r1PubKey), false},
+		{"ed25519", types.NewMsgRotatePubKey(addr, edPubKey), true},
+		{
+			"valid multisig",
+			types.NewMsgRotatePubKey(addr, multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{newPubKey, edPubKey, otherPubKey})),
+			false,
+		},
+		{
+			"multisig threshold met by ed25519 pubkeys only",
+			types.NewMsgRotatePubKey(addr, multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{newPubKey, edPubKey})),
+			true,
+		},
+		{
+			"valid nested multisig",
+			types.NewMsgRotatePubKey(addr, multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{
+				newPubKey, multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{otherPubKey, edPubKey}),
+			})),
+			false,
+		},
+		{
+			"multisig threshold of zero",
+			types.NewMsgRotatePubKey(addr, multisig.PubKeyMultisigThreshold{K: 0, PubKeys: []crypto.PubKey{newPubKey}}),
+			true,
+		},
+		{"missing address", types.NewMsgRotatePubKey(nil, newPubKey), true},
+		{"missing new pubkey", types.NewMsgRotatePubKey(addr, nil), true},
+		{"invalid new pubkey", types.MsgRotatePubKey{Address: addr, NewPubKey: []byte{0x1}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRotatePubKey(t *testing.T) {
+	_, _, addr := types.KeyTestPubAddr()
+	_, newPubKey, _ := types.KeyTestPubAddr()
+	msg := types.NewMsgRotatePubKey(addr, newPubKey)
+
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgRotatePubKey, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	require.NotEmpty(t, msg.GetSignBytes())
+
+	pk, err := msg.GetNewPubKey()
+	require.NoError(t, err)
+	require.True(t, newPubKey.Equals(pk))
+}
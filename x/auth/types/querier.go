@@ -2,12 +2,15 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 )
 
 // query endpoints supported by the auth Querier
 const (
-	QueryAccount = "account"
-	QueryParams  = "params"
+	QueryAccount  = "account"
+	QueryAccounts = "accounts"
+	QueryParams   = "params"
 )
 
 // QueryAccountParams defines the params for querying accounts.
@@ -19,3 +22,30 @@ type QueryAccountParams struct {
 func NewQueryAccountParams(addr sdk.AccAddress) QueryAccountParams {
 	return QueryAccountParams{Address: addr}
 }
+
+// QueryAccountsParams defines the params for querying a page of all accounts.
+type QueryAccountsParams struct {
+	Pagination *query.PageRequest `json:"pagination"`
+}
+
+// NewQueryAccountsParams creates a new instance of QueryAccountsParams.
+func NewQueryAccountsParams(pagination *query.PageRequest) QueryAccountsParams {
+	return QueryAccountsParams{Pagination: pagination}
+}
+
+// QueryAccountsResult defines the result of querying a page of all accounts.
+type QueryAccountsResult struct {
+	Accounts   []exported.Account  `json:"accounts" yaml:"accounts"`
+	Pagination *query.PageResponse `json:"pagination" yaml:"pagination"`
+}
+
+// NewQueryAccountsResult creates a new instance of QueryAccountsResult.
+func NewQueryAccountsResult(accounts []exported.Account, pagination *query.PageResponse) QueryAccountsResult {
+	return QueryAccountsResult{Accounts: accounts, Pagination: pagination}
+}
+
+// RegisterQueryService registers the gRPC query service of the module on a
+// gRPC server, such as a *grpc.Server or the gRPC query router of BaseApp.
+func RegisterQueryService(server sdk.GRPCServer, srv QueryServer) {
+	server.RegisterService(&_Query_serviceDesc, srv)
+}
@@ -3,7 +3,9 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/multisig"
 	yaml "gopkg.in/yaml.v2"
@@ -83,12 +85,23 @@ func (ss StdSignature) GetPubKey() (pk crypto.PubKey) {
 	return pk
 }
 
+// GetSignMode returns the mode the signature was produced in. The signatures
+// which do not specify one are SignModeLegacyAminoJSON signatures.
+func (ss StdSignature) GetSignMode() SignMode {
+	if ss.SignMode == SignModeUnspecified {
+		return SignModeLegacyAminoJSON
+	}
+
+	return ss.SignMode
+}
+
 // MarshalYAML returns the YAML representation of the signature.
 func (ss StdSignature) MarshalYAML() (interface{}, error) {
 	var (
-		bz     []byte
-		pubkey string
-		err    error
+		bz       []byte
+		pubkey   string
+		signMode string
+		err      error
 	)
 
 	if ss.PubKey != nil {
@@ -98,12 +111,19 @@ func (ss StdSignature) MarshalYAML() (interface{}, error) {
 		}
 	}
 
+	// the sign mode is omitted for the signatures which do not specify one
+	if ss.SignMode != SignModeUnspecified {
+		signMode = ss.SignMode.String()
+	}
+
 	bz, err = yaml.Marshal(struct {
 		PubKey    string
 		Signature string
+		SignMode  string `yaml:",omitempty"`
 	}{
 		PubKey:    pubkey,
 		Signature: fmt.Sprintf("%X", ss.Signature),
+		SignMode:  signMode,
 	})
 	if err != nil {
 		return nil, err
@@ -253,7 +273,22 @@ func (tx StdTx) GetPubKeys() []crypto.PubKey {
 	return pks
 }
 
-// GetSignBytes returns the signBytes of the tx for a given signer
+// GetStdSignatures returns the signatures of the tx.
+func (tx StdTx) GetStdSignatures() []StdSignature { return tx.Signatures }
+
+// GetSignModes returns the modes the signatures of the tx were produced in, in
+// the order of the signatures.
+func (tx StdTx) GetSignModes() []SignMode {
+	modes := make([]SignMode, len(tx.Signatures))
+	for i, stdSig := range tx.Signatures {
+		modes[i] = stdSig.GetSignMode()
+	}
+
+	return modes
+}
+
+// GetSignBytes returns the SignModeLegacyAminoJSON signBytes of the tx for a
+// given signer.
 func (tx StdTx) GetSignBytes(ctx sdk.Context, acc exported.Account) []byte {
 	genesis := ctx.BlockHeight() == 0
 	chainID := ctx.ChainID()
@@ -273,6 +308,9 @@ func (tx StdTx) GetGas() uint64 { return tx.Fee.Gas }
 // GetFee returns the FeeAmount in StdFee
 func (tx StdTx) GetFee() sdk.Coins { return tx.Fee.Amount }
 
+// GetStdFee returns the StdFee of the tx.
+func (tx StdTx) GetStdFee() StdFee { return tx.Fee }
+
 // FeePayer returns the address that is responsible for paying fee
 // StdTx returns the first signer as the fee payer
 // If no signers for tx, return empty address
@@ -283,6 +321,11 @@ func (tx StdTx) FeePayer() sdk.AccAddress {
 	return sdk.AccAddress{}
 }
 
+// FeeGranter returns the address paying the fee under a fee allowance granted
+// to the fee payer. If no granter is set in the StdFee, it returns nil and the
+// fee is paid by the fee payer.
+func (tx StdTx) FeeGranter() sdk.AccAddress { return tx.Fee.Granter }
+
 // StdSignDoc is replay-prevention structure.
 // It includes the result of msg.GetSignBytes(),
 // as well as the ChainID (prevent cross chain replay)
@@ -320,6 +363,106 @@ func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, ms
 	return sdk.MustSortJSON(bz)
 }
 
+// DirectSignBytes returns the bytes to sign over for a transaction in
+// SignModeDirect, which are the encoded DirectSignDoc of the transaction. The
+// signer infos are derived from the signatures, whose public keys and modes
+// are thus signed over by every signer. They must be set for all the signers
+// before the first of them signs, or the signatures would be over different
+// bytes, and an error is returned otherwise. The messages must be protobuf
+// messages.
+func DirectSignBytes(
+	chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string, sigs []StdSignature,
+) ([]byte, error) {
+	signers := StdTx{Msgs: msgs}.GetSigners()
+	if len(sigs) != len(signers) {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized, "signer infos must be set for all the signers; expected %d, got %d", len(signers), len(sigs),
+		)
+	}
+	for i, sig := range sigs {
+		if len(sig.PubKey) == 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "missing public key of signer %s", signers[i])
+		}
+	}
+
+	body, err := NewTxBody(msgs, memo)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyBz, err := body.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	authInfo := NewAuthInfo(sigs, fee)
+	authInfoBz, err := authInfo.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	signDoc := DirectSignDoc{
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+		ChainID:       chainID,
+		AccountNumber: accnum,
+		Sequence:      sequence,
+	}
+
+	return signDoc.Marshal()
+}
+
+// NewTxBody returns the TxBody of a transaction, encoding each of its messages
+// along with the URL of its protobuf type. An error is returned if a message is
+// not a protobuf message.
+func NewTxBody(msgs []sdk.Msg, memo string) (TxBody, error) {
+	encodedMsgs := make([]EncodedMsg, len(msgs))
+	for i, msg := range msgs {
+		protoMsg, ok := toProtoMsg(msg)
+		if !ok {
+			return TxBody{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "message %T is not a protobuf message", msg)
+		}
+
+		bz, err := protoMsg.Marshal()
+		if err != nil {
+			return TxBody{}, err
+		}
+
+		encodedMsgs[i] = EncodedMsg{TypeURL: "/" + proto.MessageName(protoMsg), Value: bz}
+	}
+
+	return TxBody{Msgs: encodedMsgs, Memo: memo}, nil
+}
+
+// toProtoMsg returns the given message as a protobuf message. The messages are
+// usually held by value, while the methods of the protobuf messages have pointer
+// receivers, in which case a pointer to a copy of the message is returned.
+func toProtoMsg(msg sdk.Msg) (codec.ProtoMarshaler, bool) {
+	if protoMsg, ok := msg.(codec.ProtoMarshaler); ok {
+		return protoMsg, true
+	}
+	if msg == nil {
+		return nil, false
+	}
+
+	ptr := reflect.New(reflect.TypeOf(msg))
+	ptr.Elem().Set(reflect.ValueOf(msg))
+	protoMsg, ok := ptr.Interface().(codec.ProtoMarshaler)
+
+	return protoMsg, ok
+}
+
+// NewAuthInfo returns the AuthInfo of a transaction, whose signer infos hold
+// the public keys and modes of its signatures.
+func NewAuthInfo(sigs []StdSignature, fee StdFee) AuthInfo {
+	signerInfos := make([]SignerInfo, len(sigs))
+	for i, sig := range sigs {
+		signerInfos[i] = SignerInfo{PubKey: sig.PubKey, SignMode: sig.GetSignMode()}
+	}
+
+	return AuthInfo{SignerInfos: signerInfos, Fee: fee}
+}
+
 // DefaultTxDecoder logic for standard transaction decoding
 func DefaultTxDecoder(cdc *codec.Codec) sdk.TxDecoder {
 	return func(txBytes []byte) (sdk.Tx, error) {
@@ -44,6 +44,8 @@ func TestStdSignBytes(t *testing.T) {
 		memo     string
 	}
 	defaultFee := NewTestStdFee()
+	grantedFee := NewTestStdFee()
+	grantedFee.Granter = addr
 	tests := []struct {
 		args args
 		want string
@@ -52,6 +54,10 @@ func TestStdSignBytes(t *testing.T) {
 			args{"1234", 3, 6, defaultFee, []sdk.Msg{sdk.NewTestMsg(addr)}, "memo"},
 			fmt.Sprintf("{\"account_number\":\"3\",\"chain_id\":\"1234\",\"fee\":{\"amount\":[{\"amount\":\"150\",\"denom\":\"atom\"}],\"gas\":\"100000\"},\"memo\":\"memo\",\"msgs\":[[\"%s\"]],\"sequence\":\"6\"}", addr),
 		},
+		{
+			args{"1234", 3, 6, grantedFee, []sdk.Msg{sdk.NewTestMsg(addr)}, "memo"},
+			fmt.Sprintf("{\"account_number\":\"3\",\"chain_id\":\"1234\",\"fee\":{\"amount\":[{\"amount\":\"150\",\"denom\":\"atom\"}],\"gas\":\"100000\",\"granter\":\"%s\"},\"memo\":\"memo\",\"msgs\":[[\"%s\"]],\"sequence\":\"6\"}", addr, addr),
+		},
 	}
 	for i, tc := range tests {
 		got := string(StdSignBytes(tc.args.chainID, tc.args.accnum, tc.args.sequence, tc.args.fee, tc.args.msgs, tc.args.memo))
@@ -159,6 +165,10 @@ func TestStdSignatureMarshalYAML(t *testing.T) {
 			StdSignature{PubKey: pubKey.Bytes(), Signature: nil},
 			fmt.Sprintf("|\n  pubkey: %s\n  signature: \"\"\n", sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)),
 		},
+		{
+			StdSignature{PubKey: pubKey.Bytes(), Signature: []byte("dummySig"), SignMode: SignModeDirect},
+			fmt.Sprintf("|\n  pubkey: %s\n  signature: 64756D6D79536967\n  signmode: SIGN_MODE_DIRECT\n", sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)),
+		},
 	}
 
 	for i, tc := range testCases {
@@ -80,3 +80,39 @@ func NewTestTxWithSignBytes(msgs []sdk.Msg, privs []crypto.PrivKey, accNums []ui
 	tx := NewStdTx(msgs, fee, sigs, memo)
 	return tx
 }
+
+// NewTestTxWithSignMode returns a tx whose signatures are produced in the given
+// sign mode, SignModeDirect or SignModeLegacyAminoJSON.
+func NewTestTxWithSignMode(
+	ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums []uint64, seqs []uint64, fee StdFee, mode SignMode,
+) sdk.Tx {
+	// the public keys and sign modes of all the signers are signed over in
+	// SignModeDirect, so they are set before signing
+	sigs := make([]StdSignature, len(privs))
+	for i, priv := range privs {
+		sigs[i] = StdSignature{PubKey: priv.PubKey().Bytes(), SignMode: mode}
+	}
+
+	for i, priv := range privs {
+		var (
+			signBytes []byte
+			err       error
+		)
+		switch mode {
+		case SignModeDirect:
+			signBytes, err = DirectSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, "", sigs)
+		default:
+			signBytes = StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, "")
+		}
+		if err != nil {
+			panic(err)
+		}
+
+		sigs[i].Signature, err = priv.Sign(signBytes)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return NewStdTx(msgs, fee, sigs, "")
+}
@@ -27,6 +27,7 @@ type TxBuilder struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feeGranter         sdk.AccAddress
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -70,6 +71,7 @@ func NewTxBuilderFromCLI(input io.Reader) TxBuilder {
 
 	txbldr = txbldr.WithFees(viper.GetString(flags.FlagFees))
 	txbldr = txbldr.WithGasPrices(viper.GetString(flags.FlagGasPrices))
+	txbldr = txbldr.WithFeeGranter(viper.GetString(flags.FlagFeeGranter))
 
 	return txbldr
 }
@@ -108,6 +110,10 @@ func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 // GasPrices returns the gas prices set for the transaction, if any.
 func (bldr TxBuilder) GasPrices() sdk.DecCoins { return bldr.gasPrices }
 
+// FeeGranter returns the account paying the fees of the transaction under a
+// fee allowance, if any.
+func (bldr TxBuilder) FeeGranter() sdk.AccAddress { return bldr.feeGranter }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -137,6 +143,22 @@ func (bldr TxBuilder) WithFees(fees string) TxBuilder {
 	return bldr
 }
 
+// WithFeeGranter returns a copy of the context with an updated fee granter.
+func (bldr TxBuilder) WithFeeGranter(granter string) TxBuilder {
+	if granter == "" {
+		bldr.feeGranter = nil
+		return bldr
+	}
+
+	addr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		panic(err)
+	}
+
+	bldr.feeGranter = addr
+	return bldr
+}
+
 // WithGasPrices returns a copy of the context with updated gas prices.
 func (bldr TxBuilder) WithGasPrices(gasPrices string) TxBuilder {
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
@@ -197,13 +219,16 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		}
 	}
 
+	fee := NewStdFee(bldr.gas, fees)
+	fee.Granter = bldr.feeGranter
+
 	return StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
 		Memo:          bldr.memo,
 		Msgs:          msgs,
-		Fee:           NewStdFee(bldr.gas, fees),
+		Fee:           fee,
 	}, nil
 }
 
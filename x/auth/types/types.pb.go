@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -25,6 +26,47 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SignMode defines the mode a signer signed a transaction in, which determines
+// the bytes its signature is over.
+type SignMode int32
+
+const (
+	// SIGN_MODE_UNSPECIFIED defines the mode of the signatures which do not
+	// specify one.
+	SignModeUnspecified SignMode = 0
+	// SIGN_MODE_DIRECT defines a mode signing over the protobuf encoded body and
+	// auth info of a transaction, as a DirectSignDoc.
+	SignModeDirect SignMode = 1
+	// SIGN_MODE_TEXTUAL defines a mode signing over a human-readable rendering of
+	// a transaction.
+	SignModeTextual SignMode = 2
+	// SIGN_MODE_LEGACY_AMINO_JSON defines a mode signing over the sorted amino
+	// JSON encoding of a StdSignDoc.
+	SignModeLegacyAminoJSON SignMode = 127
+)
+
+var SignMode_name = map[int32]string{
+	0:   "SIGN_MODE_UNSPECIFIED",
+	1:   "SIGN_MODE_DIRECT",
+	2:   "SIGN_MODE_TEXTUAL",
+	127: "SIGN_MODE_LEGACY_AMINO_JSON",
+}
+
+var SignMode_value = map[string]int32{
+	"SIGN_MODE_UNSPECIFIED":       0,
+	"SIGN_MODE_DIRECT":            1,
+	"SIGN_MODE_TEXTUAL":           2,
+	"SIGN_MODE_LEGACY_AMINO_JSON": 127,
+}
+
+func (x SignMode) String() string {
+	return proto.EnumName(SignMode_name, int32(x))
+}
+
+func (SignMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{0}
+}
+
 // BaseAccount defines a base account type. It contains all the necessary fields
 // for basic account functionality. Any custom account type should extend this
 // type for additional functionality (e.g. vesting).
@@ -73,6 +115,9 @@ var xxx_messageInfo_BaseAccount proto.InternalMessageInfo
 type StdFee struct {
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 	Gas    uint64                                   `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+	// granter is the account paying the fees under a fee allowance it granted
+	// to the fee payer, if set
+	Granter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=granter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"granter,omitempty" yaml:"granter,omitempty"`
 }
 
 func (m *StdFee) Reset()         { *m = StdFee{} }
@@ -113,6 +158,9 @@ var xxx_messageInfo_StdFee proto.InternalMessageInfo
 type StdSignature struct {
 	PubKey    []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"public_key,omitempty" yaml:"public_key"`
 	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// sign_mode is the mode the signature was produced in, the signatures which
+	// do not specify one being SIGN_MODE_LEGACY_AMINO_JSON signatures
+	SignMode SignMode `protobuf:"varint,3,opt,name=sign_mode,json=signMode,proto3,enum=cosmos_sdk.x.auth.v1.SignMode" json:"sign_mode,omitempty" yaml:"sign_mode"`
 }
 
 func (m *StdSignature) Reset()         { *m = StdSignature{} }
@@ -148,26 +196,25 @@ func (m *StdSignature) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StdSignature proto.InternalMessageInfo
 
-// Params defines the parameters for the auth module.
-type Params struct {
-	MaxMemoCharacters      uint64 `protobuf:"varint,1,opt,name=max_memo_characters,json=maxMemoCharacters,proto3" json:"max_memo_characters,omitempty" yaml:"max_memo_characters"`
-	TxSigLimit             uint64 `protobuf:"varint,2,opt,name=tx_sig_limit,json=txSigLimit,proto3" json:"tx_sig_limit,omitempty" yaml:"tx_sig_limit"`
-	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
-	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
-	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+// TxBody defines the body of a transaction as signed over in SIGN_MODE_DIRECT:
+// its messages and memo.
+type TxBody struct {
+	Msgs []EncodedMsg `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs"`
+	Memo string       `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
-func (m *Params) Reset()      { *m = Params{} }
-func (*Params) ProtoMessage() {}
-func (*Params) Descriptor() ([]byte, []int) {
+func (m *TxBody) Reset()         { *m = TxBody{} }
+func (m *TxBody) String() string { return proto.CompactTextString(m) }
+func (*TxBody) ProtoMessage()    {}
+func (*TxBody) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2d526fa662daab74, []int{3}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *TxBody) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxBody) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxBody.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -177,73 +224,105 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *TxBody) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxBody.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *TxBody) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *TxBody) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxBody.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_TxBody proto.InternalMessageInfo
 
-func (m *Params) GetMaxMemoCharacters() uint64 {
+func (m *TxBody) GetMsgs() []EncodedMsg {
 	if m != nil {
-		return m.MaxMemoCharacters
+		return m.Msgs
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetTxSigLimit() uint64 {
+func (m *TxBody) GetMemo() string {
 	if m != nil {
-		return m.TxSigLimit
+		return m.Memo
 	}
-	return 0
+	return ""
 }
 
-func (m *Params) GetTxSizeCostPerByte() uint64 {
-	if m != nil {
-		return m.TxSizeCostPerByte
+// EncodedMsg defines a protobuf encoded message along with the URL of its
+// protobuf type. It is wire compatible with google.protobuf.Any.
+type EncodedMsg struct {
+	TypeURL string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	Value   []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EncodedMsg) Reset()         { *m = EncodedMsg{} }
+func (m *EncodedMsg) String() string { return proto.CompactTextString(m) }
+func (*EncodedMsg) ProtoMessage()    {}
+func (*EncodedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{4}
+}
+func (m *EncodedMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncodedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncodedMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *EncodedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodedMsg.Merge(m, src)
+}
+func (m *EncodedMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncodedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodedMsg.DiscardUnknown(m)
 }
 
-func (m *Params) GetSigVerifyCostED25519() uint64 {
+var xxx_messageInfo_EncodedMsg proto.InternalMessageInfo
+
+func (m *EncodedMsg) GetTypeURL() string {
 	if m != nil {
-		return m.SigVerifyCostED25519
+		return m.TypeURL
 	}
-	return 0
+	return ""
 }
 
-func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
+func (m *EncodedMsg) GetValue() []byte {
 	if m != nil {
-		return m.SigVerifyCostSecp256k1
+		return m.Value
 	}
-	return 0
+	return nil
 }
 
-// StdTxBase defines a transaction base which application-level concrete transaction
-// types can extend.
-type StdTxBase struct {
-	Fee        StdFee         `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
-	Signatures []StdSignature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures"`
-	Memo       string         `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+// AuthInfo defines the signer infos and the fee of a transaction as signed over
+// in SIGN_MODE_DIRECT.
+type AuthInfo struct {
+	SignerInfos []SignerInfo `protobuf:"bytes,1,rep,name=signer_infos,json=signerInfos,proto3" json:"signer_infos"`
+	Fee         StdFee       `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
 }
 
-func (m *StdTxBase) Reset()         { *m = StdTxBase{} }
-func (m *StdTxBase) String() string { return proto.CompactTextString(m) }
-func (*StdTxBase) ProtoMessage()    {}
-func (*StdTxBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d526fa662daab74, []int{4}
+func (m *AuthInfo) Reset()         { *m = AuthInfo{} }
+func (m *AuthInfo) String() string { return proto.CompactTextString(m) }
+func (*AuthInfo) ProtoMessage()    {}
+func (*AuthInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{5}
 }
-func (m *StdTxBase) XXX_Unmarshal(b []byte) error {
+func (m *AuthInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StdTxBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuthInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StdTxBase.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuthInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -253,61 +332,107 @@ func (m *StdTxBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *StdTxBase) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StdTxBase.Merge(m, src)
+func (m *AuthInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthInfo.Merge(m, src)
 }
-func (m *StdTxBase) XXX_Size() int {
+func (m *AuthInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *StdTxBase) XXX_DiscardUnknown() {
-	xxx_messageInfo_StdTxBase.DiscardUnknown(m)
+func (m *AuthInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StdTxBase proto.InternalMessageInfo
+var xxx_messageInfo_AuthInfo proto.InternalMessageInfo
 
-func (m *StdTxBase) GetFee() StdFee {
+func (m *AuthInfo) GetSignerInfos() []SignerInfo {
+	if m != nil {
+		return m.SignerInfos
+	}
+	return nil
+}
+
+func (m *AuthInfo) GetFee() StdFee {
 	if m != nil {
 		return m.Fee
 	}
 	return StdFee{}
 }
 
-func (m *StdTxBase) GetSignatures() []StdSignature {
+// SignerInfo defines the public key of a signer of a transaction, if it is
+// included in the transaction, and the mode it signed in.
+type SignerInfo struct {
+	PubKey   []byte   `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	SignMode SignMode `protobuf:"varint,2,opt,name=sign_mode,json=signMode,proto3,enum=cosmos_sdk.x.auth.v1.SignMode" json:"sign_mode,omitempty"`
+}
+
+func (m *SignerInfo) Reset()         { *m = SignerInfo{} }
+func (m *SignerInfo) String() string { return proto.CompactTextString(m) }
+func (*SignerInfo) ProtoMessage()    {}
+func (*SignerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{6}
+}
+func (m *SignerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerInfo.Merge(m, src)
+}
+func (m *SignerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerInfo proto.InternalMessageInfo
+
+func (m *SignerInfo) GetPubKey() []byte {
 	if m != nil {
-		return m.Signatures
+		return m.PubKey
 	}
 	return nil
 }
 
-func (m *StdTxBase) GetMemo() string {
+func (m *SignerInfo) GetSignMode() SignMode {
 	if m != nil {
-		return m.Memo
+		return m.SignMode
 	}
-	return ""
+	return SignModeUnspecified
 }
 
-// StdSignDocBase defines the base structure for which applications can extend
-// to define the concrete structure that signers sign over.
-type StdSignDocBase struct {
-	ChainID       string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
-	AccountNumber uint64 `protobuf:"varint,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty" yaml:"account_number"`
-	Sequence      uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Memo          string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
-	Fee           StdFee `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee"`
+// DirectSignDoc defines the document signed over in SIGN_MODE_DIRECT.
+type DirectSignDoc struct {
+	BodyBytes     []byte `protobuf:"bytes,1,opt,name=body_bytes,json=bodyBytes,proto3" json:"body_bytes,omitempty"`
+	AuthInfoBytes []byte `protobuf:"bytes,2,opt,name=auth_info_bytes,json=authInfoBytes,proto3" json:"auth_info_bytes,omitempty"`
+	ChainID       string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AccountNumber uint64 `protobuf:"varint,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Sequence      uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *StdSignDocBase) Reset()         { *m = StdSignDocBase{} }
-func (m *StdSignDocBase) String() string { return proto.CompactTextString(m) }
-func (*StdSignDocBase) ProtoMessage()    {}
-func (*StdSignDocBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d526fa662daab74, []int{5}
+func (m *DirectSignDoc) Reset()         { *m = DirectSignDoc{} }
+func (m *DirectSignDoc) String() string { return proto.CompactTextString(m) }
+func (*DirectSignDoc) ProtoMessage()    {}
+func (*DirectSignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{7}
 }
-func (m *StdSignDocBase) XXX_Unmarshal(b []byte) error {
+func (m *DirectSignDoc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StdSignDocBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DirectSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StdSignDocBase.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DirectSignDoc.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -317,416 +442,648 @@ func (m *StdSignDocBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *StdSignDocBase) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StdSignDocBase.Merge(m, src)
+func (m *DirectSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DirectSignDoc.Merge(m, src)
 }
-func (m *StdSignDocBase) XXX_Size() int {
+func (m *DirectSignDoc) XXX_Size() int {
 	return m.Size()
 }
-func (m *StdSignDocBase) XXX_DiscardUnknown() {
-	xxx_messageInfo_StdSignDocBase.DiscardUnknown(m)
+func (m *DirectSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_DirectSignDoc.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StdSignDocBase proto.InternalMessageInfo
+var xxx_messageInfo_DirectSignDoc proto.InternalMessageInfo
 
-func (m *StdSignDocBase) GetChainID() string {
+func (m *DirectSignDoc) GetBodyBytes() []byte {
+	if m != nil {
+		return m.BodyBytes
+	}
+	return nil
+}
+
+func (m *DirectSignDoc) GetAuthInfoBytes() []byte {
+	if m != nil {
+		return m.AuthInfoBytes
+	}
+	return nil
+}
+
+func (m *DirectSignDoc) GetChainID() string {
 	if m != nil {
 		return m.ChainID
 	}
 	return ""
 }
 
-func (m *StdSignDocBase) GetAccountNumber() uint64 {
+func (m *DirectSignDoc) GetAccountNumber() uint64 {
 	if m != nil {
 		return m.AccountNumber
 	}
 	return 0
 }
 
-func (m *StdSignDocBase) GetSequence() uint64 {
+func (m *DirectSignDoc) GetSequence() uint64 {
 	if m != nil {
 		return m.Sequence
 	}
 	return 0
 }
 
-func (m *StdSignDocBase) GetMemo() string {
-	if m != nil {
-		return m.Memo
-	}
-	return ""
+// TextualSignDoc defines the document signed over in SIGN_MODE_TEXTUAL: the
+// screens of the human-readable rendering of a transaction, in the order a
+// hardware wallet shows them.
+type TextualSignDoc struct {
+	Screens []TextualScreen `protobuf:"bytes,1,rep,name=screens,proto3" json:"screens"`
 }
 
-func (m *StdSignDocBase) GetFee() StdFee {
-	if m != nil {
-		return m.Fee
+func (m *TextualSignDoc) Reset()         { *m = TextualSignDoc{} }
+func (m *TextualSignDoc) String() string { return proto.CompactTextString(m) }
+func (*TextualSignDoc) ProtoMessage()    {}
+func (*TextualSignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{8}
+}
+func (m *TextualSignDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TextualSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TextualSignDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return StdFee{}
 }
-
-func init() {
-	proto.RegisterType((*BaseAccount)(nil), "cosmos_sdk.x.auth.v1.BaseAccount")
-	proto.RegisterType((*StdFee)(nil), "cosmos_sdk.x.auth.v1.StdFee")
-	proto.RegisterType((*StdSignature)(nil), "cosmos_sdk.x.auth.v1.StdSignature")
-	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.auth.v1.Params")
-	proto.RegisterType((*StdTxBase)(nil), "cosmos_sdk.x.auth.v1.StdTxBase")
-	proto.RegisterType((*StdSignDocBase)(nil), "cosmos_sdk.x.auth.v1.StdSignDocBase")
+func (m *TextualSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextualSignDoc.Merge(m, src)
+}
+func (m *TextualSignDoc) XXX_Size() int {
+	return m.Size()
+}
+func (m *TextualSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextualSignDoc.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("x/auth/types/types.proto", fileDescriptor_2d526fa662daab74) }
+var xxx_messageInfo_TextualSignDoc proto.InternalMessageInfo
 
-var fileDescriptor_2d526fa662daab74 = []byte{
-	// 803 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0x37, 0x6e, 0x36, 0x99, 0x5d, 0x0a, 0x99, 0x4d, 0xdb, 0x34, 0x5a, 0x79, 0x22, 0x1f,
-	0x50, 0x90, 0x58, 0x87, 0x04, 0x16, 0x69, 0x73, 0x40, 0xac, 0xb3, 0x54, 0x54, 0x85, 0xaa, 0x9a,
-	0x20, 0x0e, 0x48, 0xc8, 0x9a, 0xd8, 0x53, 0xc7, 0xca, 0x3a, 0x76, 0x3d, 0xe3, 0x55, 0xbc, 0x57,
-	0x2e, 0x88, 0x13, 0xc7, 0xde, 0xd8, 0x33, 0x7f, 0x49, 0x8f, 0x3d, 0x72, 0x72, 0x51, 0xf6, 0x82,
-	0x38, 0x9a, 0x1b, 0x27, 0x34, 0x9e, 0xfc, 0x2c, 0x29, 0xa2, 0xda, 0x4b, 0x32, 0xf3, 0xe6, 0xfb,
-	0xbe, 0xf7, 0xf2, 0xe6, 0x9b, 0x17, 0x50, 0x9f, 0xb6, 0x49, 0xcc, 0x47, 0x6d, 0x9e, 0x84, 0x94,
-	0xc9, 0x4f, 0x23, 0x8c, 0x02, 0x1e, 0xc0, 0x9a, 0x1d, 0x30, 0x3f, 0x60, 0x16, 0x73, 0xc6, 0xc6,
-	0xd4, 0x10, 0x20, 0xe3, 0xa2, 0xd3, 0x78, 0x9f, 0x8f, 0xbc, 0xc8, 0xb1, 0x42, 0x12, 0xf1, 0xa4,
-	0x9d, 0x03, 0xdb, 0x6e, 0xe0, 0x06, 0xab, 0x95, 0x64, 0x37, 0xaa, 0xff, 0x12, 0xd4, 0x7f, 0xda,
-	0x01, 0x7b, 0x26, 0x61, 0xf4, 0xd4, 0xb6, 0x83, 0x78, 0xc2, 0xe1, 0x23, 0xb0, 0x4b, 0x1c, 0x27,
-	0xa2, 0x8c, 0xd5, 0x95, 0xa6, 0xd2, 0xda, 0x37, 0x3b, 0x7f, 0xa7, 0xe8, 0xc8, 0xf5, 0xf8, 0x28,
-	0x1e, 0x1a, 0x76, 0xe0, 0xb7, 0x65, 0x01, 0xf3, 0xaf, 0x23, 0xe6, 0x8c, 0xe7, 0x72, 0xa7, 0xb6,
-	0x7d, 0x2a, 0x89, 0x78, 0xa1, 0x00, 0x1f, 0x80, 0xdd, 0x30, 0x1e, 0x5a, 0x63, 0x9a, 0xd4, 0x77,
-	0x72, 0xb1, 0xa3, 0x3f, 0x53, 0x54, 0x0b, 0xe3, 0xe1, 0xb9, 0x67, 0x8b, 0xe8, 0x87, 0x81, 0xef,
-	0x71, 0xea, 0x87, 0x3c, 0xc9, 0x52, 0x54, 0x4d, 0x88, 0x7f, 0xde, 0xd3, 0x57, 0xa7, 0x3a, 0x2e,
-	0x85, 0xf1, 0xf0, 0x11, 0x4d, 0xe0, 0xe7, 0xe0, 0x36, 0x91, 0xf5, 0x59, 0x93, 0xd8, 0x1f, 0xd2,
-	0xa8, 0x5e, 0x6c, 0x2a, 0x2d, 0xd5, 0xbc, 0x9f, 0xa5, 0xe8, 0x8e, 0xa4, 0x6d, 0x9e, 0xeb, 0xf8,
-	0x9d, 0x79, 0xe0, 0x71, 0xbe, 0x87, 0x0d, 0x50, 0x66, 0xf4, 0x59, 0x4c, 0x27, 0x36, 0xad, 0xab,
-	0x82, 0x8b, 0x97, 0xfb, 0x5e, 0xf9, 0xc7, 0x2b, 0x54, 0x78, 0x7e, 0x85, 0x0a, 0xfa, 0x0f, 0x0a,
-	0x28, 0x0d, 0xb8, 0xf3, 0x80, 0x52, 0xf8, 0x3d, 0x28, 0x11, 0x5f, 0x08, 0xd4, 0x95, 0x66, 0xb1,
-	0xb5, 0xd7, 0x3d, 0x30, 0xd6, 0x3a, 0x7f, 0xd1, 0x31, 0xfa, 0x81, 0x37, 0x31, 0x3f, 0x7a, 0x91,
-	0xa2, 0xc2, 0xaf, 0xaf, 0x50, 0xeb, 0x7f, 0xf4, 0x47, 0x10, 0x18, 0x9e, 0x8b, 0xc2, 0xf7, 0x40,
-	0xd1, 0x25, 0x2c, 0xef, 0x8a, 0x8a, 0xc5, 0x52, 0x56, 0xf1, 0xc7, 0x15, 0x52, 0xf4, 0x4b, 0xb0,
-	0x3f, 0xe0, 0xce, 0xc0, 0x73, 0x27, 0x84, 0xc7, 0x11, 0x5d, 0xef, 0xa2, 0x72, 0x93, 0x2e, 0x1e,
-	0x82, 0x0a, 0x5b, 0x88, 0xca, 0xfb, 0xc0, 0xab, 0x40, 0x4f, 0x15, 0xf9, 0xf5, 0x57, 0x45, 0x50,
-	0x7a, 0x42, 0x22, 0xe2, 0x33, 0xf8, 0x18, 0x1c, 0xf8, 0x64, 0x6a, 0xf9, 0xd4, 0x0f, 0x2c, 0x7b,
-	0x44, 0x22, 0x62, 0x73, 0x1a, 0x49, 0x57, 0xa8, 0xa6, 0x96, 0xa5, 0xa8, 0x21, 0x53, 0x6d, 0x01,
-	0xe9, 0xb8, 0xea, 0x93, 0xe9, 0xd7, 0xd4, 0x0f, 0xfa, 0xcb, 0x18, 0x3c, 0x01, 0xfb, 0x7c, 0x6a,
-	0x31, 0xcf, 0xb5, 0xce, 0x3d, 0xdf, 0xe3, 0xf2, 0xb7, 0x9b, 0xf7, 0xb2, 0x14, 0x1d, 0x48, 0xa1,
-	0xf5, 0x53, 0x1d, 0x03, 0x3e, 0x1d, 0x78, 0xee, 0x57, 0x62, 0x03, 0x31, 0xb8, 0x93, 0x1f, 0x5e,
-	0x52, 0xcb, 0x0e, 0x18, 0xb7, 0x42, 0x1a, 0x59, 0xc3, 0x84, 0xd3, 0xb9, 0x0d, 0x9a, 0x59, 0x8a,
-	0x0e, 0xd7, 0x34, 0x5e, 0x87, 0xe9, 0xb8, 0x2a, 0xc4, 0x2e, 0x69, 0x3f, 0x60, 0xfc, 0x09, 0x8d,
-	0xcc, 0x84, 0x53, 0xf8, 0x0c, 0xdc, 0x13, 0xd9, 0x2e, 0x68, 0xe4, 0x3d, 0x4d, 0x24, 0x9e, 0x3a,
-	0xdd, 0xe3, 0xe3, 0xce, 0x89, 0x34, 0x88, 0xd9, 0x9b, 0xa5, 0xa8, 0x36, 0xf0, 0xdc, 0x6f, 0x73,
-	0x84, 0xa0, 0x7e, 0x71, 0x96, 0x9f, 0x67, 0x29, 0xd2, 0x64, 0xb6, 0x37, 0x08, 0xe8, 0xb8, 0xc6,
-	0x36, 0x78, 0x32, 0x0c, 0x13, 0x70, 0xff, 0x75, 0x06, 0xa3, 0x76, 0xd8, 0x3d, 0xfe, 0x74, 0xdc,
-	0xa9, 0xdf, 0xca, 0x93, 0x7e, 0x36, 0x4b, 0xd1, 0xdd, 0x8d, 0xa4, 0x83, 0x05, 0x22, 0x4b, 0x51,
-	0x73, 0x7b, 0xda, 0xa5, 0x88, 0x8e, 0xef, 0xb2, 0xad, 0xdc, 0x5e, 0xf9, 0xf9, 0xc2, 0x5d, 0xbf,
-	0x28, 0xa0, 0x32, 0xe0, 0xce, 0x37, 0x53, 0xf1, 0xea, 0xe1, 0x27, 0xa0, 0xf8, 0x94, 0xd2, 0xfc,
-	0x52, 0xf7, 0xba, 0x87, 0xc6, 0xb6, 0xe9, 0x62, 0xc8, 0x17, 0x61, 0xaa, 0xc2, 0xec, 0x58, 0xc0,
-	0xe1, 0x97, 0x00, 0x2c, 0x8d, 0x23, 0x4c, 0x2c, 0x1e, 0x88, 0xfe, 0x46, 0xf2, 0xd2, 0xc9, 0x73,
-	0x89, 0x35, 0x2e, 0x84, 0x40, 0x15, 0xde, 0xc9, 0x2f, 0xb2, 0x82, 0xf3, 0xb5, 0xfe, 0x97, 0x02,
-	0x6e, 0xcf, 0x69, 0x67, 0x81, 0x9d, 0x97, 0x79, 0x02, 0xca, 0xf6, 0x88, 0x78, 0x13, 0xcb, 0x73,
-	0xf2, 0x5a, 0x2b, 0xa6, 0x36, 0x4b, 0xd1, 0x6e, 0x5f, 0xc4, 0x1e, 0x9e, 0x65, 0x29, 0x7a, 0x57,
-	0x76, 0x66, 0x01, 0xd2, 0xf1, 0x6e, 0xbe, 0x7c, 0xe8, 0x6c, 0x99, 0x1d, 0x3b, 0x37, 0x98, 0x1d,
-	0xc5, 0xcd, 0xd9, 0xb1, 0xac, 0x5f, 0x5d, 0xd5, 0xbf, 0xe8, 0xe9, 0xad, 0xb7, 0xea, 0xa9, 0xd9,
-	0x7f, 0x31, 0xd3, 0x94, 0x97, 0x33, 0x4d, 0xf9, 0x7d, 0xa6, 0x29, 0x3f, 0x5f, 0x6b, 0x85, 0x97,
-	0xd7, 0x5a, 0xe1, 0xb7, 0x6b, 0xad, 0xf0, 0xdd, 0x07, 0xff, 0x39, 0x5d, 0xd6, 0xff, 0x2a, 0x86,
-	0xa5, 0x7c, 0xa8, 0x7f, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc0, 0xb1, 0x78, 0x7e, 0x41,
-	0x06, 0x00, 0x00,
+func (m *TextualSignDoc) GetScreens() []TextualScreen {
+	if m != nil {
+		return m.Screens
+	}
+	return nil
 }
 
-func (this *StdFee) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+// TextualScreen defines a screen of the rendering of a transaction in
+// SIGN_MODE_TEXTUAL, made of a title and its content. The indentation gives the
+// nesting of the screen, e.g. the fields of a message being indented under it,
+// and the expert screens are only meant to be shown in an expert mode.
+type TextualScreen struct {
+	Title   string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Indent  uint32 `protobuf:"varint,3,opt,name=indent,proto3" json:"indent,omitempty"`
+	Expert  bool   `protobuf:"varint,4,opt,name=expert,proto3" json:"expert,omitempty"`
+}
 
-	that1, ok := that.(*StdFee)
-	if !ok {
-		that2, ok := that.(StdFee)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *TextualScreen) Reset()         { *m = TextualScreen{} }
+func (m *TextualScreen) String() string { return proto.CompactTextString(m) }
+func (*TextualScreen) ProtoMessage()    {}
+func (*TextualScreen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{9}
+}
+func (m *TextualScreen) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TextualScreen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TextualScreen.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Amount) != len(that1.Amount) {
-		return false
+}
+func (m *TextualScreen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextualScreen.Merge(m, src)
+}
+func (m *TextualScreen) XXX_Size() int {
+	return m.Size()
+}
+func (m *TextualScreen) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextualScreen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextualScreen proto.InternalMessageInfo
+
+func (m *TextualScreen) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	for i := range this.Amount {
-		if !this.Amount[i].Equal(&that1.Amount[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *TextualScreen) GetContent() string {
+	if m != nil {
+		return m.Content
 	}
-	if this.Gas != that1.Gas {
-		return false
+	return ""
+}
+
+func (m *TextualScreen) GetIndent() uint32 {
+	if m != nil {
+		return m.Indent
 	}
-	return true
+	return 0
 }
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TextualScreen) GetExpert() bool {
+	if m != nil {
+		return m.Expert
 	}
+	return false
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Params defines the parameters for the auth module.
+type Params struct {
+	MaxMemoCharacters      uint64 `protobuf:"varint,1,opt,name=max_memo_characters,json=maxMemoCharacters,proto3" json:"max_memo_characters,omitempty" yaml:"max_memo_characters"`
+	TxSigLimit             uint64 `protobuf:"varint,2,opt,name=tx_sig_limit,json=txSigLimit,proto3" json:"tx_sig_limit,omitempty" yaml:"tx_sig_limit"`
+	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
+	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
+	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	SigVerifyCostSecp256r1 uint64 `protobuf:"varint,6,opt,name=sig_verify_cost_secp256r1,json=sigVerifyCostSecp256r1,proto3" json:"sig_verify_cost_secp256r1,omitempty" yaml:"sig_verify_cost_secp256r1"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{10}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MaxMemoCharacters != that1.MaxMemoCharacters {
-		return false
-	}
-	if this.TxSigLimit != that1.TxSigLimit {
-		return false
-	}
-	if this.TxSizeCostPerByte != that1.TxSizeCostPerByte {
-		return false
-	}
-	if this.SigVerifyCostED25519 != that1.SigVerifyCostED25519 {
-		return false
-	}
-	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
-		return false
-	}
-	return true
 }
-func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
 }
 
-func (m *BaseAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxMemoCharacters() uint64 {
+	if m != nil {
+		return m.MaxMemoCharacters
+	}
+	return 0
 }
 
-func (m *BaseAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x20
+func (m *Params) GetTxSigLimit() uint64 {
+	if m != nil {
+		return m.TxSigLimit
 	}
-	if m.AccountNumber != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.AccountNumber))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *Params) GetTxSizeCostPerByte() uint64 {
+	if m != nil {
+		return m.TxSizeCostPerByte
 	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *Params) GetSigVerifyCostED25519() uint64 {
+	if m != nil {
+		return m.SigVerifyCostED25519
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
+	if m != nil {
+		return m.SigVerifyCostSecp256k1
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *StdFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetSigVerifyCostSecp256r1() uint64 {
+	if m != nil {
+		return m.SigVerifyCostSecp256r1
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StdFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StdTxBase defines a transaction base which application-level concrete transaction
+// types can extend.
+type StdTxBase struct {
+	Fee        StdFee         `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	Signatures []StdSignature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures"`
+	Memo       string         `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
-func (m *StdFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Gas != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Gas))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *StdTxBase) Reset()         { *m = StdTxBase{} }
+func (m *StdTxBase) String() string { return proto.CompactTextString(m) }
+func (*StdTxBase) ProtoMessage()    {}
+func (*StdTxBase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{11}
+}
+func (m *StdTxBase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StdTxBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StdTxBase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *StdSignature) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *StdTxBase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StdTxBase.Merge(m, src)
 }
-
-func (m *StdSignature) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StdTxBase) XXX_Size() int {
+	return m.Size()
+}
+func (m *StdTxBase) XXX_DiscardUnknown() {
+	xxx_messageInfo_StdTxBase.DiscardUnknown(m)
 }
 
-func (m *StdSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_StdTxBase proto.InternalMessageInfo
+
+func (m *StdTxBase) GetFee() StdFee {
+	if m != nil {
+		return m.Fee
 	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0xa
+	return StdFee{}
+}
+
+func (m *StdTxBase) GetSignatures() []StdSignature {
+	if m != nil {
+		return m.Signatures
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StdTxBase) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StdSignDocBase defines the base structure for which applications can extend
+// to define the concrete structure that signers sign over.
+type StdSignDocBase struct {
+	ChainID       string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	AccountNumber uint64 `protobuf:"varint,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty" yaml:"account_number"`
+	Sequence      uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Memo          string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	Fee           StdFee `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SigVerifyCostSecp256k1 != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
-		i--
-		dAtA[i] = 0x28
+func (m *StdSignDocBase) Reset()         { *m = StdSignDocBase{} }
+func (m *StdSignDocBase) String() string { return proto.CompactTextString(m) }
+func (*StdSignDocBase) ProtoMessage()    {}
+func (*StdSignDocBase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{12}
+}
+func (m *StdSignDocBase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StdSignDocBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StdSignDocBase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SigVerifyCostED25519 != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostED25519))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *StdSignDocBase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StdSignDocBase.Merge(m, src)
+}
+func (m *StdSignDocBase) XXX_Size() int {
+	return m.Size()
+}
+func (m *StdSignDocBase) XXX_DiscardUnknown() {
+	xxx_messageInfo_StdSignDocBase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StdSignDocBase proto.InternalMessageInfo
+
+func (m *StdSignDocBase) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	if m.TxSizeCostPerByte != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TxSizeCostPerByte))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *StdSignDocBase) GetAccountNumber() uint64 {
+	if m != nil {
+		return m.AccountNumber
 	}
-	if m.TxSigLimit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TxSigLimit))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *StdSignDocBase) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	if m.MaxMemoCharacters != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MaxMemoCharacters))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *StdSignDocBase) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *StdTxBase) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StdSignDocBase) GetFee() StdFee {
+	if m != nil {
+		return m.Fee
 	}
-	return dAtA[:n], nil
+	return StdFee{}
 }
 
-func (m *StdTxBase) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRotatePubKey defines a message that replaces the public key of an account
+// with a new one, the address of the account being kept.
+type MsgRotatePubKey struct {
+	Address   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	NewPubKey []byte                                        `protobuf:"bytes,2,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty" yaml:"new_pub_key"`
 }
 
-func (m *StdTxBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Memo) > 0 {
-		i -= len(m.Memo)
-		copy(dAtA[i:], m.Memo)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Memo)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgRotatePubKey) Reset()         { *m = MsgRotatePubKey{} }
+func (m *MsgRotatePubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotatePubKey) ProtoMessage()    {}
+func (*MsgRotatePubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{13}
+}
+func (m *MsgRotatePubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotatePubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotatePubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+}
+func (m *MsgRotatePubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotatePubKey.Merge(m, src)
+}
+func (m *MsgRotatePubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotatePubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotatePubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotatePubKey proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("cosmos_sdk.x.auth.v1.SignMode", SignMode_name, SignMode_value)
+	proto.RegisterType((*BaseAccount)(nil), "cosmos_sdk.x.auth.v1.BaseAccount")
+	proto.RegisterType((*StdFee)(nil), "cosmos_sdk.x.auth.v1.StdFee")
+	proto.RegisterType((*StdSignature)(nil), "cosmos_sdk.x.auth.v1.StdSignature")
+	proto.RegisterType((*TxBody)(nil), "cosmos_sdk.x.auth.v1.TxBody")
+	proto.RegisterType((*EncodedMsg)(nil), "cosmos_sdk.x.auth.v1.EncodedMsg")
+	proto.RegisterType((*AuthInfo)(nil), "cosmos_sdk.x.auth.v1.AuthInfo")
+	proto.RegisterType((*SignerInfo)(nil), "cosmos_sdk.x.auth.v1.SignerInfo")
+	proto.RegisterType((*DirectSignDoc)(nil), "cosmos_sdk.x.auth.v1.DirectSignDoc")
+	proto.RegisterType((*TextualSignDoc)(nil), "cosmos_sdk.x.auth.v1.TextualSignDoc")
+	proto.RegisterType((*TextualScreen)(nil), "cosmos_sdk.x.auth.v1.TextualScreen")
+	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.auth.v1.Params")
+	proto.RegisterType((*StdTxBase)(nil), "cosmos_sdk.x.auth.v1.StdTxBase")
+	proto.RegisterType((*StdSignDocBase)(nil), "cosmos_sdk.x.auth.v1.StdSignDocBase")
+	proto.RegisterType((*MsgRotatePubKey)(nil), "cosmos_sdk.x.auth.v1.MsgRotatePubKey")
+}
+
+func init() { proto.RegisterFile("x/auth/types/types.proto", fileDescriptor_2d526fa662daab74) }
+
+var fileDescriptor_2d526fa662daab74 = []byte{
+	// 1367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdb, 0xc6,
+	0x12, 0x16, 0x6d, 0x45, 0x92, 0xd7, 0xbf, 0xd7, 0x8e, 0xad, 0x28, 0x79, 0xa2, 0xc0, 0x87, 0x17,
+	0xf8, 0x05, 0x2f, 0xf2, 0x93, 0xdb, 0x04, 0x88, 0x5b, 0x14, 0x15, 0x25, 0x25, 0x55, 0x62, 0x39,
+	0x2e, 0x25, 0x17, 0x69, 0x81, 0x82, 0xa0, 0xc8, 0x35, 0x4d, 0x58, 0xe4, 0x2a, 0xdc, 0x95, 0x23,
+	0xe5, 0xd2, 0x6b, 0xe0, 0x5e, 0x7a, 0xcc, 0xc5, 0xa8, 0x81, 0xde, 0xfa, 0x5f, 0xf4, 0x96, 0x4b,
+	0x81, 0x1c, 0x83, 0x1e, 0xd8, 0xc2, 0xb9, 0x14, 0x45, 0x4f, 0xea, 0xad, 0xa7, 0x62, 0xb9, 0xa4,
+	0x28, 0x25, 0x8a, 0x93, 0x34, 0xbd, 0xd8, 0xdc, 0x99, 0xef, 0x9b, 0x9d, 0x9d, 0x19, 0x7e, 0x5c,
+	0x81, 0x74, 0x77, 0x5d, 0xeb, 0xd0, 0xfd, 0x75, 0xda, 0x6b, 0x23, 0xc2, 0xff, 0xe6, 0xdb, 0x2e,
+	0xa6, 0x18, 0x2e, 0xeb, 0x98, 0xd8, 0x98, 0xa8, 0xc4, 0x38, 0xc8, 0x77, 0xf3, 0x0c, 0x94, 0x3f,
+	0x2c, 0x64, 0x2e, 0xd3, 0x7d, 0xcb, 0x35, 0xd4, 0xb6, 0xe6, 0xd2, 0xde, 0xba, 0x0f, 0x5c, 0x37,
+	0xb1, 0x89, 0xa3, 0x27, 0xce, 0xce, 0x2c, 0xbe, 0x14, 0x50, 0x3a, 0x9a, 0x00, 0xd3, 0xb2, 0x46,
+	0x50, 0x51, 0xd7, 0x71, 0xc7, 0xa1, 0xf0, 0x0e, 0x48, 0x6a, 0x86, 0xe1, 0x22, 0x42, 0xd2, 0x42,
+	0x4e, 0x58, 0x9b, 0x91, 0x0b, 0x7f, 0x7a, 0xe2, 0x55, 0xd3, 0xa2, 0xfb, 0x9d, 0x66, 0x5e, 0xc7,
+	0xf6, 0x3a, 0x4f, 0x20, 0xf8, 0x77, 0x95, 0x18, 0x07, 0x41, 0xb8, 0xa2, 0xae, 0x17, 0x39, 0x51,
+	0x09, 0x23, 0xc0, 0x9b, 0x20, 0xd9, 0xee, 0x34, 0xd5, 0x03, 0xd4, 0x4b, 0x4f, 0xf8, 0xc1, 0xae,
+	0xfe, 0xe6, 0x89, 0xcb, 0xed, 0x4e, 0xb3, 0x65, 0xe9, 0xcc, 0xfa, 0x3f, 0x6c, 0x5b, 0x14, 0xd9,
+	0x6d, 0xda, 0xeb, 0x7b, 0xe2, 0x62, 0x4f, 0xb3, 0x5b, 0x9b, 0x52, 0xe4, 0x95, 0x94, 0x44, 0xbb,
+	0xd3, 0xbc, 0x83, 0x7a, 0xf0, 0x63, 0x30, 0xa7, 0xf1, 0xfc, 0x54, 0xa7, 0x63, 0x37, 0x91, 0x9b,
+	0x9e, 0xcc, 0x09, 0x6b, 0x71, 0xf9, 0x42, 0xdf, 0x13, 0xcf, 0x73, 0xda, 0xa8, 0x5f, 0x52, 0x66,
+	0x03, 0xc3, 0xb6, 0xbf, 0x86, 0x19, 0x90, 0x22, 0xe8, 0x7e, 0x07, 0x39, 0x3a, 0x4a, 0xc7, 0x19,
+	0x57, 0x19, 0xac, 0x37, 0x53, 0x8f, 0x4e, 0xc4, 0xd8, 0xe3, 0x13, 0x31, 0x26, 0xfd, 0x2e, 0x80,
+	0x44, 0x9d, 0x1a, 0x37, 0x11, 0x82, 0x5f, 0x82, 0x84, 0x66, 0xb3, 0x00, 0x69, 0x21, 0x37, 0xb9,
+	0x36, 0xbd, 0xb1, 0x94, 0x1f, 0xaa, 0xfc, 0x61, 0x21, 0x5f, 0xc2, 0x96, 0x23, 0xff, 0xff, 0x89,
+	0x27, 0xc6, 0xbe, 0xff, 0x59, 0x5c, 0x7b, 0x83, 0xfa, 0x30, 0x02, 0x51, 0x82, 0xa0, 0x70, 0x01,
+	0x4c, 0x9a, 0x1a, 0xf1, 0xab, 0x12, 0x57, 0xd8, 0x23, 0x34, 0x41, 0xd2, 0x74, 0x35, 0x87, 0x06,
+	0x87, 0x9b, 0x91, 0x6b, 0x7d, 0x4f, 0x4c, 0xf3, 0xc3, 0x05, 0x8e, 0xa8, 0x5c, 0xd2, 0xdf, 0x68,
+	0x4a, 0x10, 0x84, 0x1f, 0xf7, 0xd7, 0x13, 0x51, 0x90, 0x7e, 0x14, 0xc0, 0x4c, 0x9d, 0x1a, 0x75,
+	0xcb, 0x74, 0x34, 0xda, 0x71, 0xd1, 0x70, 0xbf, 0x84, 0x77, 0xe9, 0xd7, 0x25, 0x30, 0x45, 0xc2,
+	0xa0, 0xbc, 0xf3, 0x4a, 0x64, 0x80, 0x9f, 0x72, 0xaf, 0x6a, 0x63, 0x03, 0xf9, 0x67, 0x9d, 0xdb,
+	0xc8, 0xe6, 0xc7, 0xcd, 0x75, 0x9e, 0x65, 0x56, 0xc3, 0x06, 0x92, 0x97, 0xfb, 0x9e, 0xb8, 0xc0,
+	0xf7, 0x1b, 0x50, 0x25, 0x25, 0x45, 0x02, 0xff, 0x66, 0x9c, 0x9d, 0x49, 0xba, 0x07, 0x12, 0x8d,
+	0xae, 0x8c, 0x8d, 0x1e, 0xdc, 0x04, 0x71, 0x9b, 0x98, 0x24, 0xe8, 0x5d, 0x6e, 0x7c, 0xf4, 0x8a,
+	0xa3, 0x63, 0x03, 0x19, 0x35, 0x62, 0xca, 0x71, 0xd6, 0x48, 0xc5, 0xe7, 0x40, 0x08, 0xe2, 0x36,
+	0xb2, 0xb1, 0x9f, 0xf7, 0x94, 0xe2, 0x3f, 0x4b, 0xb7, 0x01, 0x88, 0xd0, 0xf0, 0x32, 0x48, 0xb1,
+	0xf2, 0xaa, 0x1d, 0xb7, 0xe5, 0xd7, 0x69, 0x4a, 0x9e, 0x3e, 0xf5, 0xc4, 0x64, 0xa3, 0xd7, 0x46,
+	0xbb, 0xca, 0x96, 0x92, 0x64, 0xce, 0x5d, 0xb7, 0x05, 0x97, 0xc1, 0xb9, 0x43, 0xad, 0xd5, 0x09,
+	0x4b, 0xc0, 0x17, 0xd2, 0xd7, 0x02, 0x48, 0x15, 0x3b, 0x74, 0xbf, 0xea, 0xec, 0x61, 0x58, 0x05,
+	0x33, 0xec, 0x10, 0xc8, 0x55, 0x2d, 0x67, 0x0f, 0xbf, 0x26, 0xe1, 0xba, 0x8f, 0x64, 0xbc, 0x20,
+	0xe1, 0x69, 0x32, 0xb0, 0x10, 0xf8, 0x3e, 0x98, 0xdc, 0x43, 0x7c, 0xaf, 0xe9, 0x8d, 0x4b, 0xaf,
+	0x88, 0xe0, 0x0f, 0x77, 0xc0, 0x66, 0x70, 0xa9, 0x09, 0x40, 0x14, 0x16, 0xae, 0xbe, 0x30, 0x00,
+	0x83, 0x8e, 0x7e, 0x30, 0xdc, 0xb3, 0x89, 0x37, 0xe9, 0x59, 0xd4, 0x1d, 0xe9, 0x07, 0x01, 0xcc,
+	0x96, 0x2d, 0x17, 0xe9, 0x94, 0x39, 0xcb, 0x58, 0x87, 0xff, 0x02, 0xa0, 0x89, 0x8d, 0x9e, 0xda,
+	0xec, 0x51, 0x14, 0x08, 0x8d, 0x32, 0xc5, 0x2c, 0x32, 0x33, 0xc0, 0xcb, 0x60, 0x9e, 0x85, 0xf3,
+	0x6b, 0x12, 0x60, 0x78, 0x09, 0x67, 0xb5, 0xa0, 0x70, 0x21, 0x2e, 0xa5, 0xef, 0x6b, 0x96, 0xa3,
+	0x5a, 0x46, 0x7a, 0x32, 0x6a, 0x44, 0x89, 0xd9, 0xaa, 0x65, 0x25, 0xe9, 0x3b, 0xab, 0x06, 0xfc,
+	0xcf, 0x4b, 0xfa, 0xc1, 0x35, 0xe0, 0x0c, 0x91, 0x38, 0x37, 0x2a, 0x12, 0xd2, 0x2e, 0x98, 0x6b,
+	0xa0, 0x2e, 0xed, 0x68, 0xad, 0xf0, 0x0c, 0x25, 0x90, 0x24, 0xba, 0x8b, 0x90, 0x13, 0x76, 0xed,
+	0xdf, 0xe3, 0x0b, 0x12, 0xd2, 0x7c, 0x6c, 0x50, 0xfa, 0x90, 0x29, 0x61, 0x30, 0x3b, 0xe2, 0x67,
+	0x33, 0x43, 0x2d, 0xda, 0x42, 0x7c, 0xb0, 0x14, 0xbe, 0x80, 0x69, 0x90, 0xd4, 0xb1, 0x43, 0x91,
+	0x43, 0x83, 0xb1, 0x0c, 0x97, 0x70, 0x05, 0x24, 0x2c, 0xc7, 0x60, 0x0e, 0x56, 0x80, 0x59, 0x25,
+	0x58, 0x31, 0x3b, 0xea, 0xb6, 0x91, 0x4b, 0xfd, 0xa3, 0xa6, 0x94, 0x60, 0x25, 0xfd, 0x14, 0x07,
+	0x89, 0x1d, 0xcd, 0xd5, 0x6c, 0x02, 0xb7, 0xc1, 0x92, 0xad, 0x75, 0x55, 0x36, 0xe0, 0xaa, 0xbe,
+	0xaf, 0xb9, 0x9a, 0x4e, 0x91, 0xcb, 0xbb, 0x11, 0x97, 0xb3, 0x7d, 0x4f, 0xcc, 0xf0, 0x37, 0x6e,
+	0x0c, 0x48, 0x52, 0x16, 0x6d, 0xad, 0x5b, 0x43, 0x36, 0x2e, 0x0d, 0x6c, 0xf0, 0x06, 0x98, 0xa1,
+	0x5d, 0x95, 0x58, 0xa6, 0xda, 0xb2, 0x6c, 0x8b, 0x67, 0x1a, 0x97, 0x57, 0xfb, 0x9e, 0xb8, 0xc4,
+	0x03, 0x0d, 0x7b, 0x25, 0x05, 0xd0, 0x6e, 0xdd, 0x32, 0xb7, 0xd8, 0x02, 0x2a, 0xe0, 0xbc, 0xef,
+	0x7c, 0x88, 0x54, 0x1d, 0x13, 0xaa, 0xb6, 0x91, 0xeb, 0xf7, 0x3d, 0xd0, 0xf9, 0x5c, 0xdf, 0x13,
+	0x2f, 0x0d, 0xc5, 0x78, 0x11, 0x26, 0x29, 0x8b, 0x2c, 0xd8, 0x43, 0x54, 0xc2, 0x84, 0xee, 0x20,
+	0x97, 0x4d, 0x07, 0xbc, 0x0f, 0x56, 0xd9, 0x6e, 0x87, 0xc8, 0xb5, 0xf6, 0x7a, 0x1c, 0x8f, 0x8c,
+	0x8d, 0x6b, 0xd7, 0x0a, 0x37, 0x78, 0xf7, 0xe5, 0xcd, 0x53, 0x4f, 0x5c, 0xae, 0x5b, 0xe6, 0x67,
+	0x3e, 0x82, 0x51, 0x2b, 0x65, 0xdf, 0xdf, 0xf7, 0xc4, 0xec, 0x40, 0x6c, 0xc6, 0x05, 0x90, 0x94,
+	0x65, 0x32, 0xc2, 0xe3, 0x66, 0xd8, 0x03, 0x17, 0x5e, 0x64, 0x10, 0xa4, 0xb7, 0x37, 0xae, 0x5d,
+	0x3f, 0x28, 0xf0, 0x89, 0x92, 0x3f, 0x3a, 0xf5, 0xc4, 0x95, 0x91, 0x4d, 0xeb, 0x21, 0xa2, 0xef,
+	0x89, 0xb9, 0xf1, 0xdb, 0x0e, 0x82, 0x48, 0xca, 0x0a, 0x19, 0xcb, 0x3d, 0x63, 0x6b, 0xb7, 0x90,
+	0x4e, 0x9c, 0xbd, 0xb5, 0xfb, 0xfa, 0xad, 0xdd, 0x57, 0x6d, 0xed, 0x16, 0x36, 0x53, 0x8f, 0xc3,
+	0x0f, 0xca, 0xb7, 0x02, 0x98, 0xaa, 0x53, 0xa3, 0xd1, 0x65, 0x37, 0x8a, 0x50, 0x90, 0x84, 0xb7,
+	0x12, 0x24, 0xf8, 0x09, 0x00, 0x83, 0x4f, 0x05, 0x7b, 0xed, 0xd9, 0x9b, 0x25, 0xbd, 0x92, 0x3c,
+	0xf8, 0x76, 0x05, 0x21, 0x86, 0xb8, 0x03, 0x21, 0x9f, 0x1c, 0x12, 0xf2, 0x3f, 0x04, 0x30, 0x17,
+	0xd0, 0xca, 0x58, 0xf7, 0xd3, 0xbc, 0x31, 0x24, 0x22, 0x5c, 0xcd, 0xb3, 0x43, 0x22, 0xd2, 0xf7,
+	0xc4, 0x79, 0x5e, 0x99, 0x10, 0x24, 0x45, 0xba, 0xf2, 0xf2, 0xbd, 0x64, 0xe2, 0x1d, 0xee, 0x25,
+	0x93, 0xa3, 0x92, 0x33, 0xc8, 0x3f, 0x1e, 0xe5, 0x1f, 0xd6, 0xf4, 0xdc, 0xdb, 0x89, 0xfc, 0x89,
+	0x00, 0xe6, 0x6b, 0xc4, 0x54, 0x30, 0xd5, 0x28, 0xda, 0xe1, 0x8a, 0xfe, 0x8f, 0x5e, 0xf4, 0xae,
+	0x83, 0x69, 0x07, 0x3d, 0x50, 0x47, 0x2f, 0x7b, 0x2b, 0x7d, 0x4f, 0x84, 0xbc, 0x0a, 0x43, 0x4e,
+	0x49, 0x99, 0x72, 0xd0, 0x03, 0x9e, 0x44, 0x74, 0x17, 0xb9, 0xf2, 0x4c, 0x00, 0xa9, 0xf0, 0xd3,
+	0x01, 0x37, 0xc0, 0xf9, 0x7a, 0xf5, 0xd6, 0xb6, 0x5a, 0xbb, 0x5b, 0xae, 0xa8, 0xbb, 0xdb, 0xf5,
+	0x9d, 0x4a, 0xa9, 0x7a, 0xb3, 0x5a, 0x29, 0x2f, 0xc4, 0x32, 0xab, 0x47, 0xc7, 0xb9, 0xa5, 0x10,
+	0xb8, 0xeb, 0x90, 0x36, 0xd2, 0xad, 0x3d, 0x0b, 0x19, 0x70, 0x0d, 0x2c, 0x44, 0x9c, 0x72, 0x55,
+	0xa9, 0x94, 0x1a, 0x0b, 0x42, 0x06, 0x1e, 0x1d, 0xe7, 0xe6, 0x42, 0x38, 0xff, 0x06, 0xc1, 0x2b,
+	0x60, 0x31, 0x42, 0x36, 0x2a, 0xf7, 0x1a, 0xbb, 0xc5, 0xad, 0x85, 0x89, 0xcc, 0xd2, 0xd1, 0x71,
+	0x6e, 0x3e, 0x84, 0x06, 0xa2, 0x0c, 0x3f, 0x04, 0x17, 0x23, 0xec, 0x56, 0xe5, 0x56, 0xb1, 0xf4,
+	0xb9, 0x5a, 0xac, 0x55, 0xb7, 0xef, 0xaa, 0xb7, 0xeb, 0x77, 0xb7, 0x17, 0xbe, 0xca, 0x5c, 0x3c,
+	0x3a, 0xce, 0xad, 0x86, 0xac, 0x2d, 0x64, 0x6a, 0x7a, 0xaf, 0x68, 0x5b, 0x0e, 0x66, 0xee, 0x4c,
+	0xfc, 0xd1, 0x77, 0xd9, 0x98, 0x5c, 0x7a, 0x72, 0x9a, 0x15, 0x9e, 0x9e, 0x66, 0x85, 0x5f, 0x4e,
+	0xb3, 0xc2, 0x37, 0xcf, 0xb3, 0xb1, 0xa7, 0xcf, 0xb3, 0xb1, 0x67, 0xcf, 0xb3, 0xb1, 0x2f, 0xfe,
+	0x7b, 0x66, 0xb9, 0x87, 0x7f, 0x04, 0x34, 0x13, 0xfe, 0x75, 0xfd, 0xbd, 0xbf, 0x06, 0x00, 0xaa,
+	0x60, 0xd9, 0xc8, 0x1b, 0x0c, 0x00, 0x00,
+}
+
+func (this *StdFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StdFee)
+	if !ok {
+		that2, ok := that.(StdFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if this.Gas != that1.Gas {
+		return false
+	}
+	if !bytes.Equal(this.Granter, that1.Granter) {
+		return false
+	}
+	return true
 }
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *StdSignDocBase) Marshal() (dAtA []byte, err error) {
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxMemoCharacters != that1.MaxMemoCharacters {
+		return false
+	}
+	if this.TxSigLimit != that1.TxSigLimit {
+		return false
+	}
+	if this.TxSizeCostPerByte != that1.TxSizeCostPerByte {
+		return false
+	}
+	if this.SigVerifyCostED25519 != that1.SigVerifyCostED25519 {
+		return false
+	}
+	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
+		return false
+	}
+	if this.SigVerifyCostSecp256r1 != that1.SigVerifyCostSecp256r1 {
+		return false
+	}
+	return true
+}
+func (this *MsgRotatePubKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRotatePubKey)
+	if !ok {
+		that2, ok := that.(MsgRotatePubKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !bytes.Equal(this.NewPubKey, that1.NewPubKey) {
+		return false
+	}
+	return true
+}
+func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -736,199 +1093,1851 @@ func (m *StdSignDocBase) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StdSignDocBase) MarshalTo(dAtA []byte) (int, error) {
+func (m *BaseAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StdSignDocBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BaseAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Memo) > 0 {
-		i -= len(m.Memo)
-		copy(dAtA[i:], m.Memo)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Memo)))
-		i--
-		dAtA[i] = 0x22
-	}
 	if m.Sequence != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
 	if m.AccountNumber != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.AccountNumber))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainID)))
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StdFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BaseAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *StdFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StdFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovTypes(uint64(m.AccountNumber))
+	if m.Gas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovTypes(uint64(m.Sequence))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StdFee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StdSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StdSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StdSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.SignMode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SignMode))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Gas != 0 {
-		n += 1 + sovTypes(uint64(m.Gas))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *StdSignature) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TxBody) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TxBody) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EncodedMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EncodedMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncodedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxMemoCharacters != 0 {
-		n += 1 + sovTypes(uint64(m.MaxMemoCharacters))
-	}
-	if m.TxSigLimit != 0 {
-		n += 1 + sovTypes(uint64(m.TxSigLimit))
-	}
-	if m.TxSizeCostPerByte != 0 {
-		n += 1 + sovTypes(uint64(m.TxSizeCostPerByte))
-	}
-	if m.SigVerifyCostED25519 != 0 {
-		n += 1 + sovTypes(uint64(m.SigVerifyCostED25519))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SigVerifyCostSecp256k1 != 0 {
-		n += 1 + sovTypes(uint64(m.SigVerifyCostSecp256k1))
+	if len(m.TypeURL) > 0 {
+		i -= len(m.TypeURL)
+		copy(dAtA[i:], m.TypeURL)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TypeURL)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StdTxBase) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AuthInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.SignerInfos) > 0 {
+		for iNdEx := len(m.SignerInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StdSignDocBase) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.SignMode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SignMode))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovTypes(uint64(m.AccountNumber))
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DirectSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DirectSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DirectSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Sequence != 0 {
-		n += 1 + sovTypes(uint64(m.Sequence))
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AuthInfoBytes) > 0 {
+		i -= len(m.AuthInfoBytes)
+		copy(dAtA[i:], m.AuthInfoBytes)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AuthInfoBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BodyBytes) > 0 {
+		i -= len(m.BodyBytes)
+		copy(dAtA[i:], m.BodyBytes)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BodyBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TextualSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TextualSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextualSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Screens) > 0 {
+		for iNdEx := len(m.Screens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Screens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TextualScreen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TextualScreen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextualScreen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expert {
+		i--
+		if m.Expert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Indent != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Indent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SigVerifyCostSecp256r1 != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostSecp256r1))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SigVerifyCostSecp256k1 != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SigVerifyCostED25519 != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostED25519))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxSizeCostPerByte != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxSizeCostPerByte))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TxSigLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxSigLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxMemoCharacters != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxMemoCharacters))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StdTxBase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StdTxBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StdTxBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StdSignDocBase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StdSignDocBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StdSignDocBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotatePubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotatePubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotatePubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewPubKey) > 0 {
+		i -= len(m.NewPubKey)
+		copy(dAtA[i:], m.NewPubKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BaseAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovTypes(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *StdFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Gas != 0 {
+		n += 1 + sovTypes(uint64(m.Gas))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *StdSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SignMode != 0 {
+		n += 1 + sovTypes(uint64(m.SignMode))
+	}
+	return n
+}
+
+func (m *TxBody) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *EncodedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeURL)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignerInfos) > 0 {
+		for _, e := range m.SignerInfos {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *SignerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SignMode != 0 {
+		n += 1 + sovTypes(uint64(m.SignMode))
+	}
+	return n
+}
+
+func (m *DirectSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BodyBytes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AuthInfoBytes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovTypes(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *TextualSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Screens) > 0 {
+		for _, e := range m.Screens {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TextualScreen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Indent != 0 {
+		n += 1 + sovTypes(uint64(m.Indent))
+	}
+	if m.Expert {
+		n += 2
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMemoCharacters != 0 {
+		n += 1 + sovTypes(uint64(m.MaxMemoCharacters))
+	}
+	if m.TxSigLimit != 0 {
+		n += 1 + sovTypes(uint64(m.TxSigLimit))
+	}
+	if m.TxSizeCostPerByte != 0 {
+		n += 1 + sovTypes(uint64(m.TxSizeCostPerByte))
+	}
+	if m.SigVerifyCostED25519 != 0 {
+		n += 1 + sovTypes(uint64(m.SigVerifyCostED25519))
+	}
+	if m.SigVerifyCostSecp256k1 != 0 {
+		n += 1 + sovTypes(uint64(m.SigVerifyCostSecp256k1))
+	}
+	if m.SigVerifyCostSecp256r1 != 0 {
+		n += 1 + sovTypes(uint64(m.SigVerifyCostSecp256r1))
+	}
+	return n
+}
+
+func (m *StdTxBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *StdSignDocBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovTypes(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *MsgRotatePubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NewPubKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BaseAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StdFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StdSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignMode", wireType)
+			}
+			m.SignMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignMode |= SignMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxBody) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxBody: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxBody: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, EncodedMsg{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncodedMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncodedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncodedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerInfos = append(m.SignerInfos, SignerInfo{})
+			if err := m.SignerInfos[len(m.SignerInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Fee.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return nil
 }
+func (m *SignerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignMode", wireType)
+			}
+			m.SignMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignMode |= SignMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *BaseAccount) Unmarshal(dAtA []byte) error {
+func (m *DirectSignDoc) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -947,19 +2956,53 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BaseAccount: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseAccount: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DirectSignDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DirectSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BodyBytes = append(m.BodyBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.BodyBytes == nil {
+				m.BodyBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfoBytes", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -986,16 +3029,16 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.AuthInfoBytes = append(m.AuthInfoBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.AuthInfoBytes == nil {
+				m.AuthInfoBytes = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1005,27 +3048,25 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
-			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
 			}
@@ -1044,7 +3085,7 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
@@ -1087,7 +3128,7 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StdFee) Unmarshal(dAtA []byte) error {
+func (m *TextualSignDoc) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1110,15 +3151,15 @@ func (m *StdFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StdFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: TextualSignDoc: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StdFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TextualSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Screens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1145,30 +3186,11 @@ func (m *StdFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Screens = append(m.Screens, TextualScreen{})
+			if err := m.Screens[len(m.Screens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
-			}
-			m.Gas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Gas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1193,7 +3215,7 @@ func (m *StdFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StdSignature) Unmarshal(dAtA []byte) error {
+func (m *TextualScreen) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1216,17 +3238,17 @@ func (m *StdSignature) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StdSignature: wiretype end group for non-group")
+			return fmt.Errorf("proto: TextualScreen: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StdSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TextualScreen: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1236,31 +3258,29 @@ func (m *StdSignature) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1270,26 +3290,63 @@ func (m *StdSignature) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
+			m.Content = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indent", wireType)
+			}
+			m.Indent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Indent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expert = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1438,6 +3495,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256r1", wireType)
+			}
+			m.SigVerifyCostSecp256r1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256r1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1802,6 +3878,127 @@ func (m *StdSignDocBase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRotatePubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotatePubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotatePubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPubKey = append(m.NewPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewPubKey == nil {
+				m.NewPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,22 +7,58 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
+const (
+	ModuleName                          = types.ModuleName
+	RouterKey                           = types.RouterKey
+	AttributeValueCategory              = types.AttributeValueCategory
+	TypeMsgCreateVestingAccount         = types.TypeMsgCreateVestingAccount
+	TypeMsgCreatePermanentLockedAccount = types.TypeMsgCreatePermanentLockedAccount
+	TypeMsgCreatePeriodicVestingAccount = types.TypeMsgCreatePeriodicVestingAccount
+	TypeMsgCreateClawbackVestingAccount = types.TypeMsgCreateClawbackVestingAccount
+	TypeMsgClawback                     = types.TypeMsgClawback
+	EventTypeClawback                   = types.EventTypeClawback
+	AttributeKeyFunder                  = types.AttributeKeyFunder
+	AttributeKeyAccount                 = types.AttributeKeyAccount
+	AttributeKeyDestination             = types.AttributeKeyDestination
+)
+
 var (
-	RegisterCodec                  = types.RegisterCodec
-	NewBaseVestingAccount          = types.NewBaseVestingAccount
-	NewContinuousVestingAccountRaw = types.NewContinuousVestingAccountRaw
-	NewContinuousVestingAccount    = types.NewContinuousVestingAccount
-	NewPeriodicVestingAccountRaw   = types.NewPeriodicVestingAccountRaw
-	NewPeriodicVestingAccount      = types.NewPeriodicVestingAccount
-	NewDelayedVestingAccountRaw    = types.NewDelayedVestingAccountRaw
-	NewDelayedVestingAccount       = types.NewDelayedVestingAccount
+	RegisterCodec                      = types.RegisterCodec
+	RegisterMsgCodec                   = types.RegisterMsgCodec
+	RegisterMsgService                 = types.RegisterMsgService
+	NewBaseVestingAccount              = types.NewBaseVestingAccount
+	NewContinuousVestingAccountRaw     = types.NewContinuousVestingAccountRaw
+	NewContinuousVestingAccount        = types.NewContinuousVestingAccount
+	NewPeriodicVestingAccountRaw       = types.NewPeriodicVestingAccountRaw
+	NewPeriodicVestingAccount          = types.NewPeriodicVestingAccount
+	NewDelayedVestingAccountRaw        = types.NewDelayedVestingAccountRaw
+	NewDelayedVestingAccount           = types.NewDelayedVestingAccount
+	NewPermanentLockedAccount          = types.NewPermanentLockedAccount
+	NewClawbackVestingAccount          = types.NewClawbackVestingAccount
+	NewMsgCreateVestingAccount         = types.NewMsgCreateVestingAccount
+	NewMsgCreatePermanentLockedAccount = types.NewMsgCreatePermanentLockedAccount
+	NewMsgCreatePeriodicVestingAccount = types.NewMsgCreatePeriodicVestingAccount
+	NewMsgCreateClawbackVestingAccount = types.NewMsgCreateClawbackVestingAccount
+	NewMsgClawback                     = types.NewMsgClawback
+	ModuleCdc                          = types.ModuleCdc
 )
 
 type (
-	BaseVestingAccount       = types.BaseVestingAccount
-	ContinuousVestingAccount = types.ContinuousVestingAccount
-	PeriodicVestingAccount   = types.PeriodicVestingAccount
-	DelayedVestingAccount    = types.DelayedVestingAccount
-	Period                   = types.Period
-	Periods                  = types.Periods
+	BaseVestingAccount              = types.BaseVestingAccount
+	ContinuousVestingAccount        = types.ContinuousVestingAccount
+	PeriodicVestingAccount          = types.PeriodicVestingAccount
+	DelayedVestingAccount           = types.DelayedVestingAccount
+	PermanentLockedAccount          = types.PermanentLockedAccount
+	ClawbackVestingAccount          = types.ClawbackVestingAccount
+	Period                          = types.Period
+	Periods                         = types.Periods
+	MsgCreateVestingAccount         = types.MsgCreateVestingAccount
+	MsgCreatePermanentLockedAccount = types.MsgCreatePermanentLockedAccount
+	MsgCreatePeriodicVestingAccount = types.MsgCreatePeriodicVestingAccount
+	MsgCreateClawbackVestingAccount = types.MsgCreateClawbackVestingAccount
+	MsgClawback                     = types.MsgClawback
+	MsgServer                       = types.MsgServer
+	AccountKeeper                   = types.AccountKeeper
+	BankKeeper                      = types.BankKeeper
+	StakingKeeper                   = types.StakingKeeper
 )
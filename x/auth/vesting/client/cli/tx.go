@@ -0,0 +1,340 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	bankutils "github.com/cosmos/cosmos-sdk/x/bank/client/utils"
+)
+
+// flags for the vesting module transaction commands
+const (
+	FlagDelayed = "delayed"
+	FlagDest    = "dest"
+)
+
+// GetTxCmd returns the transaction commands for the vesting module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(flags.PostCommands(
+		GetCmdCreateVestingAccount(cdc),
+		GetCmdCreatePermanentLockedAccount(cdc),
+		GetCmdCreatePeriodicVestingAccount(cdc),
+		GetCmdCreateClawbackVestingAccount(cdc),
+		GetCmdClawback(cdc),
+	)...)
+
+	return txCmd
+}
+
+// GetCmdCreateVestingAccount returns a CLI command handler for creating a
+// MsgCreateVestingAccount transaction.
+func GetCmdCreateVestingAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [to_address] [amount] [end_time]",
+		Short: "Create a new vesting account funded with an allocation of tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new vesting account funded with an allocation of tokens from the signer.
+The account vests the tokens continuously from the current block time until the end time, given
+as a UNIX timestamp, or all at once at the end time with the --delayed flag. The account must not
+exist yet.
+
+Example:
+$ %s tx %s create-vesting-account cosmos1skjw.. 1000stake 1735689600 --from=mykey
+$ %s tx %s create-vesting-account cosmos1skjw.. 1000stake 1735689600 --delayed --from=mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := bankutils.ParseCoins(cliCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end time: %w", err)
+			}
+
+			msg := types.NewMsgCreateVestingAccount(
+				cliCtx.GetFromAddress(), toAddr, amount, endTime, viper.GetBool(FlagDelayed),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(FlagDelayed, false, "Vest all the tokens at the end time instead of continuously")
+
+	return cmd
+}
+
+// GetCmdCreatePermanentLockedAccount returns a CLI command handler for creating
+// a MsgCreatePermanentLockedAccount transaction.
+func GetCmdCreatePermanentLockedAccount(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-permanent-locked-account [to_address] [amount]",
+		Short: "Create a new permanent locked account funded with an allocation of tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new permanent locked account funded with an allocation of tokens from the
+signer. The tokens never vest, but they can be delegated and used to pay fees. The account must
+not exist yet.
+
+Example:
+$ %s tx %s create-permanent-locked-account cosmos1skjw.. 1000stake --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := bankutils.ParseCoins(cliCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePermanentLockedAccount(cliCtx.GetFromAddress(), toAddr, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCreatePeriodicVestingAccount returns a CLI command handler for creating
+// a MsgCreatePeriodicVestingAccount transaction.
+func GetCmdCreatePeriodicVestingAccount(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [periods_file]",
+		Short: "Create a new periodic vesting account funded with the tokens of its vesting periods",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new periodic vesting account funded with the tokens of its vesting periods
+from the signer. The start time, as a UNIX timestamp, and the periods are read from a JSON file.
+Each period vests its coins once its length, in seconds, has elapsed after the end of the
+previous period. The account must not exist yet.
+
+Example:
+$ %s tx %s create-periodic-vesting-account cosmos1skjw.. periods.json --from=mykey
+
+Where periods.json contains:
+
+{
+  "start_time": 1735689600,
+  "periods": [
+    {
+      "coins": "100stake",
+      "length_seconds": 2592000
+    },
+    {
+      "coins": "200stake",
+      "length_seconds": 2592000
+    }
+  ]
+}
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			periodsJSON, err := ParseVestingPeriodsJSON(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			periods, err := periodsJSON.ToPeriods(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(cliCtx.GetFromAddress(), toAddr, periodsJSON.StartTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCreateClawbackVestingAccount returns a CLI command handler for creating
+// a MsgCreateClawbackVestingAccount transaction.
+func GetCmdCreateClawbackVestingAccount(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [periods_file]",
+		Short: "Create a new clawback vesting account funded with the tokens of its vesting periods",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new clawback vesting account funded with the tokens of its vesting periods
+from the signer, who becomes its funder. The start time, as a UNIX timestamp, the lockup periods
+and the vesting periods are read from a JSON file. The tokens are spendable once both vested and
+unlocked, and the funder can claw back the tokens which have not vested. Without lockup periods,
+the tokens are unlocked as they vest; without vesting periods, they are all vested at the start
+time. The account must not exist yet.
+
+Example:
+$ %s tx %s create-clawback-vesting-account cosmos1skjw.. periods.json --from=mykey
+
+Where periods.json contains:
+
+{
+  "start_time": 1735689600,
+  "lockup_periods": [
+    {
+      "coins": "300stake",
+      "length_seconds": 31536000
+    }
+  ],
+  "vesting_periods": [
+    {
+      "coins": "100stake",
+      "length_seconds": 15768000
+    },
+    {
+      "coins": "200stake",
+      "length_seconds": 15768000
+    }
+  ]
+}
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			periodsJSON, err := ParseClawbackPeriodsJSON(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			lockupPeriods, vestingPeriods, err := periodsJSON.ToPeriods(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(
+				cliCtx.GetFromAddress(), toAddr, periodsJSON.StartTime, lockupPeriods, vestingPeriods,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdClawback returns a CLI command handler for creating a MsgClawback
+// transaction.
+func GetCmdClawback(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested tokens of a clawback vesting account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claw back the tokens of a clawback vesting account which have not vested yet. The
+signer must be the funder of the account. The tokens are returned to the funder, or to the address
+given by the --dest flag, first from the balance of the account and then by moving its unbonding
+and bonded delegations. The vesting schedule of the account ends with its last vested period.
+
+Example:
+$ %s tx %s clawback cosmos1skjw.. --from=mykey
+$ %s tx %s clawback cosmos1skjw.. --dest=cosmos1gghj.. --from=mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var destAddr sdk.AccAddress
+			if dest := viper.GetString(FlagDest); dest != "" {
+				destAddr, err = sdk.AccAddressFromBech32(dest)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(cliCtx.GetFromAddress(), addr, destAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address to return the tokens to instead of the funder")
+
+	return cmd
+}
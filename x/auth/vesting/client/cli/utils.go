@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	bankutils "github.com/cosmos/cosmos-sdk/x/bank/client/utils"
+)
+
+type (
+	// VestingPeriodsJSON defines the start time and vesting periods of a
+	// periodic vesting account, read from a JSON file.
+	VestingPeriodsJSON struct {
+		StartTime int64        `json:"start_time" yaml:"start_time"`
+		Periods   []PeriodJSON `json:"periods" yaml:"periods"`
+	}
+
+	// ClawbackPeriodsJSON defines the start time, lockup periods and vesting
+	// periods of a clawback vesting account, read from a JSON file.
+	ClawbackPeriodsJSON struct {
+		StartTime      int64        `json:"start_time" yaml:"start_time"`
+		LockupPeriods  []PeriodJSON `json:"lockup_periods" yaml:"lockup_periods"`
+		VestingPeriods []PeriodJSON `json:"vesting_periods" yaml:"vesting_periods"`
+	}
+
+	// PeriodJSON defines a vesting, or lockup, period read from a JSON file,
+	// whose coins may be expressed in any unit of a denomination with metadata.
+	PeriodJSON struct {
+		Coins  string `json:"coins" yaml:"coins"`
+		Length int64  `json:"length_seconds" yaml:"length_seconds"`
+	}
+)
+
+// ParseVestingPeriodsJSON reads and parses the start time and vesting periods
+// of a periodic vesting account from a JSON file.
+func ParseVestingPeriodsJSON(cdc *codec.Codec, periodsFile string) (VestingPeriodsJSON, error) {
+	periods := VestingPeriodsJSON{}
+
+	contents, err := ioutil.ReadFile(periodsFile)
+	if err != nil {
+		return periods, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &periods); err != nil {
+		return periods, err
+	}
+
+	return periods, nil
+}
+
+// ToPeriods converts the vesting periods to Periods, parsing their coins.
+func (vp VestingPeriodsJSON) ToPeriods(cliCtx context.CLIContext) (types.Periods, error) {
+	return parsePeriods(cliCtx, vp.Periods)
+}
+
+// ParseClawbackPeriodsJSON reads and parses the start time, lockup periods and
+// vesting periods of a clawback vesting account from a JSON file.
+func ParseClawbackPeriodsJSON(cdc *codec.Codec, periodsFile string) (ClawbackPeriodsJSON, error) {
+	periods := ClawbackPeriodsJSON{}
+
+	contents, err := ioutil.ReadFile(periodsFile)
+	if err != nil {
+		return periods, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &periods); err != nil {
+		return periods, err
+	}
+
+	return periods, nil
+}
+
+// ToPeriods converts the lockup and vesting periods to Periods, parsing their
+// coins.
+func (cp ClawbackPeriodsJSON) ToPeriods(cliCtx context.CLIContext) (lockupPeriods, vestingPeriods types.Periods, err error) {
+	lockupPeriods, err = parsePeriods(cliCtx, cp.LockupPeriods)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vestingPeriods, err = parsePeriods(cliCtx, cp.VestingPeriods)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return lockupPeriods, vestingPeriods, nil
+}
+
+func parsePeriods(cliCtx context.CLIContext, periodsJSON []PeriodJSON) (types.Periods, error) {
+	periods := make(types.Periods, len(periodsJSON))
+	for i, p := range periodsJSON {
+		amount, err := bankutils.ParseCoins(cliCtx, p.Coins)
+		if err != nil {
+			return nil, err
+		}
+
+		periods[i] = types.Period{Length: p.Length, Amount: amount}
+	}
+
+	return periods, nil
+}
@@ -0,0 +1,12 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// RegisterRoutes registers the REST routes of the vesting module.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerTxRoutes(cliCtx, r)
+}
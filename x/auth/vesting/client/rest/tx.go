@@ -0,0 +1,194 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/vesting/vesting_accounts/{address}",
+		createVestingAccountHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/vesting/permanent_locked_accounts/{address}",
+		createPermanentLockedAccountHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/vesting/periodic_vesting_accounts/{address}",
+		createPeriodicVestingAccountHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/vesting/clawback_vesting_accounts/{address}",
+		createClawbackVestingAccountHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/vesting/clawback/{address}",
+		clawbackHandlerFn(cliCtx),
+	).Methods("POST")
+}
+
+type (
+	// CreateVestingAccountReq defines the properties of a create vesting
+	// account request's body.
+	CreateVestingAccountReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Amount  sdk.Coins    `json:"amount" yaml:"amount"`
+		EndTime int64        `json:"end_time" yaml:"end_time"`
+		Delayed bool         `json:"delayed" yaml:"delayed"`
+	}
+
+	// CreatePermanentLockedAccountReq defines the properties of a create
+	// permanent locked account request's body.
+	CreatePermanentLockedAccountReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Amount  sdk.Coins    `json:"amount" yaml:"amount"`
+	}
+
+	// CreatePeriodicVestingAccountReq defines the properties of a create
+	// periodic vesting account request's body.
+	CreatePeriodicVestingAccountReq struct {
+		BaseReq        rest.BaseReq  `json:"base_req" yaml:"base_req"`
+		StartTime      int64         `json:"start_time" yaml:"start_time"`
+		VestingPeriods types.Periods `json:"vesting_periods" yaml:"vesting_periods"`
+	}
+
+	// CreateClawbackVestingAccountReq defines the properties of a create
+	// clawback vesting account request's body.
+	CreateClawbackVestingAccountReq struct {
+		BaseReq        rest.BaseReq  `json:"base_req" yaml:"base_req"`
+		StartTime      int64         `json:"start_time" yaml:"start_time"`
+		LockupPeriods  types.Periods `json:"lockup_periods" yaml:"lockup_periods"`
+		VestingPeriods types.Periods `json:"vesting_periods" yaml:"vesting_periods"`
+	}
+
+	// ClawbackReq defines the properties of a clawback request's body.
+	ClawbackReq struct {
+		BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		DestAddress sdk.AccAddress `json:"dest_address" yaml:"dest_address"`
+	}
+)
+
+func createVestingAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateVestingAccountReq
+		fromAddr, toAddr, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCreateVestingAccount(fromAddr, toAddr, req.Amount, req.EndTime, req.Delayed)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func createPermanentLockedAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreatePermanentLockedAccountReq
+		fromAddr, toAddr, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCreatePermanentLockedAccount(fromAddr, toAddr, req.Amount)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func createPeriodicVestingAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreatePeriodicVestingAccountReq
+		fromAddr, toAddr, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr, req.StartTime, req.VestingPeriods)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func createClawbackVestingAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateClawbackVestingAccountReq
+		fromAddr, toAddr, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCreateClawbackVestingAccount(
+			fromAddr, toAddr, req.StartTime, req.LockupPeriods, req.VestingPeriods,
+		)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func clawbackHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ClawbackReq
+		funderAddr, addr, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgClawback(funderAddr, addr, req.DestAddress)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// readTxReq reads the body of a request into req, whose base request is
+// baseReq, and returns the address of the sender along with the address of the
+// account to create, given by the path of the request. A failure is written to
+// the response. For a clawback, the address in the path is that of the
+// vesting account to claw back from.
+func readTxReq(
+	w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, req interface{}, baseReq *rest.BaseReq,
+) (sdk.AccAddress, sdk.AccAddress, bool) {
+	toAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+	if rest.CheckBadRequestError(w, err) {
+		return nil, nil, false
+	}
+
+	if !rest.ReadRESTReq(w, r, cliCtx.Codec, req) {
+		return nil, nil, false
+	}
+
+	*baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return nil, nil, false
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+	if rest.CheckBadRequestError(w, err) {
+		return nil, nil, false
+	}
+
+	return fromAddr, toAddr, true
+}
@@ -0,0 +1,42 @@
+package vesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// NewHandler returns a handler for "vesting" type messages. It is kept for the
+// legacy route of the module and dispatches to the vesting Msg service.
+func NewHandler(ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) sdk.Handler {
+	msgServer := NewMsgServerImpl(ak, bk, sk)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgCreateVestingAccount:
+			res, err := msgServer.CreateVestingAccount(sdk.WrapSDKContext(ctx), &msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case types.MsgCreatePermanentLockedAccount:
+			res, err := msgServer.CreatePermanentLockedAccount(sdk.WrapSDKContext(ctx), &msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case types.MsgCreatePeriodicVestingAccount:
+			res, err := msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(ctx), &msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case types.MsgCreateClawbackVestingAccount:
+			res, err := msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(ctx), &msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case types.MsgClawback:
+			res, err := msgServer.Clawback(sdk.WrapSDKContext(ctx), &msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
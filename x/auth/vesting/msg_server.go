@@ -0,0 +1,264 @@
+package vesting
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type msgServer struct {
+	ak types.AccountKeeper
+	bk types.BankKeeper
+	sk types.StakingKeeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the vesting Msg service backed
+// by the given account, bank and staking keepers.
+func NewMsgServerImpl(ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return msgServer{ak: ak, bk: bk, sk: sk}
+}
+
+// CreateVestingAccount implements the Msg/CreateVestingAccount method.
+func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCreateVestingAccount) (*types.MsgCreateVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	baseAccount, err := s.newBaseAccount(ctx, msg.ToAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	baseVestingAccount := types.NewBaseVestingAccount(baseAccount, msg.Amount.Sort(), msg.EndTime)
+
+	var acc authexported.Account
+	if msg.Delayed {
+		acc = types.NewDelayedVestingAccountRaw(baseVestingAccount)
+	} else {
+		acc = types.NewContinuousVestingAccountRaw(baseVestingAccount, ctx.BlockTime().Unix())
+	}
+
+	if err := s.fundAccount(ctx, acc, msg.FromAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateVestingAccountResponse{}, nil
+}
+
+// CreatePermanentLockedAccount implements the Msg/CreatePermanentLockedAccount
+// method.
+func (s msgServer) CreatePermanentLockedAccount(goCtx context.Context, msg *types.MsgCreatePermanentLockedAccount) (*types.MsgCreatePermanentLockedAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	baseAccount, err := s.newBaseAccount(ctx, msg.ToAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := types.NewPermanentLockedAccount(baseAccount, msg.Amount.Sort())
+	if err := s.fundAccount(ctx, acc, msg.FromAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePermanentLockedAccountResponse{}, nil
+}
+
+// CreatePeriodicVestingAccount implements the Msg/CreatePeriodicVestingAccount
+// method.
+func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *types.MsgCreatePeriodicVestingAccount) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amount := msg.GetTotalAmount()
+	baseAccount, err := s.newBaseAccount(ctx, msg.ToAddress, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := types.NewPeriodicVestingAccount(baseAccount, amount, msg.StartTime, msg.VestingPeriods)
+	if err := s.fundAccount(ctx, acc, msg.FromAddress, amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+// CreateClawbackVestingAccount implements the Msg/CreateClawbackVestingAccount
+// method. The sender becomes the funder of the new account.
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lockupPeriods, vestingPeriods := msg.GetSchedules()
+	amount := vestingPeriods.TotalAmount()
+	baseAccount, err := s.newBaseAccount(ctx, msg.ToAddress, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := types.NewClawbackVestingAccount(
+		baseAccount, msg.FromAddress, amount, msg.StartTime, lockupPeriods, vestingPeriods,
+	)
+	if err := s.fundAccount(ctx, acc, msg.FromAddress, amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+// Clawback implements the Msg/Clawback method. The vesting schedule of the
+// account is truncated to the periods which have vested and its unvested coins
+// are returned to the destination.
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acc := s.ak.GetAccount(ctx, msg.Address)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+	if !va.FunderAddress.Equals(msg.FunderAddress) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of account %s", msg.FunderAddress, msg.Address)
+	}
+
+	dest := msg.GetDestination()
+	if s.bk.BlacklistedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", dest)
+	}
+
+	clawedBack, err := s.clawback(ctx, va, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, dest.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, clawedBack.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// clawback moves the unvested coins of the account to the destination and
+// returns the amount moved. The coins are taken from the balance of the account
+// first, then from its unbonding delegations and finally from its delegations,
+// which are moved to the destination with their remaining time or shares. The
+// unvested coins lost to slashing cannot be clawed back.
+func (s msgServer) clawback(ctx sdk.Context, va *types.ClawbackVestingAccount, dest sdk.AccAddress) (sdk.Coins, error) {
+	addr := va.GetAddress()
+	bondDenom := s.sk.BondDenom(ctx)
+
+	toClawBack := va.ComputeClawback(ctx.BlockTime().Unix())
+	bonded := sdk.NewCoins(sdk.NewCoin(bondDenom, s.sk.GetDelegatorBonded(ctx, addr)))
+	unbonding := sdk.NewCoins(sdk.NewCoin(bondDenom, s.sk.GetDelegatorUnbonding(ctx, addr)))
+	unbonded := s.bk.GetAllBalances(ctx, addr)
+	toClawBack = va.UpdateDelegation(va.GetVestingCoins(ctx.BlockTime()), toClawBack, bonded, unbonding, unbonded)
+
+	// store the account first, so that its unvested coins are no longer locked
+	s.ak.SetAccount(ctx, va)
+
+	clawedBack := toClawBack.Min(s.bk.SpendableCoins(ctx, addr))
+	if !clawedBack.IsZero() {
+		if err := s.bk.SendCoins(ctx, addr, dest, clawedBack); err != nil {
+			return nil, err
+		}
+	}
+
+	want := toClawBack.AmountOf(bondDenom).Sub(clawedBack.AmountOf(bondDenom))
+	moved, delegated := sdk.ZeroInt(), sdk.ZeroDec()
+
+	for _, ubd := range s.sk.GetAllUnbondingDelegations(ctx, addr) {
+		if !want.IsPositive() {
+			break
+		}
+
+		transferred := s.sk.TransferUnbonding(ctx, addr, dest, ubd.ValidatorAddress, want)
+		want = want.Sub(transferred)
+		moved = moved.Add(transferred)
+	}
+
+	for _, delegation := range s.sk.GetAllDelegatorDelegations(ctx, addr) {
+		if !want.IsPositive() {
+			break
+		}
+
+		validator, found := s.sk.GetValidator(ctx, delegation.ValidatorAddress)
+		if !found {
+			continue
+		}
+
+		wantShares, err := validator.SharesFromTokensTruncated(want)
+		if err != nil {
+			continue
+		}
+
+		// the tokens of the moved shares are rounded up to count what is left to
+		// claw back, so as not to claw back more than wanted, while the amount
+		// moved sums the tokens of the shares actually transferred, as counted
+		// by GetDelegatorBonded
+		shares := s.sk.TransferDelegation(ctx, addr, dest, delegation.ValidatorAddress, wantShares)
+		want = want.Sub(sdk.MinInt(validator.TokensFromSharesRoundUp(shares).RoundInt(), want))
+		delegated = delegated.Add(validator.TokensFromSharesTruncated(shares))
+	}
+
+	moved = moved.Add(delegated.RoundInt())
+	if moved.IsPositive() {
+		clawedBack = clawedBack.Add(sdk.NewCoin(bondDenom, moved))
+	}
+
+	return clawedBack, nil
+}
+
+// newBaseAccount returns a new base account for the given address, which must
+// not have an account yet, once checked that it can receive the given amount.
+func (s msgServer) newBaseAccount(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) (*authtypes.BaseAccount, error) {
+	if err := s.bk.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+	if s.bk.BlacklistedAddr(addr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", addr)
+	}
+	if s.ak.GetAccount(ctx, addr) != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", addr)
+	}
+
+	baseAccount, ok := s.ak.NewAccountWithAddress(ctx, addr).(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount")
+	}
+
+	return baseAccount, nil
+}
+
+// fundAccount stores the new vesting account and funds it from the sender.
+func (s msgServer) fundAccount(ctx sdk.Context, acc authexported.Account, fromAddr sdk.AccAddress, amount sdk.Coins) error {
+	s.ak.SetAccount(ctx, acc)
+
+	if err := s.bk.SendCoins(ctx, fromAddr, acc.GetAddress(), amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+type MsgServerTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	from      sdk.AccAddress
+	balance   sdk.Coins
+}
+
+func (suite *MsgServerTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	suite.msgServer = vesting.NewMsgServerImpl(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.StakingKeeper)
+
+	_, _, suite.from = authtypes.KeyTestPubAddr()
+	suite.balance = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, suite.from))
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(suite.ctx, suite.from, suite.balance))
+}
+
+func (suite *MsgServerTestSuite) TestCreateVestingAccount() {
+	app, ctx := suite.app, suite.ctx
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	endTime := ctx.BlockTime().Unix() + 1000
+
+	_, _, to := authtypes.KeyTestPubAddr()
+	msg := types.NewMsgCreateVestingAccount(suite.from, to, amount, endTime, false)
+	_, err := suite.msgServer.CreateVestingAccount(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+
+	acc, ok := app.AccountKeeper.GetAccount(ctx, to).(*types.ContinuousVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(amount, acc.GetOriginalVesting())
+	suite.Require().Equal(ctx.BlockTime().Unix(), acc.GetStartTime())
+	suite.Require().Equal(endTime, acc.GetEndTime())
+	suite.Require().Equal(amount, app.BankKeeper.GetAllBalances(ctx, to))
+	suite.Require().Equal(suite.balance.Sub(amount), app.BankKeeper.GetAllBalances(ctx, suite.from))
+
+	// the account must not exist yet
+	_, err = suite.msgServer.CreateVestingAccount(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().Error(err)
+
+	_, _, to = authtypes.KeyTestPubAddr()
+	msg = types.NewMsgCreateVestingAccount(suite.from, to, amount, endTime, true)
+	_, err = suite.msgServer.CreateVestingAccount(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+
+	delayedAcc, ok := app.AccountKeeper.GetAccount(ctx, to).(*types.DelayedVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(endTime, delayedAcc.GetEndTime())
+	suite.Require().True(app.BankKeeper.SpendableCoins(ctx, to).IsZero())
+}
+
+func (suite *MsgServerTestSuite) TestCreatePermanentLockedAccount() {
+	app, ctx := suite.app, suite.ctx
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	_, _, to := authtypes.KeyTestPubAddr()
+	msg := types.NewMsgCreatePermanentLockedAccount(suite.from, to, amount)
+	_, err := suite.msgServer.CreatePermanentLockedAccount(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+
+	acc, ok := app.AccountKeeper.GetAccount(ctx, to).(*types.PermanentLockedAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(amount, acc.GetOriginalVesting())
+	suite.Require().Equal(amount, app.BankKeeper.GetAllBalances(ctx, to))
+	suite.Require().True(app.BankKeeper.SpendableCoins(ctx, to).IsZero())
+
+	// the sender must have enough coins
+	_, _, to = authtypes.KeyTestPubAddr()
+	msg = types.NewMsgCreatePermanentLockedAccount(suite.from, to, suite.balance)
+	_, err = suite.msgServer.CreatePermanentLockedAccount(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().Error(err)
+}
+
+func (suite *MsgServerTestSuite) TestCreatePeriodicVestingAccount() {
+	app, ctx := suite.app, suite.ctx
+	periods := types.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+		{Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 200))},
+	}
+	startTime := ctx.BlockTime().Unix()
+
+	_, _, to := authtypes.KeyTestPubAddr()
+	msg := types.NewMsgCreatePeriodicVestingAccount(suite.from, to, startTime, periods)
+	_, err := suite.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+
+	acc, ok := app.AccountKeeper.GetAccount(ctx, to).(*types.PeriodicVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().NoError(acc.Validate())
+	suite.Require().Equal(startTime+300, acc.GetEndTime())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 300)), app.BankKeeper.GetAllBalances(ctx, to))
+}
+
+func (suite *MsgServerTestSuite) TestCreateVestingAccountRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	endTime := ctx.BlockTime().Unix() + 1000
+
+	// module accounts cannot receive coins
+	blacklisted := supply.NewModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().True(app.BankKeeper.BlacklistedAddr(blacklisted))
+	msg := types.NewMsgCreateVestingAccount(suite.from, blacklisted, amount, endTime, false)
+	_, err := suite.msgServer.CreateVestingAccount(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().Error(err)
+
+	// the coins must be sendable
+	app.BankKeeper.SetParams(ctx, banktypes.NewParams(true, banktypes.NewSendEnabled("stake", false)))
+	_, _, to := authtypes.KeyTestPubAddr()
+	msg = types.NewMsgCreateVestingAccount(suite.from, to, amount, endTime, false)
+	_, err = suite.msgServer.CreateVestingAccount(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().Error(err)
+	suite.Require().Nil(app.AccountKeeper.GetAccount(ctx, to))
+}
+
+func (suite *MsgServerTestSuite) TestCreateClawbackVestingAccount() {
+	app, ctx := suite.app, suite.ctx
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 400))
+	vestingPeriods := types.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 300))},
+	}
+	startTime := ctx.BlockTime().Unix()
+
+	_, _, to := authtypes.KeyTestPubAddr()
+	msg := types.NewMsgCreateClawbackVestingAccount(suite.from, to, startTime, nil, vestingPeriods)
+	_, err := suite.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+
+	acc, ok := app.AccountKeeper.GetAccount(ctx, to).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().NoError(acc.Validate())
+	suite.Require().Equal(suite.from, acc.FunderAddress)
+	suite.Require().Equal(amount, acc.GetOriginalVesting())
+	suite.Require().Equal(startTime+200, acc.GetEndTime())
+	suite.Require().Equal([]types.Period{{Length: 0, Amount: amount}}, acc.LockupPeriods)
+	suite.Require().Equal(amount, app.BankKeeper.GetAllBalances(ctx, to))
+}
+
+func (suite *MsgServerTestSuite) TestClawback() {
+	app := suite.app
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000000, 0))
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	startTime := ctx.BlockTime().Unix()
+
+	// create a bonded validator to delegate to
+	valAddr := sdk.ValAddress(suite.from)
+	validator := staking.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), staking.Description{})
+	validator = stakingkeeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.AfterValidatorCreated(ctx, valAddr)
+
+	lockupPeriods := types.Periods{{Length: 300, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 400))}}
+	vestingPeriods := types.Periods{}
+	for i := 0; i < 4; i++ {
+		vestingPeriods = append(vestingPeriods, types.Period{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))})
+	}
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	createMsg := types.NewMsgCreateClawbackVestingAccount(suite.from, addr, startTime, lockupPeriods, vestingPeriods)
+	_, err := suite.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(ctx), &createMsg)
+	suite.Require().NoError(err)
+
+	// delegate most of the vesting coins
+	_, err = app.StakingKeeper.Delegate(ctx, addr, sdk.NewInt(300), sdk.Unbonded, validator, true)
+	suite.Require().NoError(err)
+
+	// only the funder can claw back
+	ctx = ctx.WithBlockTime(time.Unix(startTime+150, 0))
+	msg := types.NewMsgClawback(addr, addr, nil)
+	_, err = suite.msgServer.Clawback(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().Error(err)
+
+	// the three unvested periods are clawed back, from the balance first and
+	// then by moving the delegation
+	_, _, dest := authtypes.KeyTestPubAddr()
+	msg = types.NewMsgClawback(suite.from, addr, dest)
+	_, err = suite.msgServer.Clawback(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+
+	acc, ok := app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().NoError(acc.Validate())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), acc.GetOriginalVesting())
+	suite.Require().Equal(startTime+300, acc.GetEndTime())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), acc.GetDelegatedVesting())
+	suite.Require().True(acc.GetDelegatedFree().IsZero())
+
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr).IsZero())
+	suite.Require().Equal(sdk.NewInt(100), app.StakingKeeper.GetDelegatorBonded(ctx, addr))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), app.BankKeeper.GetAllBalances(ctx, dest))
+	suite.Require().Equal(sdk.NewInt(200), app.StakingKeeper.GetDelegatorBonded(ctx, dest))
+
+	// nothing is left to claw back once unlocked
+	ctx = ctx.WithBlockTime(time.Unix(startTime+300, 0))
+	_, err = suite.msgServer.Clawback(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), app.StakingKeeper.GetDelegatorBonded(ctx, addr))
+
+	// only clawback vesting accounts can be clawed back
+	msg = types.NewMsgClawback(suite.from, suite.from, nil)
+	_, err = suite.msgServer.Clawback(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().Error(err)
+}
+
+func (suite *MsgServerTestSuite) TestClawbackAmountMoved() {
+	app := suite.app
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000000, 0))
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	startTime := ctx.BlockTime().Unix()
+
+	vestingPeriods := types.Periods{{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 400))}}
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	createMsg := types.NewMsgCreateClawbackVestingAccount(suite.from, addr, startTime, nil, vestingPeriods)
+	_, err := suite.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(ctx), &createMsg)
+	suite.Require().NoError(err)
+
+	// delegate to three validators whose shares are no longer worth a whole
+	// number of tokens, the delegation of the account to each being worth 99.67
+	for i := 0; i < 3; i++ {
+		_, _, operator := authtypes.KeyTestPubAddr()
+		valAddr := sdk.ValAddress(operator)
+		validator := staking.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), staking.Description{})
+		validator = stakingkeeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+		app.StakingKeeper.AfterValidatorCreated(ctx, valAddr)
+
+		_, err = app.StakingKeeper.Delegate(ctx, suite.from, sdk.NewInt(200), sdk.Unbonded, validator, true)
+		suite.Require().NoError(err)
+		validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+		_, err = app.StakingKeeper.Delegate(ctx, addr, sdk.NewInt(100), sdk.Unbonded, validator, true)
+		suite.Require().NoError(err)
+
+		validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+		app.StakingKeeper.RemoveValidatorTokens(ctx, validator, sdk.NewInt(1))
+	}
+
+	_, _, dest := authtypes.KeyTestPubAddr()
+	msg := types.NewMsgClawback(suite.from, addr, dest)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.Clawback(sdk.WrapSDKContext(ctx), &msg)
+	suite.Require().NoError(err)
+
+	// the amount clawed back is the one actually moved to the destination
+	moved := app.BankKeeper.GetAllBalances(ctx, dest).AmountOf(bondDenom).Add(app.StakingKeeper.GetDelegatorBonded(ctx, dest))
+	suite.Require().Equal(sdk.NewInt(398), moved)
+
+	var clawedBack string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeClawback {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == sdk.AttributeKeyAmount {
+				clawedBack = string(attr.Value)
+			}
+		}
+	}
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, moved)).String(), clawedBack)
+}
+
+func TestMsgServerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgServerTestSuite))
+}
@@ -0,0 +1,304 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateVestingAccount         = "op_weight_msg_create_vesting_account"
+	OpWeightMsgCreatePermanentLockedAccount = "op_weight_msg_create_permanent_locked_account"
+	OpWeightMsgCreatePeriodicVestingAccount = "op_weight_msg_create_periodic_vesting_account"
+	OpWeightMsgCreateClawbackVestingAccount = "op_weight_msg_create_clawback_vesting_account"
+	OpWeightMsgClawback                     = "op_weight_msg_clawback"
+)
+
+// maxVestingDuration is the maximum duration of the simulated vesting schedules.
+const maxVestingDuration = 365 * 24 * time.Hour
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc *codec.Codec, ak types.AccountKeeper, bk types.BankKeeper,
+) simulation.WeightedOperations {
+
+	var weightMsgCreateVestingAccount int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateVestingAccount, &weightMsgCreateVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateVestingAccount = simappparams.DefaultWeightMsgCreateVestingAccount
+		},
+	)
+
+	var weightMsgCreatePermanentLockedAccount int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreatePermanentLockedAccount, &weightMsgCreatePermanentLockedAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreatePermanentLockedAccount = simappparams.DefaultWeightMsgCreatePermanentLockedAccount
+		},
+	)
+
+	var weightMsgCreatePeriodicVestingAccount int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreatePeriodicVestingAccount, &weightMsgCreatePeriodicVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreatePeriodicVestingAccount = simappparams.DefaultWeightMsgCreatePeriodicVestingAccount
+		},
+	)
+
+	var weightMsgCreateClawbackVestingAccount int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateClawbackVestingAccount, &weightMsgCreateClawbackVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateClawbackVestingAccount = simappparams.DefaultWeightMsgCreateClawbackVestingAccount
+		},
+	)
+
+	var weightMsgClawback int
+	appParams.GetOrGenerate(cdc, OpWeightMsgClawback, &weightMsgClawback, nil,
+		func(_ *rand.Rand) {
+			weightMsgClawback = simappparams.DefaultWeightMsgClawback
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateVestingAccount,
+			SimulateMsgCreateVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreatePermanentLockedAccount,
+			SimulateMsgCreatePermanentLockedAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreatePeriodicVestingAccount,
+			SimulateMsgCreatePeriodicVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateClawbackVestingAccount,
+			SimulateMsgCreateClawbackVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClawback,
+			SimulateMsgClawback(ak, bk),
+		),
+	}
+}
+
+// SimulateMsgCreateVestingAccount generates a MsgCreateVestingAccount with
+// random values, creating a continuous or delayed vesting account for a new
+// address funded with some of the sender's coins.
+func SimulateMsgCreateVestingAccount(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, toAddr, amount, skip := randomCreateFields(r, ctx, accs, ak, bk)
+		if skip {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		endTime := ctx.BlockTime().Add(randomDuration(r)).Unix()
+		msg := types.NewMsgCreateVestingAccount(from.Address, toAddr, amount, endTime, r.Intn(2) == 0)
+
+		return deliverCreateMsg(r, app, ctx, ak, bk, chainID, from, amount, msg)
+	}
+}
+
+// SimulateMsgCreatePermanentLockedAccount generates a
+// MsgCreatePermanentLockedAccount with random values, creating a permanent
+// locked account for a new address funded with some of the sender's coins.
+func SimulateMsgCreatePermanentLockedAccount(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, toAddr, amount, skip := randomCreateFields(r, ctx, accs, ak, bk)
+		if skip {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgCreatePermanentLockedAccount(from.Address, toAddr, amount)
+
+		return deliverCreateMsg(r, app, ctx, ak, bk, chainID, from, amount, msg)
+	}
+}
+
+// SimulateMsgCreatePeriodicVestingAccount generates a
+// MsgCreatePeriodicVestingAccount with random values, creating a periodic
+// vesting account for a new address whose periods split some of the sender's
+// coins.
+func SimulateMsgCreatePeriodicVestingAccount(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, toAddr, amount, skip := randomCreateFields(r, ctx, accs, ak, bk)
+		if skip {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		periods := randomPeriods(r, amount)
+		msg := types.NewMsgCreatePeriodicVestingAccount(from.Address, toAddr, ctx.BlockTime().Unix(), periods)
+
+		return deliverCreateMsg(r, app, ctx, ak, bk, chainID, from, amount, msg)
+	}
+}
+
+// SimulateMsgCreateClawbackVestingAccount generates a
+// MsgCreateClawbackVestingAccount with random values, creating a clawback
+// vesting account for a new address whose lockup and vesting periods split
+// some of the sender's coins. Either schedule may be left empty.
+func SimulateMsgCreateClawbackVestingAccount(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, toAddr, amount, skip := randomCreateFields(r, ctx, accs, ak, bk)
+		if skip {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		var lockupPeriods, vestingPeriods types.Periods
+		switch r.Intn(3) {
+		case 0:
+			lockupPeriods = randomPeriods(r, amount)
+		case 1:
+			vestingPeriods = randomPeriods(r, amount)
+		default:
+			lockupPeriods, vestingPeriods = randomPeriods(r, amount), randomPeriods(r, amount)
+		}
+
+		msg := types.NewMsgCreateClawbackVestingAccount(
+			from.Address, toAddr, ctx.BlockTime().Unix(), lockupPeriods, vestingPeriods,
+		)
+
+		return deliverCreateMsg(r, app, ctx, ak, bk, chainID, from, amount, msg)
+	}
+}
+
+// SimulateMsgClawback generates a MsgClawback for a random clawback vesting
+// account funded by one of the simulation accounts, returning the unvested
+// coins to the funder or to another random account.
+func SimulateMsgClawback(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var vestingAccs []*types.ClawbackVestingAccount
+		ak.IterateAccounts(ctx, func(acc exported.Account) bool {
+			if va, ok := acc.(*types.ClawbackVestingAccount); ok {
+				if _, found := simtypes.FindAccount(accs, va.FunderAddress); found {
+					vestingAccs = append(vestingAccs, va)
+				}
+			}
+			return false
+		})
+		if len(vestingAccs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		va := vestingAccs[r.Intn(len(vestingAccs))]
+		funder, _ := simtypes.FindAccount(accs, va.FunderAddress)
+
+		var destAddr sdk.AccAddress
+		if r.Intn(2) == 0 {
+			dest, _ := simtypes.RandomAcc(r, accs)
+			destAddr = dest.Address
+		}
+
+		msg := types.NewMsgClawback(funder.Address, va.GetAddress(), destAddr)
+
+		return deliverCreateMsg(r, app, ctx, ak, bk, chainID, funder, sdk.NewCoins(), msg)
+	}
+}
+
+// randomPeriods splits the given coins into a random number of periods of
+// random lengths, the last one holding the remaining coins.
+func randomPeriods(r *rand.Rand, amount sdk.Coins) types.Periods {
+	var periods types.Periods
+	remaining := amount
+	for n := simtypes.RandIntBetween(r, 1, 5); n > 1; n-- {
+		periodAmount := simtypes.RandSubsetCoins(r, remaining)
+		if periodAmount.Empty() || periodAmount.IsEqual(remaining) {
+			break
+		}
+
+		periods = append(periods, types.Period{Length: int64(randomDuration(r).Seconds()), Amount: periodAmount})
+		remaining = remaining.Sub(periodAmount)
+	}
+
+	return append(periods, types.Period{Length: int64(randomDuration(r).Seconds()), Amount: remaining})
+}
+
+// randomCreateFields returns a random sender, the address of a new account and
+// a random amount of the sender's coins which can be sent. The operation is
+// skipped if there are no such coins.
+func randomCreateFields(
+	r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, ak types.AccountKeeper, bk types.BankKeeper,
+) (simtypes.Account, sdk.AccAddress, sdk.Coins, bool) {
+	from, _ := simtypes.RandomAcc(r, accs)
+	toAddr := simtypes.RandomAccounts(r, 1)[0].Address
+	if ak.GetAccount(ctx, toAddr) != nil {
+		return from, toAddr, nil, true
+	}
+
+	// only the coins of the denominations which can be sent are selected
+	var spendable sdk.Coins
+	for _, coin := range bk.SpendableCoins(ctx, from.Address) {
+		if bk.IsSendEnabledCoins(ctx, coin) == nil {
+			spendable = append(spendable, coin)
+		}
+	}
+
+	amount := simtypes.RandSubsetCoins(r, spendable)
+	if amount.Empty() {
+		return from, toAddr, nil, true
+	}
+
+	return from, toAddr, amount, false
+}
+
+// randomDuration returns a random duration of up to maxVestingDuration, in
+// whole seconds.
+func randomDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, int(maxVestingDuration.Seconds()))) * time.Second
+}
+
+// deliverCreateMsg delivers a tx holding the given msg, which sends the given
+// amount from the sender, paying fees out of the rest of its spendable coins.
+// It is also used for clawbacks, which send nothing from the funder.
+func deliverCreateMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	chainID string, from simtypes.Account, amount sdk.Coins, msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, from.Address)
+
+	coins, hasNeg := bk.SpendableCoins(ctx, from.Address).SafeSub(amount)
+	if hasNeg {
+		return simtypes.NoOpMsg(types.ModuleName), nil, nil
+	}
+
+	fees, err := simtypes.RandomFees(r, ctx, coins)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName), nil, err
+	}
+
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		from.PrivKey,
+	)
+
+	_, _, err = app.Deliver(tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
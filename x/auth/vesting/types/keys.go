@@ -0,0 +1,21 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "vesting"
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// AttributeValueCategory defines the module category of the vesting events
+	AttributeValueCategory = ModuleName
+)
+
+// vesting module event types
+const (
+	EventTypeClawback = "clawback"
+
+	AttributeKeyFunder      = "funder"
+	AttributeKeyAccount     = "account"
+	AttributeKeyDestination = "destination"
+)
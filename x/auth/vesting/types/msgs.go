@@ -0,0 +1,319 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// vesting message types
+const (
+	TypeMsgCreateVestingAccount         = "create_vesting_account"
+	TypeMsgCreatePermanentLockedAccount = "create_permanent_locked_account"
+	TypeMsgCreatePeriodicVestingAccount = "create_periodic_vesting_account"
+	TypeMsgCreateClawbackVestingAccount = "create_clawback_vesting_account"
+	TypeMsgClawback                     = "clawback"
+)
+
+var (
+	_ sdk.Msg = MsgCreateVestingAccount{}
+	_ sdk.Msg = MsgCreatePermanentLockedAccount{}
+	_ sdk.Msg = MsgCreatePeriodicVestingAccount{}
+	_ sdk.Msg = MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg = MsgClawback{}
+)
+
+// NewMsgCreateVestingAccount returns a new MsgCreateVestingAccount.
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, endTime int64, delayed bool) MsgCreateVestingAccount {
+	return MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+}
+
+// Route returns the message route for a MsgCreateVestingAccount.
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateVestingAccount.
+func (msg MsgCreateVestingAccount) Type() string { return TypeMsgCreateVestingAccount }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingAccount) ValidateBasic() error {
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
+		return err
+	}
+	if err := validateAmount(msg.Amount); err != nil {
+		return err
+	}
+	if msg.EndTime <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid end time: %d", msg.EndTime)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateVestingAccount.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateVestingAccount.
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// NewMsgCreatePermanentLockedAccount returns a new
+// MsgCreatePermanentLockedAccount.
+func NewMsgCreatePermanentLockedAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins) MsgCreatePermanentLockedAccount {
+	return MsgCreatePermanentLockedAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+	}
+}
+
+// Route returns the message route for a MsgCreatePermanentLockedAccount.
+func (msg MsgCreatePermanentLockedAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreatePermanentLockedAccount.
+func (msg MsgCreatePermanentLockedAccount) Type() string {
+	return TypeMsgCreatePermanentLockedAccount
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreatePermanentLockedAccount) ValidateBasic() error {
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
+		return err
+	}
+
+	return validateAmount(msg.Amount)
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreatePermanentLockedAccount.
+func (msg MsgCreatePermanentLockedAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreatePermanentLockedAccount.
+func (msg MsgCreatePermanentLockedAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// NewMsgCreatePeriodicVestingAccount returns a new
+// MsgCreatePeriodicVestingAccount.
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods Periods) MsgCreatePeriodicVestingAccount {
+	return MsgCreatePeriodicVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the message route for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) Type() string {
+	return TypeMsgCreatePeriodicVestingAccount
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
+		return err
+	}
+	if msg.StartTime <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time: %d", msg.StartTime)
+	}
+	if len(msg.VestingPeriods) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing vesting periods")
+	}
+
+	return validatePeriods(msg.VestingPeriods)
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreatePeriodicVestingAccount.
+func (msg MsgCreatePeriodicVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// GetTotalAmount returns the sum of the amounts of all the vesting periods,
+// which funds the new account.
+func (msg MsgCreatePeriodicVestingAccount) GetTotalAmount() sdk.Coins {
+	return Periods(msg.VestingPeriods).TotalAmount()
+}
+
+// NewMsgCreateClawbackVestingAccount returns a new
+// MsgCreateClawbackVestingAccount.
+func NewMsgCreateClawbackVestingAccount(
+	fromAddr, toAddr sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods Periods,
+) MsgCreateClawbackVestingAccount {
+	return MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string {
+	return TypeMsgCreateClawbackVestingAccount
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
+		return err
+	}
+	if msg.StartTime <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time: %d", msg.StartTime)
+	}
+	if len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing lockup and vesting periods")
+	}
+	if err := validatePeriods(msg.LockupPeriods); err != nil {
+		return sdkerrors.Wrap(err, "lockup")
+	}
+	if err := validatePeriods(msg.VestingPeriods); err != nil {
+		return sdkerrors.Wrap(err, "vesting")
+	}
+
+	lockupTotal := Periods(msg.LockupPeriods).TotalAmount()
+	vestingTotal := Periods(msg.VestingPeriods).TotalAmount()
+	if len(msg.LockupPeriods) > 0 && len(msg.VestingPeriods) > 0 && !lockupTotal.IsEqual(vestingTotal) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidCoins, "lockup total %s does not match vesting total %s", lockupTotal, vestingTotal,
+		)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// GetSchedules returns the lockup and vesting periods of the new account. An
+// empty schedule is replaced by a single period of zero length, which unlocks
+// or vests the total amount at start time.
+func (msg MsgCreateClawbackVestingAccount) GetSchedules() (lockupPeriods, vestingPeriods Periods) {
+	lockupPeriods, vestingPeriods = msg.LockupPeriods, msg.VestingPeriods
+	if len(lockupPeriods) == 0 {
+		lockupPeriods = Periods{{Length: 0, Amount: vestingPeriods.TotalAmount()}}
+	}
+	if len(vestingPeriods) == 0 {
+		vestingPeriods = Periods{{Length: 0, Amount: lockupPeriods.TotalAmount()}}
+	}
+
+	return lockupPeriods, vestingPeriods
+}
+
+// NewMsgClawback returns a new MsgClawback. The coins are returned to the
+// funder if the destination address is empty.
+func NewMsgClawback(funderAddr, addr, destAddr sdk.AccAddress) MsgClawback {
+	return MsgClawback{
+		FunderAddress: funderAddr,
+		Address:       addr,
+		DestAddress:   destAddr,
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if msg.FunderAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing funder address")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing account address")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FunderAddress}
+}
+
+// GetDestination returns the address receiving the clawed back coins, which is
+// the funder unless a destination address is given.
+func (msg MsgClawback) GetDestination() sdk.AccAddress {
+	if msg.DestAddress.Empty() {
+		return msg.FunderAddress
+	}
+
+	return msg.DestAddress
+}
+
+// RegisterMsgService registers the Msg service of the module on a gRPC server,
+// such as the Msg service router of BaseApp.
+func RegisterMsgService(server sdk.GRPCServer, srv MsgServer) {
+	server.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func validateAddresses(fromAddr, toAddr sdk.AccAddress) error {
+	if fromAddr.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if toAddr.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+
+	return nil
+}
+
+func validateAmount(amount sdk.Coins) error {
+	if !amount.IsValid() || !amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	return nil
+}
+
+func validatePeriods(periods []Period) error {
+	for i, period := range periods {
+		if period.Length <= 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid length of period %d: %d", i, period.Length)
+		}
+		if err := validateAmount(period.Amount); err != nil {
+			return sdkerrors.Wrapf(err, "period %d", i)
+		}
+	}
+
+	return nil
+}
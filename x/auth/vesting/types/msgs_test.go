@@ -0,0 +1,167 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestMsgCreateVestingAccountValidateBasic(t *testing.T) {
+	_, _, from := authtypes.KeyTestPubAddr()
+	_, _, to := authtypes.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+
+	testCases := []struct {
+		name   string
+		msg    types.MsgCreateVestingAccount
+		expErr bool
+	}{
+		{"valid", types.NewMsgCreateVestingAccount(from, to, coins, 1000, false), false},
+		{"valid delayed", types.NewMsgCreateVestingAccount(from, to, coins, 1000, true), false},
+		{"missing sender", types.NewMsgCreateVestingAccount(nil, to, coins, 1000, false), true},
+		{"missing recipient", types.NewMsgCreateVestingAccount(from, nil, coins, 1000, false), true},
+		{"empty amount", types.NewMsgCreateVestingAccount(from, to, sdk.Coins{}, 1000, false), true},
+		{"zero amount", types.NewMsgCreateVestingAccount(from, to, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)}, 1000, false), true},
+		{"zero end time", types.NewMsgCreateVestingAccount(from, to, coins, 0, false), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgCreatePermanentLockedAccountValidateBasic(t *testing.T) {
+	_, _, from := authtypes.KeyTestPubAddr()
+	_, _, to := authtypes.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+
+	testCases := []struct {
+		name   string
+		msg    types.MsgCreatePermanentLockedAccount
+		expErr bool
+	}{
+		{"valid", types.NewMsgCreatePermanentLockedAccount(from, to, coins), false},
+		{"missing sender", types.NewMsgCreatePermanentLockedAccount(nil, to, coins), true},
+		{"missing recipient", types.NewMsgCreatePermanentLockedAccount(from, nil, coins), true},
+		{"empty amount", types.NewMsgCreatePermanentLockedAccount(from, to, sdk.Coins{}), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgCreatePeriodicVestingAccountValidateBasic(t *testing.T) {
+	_, _, from := authtypes.KeyTestPubAddr()
+	_, _, to := authtypes.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+	periods := types.Periods{{Length: 100, Amount: coins}, {Length: 200, Amount: coins}}
+
+	testCases := []struct {
+		name   string
+		msg    types.MsgCreatePeriodicVestingAccount
+		expErr bool
+	}{
+		{"valid", types.NewMsgCreatePeriodicVestingAccount(from, to, 1000, periods), false},
+		{"missing sender", types.NewMsgCreatePeriodicVestingAccount(nil, to, 1000, periods), true},
+		{"missing recipient", types.NewMsgCreatePeriodicVestingAccount(from, nil, 1000, periods), true},
+		{"zero start time", types.NewMsgCreatePeriodicVestingAccount(from, to, 0, periods), true},
+		{"no periods", types.NewMsgCreatePeriodicVestingAccount(from, to, 1000, nil), true},
+		{"zero length", types.NewMsgCreatePeriodicVestingAccount(from, to, 1000, types.Periods{{Length: 0, Amount: coins}}), true},
+		{"empty amount", types.NewMsgCreatePeriodicVestingAccount(from, to, 1000, types.Periods{{Length: 100}}), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+
+	msg := types.NewMsgCreatePeriodicVestingAccount(from, to, 1000, periods)
+	require.Equal(t, coins.Add(coins...), msg.GetTotalAmount())
+}
+
+func TestMsgCreateClawbackVestingAccountValidateBasic(t *testing.T) {
+	_, _, from := authtypes.KeyTestPubAddr()
+	_, _, to := authtypes.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+	periods := types.Periods{{Length: 100, Amount: coins}, {Length: 200, Amount: coins}}
+	lockup := types.Periods{{Length: 300, Amount: coins.Add(coins...)}}
+
+	testCases := []struct {
+		name   string
+		msg    types.MsgCreateClawbackVestingAccount
+		expErr bool
+	}{
+		{"valid", types.NewMsgCreateClawbackVestingAccount(from, to, 1000, lockup, periods), false},
+		{"no lockup", types.NewMsgCreateClawbackVestingAccount(from, to, 1000, nil, periods), false},
+		{"no vesting", types.NewMsgCreateClawbackVestingAccount(from, to, 1000, lockup, nil), false},
+		{"no periods", types.NewMsgCreateClawbackVestingAccount(from, to, 1000, nil, nil), true},
+		{"missing sender", types.NewMsgCreateClawbackVestingAccount(nil, to, 1000, lockup, periods), true},
+		{"missing recipient", types.NewMsgCreateClawbackVestingAccount(from, nil, 1000, lockup, periods), true},
+		{"zero start time", types.NewMsgCreateClawbackVestingAccount(from, to, 0, lockup, periods), true},
+		{"zero length", types.NewMsgCreateClawbackVestingAccount(from, to, 1000, types.Periods{{Length: 0, Amount: coins}}, nil), true},
+		{"mismatched totals", types.NewMsgCreateClawbackVestingAccount(from, to, 1000, periods[:1], periods), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+
+	msg := types.NewMsgCreateClawbackVestingAccount(from, to, 1000, nil, periods)
+	lockupPeriods, vestingPeriods := msg.GetSchedules()
+	require.Equal(t, types.Periods{{Length: 0, Amount: coins.Add(coins...)}}, lockupPeriods)
+	require.Equal(t, periods, vestingPeriods)
+}
+
+func TestMsgClawbackValidateBasic(t *testing.T) {
+	_, _, funder := authtypes.KeyTestPubAddr()
+	_, _, addr := authtypes.KeyTestPubAddr()
+	_, _, dest := authtypes.KeyTestPubAddr()
+
+	testCases := []struct {
+		name   string
+		msg    types.MsgClawback
+		expErr bool
+	}{
+		{"valid", types.NewMsgClawback(funder, addr, dest), false},
+		{"no destination", types.NewMsgClawback(funder, addr, nil), false},
+		{"missing funder", types.NewMsgClawback(nil, addr, dest), true},
+		{"missing account", types.NewMsgClawback(funder, nil, dest), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+
+	require.Equal(t, dest, types.NewMsgClawback(funder, addr, dest).GetDestination())
+	require.Equal(t, funder, types.NewMsgClawback(funder, addr, nil).GetDestination())
+	require.Equal(t, []sdk.AccAddress{funder}, types.NewMsgClawback(funder, addr, dest).GetSigners())
+}
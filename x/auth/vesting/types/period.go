@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Periods stores all vesting periods passed as part of a PeriodicVestingAccount
@@ -26,3 +28,39 @@ func (vp Periods) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
 		%s`, strings.Join(periodsListString, ", ")))
 }
+
+// TotalLength returns the sum of the lengths of all the periods.
+func (vp Periods) TotalLength() int64 {
+	var length int64
+	for _, period := range vp {
+		length += period.Length
+	}
+
+	return length
+}
+
+// TotalAmount returns the sum of the amounts of all the periods.
+func (vp Periods) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range vp {
+		total = total.Add(period.Amount...)
+	}
+
+	return total
+}
+
+// elapsedAmount returns the sum of the amounts of the periods, following each
+// other from the start time, which have ended by the given time.
+func (vp Periods) elapsedAmount(startTime, blockTime int64) sdk.Coins {
+	elapsed := sdk.NewCoins()
+	for _, period := range vp {
+		startTime += period.Length
+		if blockTime < startTime {
+			break
+		}
+
+		elapsed = elapsed.Add(period.Amount...)
+	}
+
+	return elapsed
+}
@@ -20,6 +20,8 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*PermanentLockedAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 //-----------------------------------------------------------------------------
@@ -175,8 +177,10 @@ type vestingAccountYAML struct {
 	EndTime          int64          `json:"end_time" yaml:"end_time"`
 
 	// custom fields based on concrete vesting type which can be omitted
-	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
-	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	StartTime      int64          `json:"start_time,omitempty" yaml:"start_time,omitempty"`
+	VestingPeriods Periods        `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  sdk.AccAddress `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
+	LockupPeriods  Periods        `json:"lockup_periods,omitempty" yaml:"lockup_periods,omitempty"`
 }
 
 type vestingAccountJSON struct {
@@ -190,8 +194,10 @@ type vestingAccountJSON struct {
 	EndTime          int64          `json:"end_time" yaml:"end_time"`
 
 	// custom fields based on concrete vesting type which can be omitted
-	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
-	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	StartTime      int64          `json:"start_time,omitempty" yaml:"start_time,omitempty"`
+	VestingPeriods Periods        `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  sdk.AccAddress `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
+	LockupPeriods  Periods        `json:"lockup_periods,omitempty" yaml:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -713,3 +719,343 @@ func (dva *DelayedVestingAccount) UnmarshalJSON(bz []byte) error {
 
 	return nil
 }
+
+//-----------------------------------------------------------------------------
+// Permanent Locked Vesting Account
+
+var _ vestexported.VestingAccount = (*PermanentLockedAccount)(nil)
+var _ authexported.GenesisAccount = (*PermanentLockedAccount)(nil)
+
+// NewPermanentLockedAccount returns a PermanentLockedAccount
+func NewPermanentLockedAccount(baseAcc *authtypes.BaseAccount, coins sdk.Coins) *PermanentLockedAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: coins,
+		EndTime:         0, // a permanent locked account never vests
+	}
+
+	return &PermanentLockedAccount{baseVestingAcc}
+}
+
+// GetVestedCoins returns the total amount of vested coins for a permanent locked
+// account, which is always nil as the coins never vest.
+func (plva PermanentLockedAccount) GetVestedCoins(_ time.Time) sdk.Coins {
+	return nil
+}
+
+// GetVestingCoins returns the total number of vesting coins for a permanent
+// locked account, which is always its original vesting amount.
+func (plva PermanentLockedAccount) GetVestingCoins(_ time.Time) sdk.Coins {
+	return plva.OriginalVesting
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked).
+func (plva PermanentLockedAccount) LockedCoins(_ time.Time) sdk.Coins {
+	return plva.BaseVestingAccount.LockedCoinsFromVesting(plva.OriginalVesting)
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (plva *PermanentLockedAccount) TrackDelegation(_ time.Time, balance, amount sdk.Coins) {
+	plva.BaseVestingAccount.TrackDelegation(balance, plva.OriginalVesting, amount)
+}
+
+// GetStartTime returns zero since a permanent locked account has no start time.
+func (plva PermanentLockedAccount) GetStartTime() int64 {
+	return 0
+}
+
+// GetEndTime returns zero since a permanent locked account has no end time.
+func (plva PermanentLockedAccount) GetEndTime() int64 {
+	return 0
+}
+
+// Validate checks for errors on the account fields
+func (plva PermanentLockedAccount) Validate() error {
+	if plva.EndTime > 0 {
+		return errors.New("permanent locked accounts cannot have an end time")
+	}
+
+	return plva.BaseVestingAccount.Validate()
+}
+
+func (plva PermanentLockedAccount) String() string {
+	out, _ := plva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalJSON returns the JSON representation of a PermanentLockedAccount.
+func (plva PermanentLockedAccount) MarshalJSON() ([]byte, error) {
+	alias := vestingAccountJSON{
+		Address:          plva.Address,
+		PubKey:           plva.GetPubKey(),
+		AccountNumber:    plva.AccountNumber,
+		Sequence:         plva.Sequence,
+		OriginalVesting:  plva.OriginalVesting,
+		DelegatedFree:    plva.DelegatedFree,
+		DelegatedVesting: plva.DelegatedVesting,
+		EndTime:          plva.EndTime,
+	}
+
+	return codec.Cdc.MarshalJSON(alias)
+}
+
+// UnmarshalJSON unmarshals raw JSON bytes into a PermanentLockedAccount.
+func (plva *PermanentLockedAccount) UnmarshalJSON(bz []byte) error {
+	var alias vestingAccountJSON
+	if err := codec.Cdc.UnmarshalJSON(bz, &alias); err != nil {
+		return err
+	}
+
+	plva.BaseVestingAccount = &BaseVestingAccount{
+		BaseAccount:      authtypes.NewBaseAccount(alias.Address, alias.PubKey, alias.AccountNumber, alias.Sequence),
+		OriginalVesting:  alias.OriginalVesting,
+		DelegatedFree:    alias.DelegatedFree,
+		DelegatedVesting: alias.DelegatedVesting,
+		EndTime:          alias.EndTime,
+	}
+
+	return nil
+}
+
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var _ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+var _ authexported.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount. The original
+// vesting amount must be the total amount of both the lockup and the vesting
+// periods.
+func NewClawbackVestingAccount(
+	baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins,
+	startTime int64, lockupPeriods, vestingPeriods Periods,
+) *ClawbackVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         startTime + max64(lockupPeriods.TotalLength(), vestingPeriods.TotalLength()),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder,
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// GetVestedOnly returns the coins vested by the vesting schedule, regardless
+// of the lockup schedule. They are the coins the funder cannot claw back.
+func (cva ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return Periods(cva.VestingPeriods).elapsedAmount(cva.StartTime, blockTime.Unix())
+}
+
+// GetUnlockedOnly returns the coins unlocked by the lockup schedule, regardless
+// of the vesting schedule.
+func (cva ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return Periods(cva.LockupPeriods).elapsedAmount(cva.StartTime, blockTime.Unix())
+}
+
+// GetVestedCoins returns the coins which are both vested and unlocked.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return cva.GetVestedOnly(blockTime).Min(cva.GetUnlockedOnly(blockTime))
+}
+
+// GetVestingCoins returns the coins which are still vesting or locked.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked).
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting and unlocking start for a clawback
+// vesting account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// ComputeClawback truncates the vesting schedule of the account at the given
+// time to the periods which have vested, and caps the lockup schedule to their
+// amount, which becomes the original vesting amount. It returns the unvested
+// coins to claw back. The delegation tracking is updated by UpdateDelegation.
+func (cva *ClawbackVestingAccount) ComputeClawback(clawbackTime int64) sdk.Coins {
+	vestTime := cva.StartTime
+	vestedPeriods := Periods{}
+	for _, period := range cva.VestingPeriods {
+		vestTime += period.Length
+		if clawbackTime < vestTime {
+			break
+		}
+
+		vestedPeriods = append(vestedPeriods, period)
+	}
+
+	vested := vestedPeriods.TotalAmount()
+	unvested := cva.OriginalVesting.Sub(vested.Min(cva.OriginalVesting))
+
+	// cap the cumulative unlocked amount to the vested coins, dropping the
+	// trailing periods left without coins
+	lockupPeriods := Periods{}
+	unlocked := sdk.NewCoins()
+	for _, period := range cva.LockupPeriods {
+		amount := period.Amount.Min(vested.Sub(unlocked))
+		unlocked = unlocked.Add(amount...)
+		lockupPeriods = append(lockupPeriods, Period{Length: period.Length, Amount: amount})
+	}
+	for len(lockupPeriods) > 0 && lockupPeriods[len(lockupPeriods)-1].Amount.IsZero() {
+		lockupPeriods = lockupPeriods[:len(lockupPeriods)-1]
+	}
+
+	cva.OriginalVesting = vested
+	cva.LockupPeriods = lockupPeriods
+	cva.VestingPeriods = vestedPeriods
+	cva.EndTime = cva.StartTime + max64(lockupPeriods.TotalLength(), vestedPeriods.TotalLength())
+
+	return unvested
+}
+
+// UpdateDelegation updates the delegation tracking of the account for the
+// clawback of toClawBack coins, given its coins still vesting or locked once
+// its schedules have been truncated and its bonded, unbonding and unbonded
+// coins. The unbonded coins are clawed back first and the amount lost to
+// slashing remains tracked as delegated. It returns the coins to claw back,
+// capped to the coins of the account.
+func (cva *ClawbackVestingAccount) UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded sdk.Coins) sdk.Coins {
+	delegated := bonded.Add(unbonding...)
+	oldDelegated := cva.DelegatedVesting.Add(cva.DelegatedFree...)
+	slashed := oldDelegated.Sub(delegated.Min(oldDelegated))
+	total := delegated.Add(unbonded...)
+
+	toClawBack = toClawBack.Min(total)
+	newDelegated := delegated.Min(total.Sub(toClawBack)).Add(slashed...)
+
+	cva.DelegatedVesting = encumbered.Min(newDelegated)
+	cva.DelegatedFree = newDelegated.Sub(cva.DelegatedVesting)
+
+	return toClawBack
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if cva.FunderAddress.Empty() {
+		return errors.New("funder address cannot be empty")
+	}
+	if cva.GetStartTime() > cva.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+
+	endTime := cva.StartTime + max64(Periods(cva.LockupPeriods).TotalLength(), Periods(cva.VestingPeriods).TotalLength())
+	if endTime != cva.EndTime {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+	if !Periods(cva.LockupPeriods).TotalAmount().IsEqual(cva.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+	if !Periods(cva.VestingPeriods).TotalAmount().IsEqual(cva.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+func (cva ClawbackVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (cva ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	alias := vestingAccountYAML{
+		Address:          cva.Address,
+		AccountNumber:    cva.AccountNumber,
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		EndTime:          cva.EndTime,
+		StartTime:        cva.StartTime,
+		VestingPeriods:   cva.VestingPeriods,
+		FunderAddress:    cva.FunderAddress,
+		LockupPeriods:    cva.LockupPeriods,
+	}
+
+	pk := cva.GetPubKey()
+	if pk != nil {
+		pks, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pk)
+		if err != nil {
+			return nil, err
+		}
+
+		alias.PubKey = pks
+	}
+
+	bz, err := yaml.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), err
+}
+
+// MarshalJSON returns the JSON representation of a ClawbackVestingAccount.
+func (cva ClawbackVestingAccount) MarshalJSON() ([]byte, error) {
+	alias := vestingAccountJSON{
+		Address:          cva.Address,
+		PubKey:           cva.GetPubKey(),
+		AccountNumber:    cva.AccountNumber,
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		EndTime:          cva.EndTime,
+		StartTime:        cva.StartTime,
+		VestingPeriods:   cva.VestingPeriods,
+		FunderAddress:    cva.FunderAddress,
+		LockupPeriods:    cva.LockupPeriods,
+	}
+
+	return codec.Cdc.MarshalJSON(alias)
+}
+
+// UnmarshalJSON unmarshals raw JSON bytes into a ClawbackVestingAccount.
+func (cva *ClawbackVestingAccount) UnmarshalJSON(bz []byte) error {
+	var alias vestingAccountJSON
+	if err := codec.Cdc.UnmarshalJSON(bz, &alias); err != nil {
+		return err
+	}
+
+	cva.BaseVestingAccount = &BaseVestingAccount{
+		BaseAccount:      authtypes.NewBaseAccount(alias.Address, alias.PubKey, alias.AccountNumber, alias.Sequence),
+		OriginalVesting:  alias.OriginalVesting,
+		DelegatedFree:    alias.DelegatedFree,
+		DelegatedVesting: alias.DelegatedVesting,
+		EndTime:          alias.EndTime,
+	}
+	cva.FunderAddress = alias.FunderAddress
+	cva.StartTime = alias.StartTime
+	cva.LockupPeriods = alias.LockupPeriods
+	cva.VestingPeriods = alias.VestingPeriods
+
+	return nil
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+
+	return b
+}
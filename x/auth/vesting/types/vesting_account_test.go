@@ -596,8 +596,8 @@ func TestGenesisAccountValidate(t *testing.T) {
 			false,
 		},
 		{
-			"invalid base valid account",
-			authtypes.NewBaseAccount(addr, secp256k1.GenPrivKey().PubKey(), 0, 0),
+			"invalid base account pubkey",
+			&authtypes.BaseAccount{Address: addr, PubKey: []byte{0x1}},
 			true,
 		},
 		{
@@ -789,3 +789,304 @@ func TestDelayedVestingAccountJSON(t *testing.T) {
 	require.NoError(t, json.Unmarshal(bz, &a))
 	require.Equal(t, acc.String(), a.String())
 }
+
+func TestGetVestedCoinsPermLockedVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(1000 * 24 * time.Hour)
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+
+	// require no coins are vested, at any time
+	plva := types.NewPermanentLockedAccount(bacc, origCoins)
+	require.Nil(t, plva.GetVestedCoins(now))
+	require.Nil(t, plva.GetVestedCoins(endTime))
+
+	// require all coins are vesting, at any time
+	require.Equal(t, origCoins, plva.GetVestingCoins(now))
+	require.Equal(t, origCoins, plva.GetVestingCoins(endTime))
+}
+
+func TestSpendableCoinsPermLockedVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(1000 * 24 * time.Hour)
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+
+	// require that all coins are locked, at any time
+	plva := types.NewPermanentLockedAccount(bacc, origCoins)
+	require.True(t, plva.LockedCoins(now).IsEqual(origCoins))
+	require.True(t, plva.LockedCoins(endTime).IsEqual(origCoins))
+
+	// delegate some locked coins
+	// require that locked is reduced
+	delegatedAmount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))
+	plva.TrackDelegation(now, origCoins, delegatedAmount)
+	require.True(t, plva.LockedCoins(now).IsEqual(origCoins.Sub(delegatedAmount)))
+}
+
+func TestTrackDelegationPermLockedVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(1000 * 24 * time.Hour)
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+
+	// require the ability to delegate all vesting coins, at any time
+	plva := types.NewPermanentLockedAccount(bacc, origCoins)
+	plva.TrackDelegation(endTime, origCoins, origCoins)
+	require.Equal(t, origCoins, plva.DelegatedVesting)
+	require.Nil(t, plva.DelegatedFree)
+
+	// require the ability to undelegate all vesting coins
+	plva.TrackUndelegation(origCoins)
+	require.Nil(t, plva.DelegatedFree)
+	require.Nil(t, plva.DelegatedVesting)
+
+	// require no modifications when not enough funds
+	plva = types.NewPermanentLockedAccount(bacc, origCoins)
+	require.Panics(t, func() {
+		plva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)})
+	})
+	require.Nil(t, plva.DelegatedVesting)
+	require.Nil(t, plva.DelegatedFree)
+}
+
+func TestPermanentLockedAccountValidate(t *testing.T) {
+	_, _, addr := authtypes.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+
+	plva := types.NewPermanentLockedAccount(bacc, origCoins)
+	require.NoError(t, plva.Validate())
+	require.Zero(t, plva.GetStartTime())
+	require.Zero(t, plva.GetEndTime())
+
+	// require an error when the account has an end time
+	plva.EndTime = tmtime.Now().Unix()
+	require.Error(t, plva.Validate())
+}
+
+func TestPermanentLockedAccountMarshal(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewPermanentLockedAccount(baseAcc, coins)
+
+	bz, err := appCodec.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := appCodec.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.PermanentLockedAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = appCodec.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
+
+func TestPermanentLockedAccountJSON(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewPermanentLockedAccount(baseAcc, coins)
+
+	bz, err := json.Marshal(acc)
+	require.NoError(t, err)
+
+	bz1, err := acc.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, string(bz1), string(bz))
+
+	var a types.PermanentLockedAccount
+	require.NoError(t, json.Unmarshal(bz, &a))
+	require.Equal(t, acc.String(), a.String())
+}
+
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(16 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	_, _, funder := authtypes.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, va.Validate())
+	require.Equal(t, now.Add(24*time.Hour).Unix(), va.GetEndTime())
+
+	// require no coins vested at the beginning of the schedules
+	require.Empty(t, va.GetVestedCoins(now))
+	require.Equal(t, origCoins, va.GetVestingCoins(now))
+
+	// require vested coins to stay locked until the lockup period is over
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedOnly(now.Add(12*time.Hour)))
+	require.Empty(t, va.GetVestedCoins(now.Add(12*time.Hour)))
+
+	// require the coins both vested and unlocked once the lockup period is over
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(16*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, va.GetVestedCoins(now.Add(18*time.Hour)))
+
+	// require all coins vested at the end of the schedules
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Empty(t, va.GetVestingCoins(now.Add(24*time.Hour)))
+}
+
+func TestSpendableCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	_, _, funder := authtypes.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods, periods)
+
+	// require that all coins are locked at the beginning of the schedules
+	require.Equal(t, origCoins, va.LockedCoins(now))
+
+	// require that half of the coins are spendable after the first period
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.LockedCoins(now.Add(12*time.Hour)))
+
+	// require that the delegated vesting coins are not locked
+	va.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}, va.LockedCoins(now))
+	require.True(t, va.LockedCoins(now.Add(12*time.Hour)).IsZero())
+}
+
+func TestComputeClawbackClawbackVestingAcc(t *testing.T) {
+	lockupPeriods := types.Periods{
+		types.Period{Length: 300, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 200)}},
+		types.Period{Length: 100, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 200)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: 100, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+		types.Period{Length: 100, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+		types.Period{Length: 100, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 200)}},
+	}
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	_, _, funder := authtypes.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 400)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, 1000, lockupPeriods, vestingPeriods)
+
+	// require the unvested periods to be clawed back and the lockup schedule to
+	// be capped to the vested coins
+	unvested := va.ComputeClawback(1250)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 200)}, unvested)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 200)}, va.OriginalVesting)
+	require.Equal(t, []types.Period(vestingPeriods[:2]), va.VestingPeriods)
+	require.Equal(t, []types.Period{lockupPeriods[0]}, va.LockupPeriods)
+	require.Equal(t, int64(1300), va.EndTime)
+	require.NoError(t, va.Validate())
+
+	// require nothing more to be clawed back
+	require.Empty(t, va.ComputeClawback(1250))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 200)}, va.OriginalVesting)
+
+	// require the delegation tracking to claw back the unbonded coins first,
+	// the slashed coins remaining tracked
+	va.DelegatedVesting = sdk.Coins{sdk.NewInt64Coin(stakeDenom, 300)}
+	bonded := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 290)}
+	unbonded := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	toClawBack := va.UpdateDelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)}, unvested, bonded, sdk.Coins{}, unbonded)
+	require.Equal(t, unvested, toClawBack)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)}, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.DelegatedFree)
+
+	// require the coins to claw back to be capped to the coins of the account
+	toClawBack = va.UpdateDelegation(va.OriginalVesting, unvested, sdk.Coins{}, sdk.Coins{}, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, toClawBack)
+}
+
+func TestClawbackVestingAccountValidate(t *testing.T) {
+	periods := types.Periods{types.Period{Length: 100, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}}}
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	_, _, funder := authtypes.KeyTestPubAddr()
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, 1000, periods, periods)
+	require.NoError(t, va.Validate())
+
+	va = types.NewClawbackVestingAccount(bacc, nil, origCoins, 1000, periods, periods)
+	require.Error(t, va.Validate())
+
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins.Add(origCoins...), 1000, periods, periods)
+	require.Error(t, va.Validate())
+
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, 1000, types.Periods{}, periods)
+	require.Error(t, va.Validate())
+
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, 1000, periods, periods)
+	va.EndTime = 1200
+	require.Error(t, va.Validate())
+}
+
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	_, _, funder := authtypes.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	periods := types.Periods{types.Period{Length: 3600, Amount: coins}}
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), periods, periods)
+
+	bz, err := appCodec.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := appCodec.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = appCodec.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
+
+func TestClawbackVestingAccountJSON(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	_, _, funder := authtypes.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	periods := types.Periods{types.Period{Length: 3600, Amount: coins}}
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), periods, periods)
+
+	bz, err := json.Marshal(acc)
+	require.NoError(t, err)
+
+	bz1, err := acc.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, string(bz1), string(bz))
+
+	var a types.ClawbackVestingAccount
+	require.NoError(t, json.Unmarshal(bz, &a))
+	require.Equal(t, acc.String(), a.String())
+	require.Equal(t, funder, a.FunderAddress)
+}
@@ -0,0 +1,67 @@
+package authz
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// nolint
+
+const (
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	QueryAuthorization           = types.QueryAuthorization
+	QueryAuthorizations          = types.QueryAuthorizations
+	TypeMsgGrantAuthorization    = types.TypeMsgGrantAuthorization
+	TypeMsgRevokeAuthorization   = types.TypeMsgRevokeAuthorization
+	TypeMsgExec                  = types.TypeMsgExec
+	EventTypeGrantAuthorization  = types.EventTypeGrantAuthorization
+	EventTypeRevokeAuthorization = types.EventTypeRevokeAuthorization
+	EventTypeExecAuthorization   = types.EventTypeExecAuthorization
+	AttributeValueCategory       = types.AttributeValueCategory
+	AttributeKeyGranter          = types.AttributeKeyGranter
+	AttributeKeyGrantee          = types.AttributeKeyGrantee
+	AttributeKeyMsgType          = types.AttributeKeyMsgType
+)
+
+var (
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+
+	RegisterCodec                = types.RegisterCodec
+	ModuleCdc                    = types.ModuleCdc
+	MsgTypeOf                    = types.MsgTypeOf
+	NewGenericAuthorization      = types.NewGenericAuthorization
+	NewSendAuthorization         = types.NewSendAuthorization
+	NewAuthorizationGrant        = types.NewAuthorizationGrant
+	NewMsgGrantAuthorization     = types.NewMsgGrantAuthorization
+	NewMsgRevokeAuthorization    = types.NewMsgRevokeAuthorization
+	NewMsgExec                   = types.NewMsgExec
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	NewQueryAuthorizationParams  = types.NewQueryAuthorizationParams
+	NewQueryAuthorizationsParams = types.NewQueryAuthorizationsParams
+	GrantKey                     = types.GrantKey
+	GrantKeyPrefix               = types.GrantKeyPrefix
+	ErrNoAuthorization           = types.ErrNoAuthorization
+	ErrAuthorizationExpired      = types.ErrAuthorizationExpired
+	ErrSpendLimitExceeded        = types.ErrSpendLimitExceeded
+	ErrInvalidExpiration         = types.ErrInvalidExpiration
+	ErrInvalidMsgType            = types.ErrInvalidMsgType
+)
+
+type (
+	Keeper = keeper.Keeper
+
+	GenericAuthorization      = types.GenericAuthorization
+	SendAuthorization         = types.SendAuthorization
+	AuthorizationGrant        = types.AuthorizationGrant
+	MsgGrantAuthorization     = types.MsgGrantAuthorization
+	MsgRevokeAuthorization    = types.MsgRevokeAuthorization
+	MsgExec                   = types.MsgExec
+	GenesisState              = types.GenesisState
+	QueryAuthorizationParams  = types.QueryAuthorizationParams
+	QueryAuthorizationsParams = types.QueryAuthorizationsParams
+)
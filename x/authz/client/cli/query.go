@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// GetQueryCmd returns the query commands for the authz module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryAuthorization(cdc),
+		GetCmdQueryAuthorizations(cdc),
+	)...)
+
+	return queryCmd
+}
+
+// GetCmdQueryAuthorization returns the command handler for querying the
+// authorization given by a granter to a grantee for a msg type.
+func GetCmdQueryAuthorization(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorization [granter] [grantee] [msg-type]",
+		Short: "Query the authorization given by a granter to a grantee for a msg type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the authorization given by a granter to a grantee for the msgs of a type,
+given as route/type.
+
+Example:
+$ %s query %s authorization cosmos1skjw.. cosmos1skjx.. bank/send
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAuthorizationParams(granter, grantee, args[2]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuthorization)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grant types.AuthorizationGrant
+			if err := cdc.UnmarshalJSON(res, &grant); err != nil {
+				return fmt.Errorf("failed to unmarshal authorization: %w", err)
+			}
+
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+}
+
+// GetCmdQueryAuthorizations returns the command handler for querying all the
+// authorizations given by a granter to a grantee.
+func GetCmdQueryAuthorizations(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorizations [granter] [grantee]",
+		Short: "Query all the authorizations given by a granter to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the authorizations given by a granter to a grantee.
+
+Example:
+$ %s query %s authorizations cosmos1skjw.. cosmos1skjx..
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAuthorizationsParams(granter, grantee))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuthorizations)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grants []types.AuthorizationGrant
+			if err := cdc.UnmarshalJSON(res, &grants); err != nil {
+				return fmt.Errorf("failed to unmarshal authorizations: %w", err)
+			}
+
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
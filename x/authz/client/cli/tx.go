@@ -0,0 +1,193 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// flags for the grant command
+const (
+	FlagSpendLimit = "spend-limit"
+	FlagExpiration = "expiration"
+)
+
+// authorization kinds of the grant command
+const (
+	authorizationSend = "send"
+)
+
+// GetTxCmd returns the transaction commands for the authz module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(flags.PostCommands(
+		GetCmdGrantAuthorization(cdc),
+		GetCmdRevokeAuthorization(cdc),
+		GetCmdExec(cdc),
+	)...)
+
+	return txCmd
+}
+
+// GetCmdGrantAuthorization returns a CLI command handler for creating a
+// MsgGrantAuthorization transaction.
+func GetCmdGrantAuthorization(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [send|msg-type]",
+		Short: "Grant an account an authorization to execute msgs on behalf of the signer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an account an authorization to execute the msgs of a type on behalf of the signer.
+The "send" authorization allows bank sends up to the spend limit. Any other msg type, given as
+route/type, is authorized without limit. The authorization expires at the optional expiration.
+
+Example:
+$ %s tx %s grant cosmos1skjw.. send --spend-limit=100stake --expiration=2021-01-01T00:00:00Z --from=mykey
+$ %s tx %s grant cosmos1skjw.. gov/vote --from=mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authorization exported.Authorization
+			if args[1] == authorizationSend {
+				spendLimit, err := sdk.ParseCoins(viper.GetString(FlagSpendLimit))
+				if err != nil {
+					return err
+				}
+				authorization = types.NewSendAuthorization(spendLimit)
+			} else {
+				authorization = types.NewGenericAuthorization(args[1])
+			}
+
+			var expiration time.Time
+			if exp := viper.GetString(FlagExpiration); exp != "" {
+				expiration, err = time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgGrantAuthorization(cliCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "The maximum amount the grantee can send, for send authorizations")
+	cmd.Flags().String(FlagExpiration, "", "The time when the authorization expires, in RFC 3339 format; never if empty")
+
+	return cmd
+}
+
+// GetCmdRevokeAuthorization returns a CLI command handler for creating a
+// MsgRevokeAuthorization transaction.
+func GetCmdRevokeAuthorization(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] [msg-type]",
+		Short: "Revoke the authorization granted to an account for a msg type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization granted by the signer to an account for the msgs of a type,
+given as route/type.
+
+Example:
+$ %s tx %s revoke cosmos1skjw.. bank/send --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAuthorization(cliCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdExec returns a CLI command handler for creating a MsgExec transaction.
+func GetCmdExec(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [tx-json-file]",
+		Short: "Execute the msgs of a transaction on behalf of their signers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the msgs of a generated transaction on behalf of their signers, under the
+authorizations they granted to the signer.
+
+Example:
+$ %s tx bank send cosmos1skjw.. cosmos1skjx.. 10stake --generate-only > tx.json
+$ %s tx %s exec tx.json --from=mykey
+`,
+				version.ClientName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			stdTx, err := authclient.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExec(cliCtx.GetFromAddress(), stdTx.Msgs)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		fmt.Sprintf("/authz/authorizations/{%s}/{%s}", RestParamGranter, RestParamGrantee),
+		queryAuthorizationsHandler(cliCtx),
+	).Methods("GET")
+}
+
+// queryAuthorizationsHandler returns all the authorizations given by a granter
+// to a grantee, or only the one for the msg type of the msg_type parameter if
+// it is set.
+func queryAuthorizationsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		granter, err := sdk.AccAddressFromBech32(vars[RestParamGranter])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(vars[RestParamGrantee])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var (
+			params interface{} = types.NewQueryAuthorizationsParams(granter, grantee)
+			path               = types.QueryAuthorizations
+		)
+		if msgType := r.URL.Query().Get(RestParamMsgType); msgType != "" {
+			params = types.NewQueryAuthorizationParams(granter, grantee, msgType)
+			path = types.QueryAuthorization
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -0,0 +1,19 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// REST query parameters
+const (
+	RestParamGranter = "granter"
+	RestParamGrantee = "grantee"
+	RestParamMsgType = "msg_type"
+)
+
+// RegisterRoutes registers the REST routes of the authz module.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
@@ -0,0 +1,24 @@
+package exported
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization authorizes a grantee to execute the msgs of a given type on
+// behalf of a granter.
+type Authorization interface {
+	// MsgType returns the type of the msgs which can be executed under the
+	// authorization, as returned by types.MsgTypeOf.
+	MsgType() string
+
+	// Accept checks whether the msg can be executed under the authorization,
+	// and updates the authorization accordingly. It returns an error if the
+	// msg is not allowed, and remove=true if the authorization must be
+	// removed afterwards.
+	Accept(msg sdk.Msg, blockTime time.Time, blockHeight int64) (remove bool, err error)
+
+	// ValidateBasic performs basic validation of the authorization.
+	ValidateBasic() error
+}
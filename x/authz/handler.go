@@ -0,0 +1,79 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for the authz module's msgs.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgGrantAuthorization:
+			return handleGrantAuthorization(ctx, k, msg)
+
+		case MsgRevokeAuthorization:
+			return handleRevokeAuthorization(ctx, k, msg)
+
+		case MsgExec:
+			return handleExec(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleGrantAuthorization(ctx sdk.Context, k Keeper, msg MsgGrantAuthorization) (*sdk.Result, error) {
+	if err := k.Grant(ctx, msg.Grant()); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleRevokeAuthorization(ctx sdk.Context, k Keeper, msg MsgRevokeAuthorization) (*sdk.Result, error) {
+	if err := k.Revoke(ctx, msg.Granter, msg.Grantee, msg.MsgType); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleExec(ctx sdk.Context, k Keeper, msg MsgExec) (*sdk.Result, error) {
+	res, err := k.DispatchActions(ctx, msg.Grantee, msg.Msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Grantee.String()),
+		),
+	)
+
+	// the events of the authz module come first, then those of the executed
+	// msgs
+	res.Events = append(ctx.EventManager().ABCIEvents(), res.Events...)
+	return res, nil
+}
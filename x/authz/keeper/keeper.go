@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Keeper manages the authorizations given by accounts to other accounts to
+// execute msgs on their behalf, and executes such msgs.
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+	router   sdk.Router
+}
+
+// NewKeeper creates an authz Keeper. The msgs executed on behalf of the
+// granters are dispatched to the handlers of the router, which is usually the
+// Router of the BaseApp.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router sdk.Router) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Grant stores a grant, overwriting any authorization the granter already gave
+// to the grantee for the same msg type. It returns an error if the grant is
+// already expired.
+func (k Keeper) Grant(ctx sdk.Context, grant types.AuthorizationGrant) error {
+	if grant.IsExpired(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidExpiration, "%s is not after the block time", grant.Expiration)
+	}
+
+	k.setGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, grant.Authorization.MsgType()),
+		),
+	)
+
+	return nil
+}
+
+// Revoke removes the authorization given by the granter to the grantee for
+// the msgs of msgType. It returns an error if there is no such authorization.
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GrantKey(granter, grantee, msgType)
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(types.ErrNoAuthorization, "granter %s, grantee %s, msg type %s", granter, grantee, msgType)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthorization returns the authorization given by the granter to the
+// grantee for the msgs of msgType and its expiration, or nil if there is none
+// or it is expired.
+func (k Keeper) GetAuthorization(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+) (exported.Authorization, time.Time) {
+	grant, found := k.GetGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockTime()) {
+		return nil, time.Time{}
+	}
+
+	return grant.Authorization, grant.Expiration
+}
+
+// GetGrant returns the grant of the granter to the grantee for the msgs of
+// msgType and true, or an empty grant and false if there is none. The grant
+// may be expired.
+func (k Keeper) GetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) (grant types.AuthorizationGrant, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GrantKey(granter, grantee, msgType))
+	if len(bz) == 0 {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// IterateGranterGranteeGrants iterates over all the grants given by a granter
+// to a grantee, until cb returns true.
+func (k Keeper) IterateGranterGranteeGrants(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, cb func(types.AuthorizationGrant) (stop bool),
+) {
+	k.iterateGrants(ctx, types.GrantPrefixByGranterGrantee(granter, grantee), cb)
+}
+
+// IterateAllGrants iterates over all the grants in the store, until cb returns
+// true.
+func (k Keeper) IterateAllGrants(ctx sdk.Context, cb func(types.AuthorizationGrant) (stop bool)) {
+	k.iterateGrants(ctx, types.GrantKeyPrefix, cb)
+}
+
+// DispatchActions executes msgs on behalf of their signers. The msgs signed by
+// the grantee itself are executed right away, the other ones only if they are
+// accepted by the authorization their signer gave to the grantee, which is
+// updated or removed afterwards. The msgs are routed to the handlers of the
+// router, and their results merged into a single one.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) (*sdk.Result, error) {
+	var (
+		data   []byte
+		events []abci.Event
+	)
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "msg %d must have a single signer", i)
+		}
+
+		granter := signers[0]
+		if !granter.Equals(grantee) {
+			if err := k.useAuthorization(ctx, granter, grantee, msg); err != nil {
+				return nil, sdkerrors.Wrapf(err, "msg %d", i)
+			}
+		}
+
+		handler := k.router.Route(ctx, msg.Route())
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msg.Route(), i)
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		data = append(data, res.Data...)
+		events = append(events, res.Events...)
+	}
+
+	return &sdk.Result{Data: data, Events: events}, nil
+}
+
+// useAuthorization checks that the msg is accepted by the authorization given
+// by the granter to the grantee for its type, and updates the authorization.
+func (k Keeper) useAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error {
+	msgType := types.MsgTypeOf(msg)
+	grant, found := k.GetGrant(ctx, granter, grantee, msgType)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoAuthorization, "granter %s, grantee %s, msg type %s", granter, grantee, msgType)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GrantKey(granter, grantee, msgType)
+	if grant.IsExpired(ctx.BlockTime()) {
+		store.Delete(key)
+		return sdkerrors.Wrapf(types.ErrAuthorizationExpired, "granter %s, grantee %s, msg type %s", granter, grantee, msgType)
+	}
+
+	remove, err := grant.Authorization.Accept(msg, ctx.BlockTime(), ctx.BlockHeight())
+	if remove {
+		store.Delete(key)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !remove {
+		k.setGrant(ctx, grant)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) setGrant(ctx sdk.Context, grant types.AuthorizationGrant) {
+	bz := k.cdc.MustMarshalBinaryBare(grant)
+	key := types.GrantKey(grant.Granter, grant.Grantee, grant.Authorization.MsgType())
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
+
+func (k Keeper) iterateGrants(ctx sdk.Context, prefix []byte, cb func(types.AuthorizationGrant) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
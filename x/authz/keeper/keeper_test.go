@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	querier sdk.Querier
+
+	granter   sdk.AccAddress
+	grantee   sdk.AccAddress
+	recipient sdk.AccAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, abci.Header{Height: 1, Time: time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)})
+	suite.querier = keeper.NewQuerier(app.AuthzKeeper)
+
+	suite.granter = sdk.AccAddress([]byte("granter_____________"))
+	suite.grantee = sdk.AccAddress([]byte("grantee_____________"))
+	suite.recipient = sdk.AccAddress([]byte("recipient___________"))
+
+	for _, addr := range []sdk.AccAddress{suite.granter, suite.grantee} {
+		app.AccountKeeper.SetAccount(suite.ctx, app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr))
+		suite.Require().NoError(app.BankKeeper.SetBalances(suite.ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+	}
+}
+
+func (suite *KeeperTestSuite) TestGrantRevoke() {
+	ctx := suite.ctx
+	k := suite.app.AuthzKeeper
+
+	send := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	vote := types.NewGenericAuthorization("gov/vote")
+
+	// grants must not be expired
+	err := k.Grant(ctx, types.NewAuthorizationGrant(suite.granter, suite.grantee, send, ctx.BlockTime()))
+	suite.Require().Error(err)
+
+	expiration := ctx.BlockTime().Add(time.Hour)
+	suite.Require().NoError(k.Grant(ctx, types.NewAuthorizationGrant(suite.granter, suite.grantee, send, expiration)))
+	suite.Require().NoError(k.Grant(ctx, types.NewAuthorizationGrant(suite.granter, suite.grantee, vote, time.Time{})))
+
+	authorization, exp := k.GetAuthorization(ctx, suite.granter, suite.grantee, "bank/send")
+	suite.Equal(send, authorization)
+	suite.Equal(expiration, exp)
+
+	authorization, _ = k.GetAuthorization(ctx, suite.grantee, suite.granter, "bank/send")
+	suite.Nil(authorization)
+
+	// expired authorizations are not returned
+	authorization, _ = k.GetAuthorization(ctx.WithBlockTime(expiration), suite.granter, suite.grantee, "bank/send")
+	suite.Nil(authorization)
+
+	var grants []types.AuthorizationGrant
+	k.IterateGranterGranteeGrants(ctx, suite.granter, suite.grantee, func(grant types.AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	suite.Len(grants, 2)
+
+	suite.Require().NoError(k.Revoke(ctx, suite.granter, suite.grantee, "bank/send"))
+	suite.Require().Error(k.Revoke(ctx, suite.granter, suite.grantee, "bank/send"))
+
+	authorization, _ = k.GetAuthorization(ctx, suite.granter, suite.grantee, "bank/send")
+	suite.Nil(authorization)
+	authorization, _ = k.GetAuthorization(ctx, suite.granter, suite.grantee, "gov/vote")
+	suite.Equal(vote, authorization)
+}
+
+func (suite *KeeperTestSuite) TestDispatchActions() {
+	k := suite.app.AuthzKeeper
+	bk := suite.app.BankKeeper
+
+	sendLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	expiration := suite.ctx.BlockTime().Add(time.Hour)
+
+	sendFromGranter := func(amount int64) sdk.Msg {
+		return bank.NewMsgSend(suite.granter, suite.recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", amount)))
+	}
+
+	cases := map[string]struct {
+		grant   bool
+		elapsed time.Duration
+		msgs    []sdk.Msg
+		valid   bool
+		sent    int64
+		remains sdk.Coins
+		removed bool
+	}{
+		"no authorization": {
+			msgs:  []sdk.Msg{sendFromGranter(10)},
+			valid: false,
+		},
+		"within the limit": {
+			grant:   true,
+			msgs:    []sdk.Msg{sendFromGranter(10), sendFromGranter(20)},
+			valid:   true,
+			sent:    30,
+			remains: sdk.NewCoins(sdk.NewInt64Coin("atom", 70)),
+		},
+		"whole limit": {
+			grant:   true,
+			msgs:    []sdk.Msg{sendFromGranter(100)},
+			valid:   true,
+			sent:    100,
+			removed: true,
+		},
+		"over the limit": {
+			grant: true,
+			msgs:  []sdk.Msg{sendFromGranter(60), sendFromGranter(60)},
+			valid: false,
+		},
+		"expired": {
+			grant:   true,
+			elapsed: time.Hour,
+			msgs:    []sdk.Msg{sendFromGranter(10)},
+			valid:   false,
+		},
+		"signed by the grantee": {
+			msgs:  []sdk.Msg{bank.NewMsgSend(suite.grantee, suite.recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))},
+			valid: true,
+			sent:  10,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.grant {
+				grant := types.NewAuthorizationGrant(suite.granter, suite.grantee, types.NewSendAuthorization(sendLimit), expiration)
+				suite.Require().NoError(k.Grant(ctx, grant))
+			}
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.elapsed))
+
+			res, err := k.DispatchActions(ctx, suite.grantee, tc.msgs)
+			if !tc.valid {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NotEmpty(res.Events)
+			suite.Equal(sdk.NewInt(tc.sent), bk.GetBalance(ctx, suite.recipient, "atom").Amount)
+
+			if tc.grant {
+				authorization, _ := k.GetAuthorization(ctx, suite.granter, suite.grantee, "bank/send")
+				if tc.removed {
+					suite.Nil(authorization)
+				} else {
+					suite.Equal(types.NewSendAuthorization(tc.remains), authorization)
+				}
+			}
+		})
+	}
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// NewQuerier returns a new sdk.Querier for the authz module.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		var (
+			res []byte
+			err error
+		)
+
+		switch path[0] {
+		case types.QueryAuthorization:
+			res, err = queryAuthorization(ctx, req, k)
+
+		case types.QueryAuthorizations:
+			res, err = queryAuthorizations(ctx, req, k)
+
+		default:
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+
+		return res, err
+	}
+}
+
+func queryAuthorization(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAuthorizationParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	grant, found := k.GetGrant(ctx, params.Granter, params.Grantee, params.MsgType)
+	if !found || grant.IsExpired(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrNoAuthorization, "granter %s, grantee %s, msg type %s", params.Granter, params.Grantee, params.MsgType,
+		)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, grant)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAuthorizations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAuthorizationsParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	grants := []types.AuthorizationGrant{}
+	k.IterateGranterGranteeGrants(ctx, params.Granter, params.Grantee, func(grant types.AuthorizationGrant) bool {
+		if !grant.IsExpired(ctx.BlockTime()) {
+			grants = append(grants, grant)
+		}
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(k.cdc, grants)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,235 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgGrantAuthorization  = "op_weight_msg_grant_authorization"
+	OpWeightMsgRevokeAuthorization = "op_weight_msg_revoke_authorization"
+	OpWeightMsgExec                = "op_weight_msg_exec"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc *codec.Codec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var weightMsgGrantAuthorization int
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrantAuthorization, &weightMsgGrantAuthorization, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrantAuthorization = simappparams.DefaultWeightMsgGrantAuthorization
+		},
+	)
+
+	var weightMsgRevokeAuthorization int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeAuthorization, &weightMsgRevokeAuthorization, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeAuthorization = simappparams.DefaultWeightMsgRevokeAuthorization
+		},
+	)
+
+	var weightMsgExec int
+	appParams.GetOrGenerate(cdc, OpWeightMsgExec, &weightMsgExec, nil,
+		func(_ *rand.Rand) {
+			weightMsgExec = simappparams.DefaultWeightMsgExec
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgGrantAuthorization,
+			SimulateMsgGrantAuthorization(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokeAuthorization,
+			SimulateMsgRevokeAuthorization(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgExec,
+			SimulateMsgExec(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgGrantAuthorization generates a MsgGrantAuthorization with random
+// values, granting a send authorization limited to some of the granter's
+// coins.
+func SimulateMsgGrantAuthorization(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		granter, _ := simtypes.RandomAcc(r, accs)
+		grantee, _ := simtypes.RandomAcc(r, accs)
+		if granter.Address.Equals(grantee.Address) {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		spendLimit := simtypes.RandSubsetCoins(r, spendable)
+		if spendLimit.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		expiration := ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 365*24)) * time.Hour)
+		msg := types.NewMsgGrantAuthorization(
+			granter.Address, grantee.Address, types.NewSendAuthorization(spendLimit), expiration,
+		)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRevokeAuthorization generates a MsgRevokeAuthorization revoking
+// an existing authorization granted by a simulation account.
+func SimulateMsgRevokeAuthorization(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			grant   types.AuthorizationGrant
+			granter simtypes.Account
+			found   bool
+		)
+
+		k.IterateAllGrants(ctx, func(g types.AuthorizationGrant) bool {
+			granter, found = simtypes.FindAccount(accs, g.Granter)
+			grant = g
+			return found
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgRevokeAuthorization(grant.Granter, grant.Grantee, grant.Authorization.MsgType())
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgExec generates a MsgExec sending some of the coins of a granter,
+// under a send authorization it gave to a simulation account.
+func SimulateMsgExec(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			authorization *types.SendAuthorization
+			grant         types.AuthorizationGrant
+			grantee       simtypes.Account
+			found         bool
+		)
+
+		k.IterateAllGrants(ctx, func(g types.AuthorizationGrant) bool {
+			if g.IsExpired(ctx.BlockTime()) {
+				return false
+			}
+
+			authorization, found = g.Authorization.(*types.SendAuthorization)
+			if !found {
+				return false
+			}
+
+			grantee, found = simtypes.FindAccount(accs, g.Grantee)
+			grant = g
+			return found
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		amount := simtypes.RandSubsetCoins(r, authorization.SpendLimit)
+		if amount.Empty() || !bk.SpendableCoins(ctx, grant.Granter).IsAllGTE(amount) || bk.IsSendEnabledCoins(ctx, amount...) != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		recipient, _ := simtypes.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, grantee.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgExec(
+			grantee.Address, []sdk.Msg{banktypes.NewMsgSend(grant.Granter, recipient.Address, amount)},
+		)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			grantee.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/authz module sentinel errors
+var (
+	ErrNoAuthorization      = sdkerrors.Register(ModuleName, 2, "no authorization")
+	ErrAuthorizationExpired = sdkerrors.Register(ModuleName, 3, "authorization expired")
+	ErrSpendLimitExceeded   = sdkerrors.Register(ModuleName, 4, "spend limit exceeded")
+	ErrInvalidExpiration    = sdkerrors.Register(ModuleName, 5, "invalid expiration")
+	ErrInvalidMsgType       = sdkerrors.Register(ModuleName, 6, "invalid msg type")
+)
@@ -0,0 +1,14 @@
+package types
+
+// authz module event types
+const (
+	EventTypeGrantAuthorization  = "grant_authorization"
+	EventTypeRevokeAuthorization = "revoke_authorization"
+	EventTypeExecAuthorization   = "exec_authorization"
+
+	AttributeValueCategory = ModuleName
+
+	AttributeKeyGranter = "granter"
+	AttributeKeyGrantee = "grantee"
+	AttributeKeyMsgType = "msg_type"
+)
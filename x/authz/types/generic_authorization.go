@@ -0,0 +1,51 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+var _ exported.Authorization = (*GenericAuthorization)(nil)
+
+// MsgTypeOf returns the type of a msg as used by authorizations, i.e. its
+// route and its type joined by a slash, e.g. "gov/vote".
+func MsgTypeOf(msg sdk.Msg) string {
+	return msg.Route() + "/" + msg.Type()
+}
+
+// GenericAuthorization authorizes the execution of any msg of a given type,
+// without any limit.
+type GenericAuthorization struct {
+	MessageType string `json:"message_type" yaml:"message_type"`
+}
+
+// NewGenericAuthorization returns a new GenericAuthorization.
+func NewGenericAuthorization(msgType string) *GenericAuthorization {
+	return &GenericAuthorization{
+		MessageType: msgType,
+	}
+}
+
+// MsgType implements the Authorization interface.
+func (a GenericAuthorization) MsgType() string {
+	return a.MessageType
+}
+
+// Accept implements the Authorization interface. It accepts every msg.
+func (a *GenericAuthorization) Accept(_ sdk.Msg, _ time.Time, _ int64) (bool, error) {
+	return false, nil
+}
+
+// ValidateBasic implements the Authorization interface.
+func (a GenericAuthorization) ValidateBasic() error {
+	parts := strings.Split(a.MessageType, "/")
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return sdkerrors.Wrapf(ErrInvalidMsgType, "%q is not of the form route/type", a.MessageType)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState defines the authz module's genesis state.
+type GenesisState struct {
+	Authorizations []AuthorizationGrant `json:"authorizations" yaml:"authorizations"`
+}
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(authorizations []AuthorizationGrant) GenesisState {
+	return GenesisState{
+		Authorizations: authorizations,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state, without any
+// authorizations.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]AuthorizationGrant{})
+}
+
+// Validate performs basic genesis state validation, returning an error upon
+// any failure.
+func (gs GenesisState) Validate() error {
+	for i, grant := range gs.Authorizations {
+		if err := grant.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid authorization %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+// AuthorizationGrant is an authorization given by a granter to a grantee, as
+// it is stored by the keeper. A zero expiration never expires.
+type AuthorizationGrant struct {
+	Granter       sdk.AccAddress         `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress         `json:"grantee" yaml:"grantee"`
+	Authorization exported.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time              `json:"expiration" yaml:"expiration"`
+}
+
+// NewAuthorizationGrant returns a new AuthorizationGrant.
+func NewAuthorizationGrant(
+	granter, grantee sdk.AccAddress, authorization exported.Authorization, expiration time.Time,
+) AuthorizationGrant {
+	return AuthorizationGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// IsExpired returns true if the grant is expired at the given block time.
+func (g AuthorizationGrant) IsExpired(blockTime time.Time) bool {
+	return !g.Expiration.IsZero() && !blockTime.Before(g.Expiration)
+}
+
+// ValidateBasic performs basic validation of the grant and its authorization.
+func (g AuthorizationGrant) ValidateBasic() error {
+	if g.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if g.Grantee.Equals(g.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant authorization")
+	}
+	if g.Authorization == nil {
+		return sdkerrors.Wrap(ErrNoAuthorization, "missing authorization")
+	}
+
+	return g.Authorization.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (g AuthorizationGrant) String() string {
+	out, _ := yaml.Marshal(g)
+	return string(out)
+}
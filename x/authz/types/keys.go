@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "authz"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// GrantKeyPrefix is the prefix of the authorization grants, stored under
+	// their granter, their grantee and then the type of their msgs
+	GrantKeyPrefix = []byte{0x01}
+)
+
+// GrantKey returns the key of the authorization granted by granter to grantee
+// for the msgs of msgType: 0x01<granter><grantee><msgType>.
+func GrantKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GrantPrefixByGranterGrantee(granter, grantee), []byte(msgType)...)
+}
+
+// GrantPrefixByGranterGrantee returns the prefix of all the authorizations
+// granted by granter to grantee.
+func GrantPrefixByGranterGrantee(granter, grantee sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(GrantKeyPrefix)+len(granter)+len(grantee))
+	key = append(key, GrantKeyPrefix...)
+	key = append(key, granter.Bytes()...)
+	return append(key, grantee.Bytes()...)
+}
@@ -0,0 +1,184 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+// authz message types
+const (
+	TypeMsgGrantAuthorization  = "grant_authorization"
+	TypeMsgRevokeAuthorization = "revoke_authorization"
+	TypeMsgExec                = "exec"
+)
+
+var (
+	_ sdk.Msg = MsgGrantAuthorization{}
+	_ sdk.Msg = MsgRevokeAuthorization{}
+	_ sdk.Msg = MsgExec{}
+)
+
+// MsgGrantAuthorization grants an authorization from the granter to the
+// grantee, replacing any authorization the granter already gave to the
+// grantee for the same msg type.
+type MsgGrantAuthorization struct {
+	Granter       sdk.AccAddress         `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress         `json:"grantee" yaml:"grantee"`
+	Authorization exported.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time              `json:"expiration" yaml:"expiration"`
+}
+
+// NewMsgGrantAuthorization returns a new MsgGrantAuthorization.
+func NewMsgGrantAuthorization(
+	granter, grantee sdk.AccAddress, authorization exported.Authorization, expiration time.Time,
+) MsgGrantAuthorization {
+	return MsgGrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) Type() string { return TypeMsgGrantAuthorization }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) ValidateBasic() error {
+	return msg.Grant().ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. The granter signs the msg.
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// Grant returns the grant created by the msg.
+func (msg MsgGrantAuthorization) Grant() AuthorizationGrant {
+	return NewAuthorizationGrant(msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration)
+}
+
+// MsgRevokeAuthorization revokes the authorization given by the granter to the
+// grantee for the msgs of a given type.
+type MsgRevokeAuthorization struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgType string         `json:"msg_type" yaml:"msg_type"`
+}
+
+// NewMsgRevokeAuthorization returns a new MsgRevokeAuthorization.
+func NewMsgRevokeAuthorization(granter, grantee sdk.AccAddress, msgType string) MsgRevokeAuthorization {
+	return MsgRevokeAuthorization{
+		Granter: granter,
+		Grantee: grantee,
+		MsgType: msgType,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) Type() string { return TypeMsgRevokeAuthorization }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	return NewGenericAuthorization(msg.MsgType).ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. The granter signs the msg.
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgExec executes msgs on behalf of their signers, under the authorizations
+// these gave to the grantee. Each msg must have a single signer.
+type MsgExec struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs" yaml:"msgs"`
+}
+
+// NewMsgExec returns a new MsgExec.
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
+	return MsgExec{
+		Grantee: grantee,
+		Msgs:    msgs,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// ValidateBasic implements the sdk.Msg interface. It validates the inner msgs
+// as well.
+func (msg MsgExec) ValidateBasic() error {
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no msgs to execute")
+	}
+
+	for i, m := range msg.Msgs {
+		if len(m.GetSigners()) != 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "msg %d must have a single signer", i)
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. The inner msgs are signed
+// with their own sign bytes, so that they do not need to be registered on the
+// module codec.
+func (msg MsgExec) GetSignBytes() []byte {
+	msgs := make([]json.RawMessage, len(msg.Msgs))
+	for i, m := range msg.Msgs {
+		msgs[i] = m.GetSignBytes()
+	}
+
+	bz, err := json.Marshal(struct {
+		Grantee sdk.AccAddress    `json:"grantee"`
+		Msgs    []json.RawMessage `json:"msgs"`
+	}{msg.Grantee, msgs})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements the sdk.Msg interface. The grantee signs the msg.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
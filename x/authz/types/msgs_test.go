@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	expiration := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		msg   types.MsgGrantAuthorization
+		valid bool
+	}{
+		"valid":               {types.NewMsgGrantAuthorization(granterAddr, granteeAddr, authorization, expiration), true},
+		"no expiration":       {types.NewMsgGrantAuthorization(granterAddr, granteeAddr, authorization, time.Time{}), true},
+		"missing granter":     {types.NewMsgGrantAuthorization(nil, granteeAddr, authorization, expiration), false},
+		"missing grantee":     {types.NewMsgGrantAuthorization(granterAddr, nil, authorization, expiration), false},
+		"self grant":          {types.NewMsgGrantAuthorization(granterAddr, granterAddr, authorization, expiration), false},
+		"no authorization":    {types.NewMsgGrantAuthorization(granterAddr, granteeAddr, nil, expiration), false},
+		"invalid spend limit": {types.NewMsgGrantAuthorization(granterAddr, granteeAddr, types.NewSendAuthorization(nil), expiration), false},
+	}
+
+	for name, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, name)
+			require.Equal(t, []sdk.AccAddress{granterAddr}, tc.msg.GetSigners(), name)
+		} else {
+			require.Error(t, err, name)
+		}
+	}
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	require.NoError(t, types.NewMsgRevokeAuthorization(granterAddr, granteeAddr, "bank/send").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeAuthorization(nil, granteeAddr, "bank/send").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeAuthorization(granterAddr, nil, "bank/send").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeAuthorization(granterAddr, granteeAddr, "send").ValidateBasic())
+}
+
+func TestMsgExec(t *testing.T) {
+	send := bank.NewMsgSend(granterAddr, granteeAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	multiSend := bank.NewMsgMultiSend(
+		[]bank.Input{
+			bank.NewInput(granterAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 5))),
+			bank.NewInput(granteeAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 5))),
+		},
+		[]bank.Output{bank.NewOutput(granteeAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))},
+	)
+
+	msg := types.NewMsgExec(granteeAddr, []sdk.Msg{send})
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{granteeAddr}, msg.GetSigners())
+
+	require.Error(t, types.NewMsgExec(nil, []sdk.Msg{send}).ValidateBasic())
+	require.Error(t, types.NewMsgExec(granteeAddr, nil).ValidateBasic())
+	require.Error(t, types.NewMsgExec(granteeAddr, []sdk.Msg{bank.NewMsgSend(granterAddr, granteeAddr, nil)}).ValidateBasic())
+	require.Error(t, types.NewMsgExec(granteeAddr, []sdk.Msg{multiSend}).ValidateBasic())
+
+	// the sign bytes embed those of the inner msgs
+	require.Equal(t,
+		`{"grantee":"`+granteeAddr.String()+`","msgs":[`+string(send.GetSignBytes())+`]}`,
+		string(msg.GetSignBytes()),
+	)
+}
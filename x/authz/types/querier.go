@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier routes for the authz module
+const (
+	QueryAuthorization  = "authorization"
+	QueryAuthorizations = "authorizations"
+)
+
+// QueryAuthorizationParams defines the parameters for querying the authorization
+// given by a granter to a grantee for a msg type.
+type QueryAuthorizationParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgType string         `json:"msg_type" yaml:"msg_type"`
+}
+
+// NewQueryAuthorizationParams returns a new QueryAuthorizationParams.
+func NewQueryAuthorizationParams(granter, grantee sdk.AccAddress, msgType string) QueryAuthorizationParams {
+	return QueryAuthorizationParams{Granter: granter, Grantee: grantee, MsgType: msgType}
+}
+
+// QueryAuthorizationsParams defines the parameters for querying all the
+// authorizations given by a granter to a grantee.
+type QueryAuthorizationsParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryAuthorizationsParams returns a new QueryAuthorizationsParams.
+func NewQueryAuthorizationsParams(granter, grantee sdk.AccAddress) QueryAuthorizationsParams {
+	return QueryAuthorizationsParams{Granter: granter, Grantee: grantee}
+}
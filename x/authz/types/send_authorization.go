@@ -0,0 +1,61 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ exported.Authorization = (*SendAuthorization)(nil)
+
+// SendAuthorization authorizes the execution of bank MsgSends, up to a total
+// amount of coins sent. The authorization is removed once it is exhausted.
+type SendAuthorization struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+}
+
+// NewSendAuthorization returns a new SendAuthorization.
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgType implements the Authorization interface.
+func (a SendAuthorization) MsgType() string {
+	return MsgTypeOf(bank.MsgSend{})
+}
+
+// Accept implements the Authorization interface. It deducts the amount sent
+// from the spend limit.
+func (a *SendAuthorization) Accept(msg sdk.Msg, _ time.Time, _ int64) (bool, error) {
+	var amount sdk.Coins
+	switch msg := msg.(type) {
+	case bank.MsgSend:
+		amount = msg.Amount
+	case *bank.MsgSend:
+		amount = msg.Amount
+	default:
+		return false, sdkerrors.Wrapf(ErrInvalidMsgType, "expected %s, got %s", a.MsgType(), MsgTypeOf(msg))
+	}
+
+	left, invalid := a.SpendLimit.SafeSub(amount)
+	if invalid {
+		return false, sdkerrors.Wrapf(ErrSpendLimitExceeded, "%s left", a.SpendLimit)
+	}
+
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// ValidateBasic implements the Authorization interface.
+func (a SendAuthorization) ValidateBasic() error {
+	if a.SpendLimit.Empty() || !a.SpendLimit.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit: %s", a.SpendLimit)
+	}
+
+	return nil
+}
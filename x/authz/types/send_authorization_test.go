@@ -0,0 +1,117 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	granterAddr = sdk.AccAddress([]byte("granter_____________"))
+	granteeAddr = sdk.AccAddress([]byte("grantee_____________"))
+)
+
+func TestSendAuthorization(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+
+	cases := map[string]struct {
+		authorization *types.SendAuthorization
+		valid         bool
+		// all below checks are ignored if invalid
+		msg     sdk.Msg
+		accept  bool
+		remove  bool
+		remains sdk.Coins
+	}{
+		"empty": {
+			authorization: types.NewSendAuthorization(nil),
+			valid:         false,
+		},
+		"invalid limit": {
+			authorization: types.NewSendAuthorization(sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-5)}}),
+			valid:         false,
+		},
+		"send less": {
+			authorization: types.NewSendAuthorization(atom),
+			valid:         true,
+			msg:           bank.NewMsgSend(granterAddr, granteeAddr, smallAtom),
+			accept:        true,
+			remains:       leftAtom,
+		},
+		"send all": {
+			authorization: types.NewSendAuthorization(atom),
+			valid:         true,
+			msg:           bank.NewMsgSend(granterAddr, granteeAddr, atom),
+			accept:        true,
+			remove:        true,
+		},
+		"send more": {
+			authorization: types.NewSendAuthorization(smallAtom),
+			valid:         true,
+			msg:           bank.NewMsgSend(granterAddr, granteeAddr, atom),
+			accept:        false,
+		},
+		"send other denom": {
+			authorization: types.NewSendAuthorization(atom),
+			valid:         true,
+			msg:           bank.NewMsgSend(granterAddr, granteeAddr, eth),
+			accept:        false,
+		},
+		"other msg": {
+			authorization: types.NewSendAuthorization(atom),
+			valid:         true,
+			msg:           gov.NewMsgVote(granterAddr, 1, gov.OptionYes),
+			accept:        false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "bank/send", tc.authorization.MsgType())
+
+			remove, err := tc.authorization.Accept(tc.msg, time.Now(), 10)
+			if !tc.accept {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.remove, remove)
+
+			if tc.accept && !tc.remove {
+				require.Equal(t, tc.remains, tc.authorization.SpendLimit)
+			}
+		})
+	}
+}
+
+func TestGenericAuthorization(t *testing.T) {
+	vote := gov.NewMsgVote(granterAddr, 1, gov.OptionYes)
+
+	authorization := types.NewGenericAuthorization(types.MsgTypeOf(vote))
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "gov/vote", authorization.MsgType())
+
+	remove, err := authorization.Accept(vote, time.Now(), 10)
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	for _, msgType := range []string{"", "gov", "gov/", "/vote", "gov/vote/yes"} {
+		require.Error(t, types.NewGenericAuthorization(msgType).ValidateBasic(), msgType)
+	}
+}
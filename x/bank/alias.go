@@ -3,19 +3,23 @@ package bank
 // nolint
 
 import (
+	"github.com/cosmos/cosmos-sdk/x/bank/client"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 const (
-	QueryBalance       = types.QueryBalance
-	QueryAllBalances   = types.QueryAllBalances
-	ModuleName         = types.ModuleName
-	QuerierRoute       = types.QuerierRoute
-	RouterKey          = types.RouterKey
-	StoreKey           = types.StoreKey
-	DefaultParamspace  = types.DefaultParamspace
-	DefaultSendEnabled = types.DefaultSendEnabled
+	QueryBalance                 = types.QueryBalance
+	QueryAllBalances             = types.QueryAllBalances
+	QueryDenomMetadata           = types.QueryDenomMetadata
+	QueryDenomsMetadata          = types.QueryDenomsMetadata
+	ModuleName                   = types.ModuleName
+	QuerierRoute                 = types.QuerierRoute
+	RouterKey                    = types.RouterKey
+	StoreKey                     = types.StoreKey
+	DefaultParamspace            = types.DefaultParamspace
+	DefaultDefaultSendEnabled    = types.DefaultDefaultSendEnabled
+	ProposalTypeSetDenomMetadata = types.ProposalTypeSetDenomMetadata
 
 	EventTypeTransfer      = types.EventTypeTransfer
 	AttributeKeyRecipient  = types.AttributeKeyRecipient
@@ -24,50 +28,81 @@ const (
 )
 
 var (
-	RegisterInvariants          = keeper.RegisterInvariants
-	NonnegativeBalanceInvariant = keeper.NonnegativeBalanceInvariant
-	NewBaseKeeper               = keeper.NewBaseKeeper
-	NewBaseSendKeeper           = keeper.NewBaseSendKeeper
-	NewBaseViewKeeper           = keeper.NewBaseViewKeeper
-	NewQuerier                  = keeper.NewQuerier
-	RegisterCodec               = types.RegisterCodec
-	ErrNoInputs                 = types.ErrNoInputs
-	ErrNoOutputs                = types.ErrNoOutputs
-	ErrInputOutputMismatch      = types.ErrInputOutputMismatch
-	ErrSendDisabled             = types.ErrSendDisabled
-	NewGenesisState             = types.NewGenesisState
-	DefaultGenesisState         = types.DefaultGenesisState
-	ValidateGenesis             = types.ValidateGenesis
-	SanitizeGenesisBalances     = types.SanitizeGenesisBalances
-	GetGenesisStateFromAppState = types.GetGenesisStateFromAppState
-	NewMsgSend                  = types.NewMsgSend
-	NewMsgMultiSend             = types.NewMsgMultiSend
-	NewInput                    = types.NewInput
-	NewOutput                   = types.NewOutput
-	ValidateInputsOutputs       = types.ValidateInputsOutputs
-	ParamKeyTable               = types.ParamKeyTable
-	NewQueryBalanceParams       = types.NewQueryBalanceParams
-	NewQueryAllBalancesParams   = types.NewQueryAllBalancesParams
-	ModuleCdc                   = types.ModuleCdc
-	ParamStoreKeySendEnabled    = types.ParamStoreKeySendEnabled
-	BalancesPrefix              = types.BalancesPrefix
-	AddressFromBalancesStore    = types.AddressFromBalancesStore
+	RegisterInvariants             = keeper.RegisterInvariants
+	NonnegativeBalanceInvariant    = keeper.NonnegativeBalanceInvariant
+	NewBaseKeeper                  = keeper.NewBaseKeeper
+	NewBaseSendKeeper              = keeper.NewBaseSendKeeper
+	NewBaseViewKeeper              = keeper.NewBaseViewKeeper
+	NewQuerier                     = keeper.NewQuerier
+	NewQueryServer                 = keeper.NewQueryServer
+	NewMsgServerImpl               = keeper.NewMsgServerImpl
+	RegisterCodec                  = types.RegisterCodec
+	RegisterQueryService           = types.RegisterQueryService
+	RegisterMsgService             = types.RegisterMsgService
+	ErrNoInputs                    = types.ErrNoInputs
+	ErrNoOutputs                   = types.ErrNoOutputs
+	ErrInputOutputMismatch         = types.ErrInputOutputMismatch
+	ErrSendDisabled                = types.ErrSendDisabled
+	ErrInvalidDenomMetadata        = types.ErrInvalidDenomMetadata
+	ErrDenomMetadataNotFound       = types.ErrDenomMetadataNotFound
+	NewGenesisState                = types.NewGenesisState
+	DefaultGenesisState            = types.DefaultGenesisState
+	ValidateGenesis                = types.ValidateGenesis
+	SanitizeGenesisBalances        = types.SanitizeGenesisBalances
+	GetGenesisStateFromAppState    = types.GetGenesisStateFromAppState
+	NewMsgSend                     = types.NewMsgSend
+	NewMsgMultiSend                = types.NewMsgMultiSend
+	NewInput                       = types.NewInput
+	NewOutput                      = types.NewOutput
+	ValidateInputsOutputs          = types.ValidateInputsOutputs
+	ParamKeyTable                  = types.ParamKeyTable
+	NewQueryBalanceParams          = types.NewQueryBalanceParams
+	NewQueryAllBalancesParams      = types.NewQueryAllBalancesParams
+	NewQueryDenomMetadataParams    = types.NewQueryDenomMetadataParams
+	NewQueryDenomsMetadataParams   = types.NewQueryDenomsMetadataParams
+	NewParams                      = types.NewParams
+	DefaultParams                  = types.DefaultParams
+	NewSendEnabled                 = types.NewSendEnabled
+	NewDenomUnit                   = types.NewDenomUnit
+	NewMetadata                    = types.NewMetadata
+	ConvertDecCoinsToBase          = types.ConvertDecCoinsToBase
+	NewSetDenomMetadataProposal    = types.NewSetDenomMetadataProposal
+	HandleSetDenomMetadataProposal = keeper.HandleSetDenomMetadataProposal
+	ModuleCdc                      = types.ModuleCdc
+	KeySendEnabled                 = types.KeySendEnabled
+	KeyDefaultSendEnabled          = types.KeyDefaultSendEnabled
+	BalancesPrefix                 = types.BalancesPrefix
+	DenomMetadataPrefix            = types.DenomMetadataPrefix
+	ProposalHandler                = client.ProposalHandler
+	AddressFromBalancesStore       = types.AddressFromBalancesStore
+	RegisterTextualRenderers       = types.RegisterTextualRenderers
+	FormatCoins                    = types.FormatCoins
+	FormatCoin                     = types.FormatCoin
+	NewDenomMetadataQueryFn        = keeper.NewDenomMetadataQueryFn
 )
 
 type (
-	Keeper                  = keeper.Keeper
-	BaseKeeper              = keeper.BaseKeeper
-	SendKeeper              = keeper.SendKeeper
-	BaseSendKeeper          = keeper.BaseSendKeeper
-	ViewKeeper              = keeper.ViewKeeper
-	BaseViewKeeper          = keeper.BaseViewKeeper
-	GenesisState            = types.GenesisState
-	Balance                 = types.Balance
-	MsgSend                 = types.MsgSend
-	MsgMultiSend            = types.MsgMultiSend
-	Input                   = types.Input
-	Output                  = types.Output
-	QueryBalanceParams      = types.QueryBalanceParams
-	QueryAllBalancesParams  = types.QueryAllBalancesParams
-	GenesisBalancesIterator = types.GenesisBalancesIterator
+	Keeper                    = keeper.Keeper
+	BaseKeeper                = keeper.BaseKeeper
+	SendKeeper                = keeper.SendKeeper
+	BaseSendKeeper            = keeper.BaseSendKeeper
+	ViewKeeper                = keeper.ViewKeeper
+	BaseViewKeeper            = keeper.BaseViewKeeper
+	GenesisState              = types.GenesisState
+	Balance                   = types.Balance
+	MsgSend                   = types.MsgSend
+	MsgMultiSend              = types.MsgMultiSend
+	Input                     = types.Input
+	Output                    = types.Output
+	Params                    = types.Params
+	SendEnabled               = types.SendEnabled
+	QueryBalanceParams        = types.QueryBalanceParams
+	QueryAllBalancesParams    = types.QueryAllBalancesParams
+	QueryDenomMetadataParams  = types.QueryDenomMetadataParams
+	QueryDenomsMetadataParams = types.QueryDenomsMetadataParams
+	DenomUnit                 = types.DenomUnit
+	Metadata                  = types.Metadata
+	SetDenomMetadataProposal  = types.SetDenomMetadataProposal
+	GenesisBalancesIterator   = types.GenesisBalancesIterator
+	DenomMetadataQueryFn      = types.DenomMetadataQueryFn
 )
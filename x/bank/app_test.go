@@ -124,6 +124,36 @@ func TestSendNotEnoughBalance(t *testing.T) {
 	require.Equal(t, res2.GetSequence(), origSeq+1)
 }
 
+func TestSendDisabledDenom(t *testing.T) {
+	acc := &auth.BaseAccount{
+		Address: addr1,
+	}
+
+	genAccs := []authexported.GenesisAccount{acc}
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	err := app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 67), sdk.NewInt64Coin("barcoin", 67)))
+	require.NoError(t, err)
+	app.BankKeeper.SetParams(ctx, types.NewParams(true, types.NewSendEnabled("foocoin", false)))
+
+	app.Commit()
+
+	sendMsg := types.NewMsgSend(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("barcoin", 10), sdk.NewInt64Coin("foocoin", 10)})
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = simapp.SignCheckDeliver(t, app.Codec(), app.BaseApp, header, []sdk.Msg{sendMsg}, []uint64{0}, []uint64{0}, false, false, priv1)
+	require.True(t, types.ErrSendDisabled.Is(err))
+
+	simapp.CheckBalance(t, app, addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 67), sdk.NewInt64Coin("barcoin", 67)))
+
+	sendMsg = types.NewMsgSend(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("barcoin", 10)})
+	header = abci.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = simapp.SignCheckDeliver(t, app.Codec(), app.BaseApp, header, []sdk.Msg{sendMsg}, []uint64{0}, []uint64{1}, true, true, priv1)
+	require.NoError(t, err)
+
+	simapp.CheckBalance(t, app, addr2, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 10)))
+}
+
 // A module account cannot be the recipient of bank sends unless it has been marked as such
 func TestSendToModuleAcc(t *testing.T) {
 	tests := []struct {
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -28,7 +29,7 @@ func NewQueryCmd(m codec.Marshaler) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(NewBalancesCmd(m))
+	cmd.AddCommand(NewBalancesCmd(m), NewDenomsMetadataCmd(m))
 
 	return cmd
 }
@@ -55,7 +56,12 @@ func NewBalancesCmd(m codec.Marshaler) *cobra.Command {
 
 			denom := viper.GetString(flagDenom)
 			if denom == "" {
-				params = types.NewQueryAllBalancesParams(addr)
+				pageReq, err := client.ReadPageRequest()
+				if err != nil {
+					return err
+				}
+
+				params = types.NewQueryAllBalancesParams(addr, pageReq)
 				route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllBalances)
 			} else {
 				params = types.NewQueryBalanceParams(addr, denom)
@@ -73,7 +79,7 @@ func NewBalancesCmd(m codec.Marshaler) *cobra.Command {
 			}
 
 			if denom == "" {
-				var balances sdk.Coins
+				var balances types.QueryAllBalancesResponse
 				if err := m.UnmarshalJSON(res, &balances); err != nil {
 					return err
 				}
@@ -93,6 +99,32 @@ func NewBalancesCmd(m codec.Marshaler) *cobra.Command {
 	}
 
 	cmd.Flags().String(flagDenom, "", "The specific balance denomination to query for")
+	flags.AddPaginationFlagsToCmd(cmd, "all balances")
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// NewDenomsMetadataCmd returns a CLI command handler for querying the metadata
+// of a single or all the base denominations.
+func NewDenomsMetadataCmd(m codec.Marshaler) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-metadata",
+		Short: "Query the metadata of a single or all the base denominations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithMarshaler(m)
+
+			result, err := queryDenomsMetadata(cliCtx, m)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.Println(result)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "The specific base denomination to query the metadata of")
+	flags.AddPaginationFlagsToCmd(cmd, "denoms metadata")
 
 	return flags.GetCommands(cmd)[0]
 }
@@ -116,7 +148,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(GetBalancesCmd(cdc))
+	cmd.AddCommand(GetBalancesCmd(cdc), GetDenomsMetadataCmd(cdc))
 
 	return cmd
 }
@@ -147,7 +179,12 @@ func GetBalancesCmd(cdc *codec.Codec) *cobra.Command {
 
 			denom := viper.GetString(flagDenom)
 			if denom == "" {
-				params = types.NewQueryAllBalancesParams(addr)
+				pageReq, err := client.ReadPageRequest()
+				if err != nil {
+					return err
+				}
+
+				params = types.NewQueryAllBalancesParams(addr, pageReq)
 				route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllBalances)
 			} else {
 				params = types.NewQueryBalanceParams(addr, denom)
@@ -165,7 +202,7 @@ func GetBalancesCmd(cdc *codec.Codec) *cobra.Command {
 			}
 
 			if denom == "" {
-				var balances sdk.Coins
+				var balances types.QueryAllBalancesResponse
 				if err := cdc.UnmarshalJSON(res, &balances); err != nil {
 					return err
 				}
@@ -185,6 +222,68 @@ func GetBalancesCmd(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().String(flagDenom, "", "The specific balance denomination to query for")
+	flags.AddPaginationFlagsToCmd(cmd, "all balances")
 
 	return flags.GetCommands(cmd)[0]
 }
+
+// GetDenomsMetadataCmd returns a CLI command handler for querying the metadata
+// of a single or all the base denominations.
+//
+// TODO: Remove once client-side Protobuf migration has been completed.
+// ref: https://github.com/cosmos/cosmos-sdk/issues/5864
+func GetDenomsMetadataCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-metadata",
+		Short: "Query the metadata of a single or all the base denominations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			result, err := queryDenomsMetadata(cliCtx, cdc)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "The specific base denomination to query the metadata of")
+	flags.AddPaginationFlagsToCmd(cmd, "denoms metadata")
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// queryDenomsMetadata queries the metadata of the base denomination given by
+// the denom flag or, if it is not set, the page of the metadata of all the
+// base denominations given by the pagination flags.
+func queryDenomsMetadata(cliCtx context.CLIContext, m codec.JSONMarshaler) (interface{}, error) {
+	denom := viper.GetString(flagDenom)
+	if denom == "" {
+		pageReq, err := client.ReadPageRequest()
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.QueryDenomsMetadata(cliCtx, pageReq)
+	}
+
+	bz, err := m.MarshalJSON(types.NewQueryDenomMetadataParams(denom))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomMetadata)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata types.Metadata
+	if err := m.UnmarshalJSON(res, &metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
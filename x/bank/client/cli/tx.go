@@ -2,6 +2,8 @@ package cli
 
 import (
 	"bufio"
+	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -11,9 +13,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/bank/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 )
 
 // NewTxCmd returns a root CLI command handler for all x/bank transaction commands.
@@ -47,7 +52,7 @@ func NewSendTxCmd(m codec.Marshaler, txg tx.Generator, ar tx.AccountRetriever) *
 				return err
 			}
 
-			coins, err := sdk.ParseCoins(args[2])
+			coins, err := utils.ParseCoins(cliCtx, args[2])
 			if err != nil {
 				return err
 			}
@@ -108,7 +113,7 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 			}
 
 			// parse coins trying to be sent
-			coins, err := sdk.ParseCoins(args[2])
+			coins, err := utils.ParseCoins(cliCtx, args[2])
 			if err != nil {
 				return err
 			}
@@ -123,3 +128,69 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdSubmitProposal implements the command to submit a set-denom-metadata proposal
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a set denom metadata proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to set the metadata of a base denomination along with an
+initial deposit. The proposal details must be supplied via a JSON file. The units
+must be sorted by ascending exponent, starting with the base denomination.
+
+Example:
+$ %s tx gov submit-proposal set-denom-metadata <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Atom Metadata",
+  "description": "Set the display units of the uatom denomination",
+  "metadata": {
+    "description": "The native staking token of the Cosmos Hub",
+    "denom_units": [
+      {"denom": "uatom", "exponent": 0, "aliases": ["microatom"]},
+      {"denom": "matom", "exponent": 3, "aliases": ["milliatom"]},
+      {"denom": "atom", "exponent": 6}
+    ],
+    "base": "uatom",
+    "display": "atom"
+  },
+  "deposit": "1000stake",
+  "expedited": false
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := ParseSetDenomMetadataProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewSetDenomMetadataProposal(proposal.Title, proposal.Description, proposal.Metadata)
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+			msg := gov.NewMsgSubmitProposal(content, deposit, from)
+			msg.Expedited = proposal.Expedited
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -48,7 +48,12 @@ func QueryBalancesRequestHandlerFn(ctx context.CLIContext) http.HandlerFunc {
 
 		denom := r.FormValue("denom")
 		if denom == "" {
-			params = types.NewQueryAllBalancesParams(addr)
+			pageReq, err := rest.ParsePageRequest(r)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			params = types.NewQueryAllBalancesParams(addr, pageReq)
 			route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllBalances)
 		} else {
 			params = types.NewQueryBalanceParams(addr, denom)
@@ -69,3 +74,65 @@ func QueryBalancesRequestHandlerFn(ctx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, ctx, res)
 	}
 }
+
+// QueryDenomsMetadataRequestHandlerFn returns a REST handler that queries for
+// the metadata of all the base denominations.
+func QueryDenomsMetadataRequestHandlerFn(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		ctx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		pageReq, err := rest.ParsePageRequest(r)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		queryDenomsMetadata(w, ctx, types.QueryDenomsMetadata, types.NewQueryDenomsMetadataParams(pageReq))
+	}
+}
+
+// QueryDenomMetadataRequestHandlerFn returns a REST handler that queries for
+// the metadata of a base denomination.
+func QueryDenomMetadataRequestHandlerFn(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		denom := mux.Vars(r)["denom"]
+
+		ctx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		queryDenomsMetadata(w, ctx, types.QueryDenomMetadata, types.NewQueryDenomMetadataParams(denom))
+	}
+}
+
+func queryDenomsMetadata(w http.ResponseWriter, ctx context.CLIContext, path string, params interface{}) {
+	// TODO: Remove once client-side Protobuf migration has been completed.
+	// ref: https://github.com/cosmos/cosmos-sdk/issues/5864
+	var marshaler codec.JSONMarshaler
+
+	if ctx.Marshaler != nil {
+		marshaler = ctx.Marshaler
+	} else {
+		marshaler = ctx.Codec
+	}
+
+	bz, err := marshaler.MarshalJSON(params)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+	res, height, err := ctx.QueryWithData(route, bz)
+	if rest.CheckInternalServerError(w, err) {
+		return
+	}
+
+	ctx = ctx.WithHeight(height)
+	rest.PostProcessResponse(w, ctx, res)
+}
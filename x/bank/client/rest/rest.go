@@ -13,6 +13,8 @@ import (
 func RegisterHandlers(ctx context.CLIContext, m codec.Marshaler, txg tx.Generator, r *mux.Router) {
 	r.HandleFunc("/bank/accounts/{address}/transfers", NewSendRequestHandlerFn(ctx, m, txg)).Methods("POST")
 	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(ctx)).Methods("GET")
+	r.HandleFunc("/bank/denoms_metadata", QueryDenomsMetadataRequestHandlerFn(ctx)).Methods("GET")
+	r.HandleFunc("/bank/denoms_metadata/{denom}", QueryDenomMetadataRequestHandlerFn(ctx)).Methods("GET")
 }
 
 // ---------------------------------------------------------------------------
@@ -25,4 +27,6 @@ func RegisterHandlers(ctx context.CLIContext, m codec.Marshaler, txg tx.Generato
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/bank/accounts/{address}/transfers", SendRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/denoms_metadata", QueryDenomsMetadataRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/denoms_metadata/{denom}", QueryDenomMetadataRequestHandlerFn(cliCtx)).Methods("GET")
 }
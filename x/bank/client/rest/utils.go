@@ -0,0 +1,21 @@
+package rest
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type (
+	// SetDenomMetadataProposalReq defines a set denom metadata proposal request body.
+	SetDenomMetadataProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Metadata    types.Metadata `json:"metadata" yaml:"metadata"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Expedited   bool           `json:"expedited" yaml:"expedited"`
+	}
+)
@@ -0,0 +1,152 @@
+package utils
+
+import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// QueryDenomsMetadata returns a page of the metadata of all the base
+// denominations.
+func QueryDenomsMetadata(cliCtx context.CLIContext, pagination *query.PageRequest) (types.QueryDenomsMetadataResponse, error) {
+	marshaler := jsonMarshaler(cliCtx)
+
+	bz, err := marshaler.MarshalJSON(types.NewQueryDenomsMetadataParams(pagination))
+	if err != nil {
+		return types.QueryDenomsMetadataResponse{}, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomsMetadata)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.QueryDenomsMetadataResponse{}, err
+	}
+
+	var metadata types.QueryDenomsMetadataResponse
+	if err := marshaler.UnmarshalJSON(res, &metadata); err != nil {
+		return types.QueryDenomsMetadataResponse{}, fmt.Errorf("failed to unmarshal denoms metadata: %w", err)
+	}
+
+	return metadata, nil
+}
+
+// QueryAllDenomsMetadata returns the metadata of all the base denominations,
+// going through all the pages of the query.
+func QueryAllDenomsMetadata(cliCtx context.CLIContext) ([]types.Metadata, error) {
+	var (
+		metadata []types.Metadata
+		pageReq  = &query.PageRequest{}
+	)
+
+	for {
+		res, err := QueryDenomsMetadata(cliCtx, pageReq)
+		if err != nil {
+			return nil, err
+		}
+
+		metadata = append(metadata, res.Metadatas...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return metadata, nil
+		}
+
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// ParseCoins parses coins which may be expressed in any unit of a denomination
+// with metadata, e.g. "1.5atom", and converts them to coins of their base
+// denomination, e.g. "1500000uatom". The coins of a denomination which is
+// neither a unit nor an alias of the metadata are kept as is. The metadata is
+// queried from the node, so the coins must be expressed in their base
+// denomination in offline mode.
+func ParseCoins(cliCtx context.CLIContext, coinsStr string) (sdk.Coins, error) {
+	coinsStr = strings.TrimSpace(coinsStr)
+	if cliCtx.Offline || len(coinsStr) == 0 {
+		return sdk.ParseCoins(coinsStr)
+	}
+
+	var decCoins sdk.DecCoins
+	for _, coinStr := range strings.Split(coinsStr, ",") {
+		// the amount is either a whole or a decimal number
+		if coin, err := sdk.ParseCoin(coinStr); err == nil {
+			decCoins = append(decCoins, sdk.NewDecCoinFromCoin(coin))
+			continue
+		}
+
+		decCoin, err := sdk.ParseDecCoin(coinStr)
+		if err != nil {
+			return nil, err
+		}
+		decCoins = append(decCoins, decCoin)
+	}
+
+	metadata, err := QueryAllDenomsMetadata(cliCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the denoms metadata: %w", err)
+	}
+
+	return types.ConvertDecCoinsToBase(decCoins, metadata)
+}
+
+// ParseCoin parses a single coin which may be expressed in any unit of a
+// denomination with metadata, as ParseCoins does.
+func ParseCoin(cliCtx context.CLIContext, coinStr string) (sdk.Coin, error) {
+	coins, err := ParseCoins(cliCtx, coinStr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if len(coins) != 1 {
+		return sdk.Coin{}, fmt.Errorf("expected a single coin, got: %s", coinStr)
+	}
+
+	return coins[0], nil
+}
+
+// jsonMarshaler returns the JSON marshaler of the context, falling back to its
+// amino codec.
+//
+// TODO: Remove once client-side Protobuf migration has been completed.
+// ref: https://github.com/cosmos/cosmos-sdk/issues/5864
+func jsonMarshaler(cliCtx context.CLIContext) codec.JSONMarshaler {
+	if cliCtx.Marshaler != nil {
+		return cliCtx.Marshaler
+	}
+
+	return cliCtx.Codec
+}
+
+// NewDenomMetadataQueryFn returns a DenomMetadataQueryFn querying the metadata
+// from the node, for clients to sign in SIGN_MODE_TEXTUAL with the renderers of
+// the bank module. As the query is set up along with the tx commands, before
+// their flags are parsed, the CLIContext of the node is made from the flags
+// when querying. The context it is given is ignored, and the metadata cannot
+// be queried in offline mode.
+func NewDenomMetadataQueryFn(m codec.Marshaler) types.DenomMetadataQueryFn {
+	return func(_ gocontext.Context, denom string) (types.Metadata, bool, error) {
+		cliCtx := context.NewCLIContext().WithMarshaler(m)
+		if cliCtx.Offline {
+			return types.Metadata{}, false, errors.New("cannot query the denomination metadata in offline mode")
+		}
+
+		metadata, err := QueryAllDenomsMetadata(cliCtx)
+		if err != nil {
+			return types.Metadata{}, false, err
+		}
+
+		for _, m := range metadata {
+			if m.Base == denom {
+				return m, true, nil
+			}
+		}
+
+		return types.Metadata{}, false, nil
+	}
+}
@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type DenomsMetadataMock struct {
+	mock.Client
+	cdc      *codec.Codec
+	metadata []types.Metadata
+}
+
+func (mock DenomsMetadataMock) ABCIQueryWithOptions(
+	path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	bz, err := mock.cdc.MarshalJSON(types.QueryDenomsMetadataResponse{Metadatas: mock.metadata})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+func TestParseCoins(t *testing.T) {
+	cdc := codec.New()
+	metadata := []types.Metadata{types.NewMetadata(
+		"The native staking token", "uatom", "atom",
+		types.NewDenomUnit("uatom", 0, "microatom"), types.NewDenomUnit("atom", 6, "atoms"),
+	)}
+	cliCtx := context.CLIContext{Client: DenomsMetadataMock{cdc: cdc, metadata: metadata}, Codec: cdc, TrustNode: true}
+
+	testCases := []struct {
+		coinsStr string
+		offline  bool
+		expected sdk.Coins
+		expErr   bool
+	}{
+		{"", false, nil, false},
+		{"2atom", false, sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000000)), false},
+		{"1.5atom", false, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)), false},
+		{"3atoms,10microatom", false, sdk.NewCoins(sdk.NewInt64Coin("uatom", 3000010)), false},
+		{"100uatom,100stake", false, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("stake", 100)), false},
+		{"0.0000001atom", false, nil, true},
+		{"1.5stake", false, nil, true},
+		{"2atom", true, sdk.NewCoins(sdk.NewInt64Coin("atom", 2)), false},
+		{"1.5atom", true, nil, true},
+	}
+
+	for i, tc := range testCases {
+		cliCtx.Offline = tc.offline
+		coins, err := ParseCoins(cliCtx, tc.coinsStr)
+		if tc.expErr {
+			require.Error(t, err, "tc #%d", i)
+			continue
+		}
+
+		require.NoError(t, err, "tc #%d", i)
+		require.Equal(t, tc.expected, coins, "tc #%d", i)
+	}
+}
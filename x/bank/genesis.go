@@ -8,7 +8,7 @@ import (
 
 // InitGenesis initializes the bank module's state from a given genesis state.
 func InitGenesis(ctx sdk.Context, keeper Keeper, genState GenesisState) {
-	keeper.SetSendEnabled(ctx, genState.SendEnabled)
+	keeper.SetParams(ctx, genState.Params)
 
 	genState.Balances = SanitizeGenesisBalances(genState.Balances)
 	for _, balance := range genState.Balances {
@@ -20,6 +20,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, genState GenesisState) {
 			panic(fmt.Errorf("error on setting balances %w", err))
 		}
 	}
+
+	for _, metadata := range genState.DenomMetadata {
+		keeper.SetDenomMetaData(ctx, metadata)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -45,5 +49,11 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		})
 	}
 
-	return NewGenesisState(keeper.GetSendEnabled(ctx), balances)
+	denomMetadata := []Metadata{}
+	keeper.IterateAllDenomMetaData(ctx, func(metadata Metadata) bool {
+		denomMetadata = append(denomMetadata, metadata)
+		return false
+	})
+
+	return NewGenesisState(keeper.GetParams(ctx), balances, denomMetadata)
 }
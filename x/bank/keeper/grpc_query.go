@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type queryServer struct {
+	k Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the bank gRPC query service
+// backed by the given Keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Balance implements the Query/Balance gRPC method.
+func (q queryServer) Balance(goCtx context.Context, req *types.QueryBalanceRequest) (*types.QueryBalanceResponse, error) {
+	if req.Address.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	balance := q.k.GetBalance(ctx, req.Address, req.Denom)
+
+	return &types.QueryBalanceResponse{Balance: balance}, nil
+}
+
+// AllBalances implements the Query/AllBalances gRPC method.
+func (q queryServer) AllBalances(goCtx context.Context, req *types.QueryAllBalancesRequest) (*types.QueryAllBalancesResponse, error) {
+	if req.Address.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	balances, pageRes, err := q.k.GetBalancesPaginated(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+// DenomMetadata implements the Query/DenomMetadata gRPC method.
+func (q queryServer) DenomMetadata(goCtx context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	metadata, found := q.k.GetDenomMetaData(ctx, req.Denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrDenomMetadataNotFound, req.Denom)
+	}
+
+	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
+}
+
+// DenomsMetadata implements the Query/DenomsMetadata gRPC method.
+func (q queryServer) DenomsMetadata(goCtx context.Context, req *types.QueryDenomsMetadataRequest) (*types.QueryDenomsMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	metadatas, pageRes, err := q.k.GetDenomsMetaDataPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryDenomsMetadataResponse{Metadatas: metadatas, Pagination: pageRes}, nil
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *IntegrationTestSuite) TestQueryServer_Balance() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := authtypes.KeyTestPubAddr()
+	queryServer := keeper.NewQueryServer(app.BankKeeper)
+
+	_, err := queryServer.Balance(sdk.WrapSDKContext(ctx), &types.QueryBalanceRequest{Denom: fooDenom})
+	suite.Require().Error(err)
+
+	_, err = queryServer.Balance(sdk.WrapSDKContext(ctx), &types.QueryBalanceRequest{Address: addr})
+	suite.Require().Error(err)
+
+	req := &types.QueryBalanceRequest{Address: addr, Denom: fooDenom}
+	res, err := queryServer.Balance(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.True(res.Balance.IsZero())
+
+	origCoins := sdk.NewCoins(newFooCoin(50), newBarCoin(30))
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, acc.GetAddress(), origCoins))
+
+	res, err = queryServer.Balance(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.True(res.Balance.IsEqual(newFooCoin(50)))
+}
+
+func (suite *IntegrationTestSuite) TestQueryServer_AllBalances() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := authtypes.KeyTestPubAddr()
+	queryServer := keeper.NewQueryServer(app.BankKeeper)
+
+	_, err := queryServer.AllBalances(sdk.WrapSDKContext(ctx), &types.QueryAllBalancesRequest{})
+	suite.Require().Error(err)
+
+	req := &types.QueryAllBalancesRequest{Address: addr}
+	res, err := queryServer.AllBalances(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.True(res.Balances.IsZero())
+
+	origCoins := sdk.NewCoins(newFooCoin(50), newBarCoin(30))
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, acc.GetAddress(), origCoins))
+
+	res, err = queryServer.AllBalances(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.True(res.Balances.IsEqual(origCoins))
+
+	req.Pagination = &query.PageRequest{Key: []byte(fooDenom)}
+	res, err = queryServer.AllBalances(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50)), res.Balances)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	req.Pagination = &query.PageRequest{Key: []byte(fooDenom), Offset: 1}
+	_, err = queryServer.AllBalances(sdk.WrapSDKContext(ctx), req)
+	suite.Require().Error(err)
+}
+
+func (suite *IntegrationTestSuite) TestQueryServer_DenomMetadata() {
+	app, ctx := suite.app, suite.ctx
+	queryServer := keeper.NewQueryServer(app.BankKeeper)
+
+	_, err := queryServer.DenomMetadata(sdk.WrapSDKContext(ctx), &types.QueryDenomMetadataRequest{})
+	suite.Require().Error(err)
+
+	req := &types.QueryDenomMetadataRequest{Denom: fooDenom}
+	_, err = queryServer.DenomMetadata(sdk.WrapSDKContext(ctx), req)
+	suite.Require().True(types.ErrDenomMetadataNotFound.Is(err))
+
+	metadata := types.NewMetadata("", fooDenom, "kfoo", types.NewDenomUnit(fooDenom, 0), types.NewDenomUnit("kfoo", 3))
+	app.BankKeeper.SetDenomMetaData(ctx, metadata)
+
+	res, err := queryServer.DenomMetadata(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata, res.Metadata)
+
+	allRes, err := queryServer.DenomsMetadata(sdk.WrapSDKContext(ctx), &types.QueryDenomsMetadataRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{metadata}, allRes.Metadatas)
+	suite.Require().Nil(allRes.Pagination.NextKey)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -24,6 +25,8 @@ type Keeper interface {
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
+
+	SetDenomMetaData(ctx sdk.Context, metadata types.Metadata)
 }
 
 // BaseKeeper manages transfers between accounts. It implements the Keeper interface.
@@ -126,6 +129,13 @@ func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAdd
 	return nil
 }
 
+// SetDenomMetaData sets the metadata of the base denomination of the given
+// metadata, replacing any existing one.
+func (k BaseKeeper) SetDenomMetaData(ctx sdk.Context, metadata types.Metadata) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataPrefix)
+	store.Set([]byte(metadata.Base), k.cdc.MustMarshalBinaryBare(&metadata))
+}
+
 // SendKeeper defines a module interface that facilitates the transfer of coins
 // between accounts without the possibility of creating coins.
 type SendKeeper interface {
@@ -140,8 +150,11 @@ type SendKeeper interface {
 	SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) error
 	SetBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error
 
-	GetSendEnabled(ctx sdk.Context) bool
-	SetSendEnabled(ctx sdk.Context, enabled bool)
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
+
+	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
 	BlacklistedAddr(addr sdk.AccAddress) bool
 }
@@ -186,6 +199,12 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	for _, in := range inputs {
+		if err := k.IsSendEnabledCoins(ctx, in.Coins...); err != nil {
+			return err
+		}
+	}
+
 	for _, in := range inputs {
 		_, err := k.SubtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
@@ -220,6 +239,12 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // An error is returned upon failure.
+//
+// NOTE: SendCoins does not check whether the coins can be sent, as the module
+// accounts move coins through it, e.g. to collect fees or to mint and pay out
+// rewards, whatever the SendEnabled params. Callers sending coins on behalf of
+// users, such as the MsgSend handler or the ICS20 transfers, must check
+// IsSendEnabledCoins first.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -360,16 +385,39 @@ func (k BaseSendKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 	return nil
 }
 
-// GetSendEnabled returns the current SendEnabled
-func (k BaseSendKeeper) GetSendEnabled(ctx sdk.Context) bool {
-	var enabled bool
-	k.paramSpace.Get(ctx, types.ParamStoreKeySendEnabled, &enabled)
-	return enabled
+// GetParams returns the bank module's parameters.
+func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.Get(ctx, types.KeyDefaultSendEnabled, &params.DefaultSendEnabled)
+
+	// the chains upgraded from the single sendenabled param have no entries
+	params.SendEnabled = []types.SendEnabled{}
+	k.paramSpace.GetIfExists(ctx, types.KeySendEnabled, &params.SendEnabled)
+
+	return params
+}
+
+// SetParams sets the bank module's parameters.
+func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
 }
 
-// SetSendEnabled sets the send enabled
-func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
-	k.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled)
+// IsSendEnabledCoin returns whether the coins of the denomination of the given
+// coin can be sent.
+func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
+	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
+}
+
+// IsSendEnabledCoins returns an error if the coins of the denomination of any
+// of the given coins cannot be sent.
+func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	params := k.GetParams(ctx)
+	for _, coin := range coins {
+		if !params.SendEnabledDenom(coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
+		}
+	}
+
+	return nil
 }
 
 // BlacklistedAddr checks if a given address is blacklisted (i.e restricted from
@@ -387,6 +435,7 @@ type ViewKeeper interface {
 	HasBalance(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin) bool
 
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalancesPaginated(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 
 	LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
@@ -394,6 +443,10 @@ type ViewKeeper interface {
 
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+
+	GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool)
+	GetDenomsMetaDataPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.Metadata, *query.PageResponse, error)
+	IterateAllDenomMetaData(ctx sdk.Context, cb func(metadata types.Metadata) (stop bool))
 }
 
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
@@ -433,6 +486,29 @@ func (k BaseViewKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk
 	return balances.Sort()
 }
 
+// GetBalancesPaginated returns a page of the account balances for the given
+// account address, in ascending denomination order.
+func (k BaseViewKeeper) GetBalancesPaginated(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
+	accountStore := prefix.NewStore(balancesStore, addr.Bytes())
+
+	balances := sdk.NewCoins()
+	pageRes, err := query.Paginate(accountStore, pagination, func(_, value []byte) error {
+		var balance sdk.Coin
+		if err := k.cdc.UnmarshalBinaryBare(value, &balance); err != nil {
+			return err
+		}
+		balances = append(balances, balance)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return balances, pageRes, nil
+}
+
 // GetBalance returns the balance of a specific denomination for a given account
 // by address.
 func (k BaseViewKeeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
@@ -494,6 +570,61 @@ func (k BaseViewKeeper) IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddre
 	}
 }
 
+// GetDenomMetaData returns the metadata of a base denomination, if any.
+func (k BaseViewKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataPrefix)
+
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.Metadata{}, false
+	}
+
+	var metadata types.Metadata
+	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+
+	return metadata, true
+}
+
+// GetDenomsMetaDataPaginated returns a page of the metadata of all the base
+// denominations, in ascending base denomination order.
+func (k BaseViewKeeper) GetDenomsMetaDataPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.Metadata, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataPrefix)
+
+	metadatas := []types.Metadata{}
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var metadata types.Metadata
+		if err := k.cdc.UnmarshalBinaryBare(value, &metadata); err != nil {
+			return err
+		}
+		metadatas = append(metadatas, metadata)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return metadatas, pageRes, nil
+}
+
+// IterateAllDenomMetaData iterates over the metadata of all the base
+// denominations and provides it to a callback. If true is returned from the
+// callback, iteration is halted.
+func (k BaseViewKeeper) IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.Metadata
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &metadata)
+
+		if cb(metadata) {
+			break
+		}
+	}
+}
+
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
 // account by address. For standard accounts, the result will always be no coins.
 // For vesting accounts, LockedCoins is delegated to the concrete vesting account
@@ -10,10 +10,13 @@ import (
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 const (
@@ -41,7 +44,7 @@ func (suite *IntegrationTestSuite) SetupTest() {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	app.AccountKeeper.SetParams(ctx, auth.DefaultParams())
-	app.BankKeeper.SetSendEnabled(ctx, true)
+	app.BankKeeper.SetParams(ctx, types.DefaultParams())
 
 	suite.app = app
 	suite.ctx = ctx
@@ -208,9 +211,48 @@ func (suite *IntegrationTestSuite) TestBalance() {
 
 func (suite *IntegrationTestSuite) TestSendEnabled() {
 	app, ctx := suite.app, suite.ctx
-	enabled := false
-	app.BankKeeper.SetSendEnabled(ctx, enabled)
-	suite.Require().Equal(enabled, app.BankKeeper.GetSendEnabled(ctx))
+	params := types.NewParams(true, types.NewSendEnabled(barDenom, false))
+	app.BankKeeper.SetParams(ctx, params)
+	suite.Require().Equal(params, app.BankKeeper.GetParams(ctx))
+
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, newFooCoin(1)))
+	suite.Require().False(app.BankKeeper.IsSendEnabledCoin(ctx, newBarCoin(1)))
+	suite.Require().NoError(app.BankKeeper.IsSendEnabledCoins(ctx, newFooCoin(1)))
+	suite.Require().True(types.ErrSendDisabled.Is(app.BankKeeper.IsSendEnabledCoins(ctx, newFooCoin(1), newBarCoin(1))))
+
+	// the denominations without entry follow the default
+	app.BankKeeper.SetParams(ctx, types.NewParams(false, types.NewSendEnabled(barDenom, true)))
+	suite.Require().False(app.BankKeeper.IsSendEnabledCoin(ctx, newFooCoin(1)))
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, newBarCoin(1)))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	_, err := msgServer.Send(sdk.WrapSDKContext(ctx), &types.MsgSend{FromAddress: addr1, ToAddress: addr2, Amount: sdk.NewCoins(newFooCoin(10))})
+	suite.Require().True(types.ErrSendDisabled.Is(err))
+
+	_, err = msgServer.Send(sdk.WrapSDKContext(ctx), &types.MsgSend{FromAddress: addr1, ToAddress: addr2, Amount: sdk.NewCoins(newBarCoin(10))})
+	suite.Require().NoError(err)
+
+	inputs := []types.Input{types.NewInput(addr1, sdk.NewCoins(newFooCoin(10)))}
+	outputs := []types.Output{types.NewOutput(addr2, sdk.NewCoins(newFooCoin(10)))}
+	suite.Require().True(types.ErrSendDisabled.Is(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(90)), app.BankKeeper.GetAllBalances(ctx, addr1))
+}
+
+func (suite *IntegrationTestSuite) TestLegacySendEnabledParam() {
+	app, ctx := suite.app, suite.ctx
+
+	// a chain upgraded from the global sendenabled param only has its key
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(params.StoreKey)), []byte(types.ModuleName+"/"))
+	store.Delete(types.KeySendEnabled)
+	store.Set([]byte("sendenabled"), []byte("false"))
+
+	suite.Require().Equal(types.NewParams(false), app.BankKeeper.GetParams(ctx))
+	suite.Require().False(app.BankKeeper.IsSendEnabledCoin(ctx, newFooCoin(1)))
 }
 
 func (suite *IntegrationTestSuite) TestHasBalance() {
@@ -281,7 +323,7 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	app, ctx := suite.app, suite.ctx
 
-	app.BankKeeper.SetSendEnabled(ctx, true)
+	app.BankKeeper.SetParams(ctx, types.DefaultParams())
 
 	addr := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// HandleSetDenomMetadataProposal is a handler for executing a passed denom
+// metadata proposal. The metadata replaces the one of the same base
+// denomination, and its units cannot be named after the units of the other
+// base denominations.
+func HandleSetDenomMetadataProposal(ctx sdk.Context, k Keeper, p types.SetDenomMetadataProposal) error {
+	metadata := []types.Metadata{p.Metadata}
+	k.IterateAllDenomMetaData(ctx, func(m types.Metadata) bool {
+		if m.Base != p.Metadata.Base {
+			metadata = append(metadata, m)
+		}
+		return false
+	})
+
+	if err := types.ValidateDenomsMetadata(metadata); err != nil {
+		return err
+	}
+
+	k.SetDenomMetaData(ctx, p.Metadata)
+
+	logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+	logger.Info(fmt.Sprintf("set the metadata of denom %s", p.Metadata.Base))
+	return nil
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *IntegrationTestSuite) TestHandleSetDenomMetadataProposal() {
+	app, ctx := suite.app, suite.ctx
+
+	metadata := types.NewMetadata("", fooDenom, "kfoo", types.NewDenomUnit(fooDenom, 0), types.NewDenomUnit("kfoo", 3))
+	invalid := types.NewMetadata("", fooDenom, "mfoo", types.NewDenomUnit(fooDenom, 0), types.NewDenomUnit("kfoo", 3))
+
+	p := types.NewSetDenomMetadataProposal("title", "description", invalid)
+	suite.Require().Error(keeper.HandleSetDenomMetadataProposal(ctx, app.BankKeeper, p))
+
+	_, found := app.BankKeeper.GetDenomMetaData(ctx, fooDenom)
+	suite.Require().False(found)
+
+	p = types.NewSetDenomMetadataProposal("title", "description", metadata)
+	suite.Require().NoError(keeper.HandleSetDenomMetadataProposal(ctx, app.BankKeeper, p))
+
+	res, found := app.BankKeeper.GetDenomMetaData(ctx, fooDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata, res)
+
+	// the metadata can be replaced
+	metadata.DenomUnits[1].Aliases = []string{"kilofoo"}
+	p = types.NewSetDenomMetadataProposal("title", "description", metadata)
+	suite.Require().NoError(keeper.HandleSetDenomMetadataProposal(ctx, app.BankKeeper, p))
+
+	// but the units of another denomination cannot be named after its units
+	for _, name := range []string{fooDenom, "kfoo", "kilofoo"} {
+		bar := types.NewMetadata("", barDenom, barDenom, types.NewDenomUnit(barDenom, 0), types.NewDenomUnit(name, 3))
+		p = types.NewSetDenomMetadataProposal("title", "description", bar)
+		suite.Require().Error(keeper.HandleSetDenomMetadataProposal(ctx, app.BankKeeper, p), name)
+	}
+
+	_, found = app.BankKeeper.GetDenomMetaData(ctx, barDenom)
+	suite.Require().False(found)
+}
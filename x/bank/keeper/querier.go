@@ -19,6 +19,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryAllBalances:
 			return queryAllBalance(ctx, req, k)
 
+		case types.QueryDenomMetadata:
+			return queryDenomMetadata(ctx, req, k)
+
+		case types.QueryDenomsMetadata:
+			return queryDenomsMetadata(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -49,9 +55,54 @@ func queryAllBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	balances := k.GetAllBalances(ctx, params.Address)
+	balances, pageRes, err := k.GetBalancesPaginated(ctx, params.Address, params.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	res := types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDenomMetadata(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomMetadataParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	metadata, found := k.GetDenomMetaData(ctx, params.Denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrDenomMetadataNotFound, params.Denom)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, metadata)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDenomsMetadata(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomsMetadataParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	metadatas, pageRes, err := k.GetDenomsMetaDataPaginated(ctx, params.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 
-	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, balances)
+	res := types.QueryDenomsMetadataResponse{Metadatas: metadatas, Pagination: pageRes}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -6,6 +6,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -61,14 +62,14 @@ func (suite *IntegrationTestSuite) TestQuerier_QueryAllBalances() {
 	suite.Require().NotNil(err)
 	suite.Require().Nil(res)
 
-	req.Data = app.Codec().MustMarshalJSON(types.NewQueryAllBalancesParams(addr))
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryAllBalancesParams(addr, nil))
 	res, err = querier(ctx, []string{types.QueryAllBalances}, req)
 	suite.Require().NoError(err)
 	suite.Require().NotNil(res)
 
-	var balances sdk.Coins
+	var balances types.QueryAllBalancesResponse
 	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &balances))
-	suite.True(balances.IsZero())
+	suite.True(balances.Balances.IsZero())
 
 	origCoins := sdk.NewCoins(newFooCoin(50), newBarCoin(30))
 	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
@@ -80,7 +81,16 @@ func (suite *IntegrationTestSuite) TestQuerier_QueryAllBalances() {
 	suite.Require().NoError(err)
 	suite.Require().NotNil(res)
 	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &balances))
-	suite.True(balances.IsEqual(origCoins))
+	suite.True(balances.Balances.IsEqual(origCoins))
+
+	// the balances are paginated in ascending denomination order
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryAllBalancesParams(addr, &query.PageRequest{Limit: 1, CountTotal: true}))
+	res, err = querier(ctx, []string{types.QueryAllBalances}, req)
+	suite.Require().NoError(err)
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &balances))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(30)), balances.Balances)
+	suite.Require().Equal([]byte(fooDenom), balances.Pagination.NextKey)
+	suite.Require().Equal(uint64(2), balances.Pagination.Total)
 }
 
 func (suite *IntegrationTestSuite) TestQuerierRouteNotFound() {
@@ -94,3 +104,40 @@ func (suite *IntegrationTestSuite) TestQuerierRouteNotFound() {
 	_, err := querier(ctx, []string{"invalid"}, req)
 	suite.Error(err)
 }
+
+func (suite *IntegrationTestSuite) TestQuerier_QueryDenomsMetadata() {
+	app, ctx := suite.app, suite.ctx
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryDenomMetadata),
+		Data: []byte{},
+	}
+
+	querier := keeper.NewQuerier(app.BankKeeper)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryDenomMetadataParams(fooDenom))
+	_, err := querier(ctx, []string{types.QueryDenomMetadata}, req)
+	suite.Require().Error(err)
+
+	fooMetadata := types.NewMetadata("", fooDenom, fooDenom, types.NewDenomUnit(fooDenom, 0))
+	barMetadata := types.NewMetadata("", barDenom, "kbar", types.NewDenomUnit(barDenom, 0), types.NewDenomUnit("kbar", 3))
+	app.BankKeeper.SetDenomMetaData(ctx, fooMetadata)
+	app.BankKeeper.SetDenomMetaData(ctx, barMetadata)
+
+	res, err := querier(ctx, []string{types.QueryDenomMetadata}, req)
+	suite.Require().NoError(err)
+
+	var metadata types.Metadata
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &metadata))
+	suite.Require().Equal(fooMetadata, metadata)
+
+	// the metadata are paginated in ascending base denomination order
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryDenomsMetadataParams(&query.PageRequest{Limit: 1, CountTotal: true}))
+	res, err = querier(ctx, []string{types.QueryDenomsMetadata}, req)
+	suite.Require().NoError(err)
+
+	var metadatas types.QueryDenomsMetadataResponse
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &metadatas))
+	suite.Require().Equal([]types.Metadata{barMetadata}, metadatas.Metadatas)
+	suite.Require().Equal([]byte(fooDenom), metadatas.Pagination.NextKey)
+	suite.Require().Equal(uint64(2), metadatas.Pagination.Total)
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// NewDenomMetadataQueryFn returns a DenomMetadataQueryFn reading the metadata
+// from the store of the sdk.Context wrapped in the context it is given, for the
+// chain to verify SIGN_MODE_TEXTUAL signatures with the renderers of the bank
+// module.
+func NewDenomMetadataQueryFn(k ViewKeeper) types.DenomMetadataQueryFn {
+	return func(ctx context.Context, denom string) (types.Metadata, bool, error) {
+		metadata, found := k.GetDenomMetaData(sdk.UnwrapSDKContext(ctx), denom)
+		return metadata, found, nil
+	}
+}
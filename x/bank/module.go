@@ -108,6 +108,16 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return keeper.NewQuerier(am.keeper)
 }
 
+// RegisterMsgService registers the Msg service of the bank module.
+func (am AppModule) RegisterMsgService(server sdk.GRPCServer) {
+	types.RegisterMsgService(server, keeper.NewMsgServerImpl(am.keeper))
+}
+
+// RegisterQueryService registers the gRPC query service of the bank module.
+func (am AppModule) RegisterQueryService(server sdk.GRPCServer) {
+	types.RegisterQueryService(server, keeper.NewQueryServer(am.keeper))
+}
+
 // InitGenesis performs genesis initialization for the bank module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
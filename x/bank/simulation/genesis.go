@@ -12,11 +12,22 @@ import (
 
 // Simulation parameter constants
 const (
-	SendEnabled = "send_enabled"
+	SendEnabled        = "send_enabled"
+	DefaultSendEnabled = "default_send_enabled"
 )
 
-// GenSendEnabled randomized SendEnabled
-func GenSendEnabled(r *rand.Rand) bool {
+// GenSendEnabled randomized SendEnabled, which overrides the default of the
+// bond denomination half of the time
+func GenSendEnabled(r *rand.Rand) []types.SendEnabled {
+	if r.Int63n(2) == 0 {
+		return []types.SendEnabled{}
+	}
+
+	return []types.SendEnabled{types.NewSendEnabled(sdk.DefaultBondDenom, GenDefaultSendEnabled(r))}
+}
+
+// GenDefaultSendEnabled randomized DefaultSendEnabled
+func GenDefaultSendEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of transfers being enabled
 }
 
@@ -37,13 +48,20 @@ func RandomGenesisBalances(simState *module.SimulationState) []types.Balance {
 
 // RandomizedGenState generates a random GenesisState for bank
 func RandomizedGenState(simState *module.SimulationState) {
-	var sendEnabled bool
+	var sendEnabled []types.SendEnabled
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, SendEnabled, &sendEnabled, simState.Rand,
 		func(r *rand.Rand) { sendEnabled = GenSendEnabled(r) },
 	)
 
-	bankGenesis := types.NewGenesisState(sendEnabled, RandomGenesisBalances(simState))
+	var defaultSendEnabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DefaultSendEnabled, &defaultSendEnabled, simState.Rand,
+		func(r *rand.Rand) { defaultSendEnabled = GenDefaultSendEnabled(r) },
+	)
+
+	params := types.NewParams(defaultSendEnabled, sendEnabled...)
+	bankGenesis := types.NewGenesisState(params, RandomGenesisBalances(simState), []types.Metadata{})
 
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(bankGenesis)
 }
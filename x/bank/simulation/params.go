@@ -5,6 +5,7 @@ package simulation
 import (
 	"fmt"
 	"math/rand"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
@@ -12,7 +13,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-const keySendEnabled = "sendenabled"
+const (
+	keySendEnabled        = "SendEnabled"
+	keyDefaultSendEnabled = "sendenabled"
+)
 
 // ParamChanges defines the parameters that can be modified by param change proposals
 // on the simulation
@@ -20,7 +24,18 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{
 		simulation.NewSimParamChange(types.ModuleName, keySendEnabled,
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("%v", GenSendEnabled(r))
+				sendEnabled := GenSendEnabled(r)
+				entries := make([]string, len(sendEnabled))
+				for i, se := range sendEnabled {
+					entries[i] = fmt.Sprintf("{\"denom\":\"%s\",\"enabled\":%v}", se.Denom, se.Enabled)
+				}
+
+				return fmt.Sprintf("[%s]", strings.Join(entries, ","))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyDefaultSendEnabled,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%v", GenDefaultSendEnabled(r))
 			},
 		),
 	}
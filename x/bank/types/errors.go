@@ -6,8 +6,10 @@ import (
 
 // x/bank module sentinel errors
 var (
-	ErrNoInputs            = sdkerrors.Register(ModuleName, 2, "no inputs to send transaction")
-	ErrNoOutputs           = sdkerrors.Register(ModuleName, 3, "no outputs to send transaction")
-	ErrInputOutputMismatch = sdkerrors.Register(ModuleName, 4, "sum inputs != sum outputs")
-	ErrSendDisabled        = sdkerrors.Register(ModuleName, 5, "send transactions are disabled")
+	ErrNoInputs              = sdkerrors.Register(ModuleName, 2, "no inputs to send transaction")
+	ErrNoOutputs             = sdkerrors.Register(ModuleName, 3, "no outputs to send transaction")
+	ErrInputOutputMismatch   = sdkerrors.Register(ModuleName, 4, "sum inputs != sum outputs")
+	ErrSendDisabled          = sdkerrors.Register(ModuleName, 5, "send transactions are disabled")
+	ErrInvalidDenomMetadata  = sdkerrors.Register(ModuleName, 6, "invalid denom metadata")
+	ErrDenomMetadataNotFound = sdkerrors.Register(ModuleName, 7, "denom metadata not found")
 )
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -14,8 +15,9 @@ var _ exported.GenesisBalance = (*Balance)(nil)
 
 // GenesisState defines the bank module's genesis state.
 type GenesisState struct {
-	SendEnabled bool      `json:"send_enabled" yaml:"send_enabled"`
-	Balances    []Balance `json:"balances" yaml:"balances"`
+	Params        Params     `json:"params" yaml:"params"`
+	Balances      []Balance  `json:"balances" yaml:"balances"`
+	DenomMetadata []Metadata `json:"denom_metadata" yaml:"denom_metadata"`
 }
 
 // Balance defines an account address and balance pair used in the bank module's
@@ -49,16 +51,44 @@ func SanitizeGenesisBalances(balances []Balance) []Balance {
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool, balances []Balance) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled, Balances: balances}
+func NewGenesisState(params Params, balances []Balance, denomMetadata []Metadata) GenesisState {
+	return GenesisState{Params: params, Balances: balances, DenomMetadata: denomMetadata}
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
-func DefaultGenesisState() GenesisState { return NewGenesisState(true, []Balance{}) }
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), []Balance{}, []Metadata{})
+}
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenMetadata := make(map[string]bool)
+	for _, metadata := range data.DenomMetadata {
+		if seenMetadata[metadata.Base] {
+			return fmt.Errorf("duplicate denom metadata for %s", metadata.Base)
+		}
+		seenMetadata[metadata.Base] = true
+	}
+
+	// the units cannot be named after the denominations of the balances
+	var denoms []string
+	seenDenoms := make(map[string]bool)
+	for _, balance := range data.Balances {
+		for _, coin := range balance.Coins {
+			if !seenDenoms[coin.Denom] {
+				denoms = append(denoms, coin.Denom)
+				seenDenoms[coin.Denom] = true
+			}
+		}
+	}
+
+	return ValidateDenomsMetadata(data.DenomMetadata, denoms...)
+}
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
 // genesis state.
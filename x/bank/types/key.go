@@ -22,7 +22,8 @@ const (
 
 // KVStore key prefixes
 var (
-	BalancesPrefix = []byte("balances")
+	BalancesPrefix      = []byte("balances")
+	DenomMetadataPrefix = []byte("denom_metadata")
 )
 
 // AddressFromBalancesStore returns an account address from a balances prefix
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxDenomUnitExponent is the maximum exponent of a unit, which keeps the
+// amounts converted to the base denomination within the range of sdk.Dec.
+const MaxDenomUnitExponent = 18
+
+// NewDenomUnit creates a new DenomUnit instance.
+func NewDenomUnit(denom string, exponent uint32, aliases ...string) DenomUnit {
+	return DenomUnit{Denom: denom, Exponent: exponent, Aliases: aliases}
+}
+
+// NewMetadata creates a new Metadata instance.
+func NewMetadata(description, base, display string, denomUnits ...DenomUnit) Metadata {
+	return Metadata{Description: description, DenomUnits: denomUnits, Base: base, Display: display}
+}
+
+// Validate performs a basic validation of the metadata. The units must be sorted
+// by ascending exponent, starting with the base denomination of exponent 0 and
+// up to MaxDenomUnitExponent, and the names of the units, including their
+// aliases, must be valid and unique. The display denomination must be one of
+// the units.
+func (m Metadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "invalid base denomination: %s", err)
+	}
+	if len(m.DenomUnits) == 0 || m.DenomUnits[0].Denom != m.Base || m.DenomUnits[0].Exponent != 0 {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "the first unit must be the base denomination %s with exponent 0", m.Base)
+	}
+
+	names := make(map[string]bool)
+	hasDisplay := false
+	for i, unit := range m.DenomUnits {
+		if i > 0 && unit.Exponent <= m.DenomUnits[i-1].Exponent {
+			return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "the units must be sorted by ascending exponent, got %s after %s", unit.Denom, m.DenomUnits[i-1].Denom)
+		}
+		if unit.Exponent > MaxDenomUnitExponent {
+			return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "the exponent of %s cannot exceed %d", unit.Denom, MaxDenomUnitExponent)
+		}
+
+		for _, name := range unit.names() {
+			if err := sdk.ValidateDenom(name); err != nil {
+				return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "invalid unit: %s", err)
+			}
+			if names[name] {
+				return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "duplicate unit: %s", name)
+			}
+			names[name] = true
+		}
+
+		if unit.Denom == m.Display {
+			hasDisplay = true
+		}
+	}
+
+	if !hasDisplay {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "the display denomination %s is not a unit", m.Display)
+	}
+
+	return nil
+}
+
+// names returns the name of the unit followed by its aliases.
+func (u DenomUnit) names() []string {
+	return append([]string{u.Denom}, u.Aliases...)
+}
+
+// ValidateDenomsMetadata validates the metadata of several base denominations
+// as a whole: each of them must be valid, and the name of a unit, or alias of a
+// unit, can neither be the name of a unit of another base denomination nor,
+// unless it is its own base denomination, one of the given denominations, e.g.
+// the denominations of the balances. Otherwise the amounts given in that unit
+// would be converted to the wrong denomination.
+func ValidateDenomsMetadata(metadata []Metadata, denoms ...string) error {
+	bases := make(map[string]string) // by unit name
+	for _, m := range metadata {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+
+		for _, unit := range m.DenomUnits {
+			for _, name := range unit.names() {
+				if base, ok := bases[name]; ok {
+					return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "unit %s of %s is already a unit of %s", name, m.Base, base)
+				}
+				bases[name] = m.Base
+			}
+		}
+	}
+
+	for _, denom := range denoms {
+		if base, ok := bases[denom]; ok && base != denom {
+			return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "unit %s of %s is already a denomination", denom, base)
+		}
+	}
+
+	return nil
+}
+
+// ConvertDecCoinsToBase converts coins expressed in any unit, or alias of a
+// unit, of the given metadata to coins of their base denomination. The coins
+// of a denomination without metadata are kept as is, and so are the coins of a
+// base denomination, even if another metadata has a unit of the same name. An
+// error is returned if an amount is not a whole number of base units, or if it
+// is given in a unit of several base denominations.
+func ConvertDecCoinsToBase(coins sdk.DecCoins, metadata []Metadata) (sdk.Coins, error) {
+	type baseUnit struct {
+		base     string
+		exponent uint32
+	}
+
+	bases := make(map[string]bool)
+	for _, m := range metadata {
+		bases[m.Base] = true
+	}
+
+	units := make(map[string]baseUnit)
+	ambiguous := make(map[string]bool)
+	for _, m := range metadata {
+		for _, unit := range m.DenomUnits {
+			for _, name := range unit.names() {
+				switch {
+				case bases[name]:
+					// a base denomination is never converted
+
+				case units[name].base != "" && units[name].base != m.Base:
+					ambiguous[name] = true
+
+				default:
+					units[name] = baseUnit{m.Base, unit.Exponent}
+				}
+			}
+		}
+	}
+
+	baseCoins := sdk.NewCoins()
+	for _, coin := range coins {
+		denom, amount := coin.Denom, coin.Amount
+		if ambiguous[denom] {
+			return nil, fmt.Errorf("%s is a unit of several denominations", denom)
+		}
+
+		if unit, ok := units[denom]; ok {
+			denom = unit.base
+			amount = amount.Mul(sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(unit.exponent))))
+		}
+
+		if !amount.IsInteger() {
+			return nil, fmt.Errorf("%s%s is not a whole amount of %s", coin.Amount, coin.Denom, denom)
+		}
+
+		baseCoins = baseCoins.Add(sdk.NewCoin(denom, amount.TruncateInt()))
+	}
+
+	return baseCoins, nil
+}
@@ -0,0 +1,113 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func atomMetadata() types.Metadata {
+	return types.NewMetadata(
+		"The native staking token of the Cosmos Hub.", "uatom", "atom",
+		types.NewDenomUnit("uatom", 0, "microatom"),
+		types.NewDenomUnit("matom", 3, "milliatom"),
+		types.NewDenomUnit("atom", 6),
+	)
+}
+
+func TestMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(m *types.Metadata)
+		expPass  bool
+	}{
+		{"valid", func(*types.Metadata) {}, true},
+		{"invalid base", func(m *types.Metadata) { m.Base = "u" }, false},
+		{"no units", func(m *types.Metadata) { m.DenomUnits = nil }, false},
+		{"first unit is not the base", func(m *types.Metadata) { m.DenomUnits = m.DenomUnits[1:] }, false},
+		{"base of non-zero exponent", func(m *types.Metadata) { m.DenomUnits[0].Exponent = 1 }, false},
+		{"unsorted units", func(m *types.Metadata) { m.DenomUnits[2].Exponent = 3 }, false},
+		{"invalid alias", func(m *types.Metadata) { m.DenomUnits[1].Aliases = []string{"m"} }, false},
+		{"duplicate unit", func(m *types.Metadata) { m.DenomUnits[2].Aliases = []string{"microatom"} }, false},
+		{"display is not a unit", func(m *types.Metadata) { m.Display = "milliatom" }, false},
+		{"max exponent", func(m *types.Metadata) { m.DenomUnits[2].Exponent = types.MaxDenomUnitExponent }, true},
+		{"exponent too large", func(m *types.Metadata) { m.DenomUnits[2].Exponent = types.MaxDenomUnitExponent + 1 }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := atomMetadata()
+			tc.malleate(&metadata)
+
+			err := metadata.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDenomsMetadata(t *testing.T) {
+	osmo := types.NewMetadata("", "uosmo", "osmo", types.NewDenomUnit("uosmo", 0), types.NewDenomUnit("osmo", 6))
+	require.NoError(t, types.ValidateDenomsMetadata([]types.Metadata{atomMetadata(), osmo}, "uatom", "stake"))
+
+	// a unit cannot be named after a unit of another denomination, including
+	// its base denomination
+	osmo.DenomUnits[1].Aliases = []string{"atom"}
+	require.Error(t, types.ValidateDenomsMetadata([]types.Metadata{atomMetadata(), osmo}))
+	osmo.DenomUnits[1].Aliases = []string{"uatom"}
+	require.Error(t, types.ValidateDenomsMetadata([]types.Metadata{atomMetadata(), osmo}))
+
+	// nor after another denomination
+	osmo.DenomUnits[1].Aliases = []string{"stake"}
+	require.NoError(t, types.ValidateDenomsMetadata([]types.Metadata{atomMetadata(), osmo}))
+	require.Error(t, types.ValidateDenomsMetadata([]types.Metadata{atomMetadata(), osmo}, "stake"))
+
+	invalid := atomMetadata()
+	invalid.Display = "milliatom"
+	require.Error(t, types.ValidateDenomsMetadata([]types.Metadata{invalid}))
+}
+
+func TestConvertDecCoinsToBase(t *testing.T) {
+	// invalid metadata, whose units are named after a base denomination or after
+	// a unit of another denomination, must not change the conversion
+	metadata := []types.Metadata{
+		atomMetadata(),
+		types.NewMetadata("", "uosmo", "osmo", types.NewDenomUnit("uosmo", 0), types.NewDenomUnit("osmo", 6, "uatom", "token")),
+		types.NewMetadata("", "ujuno", "juno", types.NewDenomUnit("ujuno", 0), types.NewDenomUnit("juno", 6, "token")),
+	}
+
+	testCases := []struct {
+		coins    string
+		expected sdk.Coins
+		expPass  bool
+	}{
+		{"1.5atom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)), true},
+		{"2.0milliatom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000)), true},
+		{"1.0atom,1.0matom,10.0uatom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 1001010)), true},
+		{"10.0stake,3.0microatom", sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uatom", 3)), true},
+		{"0.0000005atom", nil, false},
+		{"1.5stake", nil, false},
+		{"1.0token", nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.coins, func(t *testing.T) {
+			decCoins, err := sdk.ParseDecCoins(tc.coins)
+			require.NoError(t, err)
+
+			coins, err := types.ConvertDecCoinsToBase(decCoins, metadata)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, coins)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
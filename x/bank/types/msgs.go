@@ -158,3 +158,9 @@ func ValidateInputsOutputs(inputs []Input, outputs []Output) error {
 
 	return nil
 }
+
+// RegisterMsgService registers the Msg service of the module on a gRPC server,
+// such as the Msg service router of BaseApp.
+func RegisterMsgService(server sdk.GRPCServer, srv MsgServer) {
+	server.RegisterService(&_Msg_serviceDesc, srv)
+}
@@ -3,27 +3,117 @@ package types
 import (
 	"fmt"
 
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 const (
 	// DefaultParamspace for params keeper
 	DefaultParamspace = ModuleName
-	// DefaultSendEnabled enabled
-	DefaultSendEnabled = true
+	// DefaultDefaultSendEnabled is the value of DefaultSendEnabled param
+	DefaultDefaultSendEnabled = true
+)
+
+// Parameter keys. DefaultSendEnabled is stored under the key of the former
+// global sendenabled param, whose value it keeps.
+var (
+	KeySendEnabled        = []byte("SendEnabled")
+	KeyDefaultSendEnabled = []byte("sendenabled")
 )
 
-// ParamStoreKeySendEnabled is store's key for SendEnabled
-var ParamStoreKeySendEnabled = []byte("sendenabled")
+var _ paramtypes.ParamSet = &Params{}
+
+// NewParams creates a new Params object
+func NewParams(defaultSendEnabled bool, sendEnabled ...SendEnabled) Params {
+	if sendEnabled == nil {
+		sendEnabled = []SendEnabled{}
+	}
 
-// ParamKeyTable type declaration for parameters
+	return Params{SendEnabled: sendEnabled, DefaultSendEnabled: defaultSendEnabled}
+}
+
+// ParamKeyTable for bank module
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable(
-		paramtypes.NewParamSetPair(ParamStoreKeySendEnabled, false, validateSendEnabled),
-	)
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of bank module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
+		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultDefaultSendEnabled)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate performs a basic validation of the parameters.
+func (p Params) Validate() error {
+	if err := validateSendEnabledParams(p.SendEnabled); err != nil {
+		return err
+	}
+
+	return validateIsBool(p.DefaultSendEnabled)
+}
+
+// SendEnabledDenom returns whether the coins of the given denomination can be
+// sent, which is the value of its SendEnabled entry if any and the default
+// otherwise.
+func (p Params) SendEnabledDenom(denom string) bool {
+	for _, se := range p.SendEnabled {
+		if se.Denom == denom {
+			return se.Enabled
+		}
+	}
+
+	return p.DefaultSendEnabled
+}
+
+// NewSendEnabled creates a new SendEnabled object
+func NewSendEnabled(denom string, enabled bool) SendEnabled {
+	return SendEnabled{Denom: denom, Enabled: enabled}
+}
+
+// String implements the stringer interface.
+func (se SendEnabled) String() string {
+	out, _ := yaml.Marshal(se)
+	return string(out)
+}
+
+func validateSendEnabledParams(i interface{}) error {
+	params, ok := i.([]SendEnabled)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]bool)
+	for _, se := range params {
+		if err := sdk.ValidateDenom(se.Denom); err != nil {
+			return err
+		}
+		if denoms[se.Denom] {
+			return fmt.Errorf("duplicate send enabled parameter for denom %s", se.Denom)
+		}
+		denoms[se.Denom] = true
+	}
+
+	return nil
 }
 
-func validateSendEnabled(i interface{}) error {
+func validateIsBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
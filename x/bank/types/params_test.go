@@ -0,0 +1,25 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+	require.NoError(t, types.NewParams(false, types.NewSendEnabled("stake", true), types.NewSendEnabled("atom", false)).Validate())
+	require.Error(t, types.NewParams(true, types.NewSendEnabled("s", true)).Validate())
+	require.Error(t, types.NewParams(true, types.NewSendEnabled("stake", true), types.NewSendEnabled("stake", false)).Validate())
+}
+
+func TestParamsSendEnabledDenom(t *testing.T) {
+	params := types.NewParams(true, types.NewSendEnabled("stake", false))
+	require.False(t, params.SendEnabledDenom("stake"))
+	require.True(t, params.SendEnabledDenom("atom"))
+
+	params.DefaultSendEnabled = false
+	require.False(t, params.SendEnabledDenom("atom"))
+}
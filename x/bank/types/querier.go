@@ -2,12 +2,15 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // Querier path constants
 const (
-	QueryBalance     = "balance"
-	QueryAllBalances = "all_balances"
+	QueryBalance        = "balance"
+	QueryAllBalances    = "all_balances"
+	QueryDenomMetadata  = "denom_metadata"
+	QueryDenomsMetadata = "denoms_metadata"
 )
 
 // QueryBalanceParams defines the params for querying an account balance.
@@ -21,12 +24,42 @@ func NewQueryBalanceParams(addr sdk.AccAddress, denom string) QueryBalanceParams
 	return QueryBalanceParams{Address: addr, Denom: denom}
 }
 
-// QueryAllBalancesParams defines the params for querying all account balances
+// QueryAllBalancesParams defines the params for querying a page of all account
+// balances.
 type QueryAllBalancesParams struct {
-	Address sdk.AccAddress
+	Address    sdk.AccAddress
+	Pagination *query.PageRequest
 }
 
 // NewQueryAllBalancesParams creates a new instance of QueryAllBalancesParams.
-func NewQueryAllBalancesParams(addr sdk.AccAddress) QueryAllBalancesParams {
-	return QueryAllBalancesParams{Address: addr}
+func NewQueryAllBalancesParams(addr sdk.AccAddress, pagination *query.PageRequest) QueryAllBalancesParams {
+	return QueryAllBalancesParams{Address: addr, Pagination: pagination}
+}
+
+// QueryDenomMetadataParams defines the params for querying the metadata of a
+// base denomination.
+type QueryDenomMetadataParams struct {
+	Denom string
+}
+
+// NewQueryDenomMetadataParams creates a new instance of QueryDenomMetadataParams.
+func NewQueryDenomMetadataParams(denom string) QueryDenomMetadataParams {
+	return QueryDenomMetadataParams{Denom: denom}
+}
+
+// QueryDenomsMetadataParams defines the params for querying a page of the
+// metadata of all the base denominations.
+type QueryDenomsMetadataParams struct {
+	Pagination *query.PageRequest
+}
+
+// NewQueryDenomsMetadataParams creates a new instance of QueryDenomsMetadataParams.
+func NewQueryDenomsMetadataParams(pagination *query.PageRequest) QueryDenomsMetadataParams {
+	return QueryDenomsMetadataParams{Pagination: pagination}
+}
+
+// RegisterQueryService registers the gRPC query service of the module on a
+// gRPC server, such as a *grpc.Server or the gRPC query router of BaseApp.
+func RegisterQueryService(server sdk.GRPCServer, srv QueryServer) {
+	server.RegisterService(&_Query_serviceDesc, srv)
 }
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+)
+
+// DenomMetadataQueryFn returns the metadata of a base denomination, if it has
+// any, read from the state the given context refers to.
+type DenomMetadataQueryFn func(ctx context.Context, denom string) (Metadata, bool, error)
+
+// RegisterTextualRenderers registers the SIGN_MODE_TEXTUAL renderers of the
+// coins, which are shown in the display unit of their denomination as given by
+// the metadata the query returns, e.g. 1500000uatom as "1.5 atom". The query
+// must return the same metadata to the clients and to the chain.
+func RegisterTextualRenderers(registry *textual.Registry, queryFn DenomMetadataQueryFn) {
+	registry.Register(sdk.Coin{}, func(ctx context.Context, v interface{}) (string, error) {
+		return FormatCoin(ctx, queryFn, v.(sdk.Coin))
+	})
+	registry.Register(sdk.Coins{}, func(ctx context.Context, v interface{}) (string, error) {
+		return FormatCoins(ctx, queryFn, v.(sdk.Coins))
+	})
+}
+
+// FormatCoins formats coins in the display unit of their denomination, as
+// FormatCoin does, separated by commas.
+func FormatCoins(ctx context.Context, queryFn DenomMetadataQueryFn, coins sdk.Coins) (string, error) {
+	if len(coins) == 0 {
+		return "None", nil
+	}
+
+	strs := make([]string, len(coins))
+	for i, coin := range coins {
+		str, err := FormatCoin(ctx, queryFn, coin)
+		if err != nil {
+			return "", err
+		}
+
+		strs[i] = str
+	}
+
+	return strings.Join(strs, ", "), nil
+}
+
+// FormatCoin formats a coin in the display unit of its denomination, e.g.
+// "1.5 atom" for 1500000uatom, or in its base denomination if it has no
+// metadata.
+func FormatCoin(ctx context.Context, queryFn DenomMetadataQueryFn, coin sdk.Coin) (string, error) {
+	metadata, found, err := queryFn(ctx, coin.Denom)
+	if err != nil {
+		return "", fmt.Errorf("failed to query the metadata of %s: %w", coin.Denom, err)
+	}
+
+	if found {
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Display {
+				return fmt.Sprintf("%s %s", formatDecimal(coin.Amount, unit.Exponent), unit.Denom), nil
+			}
+		}
+	}
+
+	return fmt.Sprintf("%s %s", coin.Amount, coin.Denom), nil
+}
+
+// formatDecimal formats an amount divided by 10^exponent, without trailing
+// zeros.
+func formatDecimal(amount sdk.Int, exponent uint32) string {
+	str := amount.String()
+	if exponent == 0 {
+		return str
+	}
+
+	sign := ""
+	if strings.HasPrefix(str, "-") {
+		sign, str = "-", str[1:]
+	}
+
+	exp := int(exponent)
+	if len(str) <= exp {
+		str = strings.Repeat("0", exp-len(str)+1) + str
+	}
+
+	integer, fraction := str[:len(str)-exp], strings.TrimRight(str[len(str)-exp:], "0")
+	if fraction == "" {
+		return sign + integer
+	}
+
+	return sign + integer + "." + fraction
+}
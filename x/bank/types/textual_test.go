@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestFormatCoins(t *testing.T) {
+	queryFn := func(_ context.Context, denom string) (types.Metadata, bool, error) {
+		if denom == "uatom" {
+			return atomMetadata(), true, nil
+		}
+
+		return types.Metadata{}, false, nil
+	}
+
+	testCases := []struct {
+		coins    sdk.Coins
+		expected string
+	}{
+		{sdk.NewCoins(), "None"},
+		{sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)), "1.5 atom"},
+		{sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000000)), "2 atom"},
+		{sdk.NewCoins(sdk.NewInt64Coin("uatom", 5)), "0.000005 atom"},
+		{sdk.NewCoins(sdk.NewInt64Coin("uatom", 1), sdk.NewInt64Coin("photon", 10)), "10 photon, 0.000001 atom"},
+	}
+
+	for _, tc := range testCases {
+		str, err := types.FormatCoins(context.Background(), queryFn, tc.coins)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, str)
+	}
+}
@@ -231,7 +231,8 @@ Where proposal.json contains:
   "description": "Pay me some Atoms!",
   "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
   "amount": "1000stake",
-  "deposit": "1000stake"
+  "deposit": "1000stake",
+  "expedited": false
 }
 `,
 				version.ClientName,
@@ -452,7 +453,8 @@ Where proposal.json contains:
   "description": "Pay me some Atoms!",
   "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
   "amount": "1000stake",
-  "deposit": "1000stake"
+  "deposit": "1000stake",
+  "expedited": false
 }
 `,
 				version.ClientName,
@@ -481,6 +483,7 @@ Where proposal.json contains:
 				return err
 			}
 			msg := gov.NewMsgSubmitProposal(content, deposit, from)
+			msg.Expedited = proposal.Expedited
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -91,7 +91,8 @@ func TestParseProposal(t *testing.T) {
   "description": "Pay me some Atoms!",
   "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
   "amount": "1000stake",
-  "deposit": "1000stake"
+  "deposit": "1000stake",
+  "expedited": true
 }
 `)
 	require.NoError(t, err)
@@ -107,4 +108,5 @@ func TestParseProposal(t *testing.T) {
 	require.Equal(t, addr, proposal.Recipient)
 	require.Equal(t, "1000stake", proposal.Deposit)
 	require.Equal(t, "1000stake", proposal.Amount)
+	require.True(t, proposal.Expedited)
 }
@@ -15,6 +15,7 @@ type (
 		Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
 		Amount      string         `json:"amount" yaml:"amount"`
 		Deposit     string         `json:"deposit" yaml:"deposit"`
+		Expedited   bool           `json:"expedited" yaml:"expedited"`
 	}
 )
 
@@ -51,6 +51,7 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		content := types.NewCommunityPoolSpendProposal(req.Title, req.Description, req.Recipient, req.Amount)
 
 		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		msg.Expedited = req.Expedited
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
@@ -31,6 +31,7 @@ var (
 	NewRouter                    = types.NewRouter
 	NewQueryEvidenceParams       = types.NewQueryEvidenceParams
 	NewQueryAllEvidenceParams    = types.NewQueryAllEvidenceParams
+	NewQueryAllEvidenceResponse  = types.NewQueryAllEvidenceResponse
 	RegisterCodec                = types.RegisterCodec
 	ModuleCdc                    = types.ModuleCdc
 	NewGenesisState              = types.NewGenesisState
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -28,7 +27,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	
 Example:
 $ %s query %s DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
-$ %s query %s --page=2 --limit=50
+$ %s query %s --offset=100 --limit=50
 `,
 				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
 			),
@@ -39,8 +38,7 @@ $ %s query %s --page=2 --limit=50
 		RunE:                       QueryEvidenceCmd(cdc),
 	}
 
-	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of evidence to to query for")
-	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of evidence to query for")
+	flags.AddPaginationFlagsToCmd(cmd, "evidence")
 
 	cmd.AddCommand(flags.GetCommands(QueryParamsCmd(cdc))...)
 
@@ -121,7 +119,12 @@ func queryEvidence(cdc *codec.Codec, cliCtx context.CLIContext, hash string) err
 }
 
 func queryAllEvidence(cdc *codec.Codec, cliCtx context.CLIContext) error {
-	params := types.NewQueryAllEvidenceParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+	pageReq, err := client.ReadPageRequest()
+	if err != nil {
+		return err
+	}
+
+	params := types.NewQueryAllEvidenceParams(pageReq)
 	bz, err := cdc.MarshalJSON(params)
 	if err != nil {
 		return fmt.Errorf("failed to marshal query params: %w", err)
@@ -133,7 +136,7 @@ func queryAllEvidence(cdc *codec.Codec, cliCtx context.CLIContext) error {
 		return err
 	}
 
-	var evidence []exported.Evidence
+	var evidence types.QueryAllEvidenceResponse
 	err = cdc.UnmarshalJSON(res, &evidence)
 	if err != nil {
 		return fmt.Errorf("failed to unmarshal evidence: %w", err)
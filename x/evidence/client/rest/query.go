@@ -64,7 +64,7 @@ func queryEvidenceHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 func queryAllEvidenceHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		pageReq, err := rest.ParsePageRequest(r)
 		if rest.CheckBadRequestError(w, err) {
 			return
 		}
@@ -74,7 +74,7 @@ func queryAllEvidenceHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		params := types.NewQueryAllEvidenceParams(page, limit)
+		params := types.NewQueryAllEvidenceParams(pageReq)
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to marshal query params: %s", err))
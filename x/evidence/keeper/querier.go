@@ -3,10 +3,11 @@ package keeper
 import (
 	"encoding/hex"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 
@@ -83,16 +84,22 @@ func queryAllEvidence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	evidence := k.GetAllEvidence(ctx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEvidence)
+	evidence := []exported.Evidence{}
 
-	start, end := client.Paginate(len(evidence), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
-		evidence = []exported.Evidence{}
-	} else {
-		evidence = evidence[start:end]
+	pageRes, err := query.Paginate(store, params.Pagination, func(_, value []byte) error {
+		e, err := k.cdc.UnmarshalEvidence(value)
+		if err != nil {
+			return err
+		}
+		evidence = append(evidence, e)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	res, err := codec.MarshalJSONIndent(k.cdc, evidence)
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewQueryAllEvidenceResponse(evidence, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	codecstd "github.com/cosmos/cosmos-sdk/codec/std"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 
@@ -56,18 +57,38 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 	numEvidence := 100
 
 	suite.populateEvidence(ctx, numEvidence)
-	query := abci.RequestQuery{
+	req := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryAllEvidence}, "/"),
-		Data: cdc.MustMarshalJSON(types.NewQueryAllEvidenceParams(1, numEvidence)),
+		Data: cdc.MustMarshalJSON(types.NewQueryAllEvidenceParams(&query.PageRequest{Limit: uint64(numEvidence)})),
 	}
 
-	bz, err := suite.querier(ctx, []string{types.QueryAllEvidence}, query)
+	bz, err := suite.querier(ctx, []string{types.QueryAllEvidence}, req)
 	suite.Nil(err)
 	suite.NotNil(bz)
 
-	var e []exported.Evidence
-	suite.Nil(cdc.UnmarshalJSON(bz, &e))
-	suite.Len(e, numEvidence)
+	var res types.QueryAllEvidenceResponse
+	suite.Nil(cdc.UnmarshalJSON(bz, &res))
+	suite.Len(res.Evidence, numEvidence)
+	suite.Nil(res.Pagination.NextKey)
+
+	// the next key allows to query for the evidence page by page
+	req.Data = cdc.MustMarshalJSON(types.NewQueryAllEvidenceParams(&query.PageRequest{Limit: 60, CountTotal: true}))
+	bz, err = suite.querier(ctx, []string{types.QueryAllEvidence}, req)
+	suite.Nil(err)
+
+	res = types.QueryAllEvidenceResponse{}
+	suite.Nil(cdc.UnmarshalJSON(bz, &res))
+	suite.Len(res.Evidence, 60)
+	suite.Equal(uint64(numEvidence), res.Pagination.Total)
+
+	req.Data = cdc.MustMarshalJSON(types.NewQueryAllEvidenceParams(&query.PageRequest{Key: res.Pagination.NextKey, Limit: 60}))
+	bz, err = suite.querier(ctx, []string{types.QueryAllEvidence}, req)
+	suite.Nil(err)
+
+	res = types.QueryAllEvidenceResponse{}
+	suite.Nil(cdc.UnmarshalJSON(bz, &res))
+	suite.Len(res.Evidence, numEvidence-60)
+	suite.Nil(res.Pagination.NextKey)
 }
 
 func (suite *KeeperTestSuite) TestQueryAllEvidence_InvalidPagination() {
@@ -76,18 +97,14 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence_InvalidPagination() {
 	numEvidence := 100
 
 	suite.populateEvidence(ctx, numEvidence)
-	query := abci.RequestQuery{
+	req := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryAllEvidence}, "/"),
-		Data: cdc.MustMarshalJSON(types.NewQueryAllEvidenceParams(0, numEvidence)),
+		Data: cdc.MustMarshalJSON(types.NewQueryAllEvidenceParams(&query.PageRequest{Key: []byte{0x01}, Offset: 1})),
 	}
 
-	bz, err := suite.querier(ctx, []string{types.QueryAllEvidence}, query)
-	suite.Nil(err)
-	suite.NotNil(bz)
-
-	var e []exported.Evidence
-	suite.Nil(cdc.UnmarshalJSON(bz, &e))
-	suite.Len(e, 0)
+	bz, err := suite.querier(ctx, []string{types.QueryAllEvidence}, req)
+	suite.NotNil(err)
+	suite.Nil(bz)
 }
 
 func (suite *KeeperTestSuite) TestQueryParams() {
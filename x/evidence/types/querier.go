@@ -1,5 +1,10 @@
 package types
 
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
 // Querier routes for the evidence module
 const (
 	QueryParameters  = "parameters"
@@ -18,10 +23,19 @@ func NewQueryEvidenceParams(hash string) QueryEvidenceParams {
 
 // QueryAllEvidenceParams defines the parameters necessary for querying for all Evidence.
 type QueryAllEvidenceParams struct {
-	Page  int `json:"page" yaml:"page"`
-	Limit int `json:"limit" yaml:"limit"`
+	Pagination *query.PageRequest `json:"pagination" yaml:"pagination"`
+}
+
+func NewQueryAllEvidenceParams(pagination *query.PageRequest) QueryAllEvidenceParams {
+	return QueryAllEvidenceParams{Pagination: pagination}
+}
+
+// QueryAllEvidenceResponse defines the response of querying for all Evidence.
+type QueryAllEvidenceResponse struct {
+	Evidence   []exported.Evidence `json:"evidence" yaml:"evidence"`
+	Pagination *query.PageResponse `json:"pagination" yaml:"pagination"`
 }
 
-func NewQueryAllEvidenceParams(page, limit int) QueryAllEvidenceParams {
-	return QueryAllEvidenceParams{Page: page, Limit: limit}
+func NewQueryAllEvidenceResponse(evidence []exported.Evidence, pagination *query.PageResponse) QueryAllEvidenceResponse {
+	return QueryAllEvidenceResponse{Evidence: evidence, Pagination: pagination}
 }
@@ -0,0 +1,67 @@
+package feegrant
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// nolint
+
+const (
+	ModuleName                = types.ModuleName
+	StoreKey                  = types.StoreKey
+	RouterKey                 = types.RouterKey
+	QuerierRoute              = types.QuerierRoute
+	QueryFeeAllowance         = types.QueryFeeAllowance
+	QueryFeeAllowances        = types.QueryFeeAllowances
+	TypeMsgGrantFeeAllowance  = types.TypeMsgGrantFeeAllowance
+	TypeMsgRevokeFeeAllowance = types.TypeMsgRevokeFeeAllowance
+	EventTypeSetFeeGrant      = types.EventTypeSetFeeGrant
+	EventTypeRevokeFeeGrant   = types.EventTypeRevokeFeeGrant
+	EventTypeUseFeeGrant      = types.EventTypeUseFeeGrant
+	AttributeValueCategory    = types.AttributeValueCategory
+	AttributeKeyGranter       = types.AttributeKeyGranter
+	AttributeKeyGrantee       = types.AttributeKeyGrantee
+)
+
+var (
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+
+	RegisterCodec               = types.RegisterCodec
+	ModuleCdc                   = types.ModuleCdc
+	NewBasicFeeAllowance        = types.NewBasicFeeAllowance
+	NewPeriodicFeeAllowance     = types.NewPeriodicFeeAllowance
+	NewFeeAllowanceGrant        = types.NewFeeAllowanceGrant
+	ExpiresAtTime               = types.ExpiresAtTime
+	ExpiresAtHeight             = types.ExpiresAtHeight
+	ClockDuration               = types.ClockDuration
+	BlockDuration               = types.BlockDuration
+	NewMsgGrantFeeAllowance     = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance    = types.NewMsgRevokeFeeAllowance
+	NewGenesisState             = types.NewGenesisState
+	DefaultGenesisState         = types.DefaultGenesisState
+	NewQueryFeeAllowanceParams  = types.NewQueryFeeAllowanceParams
+	NewQueryFeeAllowancesParams = types.NewQueryFeeAllowancesParams
+	FeeAllowanceKey             = types.FeeAllowanceKey
+	FeeAllowanceKeyPrefix       = types.FeeAllowanceKeyPrefix
+	ErrFeeLimitExceeded         = types.ErrFeeLimitExceeded
+	ErrFeeLimitExpired          = types.ErrFeeLimitExpired
+	ErrInvalidDuration          = types.ErrInvalidDuration
+	ErrNoAllowance              = types.ErrNoAllowance
+)
+
+type (
+	Keeper = keeper.Keeper
+
+	BasicFeeAllowance        = types.BasicFeeAllowance
+	PeriodicFeeAllowance     = types.PeriodicFeeAllowance
+	FeeAllowanceGrant        = types.FeeAllowanceGrant
+	ExpiresAt                = types.ExpiresAt
+	Duration                 = types.Duration
+	MsgGrantFeeAllowance     = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance    = types.MsgRevokeFeeAllowance
+	GenesisState             = types.GenesisState
+	QueryFeeAllowanceParams  = types.QueryFeeAllowanceParams
+	QueryFeeAllowancesParams = types.QueryFeeAllowancesParams
+)
@@ -0,0 +1,87 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+)
+
+var (
+	_ GrantedFeeTx = (*authtypes.StdTx)(nil) // assert StdTx implements GrantedFeeTx
+)
+
+// GrantedFeeTx defines the interface to be implemented by Tx to use the
+// DeductGrantedFeeDecorator.
+type GrantedFeeTx interface {
+	authante.FeeTx
+	FeeGranter() sdk.AccAddress
+}
+
+// DeductGrantedFeeDecorator deducts the fees from the fee granter of the tx if
+// one is set, under the fee allowance it gave to the fee payer, and from the
+// fee payer otherwise. It replaces the auth DeductFeeDecorator.
+// If the account paying the fees does not have the funds to pay for them, or
+// the fees are not allowed by the fee allowance, it returns an error.
+// Call next AnteHandler if fees successfully deducted
+// CONTRACT: Tx must implement GrantedFeeTx interface to use DeductGrantedFeeDecorator
+type DeductGrantedFeeDecorator struct {
+	ak           authkeeper.AccountKeeper
+	k            keeper.Keeper
+	supplyKeeper authtypes.SupplyKeeper
+}
+
+func NewDeductGrantedFeeDecorator(ak authkeeper.AccountKeeper, sk authtypes.SupplyKeeper, k keeper.Keeper) DeductGrantedFeeDecorator {
+	return DeductGrantedFeeDecorator{
+		ak:           ak,
+		k:            k,
+		supplyKeeper: sk,
+	}
+}
+
+func (d DeductGrantedFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(GrantedFeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a GrantedFeeTx")
+	}
+
+	if addr := d.supplyKeeper.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", authtypes.FeeCollectorName))
+	}
+
+	fee := feeTx.GetFee()
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+
+	deductFeesFrom := feePayer
+
+	// use the fee allowance of the granter, if the fee is paid by another
+	// account than the fee payer
+	if !feeGranter.Empty() && !feeGranter.Equals(feePayer) {
+		err := d.k.UseGrantedFees(ctx, feeGranter, feePayer, fee)
+		if err != nil {
+			return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feePayer, feeGranter)
+		}
+
+		deductFeesFrom = feeGranter
+	}
+
+	deductFeesFromAcc := d.ak.GetAccount(ctx, deductFeesFrom)
+	if deductFeesFromAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	// deduct the fees
+	if !fee.IsZero() {
+		err = authante.DeductFees(d.supplyKeeper, ctx, deductFeesFromAcc, fee)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,122 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestDeductFeesNoDelegation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+
+	dfd := ante.NewDeductGrantedFeeDecorator(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper)
+	anteHandler := sdk.ChainAnteDecorators(dfd)
+
+	// keys and addresses
+	priv1, _, addr1 := authtypes.KeyTestPubAddr()
+	priv2, _, addr2 := authtypes.KeyTestPubAddr()
+	priv3, _, addr3 := authtypes.KeyTestPubAddr()
+	priv4, _, addr4 := authtypes.KeyTestPubAddr()
+
+	// addr1 pays its own fees, addr2 has no funds
+	for _, addr := range []sdk.AccAddress{addr1, addr2, addr3, addr4} {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 100000))))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr3, sdk.NewCoins(sdk.NewInt64Coin("atom", 100000))))
+
+	// addr3 pays the fees of addr2 up to 500atom, and of addr4 up to 20atom
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(
+		addr3, addr2, types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 500)), types.ExpiresAt{}),
+	))
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(
+		addr3, addr4, types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 20)), types.ExpiresAt{}),
+	))
+
+	cases := map[string]struct {
+		signerKey  crypto.PrivKey
+		signer     sdk.AccAddress
+		feeAccount sdk.AccAddress
+		fee        int64
+		valid      bool
+	}{
+		"paying with own funds": {
+			signerKey: priv1,
+			signer:    addr1,
+			fee:       50,
+			valid:     true,
+		},
+		"paying with no funds": {
+			signerKey: priv2,
+			signer:    addr2,
+			fee:       50,
+			valid:     false,
+		},
+		"paying with granted funds": {
+			signerKey:  priv2,
+			signer:     addr2,
+			feeAccount: addr3,
+			fee:        50,
+			valid:      true,
+		},
+		"paying more than the allowance": {
+			signerKey:  priv4,
+			signer:     addr4,
+			feeAccount: addr3,
+			fee:        50,
+			valid:      false,
+		},
+		"paying with no allowance": {
+			signerKey:  priv1,
+			signer:     addr1,
+			feeAccount: addr3,
+			fee:        50,
+			valid:      false,
+		},
+		"granter is the signer": {
+			signerKey:  priv3,
+			signer:     addr3,
+			feeAccount: addr3,
+			fee:        50,
+			valid:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			fee := authtypes.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", tc.fee)))
+			fee.Granter = tc.feeAccount
+			msgs := []sdk.Msg{authtypes.NewTestMsg(tc.signer)}
+			acc := app.AccountKeeper.GetAccount(ctx, tc.signer)
+			tx := authtypes.NewTestTx(ctx, msgs, []crypto.PrivKey{tc.signerKey}, []uint64{acc.GetAccountNumber()}, []uint64{0}, fee)
+
+			payer := tc.signer
+			if !tc.feeAccount.Empty() {
+				payer = tc.feeAccount
+			}
+			before := app.BankKeeper.GetBalance(ctx, payer, "atom")
+
+			cacheCtx, _ := ctx.CacheContext()
+			_, err := anteHandler(cacheCtx, tx, false)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			after := app.BankKeeper.GetBalance(cacheCtx, payer, "atom")
+			require.Equal(t, before.Amount.SubRaw(tc.fee), after.Amount)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// GetQueryCmd returns the query commands for the feegrant module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the fee grant module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryFeeAllowance(cdc),
+		GetCmdQueryFeeAllowances(cdc),
+	)...)
+
+	return queryCmd
+}
+
+// GetCmdQueryFeeAllowance returns the command handler for querying the fee
+// allowance given by a granter to a grantee.
+func GetCmdQueryFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowance [granter] [grantee]",
+		Short: "Query the fee allowance given by a granter to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fee allowance given by a granter to a grantee.
+
+Example:
+$ %s query %s allowance cosmos1skjw.. cosmos1skjx..
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryFeeAllowanceParams(granter, grantee))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeAllowance)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grant types.FeeAllowanceGrant
+			if err := cdc.UnmarshalJSON(res, &grant); err != nil {
+				return fmt.Errorf("failed to unmarshal fee allowance: %w", err)
+			}
+
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+}
+
+// GetCmdQueryFeeAllowances returns the command handler for querying all the
+// fee allowances given to a grantee.
+func GetCmdQueryFeeAllowances(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowances [grantee]",
+		Short: "Query all the fee allowances given to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the fee allowances given to a grantee.
+
+Example:
+$ %s query %s allowances cosmos1skjw..
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryFeeAllowancesParams(grantee))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeAllowances)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grants []types.FeeAllowanceGrant
+			if err := cdc.UnmarshalJSON(res, &grants); err != nil {
+				return fmt.Errorf("failed to unmarshal fee allowances: %w", err)
+			}
+
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
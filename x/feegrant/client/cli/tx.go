@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// flags for the grant command
+const (
+	FlagSpendLimit  = "spend-limit"
+	FlagExpiration  = "expiration"
+	FlagPeriod      = "period"
+	FlagPeriodLimit = "period-limit"
+)
+
+// GetTxCmd returns the transaction commands for the feegrant module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Fee grant transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(flags.PostCommands(
+		GetCmdGrantFeeAllowance(cdc),
+		GetCmdRevokeFeeAllowance(cdc),
+	)...)
+
+	return txCmd
+}
+
+// GetCmdGrantFeeAllowance returns a CLI command handler for creating a
+// MsgGrantFeeAllowance transaction.
+func GetCmdGrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee]",
+		Short: "Grant a fee allowance to an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an account an allowance to pay the fees of its transactions from the signer's account.
+The allowance is limited by the optional spend limit and expiration. If a period is given, the fees
+paid during each period are also limited by the period limit.
+
+Example:
+$ %s tx %s grant cosmos1skjw.. --spend-limit=100stake --expiration=2021-01-01T00:00:00Z --from=mykey
+$ %s tx %s grant cosmos1skjw.. --spend-limit=100stake --period=24h --period-limit=10stake --from=mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			allowance, err := allowanceFromFlags()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantFeeAllowance(cliCtx.GetFromAddress(), grantee, allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "The maximum amount of fees the grantee can spend, unlimited if empty")
+	cmd.Flags().String(FlagExpiration, "", "The time when the allowance expires, in RFC 3339 format; never if empty")
+	cmd.Flags().Duration(FlagPeriod, 0, "The duration of the periods of the allowance; no period if zero")
+	cmd.Flags().String(FlagPeriodLimit, "", "The maximum amount of fees the grantee can spend during a period")
+
+	return cmd
+}
+
+// GetCmdRevokeFeeAllowance returns a CLI command handler for creating a
+// MsgRevokeFeeAllowance transaction.
+func GetCmdRevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "Revoke the fee allowance granted to an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the fee allowance granted by the signer to an account.
+
+Example:
+$ %s tx %s revoke cosmos1skjw.. --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(cliCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// allowanceFromFlags builds a basic allowance, or a periodic allowance if a
+// period is given, from the flags of the grant command.
+func allowanceFromFlags() (exported.FeeAllowance, error) {
+	spendLimit, err := sdk.ParseCoins(viper.GetString(FlagSpendLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	var expiration types.ExpiresAt
+	if exp := viper.GetString(FlagExpiration); exp != "" {
+		t, err := time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return nil, err
+		}
+		expiration = types.ExpiresAtTime(t)
+	}
+
+	basic := types.NewBasicFeeAllowance(spendLimit, expiration)
+
+	period := viper.GetDuration(FlagPeriod)
+	if period == 0 {
+		return basic, nil
+	}
+
+	periodLimit, err := sdk.ParseCoins(viper.GetString(FlagPeriodLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewPeriodicFeeAllowance(*basic, types.ClockDuration(period), periodLimit), nil
+}
@@ -0,0 +1,18 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// REST query parameters
+const (
+	RestParamGranter = "granter"
+	RestParamGrantee = "grantee"
+)
+
+// RegisterRoutes registers the REST routes of the feegrant module.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
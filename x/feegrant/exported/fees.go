@@ -0,0 +1,23 @@
+package exported
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowance defines the interface implemented by the allowances a granter
+// gives to a grantee to pay the fees of its transactions.
+type FeeAllowance interface {
+	// Accept is called when a grantee requests the granter to pay fees, with
+	// the time and height of the current block. It returns an error if the
+	// fees are not allowed and updates the remaining allowance otherwise, which
+	// should then be stored again.
+	//
+	// If remove is true, the allowance is exhausted or expired and must be
+	// deleted from the store.
+	Accept(fees sdk.Coins, blockTime time.Time, blockHeight int64) (remove bool, err error)
+
+	// ValidateBasic performs stateless validation of the allowance.
+	ValidateBasic() error
+}
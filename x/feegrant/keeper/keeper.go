@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// Keeper manages the fee allowances granted by accounts to pay the fees of
+// other accounts' transactions.
+type Keeper struct {
+	cdc        *codec.Codec
+	storeKey   sdk.StoreKey
+	authKeeper types.AccountKeeper
+}
+
+// NewKeeper creates a fee grant Keeper.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		authKeeper: ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GrantFeeAllowance stores a grant, overwriting any allowance the granter
+// already gave to the grantee. The account of the grantee is created if it
+// does not exist yet, so that it can sign transactions paid by the granter.
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, grant types.FeeAllowanceGrant) {
+	if k.authKeeper.GetAccount(ctx, grant.Grantee) == nil {
+		k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccountWithAddress(ctx, grant.Grantee))
+	}
+
+	if old, found := k.GetFeeGrant(ctx, grant.Granter, grant.Grantee); found {
+		k.deleteFeeGrant(ctx, old)
+	}
+
+	k.setFeeGrant(ctx, grant)
+	if key := types.FeeAllowanceQueueKey(grant.Expiration(), grant.Granter, grant.Grantee); key != nil {
+		ctx.KVStore(k.storeKey).Set(key, types.FeeAllowanceKey(grant.Granter, grant.Grantee))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee.String()),
+		),
+	)
+}
+
+// RevokeFeeAllowance removes the allowance given by the granter to the
+// grantee. It returns an error if there is no such allowance.
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "granter %s, grantee %s", granter, grantee)
+	}
+
+	k.deleteFeeGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetFeeAllowance returns the allowance given by the granter to the grantee,
+// or nil if there is none.
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) exported.FeeAllowance {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return nil
+	}
+
+	return grant.Allowance
+}
+
+// GetFeeGrant returns the grant of the granter to the grantee and true, or an
+// empty grant and false if there is none.
+func (k Keeper) GetFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant types.FeeAllowanceGrant, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FeeAllowanceKey(granter, grantee))
+	if len(bz) == 0 {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// IterateAllGranteeFeeAllowances iterates over all the grants given to a
+// grantee, until cb returns true.
+func (k Keeper) IterateAllGranteeFeeAllowances(ctx sdk.Context, grantee sdk.AccAddress, cb func(types.FeeAllowanceGrant) (stop bool)) {
+	k.iterateFeeGrants(ctx, types.FeeAllowancePrefixByGrantee(grantee), cb)
+}
+
+// IterateAllFeeAllowances iterates over all the grants in the store, until cb
+// returns true.
+func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(types.FeeAllowanceGrant) (stop bool)) {
+	k.iterateFeeGrants(ctx, types.FeeAllowanceKeyPrefix, cb)
+}
+
+// UseGrantedFees is called when the grantee requests the granter to pay fees.
+// The fees are accepted if they are allowed by the allowance of the granter,
+// which is then updated or removed once exhausted. It returns an error if
+// there is no allowance or the fees are not allowed by it. As the changes of a
+// failed tx are discarded, expired allowances are not removed here but by
+// PruneExpiredFeeAllowances at the end of the block.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fees sdk.Coins) error {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found || grant.Allowance == nil {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "granter %s, grantee %s", granter, grantee)
+	}
+
+	remove, err := grant.Allowance.Accept(fees, ctx.BlockTime(), ctx.BlockHeight())
+	if err != nil {
+		return err
+	}
+
+	if remove {
+		k.deleteFeeGrant(ctx, grant)
+	} else {
+		k.setFeeGrant(ctx, grant)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUseFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// PruneExpiredFeeAllowances removes the allowances which expired at or before
+// the time and height of the current block.
+func (k Keeper) PruneExpiredFeeAllowances(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	k.pruneQueue(store, types.FeeAllowanceTimeQueuePrefix, types.FeeAllowanceQueuePrefix(types.ExpiresAtTime(ctx.BlockTime())))
+	if ctx.BlockHeight() > 0 {
+		k.pruneQueue(store, types.FeeAllowanceHeightQueuePrefix, types.FeeAllowanceQueuePrefix(types.ExpiresAtHeight(ctx.BlockHeight())))
+	}
+}
+
+// pruneQueue removes the grants of a queue up to the given expiration prefix,
+// included, along with their queue keys.
+func (k Keeper) pruneQueue(store sdk.KVStore, queuePrefix, until []byte) {
+	iterator := store.Iterator(queuePrefix, sdk.PrefixEndBytes(until))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key(), iterator.Value())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) deleteFeeGrant(ctx sdk.Context, grant types.FeeAllowanceGrant) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FeeAllowanceKey(grant.Granter, grant.Grantee))
+	if key := types.FeeAllowanceQueueKey(grant.Expiration(), grant.Granter, grant.Grantee); key != nil {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) setFeeGrant(ctx sdk.Context, grant types.FeeAllowanceGrant) {
+	bz := k.cdc.MustMarshalBinaryBare(grant)
+	ctx.KVStore(k.storeKey).Set(types.FeeAllowanceKey(grant.Granter, grant.Grantee), bz)
+}
+
+func (k Keeper) iterateFeeGrants(ctx sdk.Context, prefix []byte, cb func(types.FeeAllowanceGrant) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	querier sdk.Querier
+
+	addr  sdk.AccAddress
+	addr2 sdk.AccAddress
+	addr3 sdk.AccAddress
+	addr4 sdk.AccAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, abci.Header{Height: 1})
+	suite.querier = keeper.NewQuerier(app.FeeGrantKeeper)
+
+	suite.addr = sdk.AccAddress([]byte("addr1_______________"))
+	suite.addr2 = sdk.AccAddress([]byte("addr2_______________"))
+	suite.addr3 = sdk.AccAddress([]byte("addr3_______________"))
+	suite.addr4 = sdk.AccAddress([]byte("addr4_______________"))
+}
+
+func (suite *KeeperTestSuite) TestKeeperCrud() {
+	ctx := suite.ctx
+	k := suite.app.FeeGrantKeeper
+
+	// some helpers
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	basic := types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(334455))
+	basic2 := types.NewBasicFeeAllowance(eth, types.ExpiresAtHeight(172436))
+
+	// let's set up some initial state here
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr, suite.addr2, basic))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr, suite.addr3, basic2))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr2, suite.addr3, basic))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr2, suite.addr4, basic))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr4, suite.addr3, basic))
+
+	// the accounts of the grantees are created
+	suite.Require().NotNil(suite.app.AccountKeeper.GetAccount(ctx, suite.addr2))
+	suite.Require().NotNil(suite.app.AccountKeeper.GetAccount(ctx, suite.addr3))
+
+	// remove some, overwrite other
+	suite.Require().NoError(k.RevokeFeeAllowance(ctx, suite.addr, suite.addr2))
+	suite.Require().NoError(k.RevokeFeeAllowance(ctx, suite.addr2, suite.addr3))
+	suite.Require().Error(k.RevokeFeeAllowance(ctx, suite.addr2, suite.addr3))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr, suite.addr3, basic))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr2, suite.addr3, basic2))
+
+	// end state:
+	// addr -> addr3 (basic)
+	// addr2 -> addr3 (basic2), addr4(basic)
+	// addr4 -> addr3 (basic)
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee   sdk.AccAddress
+		granter   sdk.AccAddress
+		allowance *types.BasicFeeAllowance
+	}{
+		"addr revoked":           {granter: suite.addr, grantee: suite.addr2},
+		"addr revoked and added": {granter: suite.addr2, grantee: suite.addr3, allowance: basic2},
+		"addr never there":       {granter: suite.addr, grantee: suite.addr4},
+		"addr modified":          {granter: suite.addr, grantee: suite.addr3, allowance: basic},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			allow := k.GetFeeAllowance(ctx, tc.granter, tc.grantee)
+			if tc.allowance == nil {
+				suite.Nil(allow)
+				return
+			}
+			suite.NotNil(allow)
+			suite.Equal(tc.allowance, allow)
+		})
+	}
+
+	allCases := map[string]struct {
+		grantee sdk.AccAddress
+		grants  []types.FeeAllowanceGrant
+	}{
+		"addr2 has none": {grantee: suite.addr2},
+		"addr has none":  {grantee: suite.addr},
+		"addr3 has three": {
+			grantee: suite.addr3,
+			grants: []types.FeeAllowanceGrant{
+				types.NewFeeAllowanceGrant(suite.addr, suite.addr3, basic),
+				types.NewFeeAllowanceGrant(suite.addr2, suite.addr3, basic2),
+				types.NewFeeAllowanceGrant(suite.addr4, suite.addr3, basic),
+			},
+		},
+	}
+
+	for name, tc := range allCases {
+		tc := tc
+		suite.Run(name, func() {
+			var grants []types.FeeAllowanceGrant
+			k.IterateAllGranteeFeeAllowances(ctx, tc.grantee, func(grant types.FeeAllowanceGrant) bool {
+				grants = append(grants, grant)
+				return false
+			})
+			suite.Equal(tc.grants, grants)
+		})
+	}
+
+	var count int
+	k.IterateAllFeeAllowances(ctx, func(types.FeeAllowanceGrant) bool {
+		count++
+		return false
+	})
+	suite.Equal(4, count)
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFee() {
+	ctx := suite.ctx
+	k := suite.app.FeeGrantKeeper
+
+	// some helpers
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	future := types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(5678))
+
+	// for testing limits of the contract
+	hugeAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 9999))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	futureAfterSmall := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 554)), types.ExpiresAtHeight(5678))
+	expired := types.NewBasicFeeAllowance(eth, types.ExpiresAtHeight(55))
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		fee     sdk.Coins
+		allowed bool
+		final   *types.BasicFeeAllowance
+	}{
+		"use entire pot": {
+			granter: suite.addr,
+			grantee: suite.addr2,
+			fee:     atom,
+			allowed: true,
+			final:   nil,
+		},
+		"expired": {
+			granter: suite.addr,
+			grantee: suite.addr3,
+			fee:     eth,
+			allowed: false,
+			final:   expired,
+		},
+		"too high": {
+			granter: suite.addr,
+			grantee: suite.addr2,
+			fee:     hugeAtom,
+			allowed: false,
+			final:   future,
+		},
+		"use a little": {
+			granter: suite.addr,
+			grantee: suite.addr2,
+			fee:     smallAtom,
+			allowed: true,
+			final:   futureAfterSmall,
+		},
+		"no allowance": {
+			granter: suite.addr2,
+			grantee: suite.addr,
+			fee:     smallAtom,
+			allowed: false,
+			final:   nil,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			// let's set up some initial state here
+			// addr -> addr2 (future)
+			// addr -> addr3 (expired)
+			ctx := ctx.WithBlockHeight(100)
+			k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr, suite.addr2, types.NewBasicFeeAllowance(atom, future.Expiration)))
+			k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr, suite.addr3, types.NewBasicFeeAllowance(eth, expired.Expiration)))
+
+			err := k.UseGrantedFees(ctx, tc.granter, tc.grantee, tc.fee)
+			if tc.allowed {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+
+			loaded := k.GetFeeAllowance(ctx, tc.granter, tc.grantee)
+			if tc.final == nil {
+				suite.Nil(loaded)
+				return
+			}
+			suite.Equal(tc.final, loaded)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredFeeAllowances() {
+	k := suite.app.FeeGrantKeeper
+	now := time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockHeight(100).WithBlockTime(now)
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	grant := func(granter, grantee sdk.AccAddress, expiration types.ExpiresAt) {
+		k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granter, grantee, types.NewBasicFeeAllowance(atom, expiration)))
+	}
+
+	grant(suite.addr, suite.addr2, types.ExpiresAtHeight(100))
+	grant(suite.addr, suite.addr3, types.ExpiresAtTime(now.Add(-time.Hour)))
+	grant(suite.addr, suite.addr4, types.ExpiresAtHeight(101))
+	grant(suite.addr2, suite.addr, types.ExpiresAtTime(now.Add(time.Hour)))
+	grant(suite.addr2, suite.addr3, types.ExpiresAt{})
+
+	// an overwritten or revoked grant is not pruned at its former expiration
+	grant(suite.addr2, suite.addr4, types.ExpiresAtHeight(50))
+	grant(suite.addr2, suite.addr4, types.ExpiresAtHeight(200))
+	grant(suite.addr3, suite.addr4, types.ExpiresAtHeight(50))
+	suite.Require().NoError(k.RevokeFeeAllowance(ctx, suite.addr3, suite.addr4))
+	grant(suite.addr3, suite.addr4, types.ExpiresAt{})
+
+	// the failed use of an expired grant does not remove it
+	suite.Require().Error(k.UseGrantedFees(ctx, suite.addr, suite.addr2, atom))
+	suite.Require().NotNil(k.GetFeeAllowance(ctx, suite.addr, suite.addr2))
+
+	k.PruneExpiredFeeAllowances(ctx)
+	suite.Require().Nil(k.GetFeeAllowance(ctx, suite.addr, suite.addr2))
+	suite.Require().Nil(k.GetFeeAllowance(ctx, suite.addr, suite.addr3))
+
+	var remaining []types.FeeAllowanceGrant
+	k.IterateAllFeeAllowances(ctx, func(grant types.FeeAllowanceGrant) bool {
+		remaining = append(remaining, grant)
+		return false
+	})
+	suite.Require().Len(remaining, 5)
+
+	// the grants expiring later are pruned once they expire
+	k.PruneExpiredFeeAllowances(ctx.WithBlockHeight(200).WithBlockTime(now.Add(time.Hour)))
+	suite.Require().Nil(k.GetFeeAllowance(ctx, suite.addr, suite.addr4))
+	suite.Require().Nil(k.GetFeeAllowance(ctx, suite.addr2, suite.addr))
+	suite.Require().Nil(k.GetFeeAllowance(ctx, suite.addr2, suite.addr4))
+	suite.Require().NotNil(k.GetFeeAllowance(ctx, suite.addr2, suite.addr3))
+	suite.Require().NotNil(k.GetFeeAllowance(ctx, suite.addr3, suite.addr4))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
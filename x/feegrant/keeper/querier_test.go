@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+const (
+	custom = "custom"
+)
+
+func (suite *KeeperTestSuite) TestQuery() {
+	ctx := suite.ctx
+	k := suite.app.FeeGrantKeeper
+	cdc := suite.app.Codec()
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	basic := types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(334455))
+	grant1 := types.NewFeeAllowanceGrant(suite.addr, suite.addr3, basic)
+	grant2 := types.NewFeeAllowanceGrant(suite.addr2, suite.addr3, basic)
+
+	k.GrantFeeAllowance(ctx, grant1)
+	k.GrantFeeAllowance(ctx, grant2)
+
+	// a single allowance
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryFeeAllowance}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryFeeAllowanceParams(suite.addr, suite.addr3)),
+	}
+
+	bz, err := suite.querier(ctx, []string{types.QueryFeeAllowance}, query)
+	suite.Require().NoError(err)
+
+	var grant types.FeeAllowanceGrant
+	suite.Require().NoError(cdc.UnmarshalJSON(bz, &grant))
+	suite.Equal(grant1, grant)
+
+	// no allowance
+	query.Data = cdc.MustMarshalJSON(types.NewQueryFeeAllowanceParams(suite.addr3, suite.addr))
+	_, err = suite.querier(ctx, []string{types.QueryFeeAllowance}, query)
+	suite.Require().Error(err)
+
+	// all the allowances of a grantee
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryFeeAllowances}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryFeeAllowancesParams(suite.addr3)),
+	}
+
+	bz, err = suite.querier(ctx, []string{types.QueryFeeAllowances}, query)
+	suite.Require().NoError(err)
+
+	var grants []types.FeeAllowanceGrant
+	suite.Require().NoError(cdc.UnmarshalJSON(bz, &grants))
+	suite.Equal([]types.FeeAllowanceGrant{grant1, grant2}, grants)
+
+	query.Data = cdc.MustMarshalJSON(types.NewQueryFeeAllowancesParams(suite.addr4))
+	bz, err = suite.querier(ctx, []string{types.QueryFeeAllowances}, query)
+	suite.Require().NoError(err)
+	suite.Equal("[]", string(bz))
+}
@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+var (
+	granterAddr = sdk.AccAddress([]byte("granter_____________"))
+	granteeAddr = sdk.AccAddress([]byte("grantee_____________"))
+)
+
+func makeTestCodec() (cdc *codec.Codec) {
+	cdc = codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	return
+}
+
+func TestDecodeStore(t *testing.T) {
+	cdc := makeTestCodec()
+	grant := types.NewFeeAllowanceGrant(
+		granterAddr, granteeAddr,
+		types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), types.ExpiresAtHeight(100)),
+	)
+
+	kvPairs := tmkv.Pairs{
+		tmkv.Pair{Key: types.FeeAllowanceKey(granterAddr, granteeAddr), Value: cdc.MustMarshalBinaryBare(grant)},
+		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"FeeAllowanceGrant", fmt.Sprintf("%v\n%v", grant, grant)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { DecodeStore(cdc, kvPairs[i], kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, DecodeStore(cdc, kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
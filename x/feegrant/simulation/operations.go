@@ -0,0 +1,150 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgGrantFeeAllowance  = "op_weight_msg_grant_fee_allowance"
+	OpWeightMsgRevokeFeeAllowance = "op_weight_msg_revoke_fee_allowance"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc *codec.Codec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var weightMsgGrantFeeAllowance int
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrantFeeAllowance, &weightMsgGrantFeeAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrantFeeAllowance = simappparams.DefaultWeightMsgGrantFeeAllowance
+		},
+	)
+
+	var weightMsgRevokeFeeAllowance int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeFeeAllowance, &weightMsgRevokeFeeAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeFeeAllowance = simappparams.DefaultWeightMsgRevokeFeeAllowance
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgGrantFeeAllowance,
+			SimulateMsgGrantFeeAllowance(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokeFeeAllowance,
+			SimulateMsgRevokeFeeAllowance(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgGrantFeeAllowance generates a MsgGrantFeeAllowance with random
+// values, granting a basic allowance limited to some of the granter's coins.
+func SimulateMsgGrantFeeAllowance(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		granter, _ := simtypes.RandomAcc(r, accs)
+		grantee, _ := simtypes.RandomAcc(r, accs)
+		if granter.Address.Equals(grantee.Address) {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		spendLimit := simtypes.RandSubsetCoins(r, spendable)
+		if spendLimit.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		expiration := types.ExpiresAtTime(ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 365*24)) * time.Hour))
+		msg := types.NewMsgGrantFeeAllowance(granter.Address, grantee.Address, types.NewBasicFeeAllowance(spendLimit, expiration))
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRevokeFeeAllowance generates a MsgRevokeFeeAllowance revoking an
+// existing allowance granted by a simulation account.
+func SimulateMsgRevokeFeeAllowance(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			grant   types.FeeAllowanceGrant
+			granter simtypes.Account
+			found   bool
+		)
+
+		k.IterateAllFeeAllowances(ctx, func(g types.FeeAllowanceGrant) bool {
+			granter, found = simtypes.FindAccount(accs, g.Granter)
+			grant = g
+			return found
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgRevokeFeeAllowance(grant.Granter, grant.Grantee)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
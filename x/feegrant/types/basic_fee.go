@@ -0,0 +1,64 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+var _ exported.FeeAllowance = (*BasicFeeAllowance)(nil)
+
+// BasicFeeAllowance allows a grantee to spend fees from the granter's account
+// up to a spend limit and until an expiration. An empty spend limit means the
+// fees are not limited, and an empty expiration that the allowance does not
+// expire.
+type BasicFeeAllowance struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+	Expiration ExpiresAt `json:"expiration" yaml:"expiration"`
+}
+
+// NewBasicFeeAllowance returns a new BasicFeeAllowance.
+func NewBasicFeeAllowance(spendLimit sdk.Coins, expiration ExpiresAt) *BasicFeeAllowance {
+	return &BasicFeeAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// Accept implements the FeeAllowance interface. It deducts the fees from the
+// spend limit and removes the allowance once it is exhausted or expired.
+func (a *BasicFeeAllowance) Accept(fees sdk.Coins, blockTime time.Time, blockHeight int64) (bool, error) {
+	if a.Expiration.IsExpired(blockTime, blockHeight) {
+		return true, sdkerrors.Wrap(ErrFeeLimitExpired, "basic allowance")
+	}
+
+	if a.SpendLimit.Empty() {
+		return false, nil
+	}
+
+	left, invalid := a.SpendLimit.SafeSub(fees)
+	if invalid {
+		return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "basic allowance: %s left", a.SpendLimit)
+	}
+
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// GetExpiration returns the expiration of the allowance.
+func (a BasicFeeAllowance) GetExpiration() ExpiresAt {
+	return a.Expiration
+}
+
+// ValidateBasic implements the FeeAllowance interface.
+func (a BasicFeeAllowance) ValidateBasic() error {
+	if !a.SpendLimit.Empty() {
+		if !a.SpendLimit.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit: %s", a.SpendLimit)
+		}
+	}
+
+	return a.Expiration.ValidateBasic()
+}
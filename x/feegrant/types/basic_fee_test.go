@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestBasicFeeValidAllow(t *testing.T) {
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+
+	cases := map[string]struct {
+		allow *types.BasicFeeAllowance
+		valid bool
+		// all below checks are ignored if invalid
+		fee         sdk.Coins
+		blockTime   time.Time
+		blockHeight int64
+		accept      bool
+		remove      bool
+		remains     sdk.Coins
+	}{
+		"empty": {
+			allow:  &types.BasicFeeAllowance{},
+			valid:  true,
+			fee:    atom,
+			accept: true,
+		},
+		"invalid limit": {
+			allow: &types.BasicFeeAllowance{SpendLimit: sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-5)}}},
+			valid: false,
+		},
+		"small fee": {
+			allow:   types.NewBasicFeeAllowance(atom, types.ExpiresAt{}),
+			valid:   true,
+			fee:     smallAtom,
+			accept:  true,
+			remove:  false,
+			remains: leftAtom,
+		},
+		"all fee": {
+			allow:  types.NewBasicFeeAllowance(smallAtom, types.ExpiresAt{}),
+			valid:  true,
+			fee:    smallAtom,
+			accept: true,
+			remove: true,
+		},
+		"wrong fee": {
+			allow:  types.NewBasicFeeAllowance(smallAtom, types.ExpiresAt{}),
+			valid:  true,
+			fee:    eth,
+			accept: false,
+		},
+		"non-expired": {
+			allow:       types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(100)),
+			valid:       true,
+			fee:         smallAtom,
+			blockHeight: 85,
+			accept:      true,
+			remove:      false,
+			remains:     leftAtom,
+		},
+		"expired": {
+			allow:       types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(100)),
+			valid:       true,
+			fee:         smallAtom,
+			blockHeight: 121,
+			accept:      false,
+			remove:      true,
+		},
+		"fee more than allowed": {
+			allow:       types.NewBasicFeeAllowance(smallAtom, types.ExpiresAtHeight(100)),
+			valid:       true,
+			fee:         atom,
+			blockHeight: 85,
+			accept:      false,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.allow.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			remove, err := tc.allow.Accept(tc.fee, tc.blockTime, tc.blockHeight)
+			if !tc.accept {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.remove, remove)
+
+			if tc.accept && !tc.remove {
+				require.Equal(t, tc.remains, tc.allow.SpendLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package types
+
+// feegrant module events
+const (
+	EventTypeSetFeeGrant    = "set_feegrant"
+	EventTypeRevokeFeeGrant = "revoke_feegrant"
+	EventTypeUseFeeGrant    = "use_feegrant"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+)
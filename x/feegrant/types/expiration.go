@@ -0,0 +1,128 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ExpiresAt is a point in time where something expires, either a block time
+// or a block height. At most one of them may be set, and the zero value never
+// expires.
+type ExpiresAt struct {
+	Time   time.Time `json:"time" yaml:"time"`
+	Height int64     `json:"height" yaml:"height"`
+}
+
+// ExpiresAtTime returns an ExpiresAt at a given block time.
+func ExpiresAtTime(t time.Time) ExpiresAt {
+	return ExpiresAt{Time: t}
+}
+
+// ExpiresAtHeight returns an ExpiresAt at a given block height.
+func ExpiresAtHeight(h int64) ExpiresAt {
+	return ExpiresAt{Height: h}
+}
+
+// ValidateBasic performs basic sanity checks. Note that empty expirations are
+// allowed.
+func (e ExpiresAt) ValidateBasic() error {
+	if !e.Time.IsZero() && e.Height != 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "both time and height are set")
+	}
+	if e.Height < 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "negative height")
+	}
+
+	return nil
+}
+
+// IsZero returns true if neither the time nor the height are set.
+func (e ExpiresAt) IsZero() bool {
+	return e.Time.IsZero() && e.Height == 0
+}
+
+// IsExpired returns true if the given block time or height is at or past the
+// expiration.
+func (e ExpiresAt) IsExpired(blockTime time.Time, blockHeight int64) bool {
+	if !e.Time.IsZero() {
+		return !blockTime.Before(e.Time)
+	}
+	if e.Height != 0 {
+		return blockHeight >= e.Height
+	}
+
+	return false
+}
+
+// IsCompatible returns true if the expiration and the duration use the same
+// unit, so that the expiration can be stepped by the duration. A zero
+// expiration is compatible with any duration.
+func (e ExpiresAt) IsCompatible(d Duration) bool {
+	if !e.Time.IsZero() {
+		return d.Clock > 0
+	}
+	if e.Height != 0 {
+		return d.Block > 0
+	}
+
+	return true
+}
+
+// Step returns the expiration moved forward by a duration. It fails if the
+// expiration is zero or uses a different unit than the duration.
+func (e ExpiresAt) Step(d Duration) (ExpiresAt, error) {
+	if e.IsZero() || !e.IsCompatible(d) {
+		return ExpiresAt{}, sdkerrors.Wrap(ErrInvalidDuration, "expiration time and duration have different units")
+	}
+
+	if !e.Time.IsZero() {
+		e.Time = e.Time.Add(d.Clock)
+	} else {
+		e.Height += d.Block
+	}
+
+	return e, nil
+}
+
+// Duration is a span of time, either a clock duration or a number of blocks.
+// Exactly one of them must be set.
+type Duration struct {
+	Clock time.Duration `json:"clock" yaml:"clock"`
+	Block int64         `json:"block" yaml:"block"`
+}
+
+// ClockDuration returns a Duration of a clock duration.
+func ClockDuration(d time.Duration) Duration {
+	return Duration{Clock: d}
+}
+
+// BlockDuration returns a Duration of a number of blocks.
+func BlockDuration(h int64) Duration {
+	return Duration{Block: h}
+}
+
+// ValidateBasic performs basic sanity checks.
+func (d Duration) ValidateBasic() error {
+	if d.Clock != 0 && d.Block != 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "both time and height are set")
+	}
+	if d.Clock < 0 || d.Block < 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "negative duration")
+	}
+	if d.Clock == 0 && d.Block == 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "zero duration")
+	}
+
+	return nil
+}
+
+// After returns the expiration a duration after the given block time or
+// height, depending on the unit of the duration.
+func (d Duration) After(blockTime time.Time, blockHeight int64) ExpiresAt {
+	if d.Clock != 0 {
+		return ExpiresAtTime(blockTime.Add(d.Clock))
+	}
+
+	return ExpiresAtHeight(blockHeight + d.Block)
+}
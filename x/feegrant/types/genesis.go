@@ -0,0 +1,33 @@
+package types
+
+// GenesisState defines the feegrant module's genesis state.
+type GenesisState struct {
+	FeeAllowances []FeeAllowanceGrant `json:"fee_allowances" yaml:"fee_allowances"`
+}
+
+// NewGenesisState returns a new GenesisState.
+func NewGenesisState(feeAllowances []FeeAllowanceGrant) GenesisState {
+	return GenesisState{
+		FeeAllowances: feeAllowances,
+	}
+}
+
+// DefaultGenesisState returns the feegrant module's default genesis state,
+// without any allowance.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		FeeAllowances: []FeeAllowanceGrant{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, grant := range gs.FeeAllowances {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
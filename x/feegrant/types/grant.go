@@ -0,0 +1,60 @@
+package types
+
+import (
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+// FeeAllowanceGrant is a fee allowance given by a granter to a grantee, as it
+// is stored by the keeper.
+type FeeAllowanceGrant struct {
+	Granter   sdk.AccAddress        `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress        `json:"grantee" yaml:"grantee"`
+	Allowance exported.FeeAllowance `json:"allowance" yaml:"allowance"`
+}
+
+// NewFeeAllowanceGrant returns a new FeeAllowanceGrant.
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, allowance exported.FeeAllowance) FeeAllowanceGrant {
+	return FeeAllowanceGrant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// ValidateBasic performs basic validation of the grant and its allowance.
+func (g FeeAllowanceGrant) ValidateBasic() error {
+	if g.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if g.Grantee.Equals(g.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee allowance")
+	}
+	if g.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "missing allowance")
+	}
+
+	return g.Allowance.ValidateBasic()
+}
+
+// Expiration returns the expiration of the allowance, which is zero if it does
+// not expire or does not expose its expiration.
+func (g FeeAllowanceGrant) Expiration() ExpiresAt {
+	if a, ok := g.Allowance.(interface{ GetExpiration() ExpiresAt }); ok {
+		return a.GetExpiration()
+	}
+
+	return ExpiresAt{}
+}
+
+// String implements the Stringer interface.
+func (g FeeAllowanceGrant) String() string {
+	out, _ := yaml.Marshal(g)
+	return string(out)
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feegrant"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// FeeAllowanceKeyPrefix is the prefix of the fee allowance grants, stored
+	// under their grantee and then their granter
+	FeeAllowanceKeyPrefix = []byte{0x00}
+
+	// FeeAllowanceTimeQueuePrefix and FeeAllowanceHeightQueuePrefix are the
+	// prefixes of the queues of the grants expiring at a block time and at a
+	// block height, in expiration order
+	FeeAllowanceTimeQueuePrefix   = []byte{0x01}
+	FeeAllowanceHeightQueuePrefix = []byte{0x02}
+)
+
+// FeeAllowanceKey returns the key of the fee allowance granted by granter to
+// grantee: 0x00<grantee><granter>.
+func FeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowancePrefixByGrantee(grantee), granter.Bytes()...)
+}
+
+// FeeAllowancePrefixByGrantee returns the prefix of all the fee allowances
+// granted to grantee.
+func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceKeyPrefix, grantee.Bytes()...)
+}
+
+// FeeAllowanceQueueKey returns the key of a grant in the queue of the grants
+// expiring at a block time, 0x01<time><grantee><granter>, or at a block
+// height, 0x02<height><grantee><granter>. It returns nil if the expiration is
+// zero.
+func FeeAllowanceQueueKey(expiration ExpiresAt, granter, grantee sdk.AccAddress) []byte {
+	prefix := FeeAllowanceQueuePrefix(expiration)
+	if prefix == nil {
+		return nil
+	}
+
+	return append(prefix, FeeAllowanceKey(granter, grantee)[len(FeeAllowanceKeyPrefix):]...)
+}
+
+// FeeAllowanceQueuePrefix returns the prefix of the queue keys of the grants
+// with the given expiration, or nil if it is zero.
+func FeeAllowanceQueuePrefix(expiration ExpiresAt) []byte {
+	switch {
+	case !expiration.Time.IsZero():
+		return append(FeeAllowanceTimeQueuePrefix, sdk.FormatTimeBytes(expiration.Time)...)
+
+	case expiration.Height != 0:
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, uint64(expiration.Height))
+		return append(FeeAllowanceHeightQueuePrefix, bz...)
+
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+// feegrant message types
+const (
+	TypeMsgGrantFeeAllowance  = "grant_fee_allowance"
+	TypeMsgRevokeFeeAllowance = "revoke_fee_allowance"
+)
+
+var (
+	_ sdk.Msg = MsgGrantFeeAllowance{}
+	_ sdk.Msg = MsgRevokeFeeAllowance{}
+)
+
+// MsgGrantFeeAllowance grants a fee allowance from the granter to the grantee,
+// replacing any allowance the granter already gave to the grantee.
+type MsgGrantFeeAllowance struct {
+	Granter   sdk.AccAddress        `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress        `json:"grantee" yaml:"grantee"`
+	Allowance exported.FeeAllowance `json:"allowance" yaml:"allowance"`
+}
+
+// NewMsgGrantFeeAllowance returns a new MsgGrantFeeAllowance.
+func NewMsgGrantFeeAllowance(granter, grantee sdk.AccAddress, allowance exported.FeeAllowance) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgGrantFeeAllowance) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgGrantFeeAllowance) Type() string { return TypeMsgGrantFeeAllowance }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrantFeeAllowance) ValidateBasic() error {
+	return msg.Grant().ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. The granter signs the msg.
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// Grant returns the grant created by the msg.
+func (msg MsgGrantFeeAllowance) Grant() FeeAllowanceGrant {
+	return NewFeeAllowanceGrant(msg.Granter, msg.Grantee, msg.Allowance)
+}
+
+// MsgRevokeFeeAllowance revokes the fee allowance given by the granter to the
+// grantee.
+type MsgRevokeFeeAllowance struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewMsgRevokeFeeAllowance returns a new MsgRevokeFeeAllowance.
+func NewMsgRevokeFeeAllowance(granter, grantee sdk.AccAddress) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevokeFeeAllowance) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevokeFeeAllowance) Type() string { return TypeMsgRevokeFeeAllowance }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeFeeAllowance) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. The granter signs the msg.
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
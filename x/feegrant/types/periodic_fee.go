@@ -0,0 +1,131 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+var _ exported.FeeAllowance = (*PeriodicFeeAllowance)(nil)
+
+// PeriodicFeeAllowance extends a BasicFeeAllowance with a limit on the fees
+// spent during each period. PeriodCanSpend is what is left to spend until the
+// PeriodReset, when it is reset to PeriodSpendLimit, or to the spend limit of
+// the basic allowance if it is lower.
+type PeriodicFeeAllowance struct {
+	Basic            BasicFeeAllowance `json:"basic" yaml:"basic"`
+	Period           Duration          `json:"period" yaml:"period"`
+	PeriodSpendLimit sdk.Coins         `json:"period_spend_limit" yaml:"period_spend_limit"`
+	PeriodCanSpend   sdk.Coins         `json:"period_can_spend" yaml:"period_can_spend"`
+	PeriodReset      ExpiresAt         `json:"period_reset" yaml:"period_reset"`
+}
+
+// NewPeriodicFeeAllowance returns a new PeriodicFeeAllowance. Its first period
+// starts with the first fees it accepts.
+func NewPeriodicFeeAllowance(basic BasicFeeAllowance, period Duration, periodSpendLimit sdk.Coins) *PeriodicFeeAllowance {
+	return &PeriodicFeeAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// Accept implements the FeeAllowance interface. It resets the period if it has
+// ended and deducts the fees from both what can be spent in the period and the
+// spend limit of the basic allowance. The allowance is removed once the basic
+// allowance is exhausted or expired.
+func (a *PeriodicFeeAllowance) Accept(fees sdk.Coins, blockTime time.Time, blockHeight int64) (bool, error) {
+	if a.Basic.Expiration.IsExpired(blockTime, blockHeight) {
+		return true, sdkerrors.Wrap(ErrFeeLimitExpired, "periodic allowance")
+	}
+
+	if err := a.tryResetPeriod(blockTime, blockHeight); err != nil {
+		return false, err
+	}
+
+	left, invalid := a.PeriodCanSpend.SafeSub(fees)
+	if invalid {
+		return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "period limit: %s left", a.PeriodCanSpend)
+	}
+	a.PeriodCanSpend = left
+
+	if a.Basic.SpendLimit.Empty() {
+		return false, nil
+	}
+
+	left, invalid = a.Basic.SpendLimit.SafeSub(fees)
+	if invalid {
+		return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "absolute limit: %s left", a.Basic.SpendLimit)
+	}
+	a.Basic.SpendLimit = left
+
+	return left.IsZero(), nil
+}
+
+// tryResetPeriod starts a new period if the current one has ended, or if none
+// has started yet. The new period starts when the previous one ended, or at the
+// current block if more than a full period has passed since then.
+func (a *PeriodicFeeAllowance) tryResetPeriod(blockTime time.Time, blockHeight int64) error {
+	if !a.PeriodReset.IsZero() && !a.PeriodReset.IsExpired(blockTime, blockHeight) {
+		return nil
+	}
+
+	a.PeriodCanSpend = a.PeriodSpendLimit
+	if !a.Basic.SpendLimit.Empty() && !a.Basic.SpendLimit.IsAllGTE(a.PeriodSpendLimit) {
+		a.PeriodCanSpend = a.Basic.SpendLimit
+	}
+
+	if a.PeriodReset.IsZero() {
+		a.PeriodReset = a.Period.After(blockTime, blockHeight)
+		return nil
+	}
+
+	next, err := a.PeriodReset.Step(a.Period)
+	if err != nil {
+		return err
+	}
+	if next.IsExpired(blockTime, blockHeight) {
+		next = a.Period.After(blockTime, blockHeight)
+	}
+
+	a.PeriodReset = next
+	return nil
+}
+
+// GetExpiration returns the expiration of the basic allowance.
+func (a PeriodicFeeAllowance) GetExpiration() ExpiresAt {
+	return a.Basic.Expiration
+}
+
+// ValidateBasic implements the FeeAllowance interface.
+func (a PeriodicFeeAllowance) ValidateBasic() error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !a.PeriodSpendLimit.IsValid() || a.PeriodSpendLimit.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "period spend limit: %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodCanSpend.Empty() && !a.PeriodCanSpend.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "period can spend: %s", a.PeriodCanSpend)
+	}
+
+	// the period limit can only spend the denoms of the basic limit
+	if !a.Basic.SpendLimit.Empty() && !a.PeriodSpendLimit.DenomsSubsetOf(a.Basic.SpendLimit) {
+		return sdkerrors.Wrap(ErrFeeLimitExceeded, "period spend limit has different denoms than the spend limit")
+	}
+
+	if err := a.Period.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := a.PeriodReset.ValidateBasic(); err != nil {
+		return err
+	}
+	if !a.PeriodReset.IsCompatible(a.Period) {
+		return sdkerrors.Wrap(ErrInvalidDuration, "period reset and period have different units")
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestPeriodicFeeValidAllow(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	oneAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 1))
+
+	cases := map[string]struct {
+		allow *types.PeriodicFeeAllowance
+		// all other checks are ignored if valid=false
+		fee           sdk.Coins
+		blockTime     time.Time
+		blockHeight   int64
+		valid         bool
+		accept        bool
+		remove        bool
+		remains       sdk.Coins
+		remainsPeriod sdk.Coins
+		periodReset   types.ExpiresAt
+	}{
+		"empty": {
+			allow: &types.PeriodicFeeAllowance{},
+			valid: false,
+		},
+		"only basic": {
+			allow: &types.PeriodicFeeAllowance{Basic: *types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(100))},
+			valid: false,
+		},
+		"empty basic": {
+			allow:       types.NewPeriodicFeeAllowance(types.BasicFeeAllowance{}, types.BlockDuration(10), smallAtom),
+			valid:       true,
+			fee:         smallAtom,
+			blockHeight: 75,
+			accept:      true,
+			periodReset: types.ExpiresAtHeight(85),
+		},
+		"mismatched denoms": {
+			allow: types.NewPeriodicFeeAllowance(*types.NewBasicFeeAllowance(atom, types.ExpiresAt{}), types.BlockDuration(10), eth),
+			valid: false,
+		},
+		"mismatched period reset": {
+			allow: &types.PeriodicFeeAllowance{
+				Basic:            *types.NewBasicFeeAllowance(atom, types.ExpiresAt{}),
+				Period:           types.BlockDuration(10),
+				PeriodSpendLimit: smallAtom,
+				PeriodReset:      types.ExpiresAtTime(time.Now()),
+			},
+			valid: false,
+		},
+		"first time": {
+			allow:       types.NewPeriodicFeeAllowance(*types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(100)), types.BlockDuration(10), smallAtom),
+			valid:       true,
+			fee:         smallAtom,
+			blockHeight: 75,
+			accept:      true,
+			remove:      false,
+			remains:     leftAtom,
+			periodReset: types.ExpiresAtHeight(85),
+		},
+		"same period": {
+			allow: &types.PeriodicFeeAllowance{
+				Basic:            *types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(100)),
+				Period:           types.BlockDuration(10),
+				PeriodReset:      types.ExpiresAtHeight(80),
+				PeriodSpendLimit: leftAtom,
+				PeriodCanSpend:   smallAtom,
+			},
+			valid:       true,
+			fee:         smallAtom,
+			blockHeight: 75,
+			accept:      true,
+			remove:      false,
+			remains:     leftAtom,
+			periodReset: types.ExpiresAtHeight(80),
+		},
+		"step one period": {
+			allow: &types.PeriodicFeeAllowance{
+				Basic:            *types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(100)),
+				Period:           types.BlockDuration(10),
+				PeriodReset:      types.ExpiresAtHeight(70),
+				PeriodSpendLimit: leftAtom,
+			},
+			valid:       true,
+			fee:         leftAtom,
+			blockHeight: 75,
+			accept:      true,
+			remove:      false,
+			remains:     smallAtom,
+			periodReset: types.ExpiresAtHeight(80), // one step from last reset, not now
+		},
+		"step limited by global allowance": {
+			allow: &types.PeriodicFeeAllowance{
+				Basic:            *types.NewBasicFeeAllowance(smallAtom, types.ExpiresAtHeight(100)),
+				Period:           types.BlockDuration(10),
+				PeriodReset:      types.ExpiresAtHeight(70),
+				PeriodSpendLimit: atom,
+			},
+			valid:         true,
+			fee:           oneAtom,
+			blockHeight:   75,
+			accept:        true,
+			remove:        false,
+			remainsPeriod: smallAtom.Sub(oneAtom),
+			remains:       smallAtom.Sub(oneAtom),
+			periodReset:   types.ExpiresAtHeight(80), // one step from last reset, not now
+		},
+		"skip periods": {
+			allow: &types.PeriodicFeeAllowance{
+				Basic:            *types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(100)),
+				Period:           types.BlockDuration(10),
+				PeriodReset:      types.ExpiresAtHeight(20),
+				PeriodSpendLimit: leftAtom,
+			},
+			valid:         true,
+			fee:           oneAtom,
+			blockHeight:   75,
+			accept:        true,
+			remove:        false,
+			remainsPeriod: leftAtom.Sub(oneAtom),
+			remains:       atom.Sub(oneAtom),
+			periodReset:   types.ExpiresAtHeight(85), // a full period from now, as the next step is expired
+		},
+		"period limit exceeded": {
+			allow: &types.PeriodicFeeAllowance{
+				Basic:            *types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(100)),
+				Period:           types.BlockDuration(10),
+				PeriodReset:      types.ExpiresAtHeight(80),
+				PeriodSpendLimit: leftAtom,
+				PeriodCanSpend:   smallAtom,
+			},
+			valid:       true,
+			fee:         leftAtom,
+			blockHeight: 75,
+			accept:      false,
+		},
+		"expired": {
+			allow: &types.PeriodicFeeAllowance{
+				Basic:            *types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(100)),
+				Period:           types.BlockDuration(10),
+				PeriodReset:      types.ExpiresAtHeight(80),
+				PeriodSpendLimit: smallAtom,
+			},
+			valid:       true,
+			fee:         smallAtom,
+			blockHeight: 101,
+			accept:      false,
+			remove:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.allow.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			remove, err := tc.allow.Accept(tc.fee, tc.blockTime, tc.blockHeight)
+			if !tc.accept {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.remove, remove)
+
+			if tc.accept && !tc.remove {
+				require.Equal(t, tc.remains, tc.allow.Basic.SpendLimit)
+				require.Equal(t, tc.remainsPeriod, tc.allow.PeriodCanSpend)
+				require.Equal(t, tc.periodReset, tc.allow.PeriodReset)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier routes for the feegrant module
+const (
+	QueryFeeAllowance  = "allowance"
+	QueryFeeAllowances = "allowances"
+)
+
+// QueryFeeAllowanceParams defines the parameters for querying the fee
+// allowance given by a granter to a grantee.
+type QueryFeeAllowanceParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryFeeAllowanceParams returns a new QueryFeeAllowanceParams.
+func NewQueryFeeAllowanceParams(granter, grantee sdk.AccAddress) QueryFeeAllowanceParams {
+	return QueryFeeAllowanceParams{Granter: granter, Grantee: grantee}
+}
+
+// QueryFeeAllowancesParams defines the parameters for querying all the fee
+// allowances given to a grantee.
+type QueryFeeAllowancesParams struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryFeeAllowancesParams returns a new QueryFeeAllowancesParams.
+func NewQueryFeeAllowancesParams(grantee sdk.AccAddress) QueryFeeAllowancesParams {
+	return QueryFeeAllowancesParams{Grantee: grantee}
+}
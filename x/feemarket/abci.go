@@ -0,0 +1,25 @@
+package feemarket
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker sets the base fee of the next block from the gas used by the
+// current block.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	gasUsed := ctx.BlockGasMeter().GasConsumedToLimit()
+
+	baseFee := types.NextBaseFee(k.GetParams(ctx), k.GetBaseFee(ctx), gasUsed)
+	k.SetBaseFee(ctx, baseFee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFee,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+		),
+	)
+}
@@ -0,0 +1,46 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	acc := app.AccountKeeper.GetAccount(ctx, supply.NewModuleAddress(feemarket.ModuleName))
+	require.NotNil(t, acc)
+	require.True(t, app.FeeMarketKeeper.GetBaseFee(ctx).IsZero())
+}
+
+func TestEndBlocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	params := feemarket.NewParams(sdk.DefaultBondDenom, 100000, 8, sdk.OneDec())
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDec(8))
+
+	// a block using twice the target gas raises the base fee by an eighth
+	blockGasMeter := sdk.NewInfiniteGasMeter()
+	blockGasMeter.ConsumeGas(200000, "test")
+	feemarket.EndBlocker(ctx.WithBlockGasMeter(blockGasMeter), app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDec(9), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	// an empty block lowers it by an eighth, down to the minimum base fee
+	for i := 0; i < 30; i++ {
+		feemarket.EndBlocker(ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter()), app.FeeMarketKeeper)
+	}
+	require.Equal(t, sdk.OneDec(), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	gs := feemarket.ExportGenesis(ctx, app.FeeMarketKeeper)
+	require.Equal(t, feemarket.NewGenesisState(params, sdk.OneDec()), gs)
+}
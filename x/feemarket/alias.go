@@ -0,0 +1,47 @@
+package feemarket
+
+// nolint
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+const (
+	ModuleName                      = types.ModuleName
+	DefaultParamspace               = types.DefaultParamspace
+	StoreKey                        = types.StoreKey
+	QuerierRoute                    = types.QuerierRoute
+	QueryParameters                 = types.QueryParameters
+	QueryBaseFee                    = types.QueryBaseFee
+	DefaultTargetBlockGas           = types.DefaultTargetBlockGas
+	DefaultBaseFeeChangeDenominator = types.DefaultBaseFeeChangeDenominator
+)
+
+var (
+	// functions aliases
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	NewGenesisState     = types.NewGenesisState
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+	NextBaseFee         = types.NextBaseFee
+	RequiredFee         = types.RequiredFee
+	ParamKeyTable       = types.ParamKeyTable
+	NewParams           = types.NewParams
+	DefaultParams       = types.DefaultParams
+
+	// variable aliases
+	ModuleCdc                   = types.ModuleCdc
+	BaseFeeKey                  = types.BaseFeeKey
+	KeyBaseFeeDenom             = types.KeyBaseFeeDenom
+	KeyTargetBlockGas           = types.KeyTargetBlockGas
+	KeyBaseFeeChangeDenominator = types.KeyBaseFeeChangeDenominator
+	KeyMinBaseFee               = types.KeyMinBaseFee
+)
+
+type (
+	Keeper       = keeper.Keeper
+	GenesisState = types.GenesisState
+	Params       = types.Params
+)
@@ -0,0 +1,70 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// BaseFeeDecorator checks that the fee of the tx pays the base fee of the
+// current block for its gas limit, in the base fee denomination. The base fee
+// part of the fee is burned and the rest of the fee is tipped to the proposer
+// of the block. While the base fee is zero, the fee is left to the fee
+// collector, to be distributed as without the fee market. Unlike the
+// MempoolFeeDecorator, the base fee is enforced in DeliverTx, the genesis
+// transactions paying none.
+// CONTRACT: Tx must implement FeeTx and the BaseFeeDecorator must be called
+// after the fees are deducted to the fee collector.
+type BaseFeeDecorator struct {
+	k keeper.Keeper
+}
+
+func NewBaseFeeDecorator(k keeper.Keeper) BaseFeeDecorator {
+	return BaseFeeDecorator{k: k}
+}
+
+func (d BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(authante.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	baseFee := d.k.GetBaseFee(ctx)
+	if baseFee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	params := d.k.GetParams(ctx)
+	fee := feeTx.GetFee()
+
+	required := types.RequiredFee(baseFee, feeTx.GetGas())
+	paid := fee.AmountOf(params.BaseFeeDenom)
+	if paid.LT(required) {
+		// the fees of a simulated tx are not known
+		if !simulate {
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s%s (base fee %s)",
+				fee, required, params.BaseFeeDenom, baseFee,
+			)
+		}
+
+		required = paid
+	}
+
+	burned := sdk.NewCoins(sdk.NewCoin(params.BaseFeeDenom, required))
+	if err := d.k.BurnFees(ctx, burned); err != nil {
+		return ctx, err
+	}
+
+	if err := d.k.TipProposer(ctx, fee.Sub(burned)); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
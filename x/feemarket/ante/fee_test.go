@@ -0,0 +1,132 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+func TestBaseFeeDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+
+	// the proposer of the block is tipped
+	consPubKey := ed25519.GenPrivKey().PubKey()
+	_, _, operator := authtypes.KeyTestPubAddr()
+	validator := staking.NewValidator(sdk.ValAddress(operator), consPubKey, staking.Description{})
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, ProposerAddress: consPubKey.Address()})
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(5, 1))
+
+	anteHandler := sdk.ChainAnteDecorators(
+		authante.NewDeductFeeDecorator(app.AccountKeeper, app.SupplyKeeper),
+		ante.NewBaseFeeDecorator(app.FeeMarketKeeper),
+	)
+
+	priv1, _, addr1 := authtypes.KeyTestPubAddr()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr1, coins))
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(coins))
+
+	newTx := func(fee int64, gas uint64) sdk.Tx {
+		return authtypes.NewTestTx(
+			ctx, []sdk.Msg{authtypes.NewTestMsg(addr1)}, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0},
+			authtypes.NewStdFee(gas, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, fee))),
+		)
+	}
+
+	// the base fee of 0.5 per gas requires a fee of 501 for a gas limit of 1001
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := anteHandler(cacheCtx, newTx(500, 1001), false)
+	require.True(t, sdkerrors.ErrInsufficientFee.Is(err), err)
+
+	// the fees of a simulated tx are burned up to the base fee
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = anteHandler(cacheCtx, newTx(500, 1001), true)
+	require.NoError(t, err)
+
+	// the genesis transactions pay no base fee
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = anteHandler(cacheCtx.WithBlockHeight(0), newTx(0, 1001), false)
+	require.NoError(t, err)
+
+	_, err = anteHandler(ctx, newTx(800, 1001), false)
+	require.NoError(t, err)
+
+	// the base fee is burned and the rest tipped to the proposer
+	burned := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 501))
+	require.Equal(t, coins.Sub(burned), app.SupplyKeeper.GetSupply(ctx).GetTotal())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 299)), app.BankKeeper.GetAllBalances(ctx, operator))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, app.SupplyKeeper.GetModuleAddress(authtypes.FeeCollectorName)).IsZero())
+}
+
+func TestBaseFeeDecoratorZeroBaseFee(t *testing.T) {
+	app := simapp.Setup(false)
+
+	consPubKey := ed25519.GenPrivKey().PubKey()
+	_, _, operator := authtypes.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(operator)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, ProposerAddress: consPubKey.Address()})
+
+	validator := staking.NewValidator(valAddr, consPubKey, staking.Description{})
+	validator.Commission = staking.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1), sdk.ZeroDec())
+	validator = stakingkeeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+	app.StakingKeeper.AfterValidatorCreated(ctx, valAddr)
+
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.ZeroDec())
+
+	anteHandler := sdk.ChainAnteDecorators(
+		authante.NewDeductFeeDecorator(app.AccountKeeper, app.SupplyKeeper),
+		ante.NewBaseFeeDecorator(app.FeeMarketKeeper),
+	)
+
+	priv1, _, addr1 := authtypes.KeyTestPubAddr()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr1, coins))
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(coins))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 800))
+	tx := authtypes.NewTestTx(
+		ctx, []sdk.Msg{authtypes.NewTestMsg(addr1)}, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0},
+		authtypes.NewStdFee(1001, fee),
+	)
+	_, err := anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// nothing is burned nor tipped to the proposer while the base fee is zero
+	feeCollector := app.SupplyKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, coins, app.SupplyKeeper.GetSupply(ctx).GetTotal())
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, operator).IsZero())
+	require.Equal(t, fee, app.BankKeeper.GetAllBalances(ctx, feeCollector))
+
+	// and the fee is distributed as the other fees, between the validators and
+	// the community pool
+	votes := []abci.VoteInfo{{Validator: abci.Validator{Address: consPubKey.Address(), Power: 10}, SignedLastBlock: true}}
+	app.DistrKeeper.AllocateTokens(ctx, 10, 10, sdk.ConsAddress(consPubKey.Address()), votes)
+
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+	rewards := app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	require.True(t, rewards.IsAllPositive())
+	require.True(t, communityPool.IsAllPositive())
+	require.Equal(t, sdk.NewDecCoinsFromCoins(fee...), rewards.Add(communityPool...))
+}
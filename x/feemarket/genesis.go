@@ -0,0 +1,21 @@
+package feemarket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// InitGenesis new fee market genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetBaseFee(ctx, data.BaseFee)
+
+	supplyKeeper.GetModuleAccount(ctx, ModuleName)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	params := keeper.GetParams(ctx)
+	baseFee := keeper.GetBaseFee(ctx)
+	return NewGenesisState(params, baseFee)
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the fee market store
+type Keeper struct {
+	cdc              codec.Marshaler
+	storeKey         sdk.StoreKey
+	paramSpace       paramtypes.Subspace
+	sk               types.StakingKeeper
+	supplyKeeper     types.SupplyKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates a new fee market Keeper instance
+func NewKeeper(
+	cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, feeCollectorName string,
+) Keeper {
+
+	// ensure fee market module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the fee market module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace,
+		sk:               sk,
+		supplyKeeper:     supplyKeeper,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+//______________________________________________________________________
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetBaseFee returns the base fee, per unit of gas, of the current block.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.BaseFeeKey)
+	if b == nil {
+		panic("stored base fee should not have been nil")
+	}
+
+	var baseFee sdk.DecProto
+	k.cdc.MustUnmarshalBinaryBare(b, &baseFee)
+	return baseFee.Dec
+}
+
+// SetBaseFee sets the base fee, per unit of gas, of the current block.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: baseFee})
+	store.Set(types.BaseFeeKey, b)
+}
+
+//______________________________________________________________________
+
+// GetParams returns the total set of fee market parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of fee market parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+//______________________________________________________________________
+
+// BurnFees burns the given fees out of the collected fees.
+func (k Keeper) BurnFees(ctx sdk.Context, fees sdk.Coins) error {
+	if fees.Empty() {
+		return nil
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, fees); err != nil {
+		return err
+	}
+
+	if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, fees); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnFee,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fees.String()),
+		),
+	)
+
+	return nil
+}
+
+// TipProposer sends the given fees out of the collected fees to the account of
+// the operator of the proposer of the current block. The fees are left to the
+// fee collector, and distributed as the other fees, when the proposer is not
+// known, e.g. for the genesis transactions.
+func (k Keeper) TipProposer(ctx sdk.Context, fees sdk.Coins) error {
+	if fees.Empty() {
+		return nil
+	}
+
+	proposer := ctx.BlockHeader().ProposerAddress
+	if len(proposer) == 0 {
+		return nil
+	}
+
+	validator := k.sk.ValidatorByConsAddr(ctx, sdk.ConsAddress(proposer))
+	if validator == nil {
+		return nil
+	}
+
+	operator := sdk.AccAddress(validator.GetOperator())
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, operator, fees); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTipFee,
+			sdk.NewAttribute(types.AttributeKeyProposer, operator.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fees.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keep "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestNewQuerier(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	querier := keep.NewQuerier(app.FeeMarketKeeper)
+
+	_, err := querier(ctx, []string{"foo"}, abci.RequestQuery{})
+	require.Error(t, err)
+}
+
+func TestQueryParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	querier := keep.NewQuerier(app.FeeMarketKeeper)
+
+	res, err := querier(ctx, []string{types.QueryParameters}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var params types.Params
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &params))
+	require.Equal(t, types.DefaultParams(), params)
+}
+
+func TestQueryBaseFee(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	querier := keep.NewQuerier(app.FeeMarketKeeper)
+
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(25, 2))
+
+	res, err := querier(ctx, []string{types.QueryBaseFee}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var baseFee sdk.Dec
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &baseFee))
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), baseFee)
+}
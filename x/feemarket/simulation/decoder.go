@@ -0,0 +1,25 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding fee market type
+func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
+	switch {
+	case bytes.Equal(kvA.Key, types.BaseFeeKey):
+		var baseFeeA, baseFeeB sdk.DecProto
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &baseFeeA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &baseFeeB)
+		return fmt.Sprintf("%v\n%v", baseFeeA.Dec, baseFeeB.Dec)
+	default:
+		panic(fmt.Sprintf("invalid fee market key %X", kvA.Key))
+	}
+}
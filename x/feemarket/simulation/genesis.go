@@ -0,0 +1,51 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Simulation parameter constants
+const (
+	TargetBlockGas           = "target_block_gas"
+	BaseFeeChangeDenominator = "base_fee_change_denominator"
+)
+
+// GenTargetBlockGas randomized TargetBlockGas
+func GenTargetBlockGas(r *rand.Rand) uint64 {
+	return uint64(1_000_000 + r.Intn(20_000_000))
+}
+
+// GenBaseFeeChangeDenominator randomized BaseFeeChangeDenominator
+func GenBaseFeeChangeDenominator(r *rand.Rand) uint32 {
+	return uint32(1 + r.Intn(16))
+}
+
+// RandomizedGenState generates a random GenesisState for the fee market. The
+// base fee is zero, as the operations of the simulation pay random fees.
+func RandomizedGenState(simState *module.SimulationState) {
+	var targetBlockGas uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TargetBlockGas, &targetBlockGas, simState.Rand,
+		func(r *rand.Rand) { targetBlockGas = GenTargetBlockGas(r) },
+	)
+
+	var baseFeeChangeDenominator uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BaseFeeChangeDenominator, &baseFeeChangeDenominator, simState.Rand,
+		func(r *rand.Rand) { baseFeeChangeDenominator = GenBaseFeeChangeDenominator(r) },
+	)
+
+	params := types.NewParams(sdk.DefaultBondDenom, targetBlockGas, baseFeeChangeDenominator, sdk.ZeroDec())
+	feeMarketGenesis := types.NewGenesisState(params, sdk.ZeroDec())
+
+	fmt.Printf("Selected randomly generated fee market parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, feeMarketGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feeMarketGenesis)
+}
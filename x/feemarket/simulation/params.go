@@ -0,0 +1,34 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+const (
+	keyTargetBlockGas           = "TargetBlockGas"
+	keyBaseFeeChangeDenominator = "BaseFeeChangeDenominator"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, keyTargetBlockGas,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenTargetBlockGas(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyBaseFeeChangeDenominator,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenBaseFeeChangeDenominator(r))
+			},
+		),
+	}
+}
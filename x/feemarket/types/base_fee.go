@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseFee returns the base fee of the block following a block using the
+// given gas under the given base fee. As in EIP-1559, the base fee changes in
+// proportion to the deviation of the gas used from the target block gas, by at
+// most a fraction of 1/BaseFeeChangeDenominator, and never falls below the
+// minimum base fee. A zero base fee therefore stays zero, until the minimum
+// base fee is raised.
+func NextBaseFee(params Params, baseFee sdk.Dec, gasUsed uint64) sdk.Dec {
+	target := sdk.NewIntFromUint64(params.TargetBlockGas)
+	deviation := sdk.NewIntFromUint64(gasUsed).Sub(target)
+
+	delta := baseFee.MulInt(deviation).QuoInt(target).QuoInt64(int64(params.BaseFeeChangeDenominator))
+
+	// unlike Ethereum blocks, whose gas limit is twice their target, blocks
+	// may use any multiple of the target gas, so the increase is capped too
+	if maxDelta := baseFee.QuoInt64(int64(params.BaseFeeChangeDenominator)); delta.GT(maxDelta) {
+		delta = maxDelta
+	}
+
+	next := baseFee.Add(delta)
+	if next.LT(params.MinBaseFee) {
+		return params.MinBaseFee
+	}
+
+	return next
+}
+
+// RequiredFee returns the fee a transaction of the given gas limit has to pay
+// in the base fee denomination under the given base fee, rounded up.
+func RequiredFee(baseFee sdk.Dec, gas uint64) sdk.Int {
+	return baseFee.MulInt(sdk.NewIntFromUint64(gas)).Ceil().TruncateInt()
+}
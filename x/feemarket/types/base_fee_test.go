@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := NewParams(sdk.DefaultBondDenom, 1000, 8, sdk.NewDecWithPrec(1, 1))
+	baseFee := sdk.NewDec(8)
+
+	tests := []struct {
+		name    string
+		baseFee sdk.Dec
+		gasUsed uint64
+		expNext sdk.Dec
+	}{
+		{"at target", baseFee, 1000, baseFee},
+		{"empty block", baseFee, 0, sdk.NewDec(7)},
+		{"twice the target", baseFee, 2000, sdk.NewDec(9)},
+		{"half the target", baseFee, 500, sdk.NewDecWithPrec(75, 1)},
+		{"more than twice the target", baseFee, 10000, sdk.NewDec(9)},
+		{"below the minimum", sdk.NewDecWithPrec(1, 1), 0, sdk.NewDecWithPrec(1, 1)},
+		{"raised to the minimum", sdk.ZeroDec(), 2000, sdk.NewDecWithPrec(1, 1)},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expNext, NextBaseFee(params, tc.baseFee, tc.gasUsed))
+		})
+	}
+
+	// a zero base fee stays zero without a minimum
+	params.MinBaseFee = sdk.ZeroDec()
+	require.True(t, NextBaseFee(params, sdk.ZeroDec(), 2000).IsZero())
+}
+
+func TestRequiredFee(t *testing.T) {
+	require.Equal(t, sdk.ZeroInt(), RequiredFee(sdk.ZeroDec(), 1000))
+	require.Equal(t, sdk.NewInt(500), RequiredFee(sdk.NewDecWithPrec(5, 1), 1000))
+	require.Equal(t, sdk.NewInt(501), RequiredFee(sdk.NewDecWithPrec(5, 1), 1001))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	invalidParams := DefaultParams()
+	invalidParams.TargetBlockGas = 0
+	require.Error(t, ValidateGenesis(NewGenesisState(invalidParams, sdk.ZeroDec())))
+
+	invalidParams = DefaultParams()
+	invalidParams.BaseFeeChangeDenominator = 0
+	require.Error(t, ValidateGenesis(NewGenesisState(invalidParams, sdk.ZeroDec())))
+
+	invalidParams = DefaultParams()
+	invalidParams.MinBaseFee = sdk.NewDec(-1)
+	require.Error(t, ValidateGenesis(NewGenesisState(invalidParams, sdk.ZeroDec())))
+
+	invalidParams = DefaultParams()
+	invalidParams.BaseFeeDenom = ""
+	require.Error(t, ValidateGenesis(NewGenesisState(invalidParams, sdk.ZeroDec())))
+
+	require.Error(t, ValidateGenesis(NewGenesisState(DefaultParams(), sdk.NewDec(-1))))
+}
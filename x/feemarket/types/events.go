@@ -0,0 +1,13 @@
+package types
+
+// fee market module events
+const (
+	EventTypeBaseFee = "base_fee"
+	EventTypeBurnFee = "burn_fee"
+	EventTypeTipFee  = "tip_fee"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyBaseFee    = "base_fee"
+	AttributeKeyGasUsed    = "gas_used"
+	AttributeKeyProposer   = "proposer"
+)
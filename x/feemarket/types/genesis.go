@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - fee market state
+type GenesisState struct {
+	Params  Params  `json:"params" yaml:"params"`     // fee market params
+	BaseFee sdk.Dec `json:"base_fee" yaml:"base_fee"` // base fee of the next block
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseFee sdk.Dec) GenesisState {
+	return GenesisState{
+		Params:  params,
+		BaseFee: baseFee,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object, with a zero base
+// fee.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), sdk.ZeroDec())
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.BaseFee.IsNil() || data.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee cannot be negative: %s", data.BaseFee)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package types
+
+// BaseFeeKey is the key of the base fee in the store of the fee market module
+var BaseFeeKey = []byte{0x00}
+
+// nolint
+const (
+	// module name
+	ModuleName = "feemarket"
+
+	// default paramspace for params keeper
+	DefaultParamspace = ModuleName
+
+	// StoreKey is the default store key for the fee market
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the fee market store.
+	QuerierRoute = StoreKey
+
+	// Query endpoints supported by the fee market querier
+	QueryParameters = "parameters"
+	QueryBaseFee    = "base_fee"
+)
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default fee market parameters
+const (
+	DefaultTargetBlockGas           uint64 = 10_000_000
+	DefaultBaseFeeChangeDenominator uint32 = 8
+)
+
+// Parameter store keys
+var (
+	KeyBaseFeeDenom             = []byte("BaseFeeDenom")
+	KeyTargetBlockGas           = []byte("TargetBlockGas")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyMinBaseFee               = []byte("MinBaseFee")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable for the fee market module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	baseFeeDenom string, targetBlockGas uint64, baseFeeChangeDenominator uint32, minBaseFee sdk.Dec,
+) Params {
+
+	return Params{
+		BaseFeeDenom:             baseFeeDenom,
+		TargetBlockGas:           targetBlockGas,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		MinBaseFee:               minBaseFee,
+	}
+}
+
+// DefaultParams returns the default fee market parameters, with no minimum
+// base fee.
+func DefaultParams() Params {
+	return NewParams(
+		sdk.DefaultBondDenom, DefaultTargetBlockGas, DefaultBaseFeeChangeDenominator, sdk.ZeroDec(),
+	)
+}
+
+// Validate performs a basic validation of the parameters.
+func (p Params) Validate() error {
+	if err := validateBaseFeeDenom(p.BaseFeeDenom); err != nil {
+		return err
+	}
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+
+	return validateMinBaseFee(p.MinBaseFee)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of the fee market module's parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyBaseFeeDenom, &p.BaseFeeDenom, validateBaseFeeDenom),
+		paramtypes.NewParamSetPair(KeyTargetBlockGas, &p.TargetBlockGas, validateTargetBlockGas),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+	}
+}
+
+func validateBaseFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return errors.New("base fee denom cannot be blank")
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateTargetBlockGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("target block gas must be positive")
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+
+	return nil
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,455 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: x/feemarket/types/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the fee market module.
+type Params struct {
+	// denomination the base fee is charged in
+	BaseFeeDenom string `protobuf:"bytes,1,opt,name=base_fee_denom,json=baseFeeDenom,proto3" json:"base_fee_denom,omitempty" yaml:"base_fee_denom"`
+	// gas a block is targeted to use, the base fee rising after the blocks using
+	// more gas and falling after the blocks using less
+	TargetBlockGas uint64 `protobuf:"varint,2,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty" yaml:"target_block_gas"`
+	// bound on the change of the base fee from a block to the next, as the
+	// inverse of its maximum relative change
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,3,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty" yaml:"base_fee_change_denominator"`
+	// minimum base fee, per unit of gas
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee" yaml:"min_base_fee"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b9a07145442bc09, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetBaseFeeDenom() string {
+	if m != nil {
+		return m.BaseFeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.feemarket.v1.Params")
+}
+
+func init() { proto.RegisterFile("x/feemarket/types/types.proto", fileDescriptor_9b9a07145442bc09) }
+
+var fileDescriptor_9b9a07145442bc09 = []byte{
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4f, 0x4f, 0xf2, 0x30,
+	0x1c, 0xde, 0x78, 0x09, 0xc9, 0xbb, 0x20, 0x31, 0x33, 0xc6, 0x21, 0x71, 0x23, 0x3b, 0x10, 0x2e,
+	0x6e, 0x31, 0xde, 0xb8, 0x98, 0x4c, 0x50, 0x8f, 0x66, 0x47, 0x2f, 0x4d, 0xb7, 0x95, 0xb2, 0x8c,
+	0xae, 0xa4, 0xad, 0x06, 0xbe, 0x85, 0x47, 0x8f, 0x7e, 0x1c, 0x8e, 0x1c, 0x8d, 0x87, 0xc5, 0x40,
+	0xe2, 0x07, 0xd8, 0x27, 0x30, 0xac, 0x13, 0x14, 0x12, 0x2f, 0xfd, 0xf3, 0x7b, 0x9e, 0x3e, 0xcf,
+	0xd3, 0xfe, 0xaa, 0x9d, 0x4d, 0xdd, 0x21, 0x42, 0x04, 0xb2, 0x04, 0x09, 0x57, 0xcc, 0x26, 0x88,
+	0xcb, 0xd1, 0x99, 0x30, 0x2a, 0xa8, 0xde, 0x0c, 0x29, 0x27, 0x94, 0x03, 0x1e, 0x25, 0xce, 0xd4,
+	0xd9, 0x30, 0x9d, 0xa7, 0x8b, 0xd3, 0x8e, 0x18, 0xc5, 0x2c, 0x02, 0x13, 0xc8, 0xc4, 0xcc, 0x2d,
+	0xd8, 0x2e, 0xa6, 0x98, 0x6e, 0x57, 0x52, 0xc2, 0xfe, 0xac, 0x68, 0xb5, 0x7b, 0xc8, 0x20, 0xe1,
+	0xfa, 0x95, 0xd6, 0x08, 0x20, 0x47, 0x60, 0x88, 0x10, 0x88, 0x50, 0x4a, 0x89, 0xa1, 0xb6, 0xd5,
+	0xee, 0x7f, 0xaf, 0x99, 0x67, 0xd6, 0xf1, 0x0c, 0x92, 0x71, 0xcf, 0xfe, 0x8d, 0xdb, 0x7e, 0x7d,
+	0x5d, 0xb8, 0x41, 0xa8, 0xbf, 0xde, 0xea, 0x03, 0xed, 0x50, 0x40, 0x86, 0x91, 0x00, 0xc1, 0x98,
+	0x86, 0x09, 0xc0, 0x90, 0x1b, 0x95, 0xb6, 0xda, 0xad, 0x7a, 0xad, 0x3c, 0xb3, 0x4e, 0xa4, 0xc4,
+	0x2e, 0xc3, 0xf6, 0x1b, 0xb2, 0xe4, 0xad, 0x2b, 0xb7, 0x90, 0xeb, 0x48, 0x6b, 0x6d, 0x7c, 0xc2,
+	0x11, 0x4c, 0x71, 0x69, 0x17, 0xa7, 0x50, 0x50, 0x66, 0xfc, 0x6b, 0xab, 0xdd, 0x03, 0xaf, 0x93,
+	0x67, 0x96, 0xbd, 0x13, 0x6a, 0x9f, 0x6c, 0xfb, 0x46, 0x99, 0xf0, 0xba, 0xc0, 0xfa, 0x5b, 0x48,
+	0xc7, 0x5a, 0x9d, 0xc4, 0x29, 0xf8, 0x3e, 0x6d, 0x54, 0x8b, 0xcb, 0x0e, 0xe6, 0x99, 0xa5, 0xbc,
+	0x67, 0x56, 0x07, 0xc7, 0x62, 0xf4, 0x18, 0x38, 0x21, 0x25, 0xae, 0x7c, 0xe5, 0x72, 0x3a, 0xe7,
+	0x51, 0x52, 0x36, 0xa1, 0x8f, 0xc2, 0x3c, 0xb3, 0x8e, 0x64, 0x8a, 0x9f, 0x5a, 0xb6, 0xaf, 0x91,
+	0x38, 0xf5, 0xa4, 0x73, 0xaf, 0xfa, 0xf2, 0x6a, 0x29, 0xde, 0xdd, 0x7c, 0x69, 0xaa, 0x8b, 0xa5,
+	0xa9, 0x7e, 0x2c, 0x4d, 0xf5, 0x79, 0x65, 0x2a, 0x8b, 0x95, 0xa9, 0xbc, 0xad, 0x4c, 0xe5, 0xc1,
+	0xf9, 0xd3, 0x6a, 0xef, 0x07, 0x04, 0xb5, 0xa2, 0x73, 0x97, 0x5f, 0x03, 0x00, 0x75, 0x0a, 0xb1,
+	0x45, 0x1d, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BaseFeeDenom) > 0 {
+		i -= len(m.BaseFeeDenom)
+		copy(dAtA[i:], m.BaseFeeDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BaseFeeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovTypes(uint64(m.TargetBlockGas))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovTypes(uint64(m.BaseFeeChangeDenominator))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
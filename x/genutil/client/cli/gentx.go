@@ -122,7 +122,11 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager, sm
 			}
 
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+
+			// There is no node to query before genesis, so the amount must be
+			// given in its base denomination.
 			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			cliCtx.Offline = true
 
 			// Set the generate-only flag here after the CLI context has
 			// been created. This allows the from name/key to be correctly populated.
@@ -28,7 +28,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalID,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				keeper.GetDepositParams(ctx).GetMinDeposit(proposal.Expedited),
 				proposal.TotalDeposit,
 			),
 		)
@@ -41,6 +41,48 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
+		// An expedited proposal which fails is converted to a regular proposal,
+		// whose voting period is counted from the start of the expedited one.
+		// Its deposits and votes are kept until it is tallied again. If the
+		// voting period was shortened so that it is already over, the proposal
+		// is given the time a regular voting period lasts over an expedited one
+		// from the current block, so that it is not tallied again at once.
+		if !passes && proposal.Expedited {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			votingParams := keeper.GetVotingParams(ctx)
+			proposal.Expedited = false
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(votingParams.VotingPeriod)
+			if !proposal.VotingEndTime.After(ctx.BlockTime()) {
+				proposal.VotingEndTime = ctx.BlockTime().Add(votingParams.VotingPeriod - votingParams.ExpeditedVotingPeriod)
+			}
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) tallied; result: rejected, converted to a regular proposal",
+					proposal.ProposalID, proposal.GetTitle(),
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		// the votes may be kept so that the final vote of each voter remains
+		// queryable after the proposal is tallied
+		if !keeper.GetVotingParams(ctx).KeepVotes {
+			keeper.DeleteVotes(ctx, proposal.ProposalID)
+		}
+
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalID)
 		} else {
@@ -52,9 +94,13 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			cacheCtx, writeCache := ctx.CacheContext()
 
 			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
+			// on the proposal content, followed by the msgs of the proposal. If
+			// the handler or any of the msgs fails, no state mutation is written
+			// and the error message is logged.
 			err := handler(cacheCtx, proposal.Content)
+			if err == nil {
+				proposal.MsgResults, err = keeper.ExecuteProposalMsgs(cacheCtx, proposal)
+			}
 			if err == nil {
 				proposal.Status = StatusPassed
 				tagValue = types.AttributeValueProposalPassed
@@ -10,6 +10,7 @@ import (
 	codecstd "github.com/cosmos/cosmos-sdk/codec/std"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
@@ -282,7 +283,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
@@ -313,6 +314,52 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
 }
 
+func TestKeepVotesEndBlocker(t *testing.T) {
+	for _, keepVotes := range []bool{false, true} {
+		app := simapp.Setup(false)
+		ctx := app.BaseApp.NewContext(false, abci.Header{})
+		addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+		stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+		header := abci.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+		staking.EndBlocker(ctx, app.StakingKeeper)
+
+		votingParams := app.GovKeeper.GetVotingParams(ctx)
+		votingParams.KeepVotes = keepVotes
+		app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, false)
+		require.NoError(t, err)
+
+		proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
+		_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalID, addrs[0], proposalCoins)
+		require.NoError(t, err)
+
+		err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], gov.OptionYes)
+		require.NoError(t, err)
+
+		newHeader := ctx.BlockHeader()
+		newHeader.Time = ctx.BlockHeader().Time.Add(votingParams.VotingPeriod)
+		ctx = ctx.WithBlockHeader(newHeader)
+
+		gov.EndBlocker(ctx, app.GovKeeper)
+
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+		require.True(t, ok)
+		require.Equal(t, gov.StatusPassed, proposal.Status)
+
+		vote, found := app.GovKeeper.GetVote(ctx, proposal.ProposalID, addrs[0])
+		require.Equal(t, keepVotes, found)
+		if keepVotes {
+			require.Equal(t, gov.NewNonSplitVoteOption(gov.OptionYes), vote.WeightedOptions())
+		}
+	}
+}
+
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
@@ -334,7 +381,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
@@ -358,3 +405,179 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestExpeditedProposal(t *testing.T) {
+	testcases := []struct {
+		name            string
+		yesVotes        []int // indices of the validators voting yes, the others vote no
+		expeditedPasses bool
+		shortenPeriods  bool // the voting periods are shortened before the expedited one ends
+	}{
+		{"expedited threshold reached", []int{0, 1}, true, false},
+		{"only regular threshold reached", []int{0}, false, false},
+		{"voting period shortened", []int{0}, false, true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, abci.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+			SortAddresses(addrs)
+
+			header := abci.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}
+			createValidators(t, staking.NewHandler(app.StakingKeeper), ctx, valAddrs, []int64{6, 4})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, true)
+			require.NoError(t, err)
+			require.True(t, proposal.Expedited)
+
+			// the regular min deposit is not enough to activate an expedited proposal
+			depositParams := app.GovKeeper.GetDepositParams(ctx)
+			activated, err := app.GovKeeper.AddDeposit(ctx, proposal.ProposalID, addrs[2], depositParams.MinDeposit)
+			require.NoError(t, err)
+			require.False(t, activated)
+
+			remaining := depositParams.ExpeditedMinDeposit.Sub(depositParams.MinDeposit)
+			activated, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalID, addrs[3], remaining)
+			require.NoError(t, err)
+			require.True(t, activated)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			votingParams := app.GovKeeper.GetVotingParams(ctx)
+			require.Equal(t, proposal.VotingStartTime.Add(votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+			for i := range valAddrs {
+				option := gov.OptionNo
+				for _, j := range tc.yesVotes {
+					if i == j {
+						option = gov.OptionYes
+					}
+				}
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[i], option))
+			}
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(votingParams.ExpeditedVotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			// the regular voting period of the proposal would end before the
+			// current block
+			if tc.shortenPeriods {
+				votingParams.VotingPeriod = votingParams.ExpeditedVotingPeriod / 2
+				votingParams.ExpeditedVotingPeriod /= 4
+				app.GovKeeper.SetVotingParams(ctx, votingParams)
+			}
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+
+			if tc.expeditedPasses {
+				require.Equal(t, gov.StatusPassed, proposal.Status)
+				return
+			}
+
+			// the proposal is converted to a regular one, keeping its deposits and votes
+			require.Equal(t, gov.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.Expedited)
+			if tc.shortenPeriods {
+				require.Equal(t, ctx.BlockTime().Add(votingParams.VotingPeriod-votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime)
+			} else {
+				require.Equal(t, proposal.VotingStartTime.Add(votingParams.VotingPeriod), proposal.VotingEndTime)
+			}
+			require.Len(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalID), 2)
+			require.Len(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalID), 2)
+
+			activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+			require.False(t, activeQueue.Valid())
+			activeQueue.Close()
+
+			newHeader = ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			require.Equal(t, gov.StatusPassed, proposal.Status)
+			require.Empty(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalID))
+		})
+	}
+}
+
+func TestProposalMsgsEndBlocker(t *testing.T) {
+	testcases := []struct {
+		name       string
+		amounts    []int64 // amounts sent by the msgs, the governance account holds 100
+		expPassed  bool
+		expBalance int64 // expected balance of the recipient
+	}{
+		{"all msgs succeed", []int64{60, 40}, true, 100},
+		{"last msg fails", []int64{60, 60}, false, 0},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, abci.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+			SortAddresses(addrs)
+
+			header := abci.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddr := sdk.ValAddress(addrs[0])
+			createValidators(t, staking.NewHandler(app.StakingKeeper), ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+			funds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+			require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[1], govAddr, funds))
+
+			recipient := sdk.AccAddress([]byte("recipient___________"))
+			var msgs []sdk.Msg
+			for _, amount := range tc.amounts {
+				coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount)))
+				msgs = append(msgs, bank.NewMsgSend(govAddr, recipient, coins))
+			}
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, msgs, false)
+			require.NoError(t, err)
+
+			proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
+			_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalID, addrs[0], proposalCoins)
+			require.NoError(t, err)
+
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], gov.OptionYes))
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			require.Equal(t, sdk.NewInt(tc.expBalance).String(), app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom).Amount.String())
+
+			if tc.expPassed {
+				require.Equal(t, gov.StatusPassed, proposal.Status)
+				require.Len(t, proposal.MsgResults, len(msgs))
+			} else {
+				require.Equal(t, gov.StatusFailed, proposal.Status)
+				require.Empty(t, proposal.MsgResults)
+				require.Equal(t, funds, app.BankKeeper.GetAllBalances(ctx, govAddr))
+			}
+		})
+	}
+}
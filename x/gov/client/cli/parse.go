@@ -19,6 +19,7 @@ func parseSubmitProposalFlags() (*proposal, error) {
 		proposal.Description = viper.GetString(FlagDescription)
 		proposal.Type = govutils.NormalizeProposalType(viper.GetString(flagProposalType))
 		proposal.Deposit = viper.GetString(FlagDeposit)
+		proposal.Expedited = viper.GetBool(FlagExpedited)
 		return proposal, nil
 	}
 
@@ -27,6 +28,9 @@ func parseSubmitProposalFlags() (*proposal, error) {
 			return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", flag)
 		}
 	}
+	if viper.GetBool(FlagExpedited) {
+		return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", FlagExpedited)
+	}
 
 	contents, err := ioutil.ReadFile(proposalFile)
 	if err != nil {
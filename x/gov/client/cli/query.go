@@ -95,22 +95,26 @@ $ %s query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39
 $ %s query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
 $ %s query gov proposals --page=2 --limit=100
+$ %s query gov proposals --page-key=AAAAAAAAAGQ= --limit=100
 `,
-				version.ClientName, version.ClientName, version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			bechDepositorAddr := viper.GetString(flagDepositor)
 			bechVoterAddr := viper.GetString(flagVoter)
 			strProposalStatus := viper.GetString(flagStatus)
-			page := viper.GetInt(flags.FlagPage)
-			limit := viper.GetInt(flags.FlagLimit)
+
+			pageReq, err := client.ReadPageRequest()
+			if err != nil {
+				return err
+			}
 
 			var depositorAddr sdk.AccAddress
 			var voterAddr sdk.AccAddress
 			var proposalStatus types.ProposalStatus
 
-			params := types.NewQueryProposalsParams(page, limit, proposalStatus, voterAddr, depositorAddr)
+			params := types.NewQueryProposalsParams(pageReq, proposalStatus, voterAddr, depositorAddr)
 
 			if len(bechDepositorAddr) != 0 {
 				depositorAddr, err := sdk.AccAddressFromBech32(bechDepositorAddr)
@@ -148,13 +152,13 @@ $ %s query gov proposals --page=2 --limit=100
 				return err
 			}
 
-			var matchingProposals types.Proposals
+			var matchingProposals types.QueryProposalsResponse
 			err = cdc.UnmarshalJSON(res, &matchingProposals)
 			if err != nil {
 				return err
 			}
 
-			if len(matchingProposals) == 0 {
+			if len(matchingProposals.Proposals) == 0 {
 				return fmt.Errorf("no matching proposals found")
 			}
 
@@ -162,8 +166,7 @@ $ %s query gov proposals --page=2 --limit=100
 		},
 	}
 
-	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of proposals to to query for")
-	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of proposals to query for")
+	flags.AddPaginationFlagsToCmd(cmd, "proposals")
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
 	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
@@ -253,6 +256,7 @@ func GetCmdQueryVotes(queryRoute string, cdc *codec.Codec) *cobra.Command {
 Example:
 $ %[1]s query gov votes 1
 $ %[1]s query gov votes 1 --page=2 --limit=100
+$ %[1]s query gov votes 1 --page-key=FNzJ3s7cFzG7Ts2aYSZ7aQaN8Dw= --limit=100
 `,
 				version.ClientName,
 			),
@@ -266,10 +270,12 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
 			}
 
-			page := viper.GetInt(flags.FlagPage)
-			limit := viper.GetInt(flags.FlagLimit)
+			pageReq, err := client.ReadPageRequest()
+			if err != nil {
+				return err
+			}
 
-			params := types.NewQueryProposalVotesParams(proposalID, page, limit)
+			params := types.NewQueryProposalVotesParams(proposalID, pageReq)
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
@@ -284,24 +290,30 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 			var proposal types.Proposal
 			cdc.MustUnmarshalJSON(res, &proposal)
 
-			propStatus := proposal.Status
-			if !(propStatus == types.StatusVotingPeriod || propStatus == types.StatusDepositPeriod) {
-				res, err = gcutils.QueryVotesByTxQuery(cliCtx, params)
-			} else {
-				res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/votes", queryRoute), bz)
-			}
-
+			res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/votes", queryRoute), bz)
 			if err != nil {
 				return err
 			}
 
-			var votes types.Votes
+			var votes types.QueryVotesResponse
 			cdc.MustUnmarshalJSON(res, &votes)
+
+			// the votes of inactive proposals are only kept in the store if the
+			// voting params say so, otherwise they are rebuilt from the txs
+			propStatus := proposal.Status
+			if len(votes.Votes) == 0 && len(pageReq.Key) == 0 &&
+				!(propStatus == types.StatusVotingPeriod || propStatus == types.StatusDepositPeriod) {
+				res, err = gcutils.QueryVotesByTxQuery(cliCtx, params)
+				if err != nil {
+					return err
+				}
+				cdc.MustUnmarshalJSON(res, &votes)
+			}
+
 			return cliCtx.PrintOutput(votes)
 		},
 	}
-	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of votes to to query for")
-	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of votes to query for")
+	flags.AddPaginationFlagsToCmd(cmd, "votes")
 	return cmd
 }
 
@@ -370,7 +382,7 @@ $ %s query gov deposit 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 
 // GetCmdQueryDeposits implements the command to query for proposal deposits.
 func GetCmdQueryDeposits(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "deposits [proposal-id]",
 		Args:  cobra.ExactArgs(1),
 		Short: "Query deposits on a proposal",
@@ -380,8 +392,9 @@ You can find the proposal-id by running "%s query gov proposals".
 
 Example:
 $ %s query gov deposits 1
+$ %s query gov deposits 1 --page-key=FNzJ3s7cFzG7Ts2aYSZ7aQaN8Dw= --limit=100
 `,
-				version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -393,7 +406,12 @@ $ %s query gov deposits 1
 				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
 			}
 
-			params := types.NewQueryProposalParams(proposalID)
+			pageReq, err := client.ReadPageRequest()
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryProposalDepositsParams(proposalID, pageReq)
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
@@ -419,11 +437,13 @@ $ %s query gov deposits 1
 				return err
 			}
 
-			var dep types.Deposits
+			var dep types.QueryDepositsResponse
 			cdc.MustUnmarshalJSON(res, &dep)
 			return cliCtx.PrintOutput(dep)
 		},
 	}
+	flags.AddPaginationFlagsToCmd(cmd, "deposits")
+	return cmd
 }
 
 // GetCmdQueryTally implements the command to query for proposal tally result.
@@ -94,12 +94,17 @@ func queryDepositsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		pageReq, err := rest.ParsePageRequest(r)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
 		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		params := types.NewQueryProposalParams(proposalID)
+		params := types.NewQueryProposalDepositsParams(proposalID, pageReq)
 
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if rest.CheckBadRequestError(w, err) {
@@ -313,7 +318,7 @@ func queryVoteHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 // todo: Split this functionality into helper functions to remove the above
 func queryVotesOnProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		pageReq, err := rest.ParsePageRequest(r)
 		if rest.CheckBadRequestError(w, err) {
 			return
 		}
@@ -337,7 +342,7 @@ func queryVotesOnProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		params := types.NewQueryProposalVotesParams(proposalID, page, limit)
+		params := types.NewQueryProposalVotesParams(proposalID, pageReq)
 
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if rest.CheckBadRequestError(w, err) {
@@ -354,19 +359,27 @@ func queryVotesOnProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		// For inactive proposals we must query the txs directly to get the votes
-		// as they're no longer in state.
-		propStatus := proposal.Status
-		if !(propStatus == types.StatusVotingPeriod || propStatus == types.StatusDepositPeriod) {
-			res, err = gcutils.QueryVotesByTxQuery(cliCtx, params)
-		} else {
-			res, _, err = cliCtx.QueryWithData("custom/gov/votes", bz)
+		res, _, err = cliCtx.QueryWithData("custom/gov/votes", bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
 		}
 
-		if rest.CheckInternalServerError(w, err) {
+		var votes types.QueryVotesResponse
+		if rest.CheckInternalServerError(w, cliCtx.Codec.UnmarshalJSON(res, &votes)) {
 			return
 		}
 
+		// For inactive proposals we must query the txs directly to get the votes
+		// if they're no longer in state.
+		propStatus := proposal.Status
+		if len(votes.Votes) == 0 && len(pageReq.Key) == 0 &&
+			!(propStatus == types.StatusVotingPeriod || propStatus == types.StatusDepositPeriod) {
+			res, err = gcutils.QueryVotesByTxQuery(cliCtx, params)
+			if rest.CheckInternalServerError(w, err) {
+				return
+			}
+		}
+
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
@@ -374,7 +387,7 @@ func queryVotesOnProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 // HTTP request handler to query list of governance proposals
 func queryProposalsWithParameterFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		pageReq, err := rest.ParsePageRequest(r)
 		if rest.CheckBadRequestError(w, err) {
 			return
 		}
@@ -411,7 +424,7 @@ func queryProposalsWithParameterFn(cliCtx context.CLIContext) http.HandlerFunc {
 			}
 		}
 
-		params := types.NewQueryProposalsParams(page, limit, proposalStatus, voterAddr, depositorAddr)
+		params := types.NewQueryProposalsParams(pageReq, proposalStatus, voterAddr, depositorAddr)
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if rest.CheckBadRequestError(w, err) {
 			return
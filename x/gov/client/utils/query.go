@@ -3,9 +3,9 @@ package utils
 import (
 	"fmt"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -33,11 +33,11 @@ func (p Proposer) String() string {
 
 // QueryDepositsByTxQuery will query for deposits via a direct txs tags query. It
 // will fetch and build deposits directly from the returned txs and return a
-// JSON marshalled result or any error that occurred.
+// JSON marshalled QueryDepositsResponse or any error that occurred.
 //
 // NOTE: SearchTxs is used to facilitate the txs query which does not currently
 // support configurable pagination.
-func QueryDepositsByTxQuery(cliCtx context.CLIContext, params types.QueryProposalParams) ([]byte, error) {
+func QueryDepositsByTxQuery(cliCtx context.CLIContext, params types.QueryProposalDepositsParams) ([]byte, error) {
 	events := []string{
 		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgDeposit),
 		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalDeposit, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
@@ -50,7 +50,7 @@ func QueryDepositsByTxQuery(cliCtx context.CLIContext, params types.QueryProposa
 		return nil, err
 	}
 
-	var deposits []types.Deposit
+	deposits := types.Deposits{}
 
 	for _, info := range searchResult.Txs {
 		for _, msg := range info.Tx.GetMsgs() {
@@ -66,28 +66,38 @@ func QueryDepositsByTxQuery(cliCtx context.CLIContext, params types.QueryProposa
 		}
 	}
 
+	res := types.NewQueryDepositsResponse(deposits, nil)
 	if cliCtx.Indent {
-		return cliCtx.Codec.MarshalJSONIndent(deposits, "", "  ")
+		return cliCtx.Codec.MarshalJSONIndent(res, "", "  ")
 	}
 
-	return cliCtx.Codec.MarshalJSON(deposits)
+	return cliCtx.Codec.MarshalJSON(res)
 }
 
 // QueryVotesByTxQuery will query for votes via a direct txs tags query. It
 // will fetch and build votes directly from the returned txs and return a JSON
-// marshalled result or any error that occurred.
+// marshalled QueryVotesResponse or any error that occurred. The votes are
+// paginated by offset only, the key of the page request is ignored.
 func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalVotesParams) ([]byte, error) {
+	var offset, limit uint64 = 0, query.DefaultLimit
+	if params.Pagination != nil {
+		offset = params.Pagination.Offset
+		if params.Pagination.Limit > 0 {
+			limit = params.Pagination.Limit
+		}
+	}
+
 	var (
+		// both MsgVote and MsgVoteWeighted emit the proposal vote event
 		events = []string{
-			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
 			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		}
 		votes      []types.Vote
 		nextTxPage = defaultPage
-		totalLimit = params.Limit * params.Page
+		totalLimit = offset + limit
 	)
 	// query interrupted either if we collected enough votes or tx indexer run out of relevant txs
-	for len(votes) < totalLimit {
+	for uint64(len(votes)) < totalLimit {
 		searchResult, err := authclient.QueryTxsByEvents(cliCtx, events, nextTxPage, defaultLimit, "")
 		if err != nil {
 			return nil, err
@@ -95,14 +105,8 @@ func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalVo
 		nextTxPage++
 		for _, info := range searchResult.Txs {
 			for _, msg := range info.Tx.GetMsgs() {
-				if msg.Type() == types.TypeMsgVote {
-					voteMsg := msg.(types.MsgVote)
-
-					votes = append(votes, types.Vote{
-						Voter:      voteMsg.Voter,
-						ProposalID: params.ProposalID,
-						Option:     voteMsg.Option,
-					})
+				if vote, ok := voteFromMsg(msg, params.ProposalID); ok {
+					votes = append(votes, vote)
 				}
 			}
 		}
@@ -110,22 +114,39 @@ func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalVo
 			break
 		}
 	}
-	start, end := client.Paginate(len(votes), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
+	switch {
+	case offset >= uint64(len(votes)):
 		votes = []types.Vote{}
-	} else {
-		votes = votes[start:end]
+	case totalLimit < uint64(len(votes)):
+		votes = votes[offset:totalLimit]
+	default:
+		votes = votes[offset:]
 	}
+	res := types.NewQueryVotesResponse(votes, nil)
 	if cliCtx.Indent {
-		return cliCtx.Codec.MarshalJSONIndent(votes, "", "  ")
+		return cliCtx.Codec.MarshalJSONIndent(res, "", "  ")
+	}
+	return cliCtx.Codec.MarshalJSON(res)
+}
+
+// voteFromMsg builds the vote cast on a proposal by a MsgVote or a
+// MsgVoteWeighted. It returns false for any other message.
+func voteFromMsg(msg sdk.Msg, proposalID uint64) (types.Vote, bool) {
+	switch msg := msg.(type) {
+	case types.MsgVote:
+		return types.NewVote(proposalID, msg.Voter, types.NewNonSplitVoteOption(msg.Option)), true
+
+	case types.MsgVoteWeighted:
+		return types.NewVote(proposalID, msg.Voter, msg.Options), true
+
+	default:
+		return types.Vote{}, false
 	}
-	return cliCtx.Codec.MarshalJSON(votes)
 }
 
 // QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
 func QueryVoteByTxQuery(cliCtx context.CLIContext, params types.QueryVoteParams) ([]byte, error) {
 	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
 		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
 	}
@@ -139,15 +160,7 @@ func QueryVoteByTxQuery(cliCtx context.CLIContext, params types.QueryVoteParams)
 	for _, info := range searchResult.Txs {
 		for _, msg := range info.Tx.GetMsgs() {
 			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(types.MsgVote)
-
-				vote := types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				}
-
+			if vote, ok := voteFromMsg(msg, params.ProposalID); ok && vote.Voter.Equals(params.Voter) {
 				if cliCtx.Indent {
 					return cliCtx.Codec.MarshalJSONIndent(vote, "", "  ")
 				}
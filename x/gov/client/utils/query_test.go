@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -50,10 +51,10 @@ func newTestCodec() *codec.Codec {
 
 func TestGetPaginatedVotes(t *testing.T) {
 	type testCase struct {
-		description string
-		page, limit int
-		txs         []authtypes.StdTx
-		votes       []types.Vote
+		description   string
+		offset, limit uint64
+		txs           []authtypes.StdTx
+		votes         []types.Vote
 	}
 	acc1 := make(sdk.AccAddress, 20)
 	acc1[0] = 1
@@ -67,63 +68,75 @@ func TestGetPaginatedVotes(t *testing.T) {
 		types.NewMsgVote(acc2, 0, types.OptionYes),
 		types.NewMsgVote(acc2, 0, types.OptionYes),
 	}
+	splitOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
 	for _, tc := range []testCase{
 		{
 			description: "1MsgPerTxAll",
-			page:        1,
 			limit:       2,
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs[:1]},
 				{Msgs: acc2Msgs[:1]},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 
 		{
 			description: "2MsgPerTx1Chunk",
-			page:        1,
 			limit:       2,
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs},
 				{Msgs: acc2Msgs},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc1, types.OptionYes)},
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "2MsgPerTx2Chunk",
-			page:        2,
+			offset:      2,
 			limit:       2,
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs},
 				{Msgs: acc2Msgs},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc2, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "IncompleteSearchTx",
-			page:        1,
 			limit:       2,
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs[:1]},
 			},
-			votes: []types.Vote{types.NewVote(0, acc1, types.OptionYes)},
+			votes: []types.Vote{types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
+		},
+		{
+			description: "WeightedVote",
+			limit:       2,
+			txs: []authtypes.StdTx{
+				{Msgs: acc1Msgs[:1]},
+				{Msgs: []sdk.Msg{types.NewMsgVoteWeighted(acc2, 0, splitOptions)}},
+			},
+			votes: []types.Vote{
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, splitOptions)},
 		},
 		{
-			description: "InvalidPage",
-			page:        -1,
+			description: "DefaultLimit",
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs[:1]},
 			},
+			votes: []types.Vote{types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "OutOfBounds",
-			page:        2,
+			offset:      10,
 			limit:       10,
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs[:1]},
@@ -144,11 +157,13 @@ func TestGetPaginatedVotes(t *testing.T) {
 			client := TxSearchMock{txs: marshalled}
 			ctx := context.CLIContext{}.WithCodec(cdc).WithTrustNode(true).WithClient(client)
 
-			params := types.NewQueryProposalVotesParams(0, tc.page, tc.limit)
+			params := types.NewQueryProposalVotesParams(0, &query.PageRequest{Offset: tc.offset, Limit: tc.limit})
 			votesData, err := QueryVotesByTxQuery(ctx, params)
 			require.NoError(t, err)
-			votes := []types.Vote{}
-			require.NoError(t, ctx.Codec.UnmarshalJSON(votesData, &votes))
+			var res types.QueryVotesResponse
+			require.NoError(t, ctx.Codec.UnmarshalJSON(votesData, &res))
+			require.Nil(t, res.Pagination)
+			votes := res.Votes
 			require.Equal(t, len(tc.votes), len(votes))
 			for i := range votes {
 				require.Equal(t, tc.votes[i], votes[i])
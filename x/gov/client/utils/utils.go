@@ -1,6 +1,12 @@
 package utils
 
-import "github.com/cosmos/cosmos-sdk/x/gov/types"
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,7 +28,34 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
-//NormalizeProposalType - normalize user specified proposal type
+// ParseWeightedVoteOptions parses user specified weighted vote options formatted
+// as option=weight pairs separated by commas, e.g. "yes=0.6,no=0.3,abstain=0.1".
+// The options are not validated.
+func ParseWeightedVoteOptions(str string) (types.WeightedVoteOptions, error) {
+	var options types.WeightedVoteOptions
+	for _, pair := range strings.Split(strings.TrimSpace(str), ",") {
+		fields := strings.Split(strings.TrimSpace(pair), "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", pair)
+		}
+
+		option, err := types.VoteOptionFromString(NormalizeVoteOption(fields[0]))
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid vote option", fields[0])
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid vote weight: %w", fields[1], err)
+		}
+
+		options = append(options, types.NewWeightedVoteOption(option, weight))
+	}
+
+	return options, nil
+}
+
+// NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
 	case "Text", "text":
@@ -33,7 +66,7 @@ func NormalizeProposalType(proposalType string) string {
 	}
 }
 
-//NormalizeProposalStatus - normalize user specified proposal status
+// NormalizeProposalStatus - normalize user specified proposal status
 func NormalizeProposalStatus(status string) string {
 	switch status {
 	case "DepositPeriod", "deposit_period":
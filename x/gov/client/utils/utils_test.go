@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestParseWeightedVoteOptions(t *testing.T) {
+	tests := []struct {
+		str       string
+		options   types.WeightedVoteOptions
+		expectErr bool
+	}{
+		{"yes=1", types.NewNonSplitVoteOption(types.OptionYes), false},
+		{
+			"yes=0.6, no_with_veto=0.3,Abstain=0.1",
+			types.WeightedVoteOptions{
+				types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+				types.NewWeightedVoteOption(types.OptionNoWithVeto, sdk.NewDecWithPrec(3, 1)),
+				types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(1, 1)),
+			},
+			false,
+		},
+		{"", nil, true},
+		{"yes", nil, true},
+		{"yes=0.5=no", nil, true},
+		{"maybe=1", nil, true},
+		{"yes=half", nil, true},
+	}
+
+	for i, tc := range tests {
+		options, err := ParseWeightedVoteOptions(tc.str)
+		if tc.expectErr {
+			require.Error(t, err, "test: %v", i)
+		} else {
+			require.NoError(t, err, "test: %v", i)
+			require.Equal(t, tc.options, options, "test: %v", i)
+		}
+	}
+}
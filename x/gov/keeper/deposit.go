@@ -3,8 +3,10 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -45,6 +47,26 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) (deposits t
 	return
 }
 
+// GetDepositsPaginated returns a page of the deposits from a proposal.
+func (keeper Keeper) GetDepositsPaginated(ctx sdk.Context, proposalID uint64, pagination *query.PageRequest) (types.Deposits, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.DepositsKey(proposalID))
+	deposits := types.Deposits{}
+
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var deposit types.Deposit
+		if err := keeper.cdc.UnmarshalBinaryBare(value, &deposit); err != nil {
+			return err
+		}
+		deposits = append(deposits, deposit)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deposits, pageRes, nil
+}
+
 // DeleteDeposits deletes all the deposits on a specific proposal without refunding them
 func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -118,7 +140,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).GetMinDeposit(proposal.Expedited)) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
 	}
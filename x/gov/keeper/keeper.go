@@ -30,6 +30,9 @@ type Keeper struct {
 
 	// Proposal router
 	router types.Router
+
+	// Msg router, to which the msgs of passed proposals are dispatched
+	msgRouter sdk.Router
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -38,10 +41,13 @@ type Keeper struct {
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
 //
+// The msgs of passed proposals are dispatched to the handlers of msgRouter, which
+// is usually the Router of the BaseApp.
+//
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc types.Codec, key sdk.StoreKey, paramSpace types.ParamSubspace,
-	supplyKeeper types.SupplyKeeper, sk types.StakingKeeper, rtr types.Router,
+	supplyKeeper types.SupplyKeeper, sk types.StakingKeeper, rtr types.Router, msgRouter sdk.Router,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -61,6 +67,7 @@ func NewKeeper(
 		sk:           sk,
 		cdc:          cdc,
 		router:       rtr,
+		msgRouter:    msgRouter,
 	}
 }
 
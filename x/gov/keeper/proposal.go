@@ -3,18 +3,35 @@ package keeper
 import (
 	"fmt"
 
-	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+// SubmitProposal create new proposal given a content and the msgs to execute
+// if it passes. The msgs must be signed by the governance module account only.
+// Expedited proposals use the expedited minimum deposit, voting period and
+// threshold.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content, msgs []sdk.Msg, expedited bool) (types.Proposal, error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
 
+	govAddr := keeper.supplyKeeper.GetModuleAddress(types.ModuleName)
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return types.Proposal{}, sdkerrors.Wrapf(
+				types.ErrInvalidProposalMsg, "msg %d must be signed by the governance module account %s only", i, govAddr,
+			)
+		}
+		if keeper.msgRouter.Route(ctx, msg.Route()) == nil {
+			return types.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidProposalMsg, "unrecognized route of msg %d: %s", i, msg.Route())
+		}
+	}
+
 	// Execute the proposal content in a cache-wrapped context to validate the
 	// actual parameter changes before the proposal proceeds through the
 	// governance process. State is not persisted.
@@ -32,7 +49,12 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal := types.NewProposal(content, msgs, proposalID, submitTime, submitTime.Add(depositPeriod), expedited)
+
+	// the msgs must be supported by the codec of the proposals
+	if _, err := keeper.cdc.MarshalProposal(proposal); err != nil {
+		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
+	}
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -77,6 +99,34 @@ func (keeper Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
 	store.Set(types.ProposalKey(proposal.ProposalID), bz)
 }
 
+// ExecuteProposalMsgs dispatches the msgs of a proposal to their handlers, in
+// order, and returns their results. The events of the msgs are emitted on the
+// event manager of the context. It stops at the first msg which fails: the
+// caller is responsible for discarding the state changes of the previous msgs,
+// e.g. by using a cache-wrapped context.
+func (keeper Keeper) ExecuteProposalMsgs(ctx sdk.Context, proposal types.Proposal) ([]types.MsgResult, error) {
+	results := make([]types.MsgResult, len(proposal.Msgs))
+	for i, msg := range proposal.Msgs {
+		handler := keeper.msgRouter.Route(ctx, msg.Route())
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msg.Route(), i)
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		for _, event := range res.Events {
+			ctx.EventManager().EmitEvent(sdk.Event(event))
+		}
+
+		results[i] = types.NewMsgResult(res)
+	}
+
+	return results, nil
+}
+
 // DeleteProposal deletes a proposal from store
 func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -117,20 +167,25 @@ func (keeper Keeper) GetProposals(ctx sdk.Context) (proposals types.Proposals) {
 	return
 }
 
-// GetProposalsFiltered retrieves proposals filtered by a given set of params which
-// include pagination parameters along with voter and depositor addresses and a
-// proposal status. The voter address will filter proposals by whether or not
-// that address has voted on proposals. The depositor address will filter proposals
-// by whether or not that address has deposited to them. Finally, status will filter
-// proposals by status.
+// GetProposalsFiltered retrieves a page of proposals filtered by a given set of
+// params which include pagination parameters along with voter and depositor
+// addresses and a proposal status. The voter address will filter proposals by
+// whether or not that address has voted on proposals. The depositor address
+// will filter proposals by whether or not that address has deposited to them.
+// Finally, status will filter proposals by status.
 //
 // NOTE: If no filters are provided, all proposals will be returned in paginated
 // form.
-func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, params types.QueryProposalsParams) []types.Proposal {
-	proposals := keeper.GetProposals(ctx)
-	filteredProposals := make([]types.Proposal, 0, len(proposals))
+func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, params types.QueryProposalsParams) (types.Proposals, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.ProposalsKeyPrefix)
+	filteredProposals := types.Proposals{}
+
+	pageRes, err := query.FilteredPaginate(store, params.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		p, err := keeper.cdc.UnmarshalProposal(value)
+		if err != nil {
+			return false, err
+		}
 
-	for _, p := range proposals {
 		matchVoter, matchDepositor, matchStatus := true, true, true
 
 		// match status (if supplied/valid)
@@ -148,19 +203,20 @@ func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, params types.QueryPro
 			_, matchDepositor = keeper.GetDeposit(ctx, p.ProposalID, params.Depositor)
 		}
 
-		if matchVoter && matchDepositor && matchStatus {
-			filteredProposals = append(filteredProposals, p)
+		if !matchVoter || !matchDepositor || !matchStatus {
+			return false, nil
 		}
-	}
 
-	start, end := client.Paginate(len(filteredProposals), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
-		filteredProposals = []types.Proposal{}
-	} else {
-		filteredProposals = filteredProposals[start:end]
+		if accumulate {
+			filteredProposals = append(filteredProposals, p)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return filteredProposals
+	return filteredProposals, pageRes, nil
 }
 
 // GetProposalID gets the highest proposal ID
@@ -183,7 +239,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetVotingParams(ctx).GetVotingPeriod(proposal.Expedited)
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
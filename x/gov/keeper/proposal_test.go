@@ -11,6 +11,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -19,7 +21,7 @@ func TestGetSetProposal(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	app.GovKeeper.SetProposal(ctx, proposal)
@@ -34,7 +36,7 @@ func TestActivateVotingPeriod(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 
 	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
@@ -77,11 +79,47 @@ func TestSubmitProposal(t *testing.T) {
 	}
 
 	for i, tc := range testCases {
-		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content)
+		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content, nil, false)
 		require.True(t, errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func TestSubmitProposalMsgs(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
+
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+
+	testCases := []struct {
+		msgs        []sdk.Msg
+		expectedErr error
+	}{
+		{nil, nil},
+		{[]sdk.Msg{bank.NewMsgSend(govAddr, addrs[0], coins), bank.NewMsgSend(govAddr, addrs[1], coins)}, nil},
+		// msgs must be signed by the governance module account only
+		{[]sdk.Msg{bank.NewMsgSend(addrs[0], addrs[1], coins)}, types.ErrInvalidProposalMsg},
+		{[]sdk.Msg{bank.NewMsgSend(govAddr, addrs[0], coins), sdk.NewTestMsg(govAddr, addrs[0])}, types.ErrInvalidProposalMsg},
+		// msgs must have a route
+		{[]sdk.Msg{sdk.NewTestMsg(govAddr)}, types.ErrInvalidProposalMsg},
+	}
+
+	for i, tc := range testCases {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, tc.msgs, false)
+		if tc.expectedErr != nil {
+			require.True(t, errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
+			continue
+		}
+
+		require.NoError(t, err, "tc #%d", i)
+		stored, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+		require.True(t, ok)
+		require.True(t, proposal.Equal(stored), "tc #%d", i)
+		require.Len(t, stored.Msgs, len(tc.msgs))
+	}
+}
+
 func TestGetProposalsFiltered(t *testing.T) {
 	proposalID := uint64(1)
 	app := simapp.Setup(false)
@@ -93,12 +131,12 @@ func TestGetProposalsFiltered(t *testing.T) {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p := types.NewProposal(TestProposal, proposalID, time.Now(), time.Now())
+			p := types.NewProposal(TestProposal, nil, proposalID, time.Now(), time.Now(), false)
 			p.Status = s
 
 			if i%2 == 0 {
 				d := types.NewDeposit(proposalID, addr1, nil)
-				v := types.NewVote(proposalID, addr1, types.OptionYes)
+				v := types.NewVote(proposalID, addr1, types.NewNonSplitVoteOption(types.OptionYes))
 				app.GovKeeper.SetDeposit(ctx, d)
 				app.GovKeeper.SetVote(ctx, v)
 			}
@@ -112,22 +150,23 @@ func TestGetProposalsFiltered(t *testing.T) {
 		params             types.QueryProposalsParams
 		expectedNumResults int
 	}{
-		{types.NewQueryProposalsParams(1, 50, types.StatusNil, nil, nil), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusDepositPeriod, nil, nil), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusVotingPeriod, nil, nil), 50},
-		{types.NewQueryProposalsParams(1, 25, types.StatusNil, nil, nil), 25},
-		{types.NewQueryProposalsParams(2, 25, types.StatusNil, nil, nil), 25},
-		{types.NewQueryProposalsParams(1, 50, types.StatusRejected, nil, nil), 0},
-		{types.NewQueryProposalsParams(1, 50, types.StatusNil, addr1, nil), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusNil, nil, addr1), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusNil, addr1, addr1), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusDepositPeriod, addr1, addr1), 25},
-		{types.NewQueryProposalsParams(1, 50, types.StatusDepositPeriod, nil, nil), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusVotingPeriod, nil, nil), 50},
+		{types.NewQueryProposalsParams(&query.PageRequest{Limit: 50}, types.StatusNil, nil, nil), 50},
+		{types.NewQueryProposalsParams(&query.PageRequest{Limit: 50}, types.StatusDepositPeriod, nil, nil), 50},
+		{types.NewQueryProposalsParams(&query.PageRequest{Limit: 50}, types.StatusVotingPeriod, nil, nil), 50},
+		{types.NewQueryProposalsParams(&query.PageRequest{Limit: 25}, types.StatusNil, nil, nil), 25},
+		{types.NewQueryProposalsParams(&query.PageRequest{Offset: 25, Limit: 25}, types.StatusNil, nil, nil), 25},
+		{types.NewQueryProposalsParams(&query.PageRequest{Limit: 50}, types.StatusRejected, nil, nil), 0},
+		{types.NewQueryProposalsParams(&query.PageRequest{Limit: 50}, types.StatusNil, addr1, nil), 50},
+		{types.NewQueryProposalsParams(&query.PageRequest{Limit: 50}, types.StatusNil, nil, addr1), 50},
+		{types.NewQueryProposalsParams(&query.PageRequest{Limit: 50}, types.StatusNil, addr1, addr1), 50},
+		{types.NewQueryProposalsParams(&query.PageRequest{Limit: 50}, types.StatusDepositPeriod, addr1, addr1), 25},
+		{types.NewQueryProposalsParams(&query.PageRequest{Limit: 50}, types.StatusDepositPeriod, nil, nil), 50},
+		{types.NewQueryProposalsParams(&query.PageRequest{Limit: 50}, types.StatusVotingPeriod, nil, nil), 50},
 	}
 
 	for _, tc := range testCases {
-		proposals := app.GovKeeper.GetProposalsFiltered(ctx, tc.params)
+		proposals, _, err := app.GovKeeper.GetProposalsFiltered(ctx, tc.params)
+		require.NoError(t, err)
 		require.Len(t, proposals, tc.expectedNumResults)
 
 		for _, p := range proposals {
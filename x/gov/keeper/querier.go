@@ -3,7 +3,6 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -129,18 +128,18 @@ func queryVote(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 
 // nolint: unparam
 func queryDeposits(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
-	var params types.QueryProposalParams
+	var params types.QueryProposalDepositsParams
 	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	deposits := keeper.GetDeposits(ctx, params.ProposalID)
-	if deposits == nil {
-		deposits = types.Deposits{}
+	deposits, pageRes, err := keeper.GetDepositsPaginated(ctx, params.ProposalID, params.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	bz, err := codec.MarshalJSONIndent(keeper.cdc, deposits)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, types.NewQueryDepositsResponse(deposits, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -193,19 +192,12 @@ func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	votes := keeper.GetVotes(ctx, params.ProposalID)
-	if votes == nil {
-		votes = types.Votes{}
-	} else {
-		start, end := client.Paginate(len(votes), params.Page, params.Limit, 100)
-		if start < 0 || end < 0 {
-			votes = types.Votes{}
-		} else {
-			votes = votes[start:end]
-		}
+	votes, pageRes, err := keeper.GetVotesPaginated(ctx, params.ProposalID, params.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	bz, err := codec.MarshalJSONIndent(keeper.cdc, votes)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, types.NewQueryVotesResponse(votes, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -220,12 +212,12 @@ func queryProposals(ctx sdk.Context, _ []string, req abci.RequestQuery, keeper K
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	proposals := keeper.GetProposalsFiltered(ctx, params)
-	if proposals == nil {
-		proposals = types.Proposals{}
+	proposals, pageRes, err := keeper.GetProposalsFiltered(ctx, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	bz, err := codec.MarshalJSONIndent(keeper.cdc, proposals)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, types.NewQueryProposalsResponse(proposals, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
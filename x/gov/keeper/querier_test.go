@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"math/rand"
 	"strings"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	codecstd "github.com/cosmos/cosmos-sdk/codec/std"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -61,22 +63,22 @@ func getQueriedParams(t *testing.T, ctx sdk.Context, cdc codec.JSONMarshaler, qu
 
 func getQueriedProposals(
 	t *testing.T, ctx sdk.Context, cdc codec.JSONMarshaler, querier sdk.Querier,
-	depositor, voter sdk.AccAddress, status types.ProposalStatus, page, limit int,
+	depositor, voter sdk.AccAddress, status types.ProposalStatus, pagination *query.PageRequest,
 ) []types.Proposal {
 
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryProposals}, "/"),
-		Data: cdc.MustMarshalJSON(types.NewQueryProposalsParams(page, limit, status, voter, depositor)),
+		Data: cdc.MustMarshalJSON(types.NewQueryProposalsParams(pagination, status, voter, depositor)),
 	}
 
 	bz, err := querier(ctx, []string{types.QueryProposals}, query)
 	require.NoError(t, err)
 	require.NotNil(t, bz)
 
-	var proposals types.Proposals
-	require.NoError(t, cdc.UnmarshalJSON(bz, &proposals))
+	var res types.QueryProposalsResponse
+	require.NoError(t, cdc.UnmarshalJSON(bz, &res))
 
-	return proposals
+	return res.Proposals
 }
 
 func getQueriedDeposit(t *testing.T, ctx sdk.Context, cdc codec.JSONMarshaler, querier sdk.Querier, proposalID uint64, depositor sdk.AccAddress) types.Deposit {
@@ -98,17 +100,17 @@ func getQueriedDeposit(t *testing.T, ctx sdk.Context, cdc codec.JSONMarshaler, q
 func getQueriedDeposits(t *testing.T, ctx sdk.Context, cdc codec.JSONMarshaler, querier sdk.Querier, proposalID uint64) []types.Deposit {
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryDeposits}, "/"),
-		Data: cdc.MustMarshalJSON(types.NewQueryProposalParams(proposalID)),
+		Data: cdc.MustMarshalJSON(types.NewQueryProposalDepositsParams(proposalID, nil)),
 	}
 
 	bz, err := querier(ctx, []string{types.QueryDeposits}, query)
 	require.NoError(t, err)
 	require.NotNil(t, bz)
 
-	var deposits []types.Deposit
-	require.NoError(t, cdc.UnmarshalJSON(bz, &deposits))
+	var res types.QueryDepositsResponse
+	require.NoError(t, cdc.UnmarshalJSON(bz, &res))
 
-	return deposits
+	return res.Deposits
 }
 
 func getQueriedVote(t *testing.T, ctx sdk.Context, cdc codec.JSONMarshaler, querier sdk.Querier, proposalID uint64, voter sdk.AccAddress) types.Vote {
@@ -128,20 +130,20 @@ func getQueriedVote(t *testing.T, ctx sdk.Context, cdc codec.JSONMarshaler, quer
 }
 
 func getQueriedVotes(t *testing.T, ctx sdk.Context, cdc codec.JSONMarshaler, querier sdk.Querier,
-	proposalID uint64, page, limit int) []types.Vote {
+	proposalID uint64, pagination *query.PageRequest) ([]types.Vote, *query.PageResponse) {
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryVote}, "/"),
-		Data: cdc.MustMarshalJSON(types.NewQueryProposalVotesParams(proposalID, page, limit)),
+		Data: cdc.MustMarshalJSON(types.NewQueryProposalVotesParams(proposalID, pagination)),
 	}
 
 	bz, err := querier(ctx, []string{types.QueryVotes}, query)
 	require.NoError(t, err)
 	require.NotNil(t, bz)
 
-	var votes []types.Vote
-	require.NoError(t, cdc.UnmarshalJSON(bz, &votes))
+	var res types.QueryVotesResponse
+	require.NoError(t, cdc.UnmarshalJSON(bz, &res))
 
-	return votes
+	return res.Votes, res.Pagination
 }
 
 func TestQueries(t *testing.T) {
@@ -161,7 +163,7 @@ func TestQueries(t *testing.T) {
 	depositParams, _, _ := getQueriedParams(t, ctx, appCodec, querier)
 
 	// TestAddrs[0] proposes (and deposits) proposals #1 and #2
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	deposit1 := types.NewDeposit(proposal1.ProposalID, TestAddrs[0], oneCoins)
 	_, err = app.GovKeeper.AddDeposit(ctx, deposit1.ProposalID, deposit1.Depositor, deposit1.Amount)
@@ -169,7 +171,7 @@ func TestQueries(t *testing.T) {
 
 	proposal1.TotalDeposit = proposal1.TotalDeposit.Add(deposit1.Amount...)
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	deposit2 := types.NewDeposit(proposal2.ProposalID, TestAddrs[0], consCoins)
 	_, err = app.GovKeeper.AddDeposit(ctx, deposit2.ProposalID, deposit2.Depositor, deposit2.Amount)
@@ -178,7 +180,7 @@ func TestQueries(t *testing.T) {
 	proposal2.TotalDeposit = proposal2.TotalDeposit.Add(deposit2.Amount...)
 
 	// TestAddrs[1] proposes (and deposits) on proposal #3
-	proposal3, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal3, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	deposit3 := types.NewDeposit(proposal3.ProposalID, TestAddrs[1], oneCoins)
 	_, err = app.GovKeeper.AddDeposit(ctx, deposit3.ProposalID, deposit3.Depositor, deposit3.Amount)
@@ -229,33 +231,33 @@ func TestQueries(t *testing.T) {
 	require.Equal(t, deposit5, deposit)
 
 	// Only proposal #1 should be in types.Deposit Period
-	proposals := getQueriedProposals(t, ctx, appCodec, querier, nil, nil, types.StatusDepositPeriod, 1, 0)
+	proposals := getQueriedProposals(t, ctx, appCodec, querier, nil, nil, types.StatusDepositPeriod, nil)
 	require.Len(t, proposals, 1)
 	require.Equal(t, proposal1, proposals[0])
 
 	// Only proposals #2 and #3 should be in Voting Period
-	proposals = getQueriedProposals(t, ctx, appCodec, querier, nil, nil, types.StatusVotingPeriod, 1, 0)
+	proposals = getQueriedProposals(t, ctx, appCodec, querier, nil, nil, types.StatusVotingPeriod, nil)
 	require.Len(t, proposals, 2)
 	require.Equal(t, proposal2, proposals[0])
 	require.Equal(t, proposal3, proposals[1])
 
 	// Addrs[0] votes on proposals #2 & #3
-	vote1 := types.NewVote(proposal2.ProposalID, TestAddrs[0], types.OptionYes)
-	vote2 := types.NewVote(proposal3.ProposalID, TestAddrs[0], types.OptionYes)
+	vote1 := types.NewVote(proposal2.ProposalID, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	vote2 := types.NewVote(proposal3.ProposalID, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	app.GovKeeper.SetVote(ctx, vote1)
 	app.GovKeeper.SetVote(ctx, vote2)
 
 	// Addrs[1] votes on proposal #3
-	vote3 := types.NewVote(proposal3.ProposalID, TestAddrs[1], types.OptionYes)
+	vote3 := types.NewVote(proposal3.ProposalID, TestAddrs[1], types.NewNonSplitVoteOption(types.OptionYes))
 	app.GovKeeper.SetVote(ctx, vote3)
 
 	// Test query voted by TestAddrs[0]
-	proposals = getQueriedProposals(t, ctx, appCodec, querier, nil, TestAddrs[0], types.StatusNil, 1, 0)
+	proposals = getQueriedProposals(t, ctx, appCodec, querier, nil, TestAddrs[0], types.StatusNil, nil)
 	require.Equal(t, proposal2, proposals[0])
 	require.Equal(t, proposal3, proposals[1])
 
 	// Test query votes on types.Proposal 2
-	votes := getQueriedVotes(t, ctx, appCodec, querier, proposal2.ProposalID, 1, 0)
+	votes, _ := getQueriedVotes(t, ctx, appCodec, querier, proposal2.ProposalID, nil)
 	require.Len(t, votes, 1)
 	require.Equal(t, vote1, votes[0])
 
@@ -263,32 +265,32 @@ func TestQueries(t *testing.T) {
 	require.Equal(t, vote1, vote)
 
 	// Test query votes on types.Proposal 3
-	votes = getQueriedVotes(t, ctx, appCodec, querier, proposal3.ProposalID, 1, 0)
+	votes, _ = getQueriedVotes(t, ctx, appCodec, querier, proposal3.ProposalID, nil)
 	require.Len(t, votes, 2)
 	require.Equal(t, vote2, votes[0])
 	require.Equal(t, vote3, votes[1])
 
 	// Test query all proposals
-	proposals = getQueriedProposals(t, ctx, appCodec, querier, nil, nil, types.StatusNil, 1, 0)
+	proposals = getQueriedProposals(t, ctx, appCodec, querier, nil, nil, types.StatusNil, nil)
 	require.Equal(t, proposal1, proposals[0])
 	require.Equal(t, proposal2, proposals[1])
 	require.Equal(t, proposal3, proposals[2])
 
 	// Test query voted by TestAddrs[1]
-	proposals = getQueriedProposals(t, ctx, appCodec, querier, nil, TestAddrs[1], types.StatusNil, 1, 0)
+	proposals = getQueriedProposals(t, ctx, appCodec, querier, nil, TestAddrs[1], types.StatusNil, nil)
 	require.Equal(t, proposal3.ProposalID, proposals[0].ProposalID)
 
 	// Test query deposited by TestAddrs[0]
-	proposals = getQueriedProposals(t, ctx, appCodec, querier, TestAddrs[0], nil, types.StatusNil, 1, 0)
+	proposals = getQueriedProposals(t, ctx, appCodec, querier, TestAddrs[0], nil, types.StatusNil, nil)
 	require.Equal(t, proposal1.ProposalID, proposals[0].ProposalID)
 
 	// Test query deposited by addr2
-	proposals = getQueriedProposals(t, ctx, appCodec, querier, TestAddrs[1], nil, types.StatusNil, 1, 0)
+	proposals = getQueriedProposals(t, ctx, appCodec, querier, TestAddrs[1], nil, types.StatusNil, nil)
 	require.Equal(t, proposal2.ProposalID, proposals[0].ProposalID)
 	require.Equal(t, proposal3.ProposalID, proposals[1].ProposalID)
 
 	// Test query voted AND deposited by addr1
-	proposals = getQueriedProposals(t, ctx, appCodec, querier, TestAddrs[0], TestAddrs[0], types.StatusNil, 1, 0)
+	proposals = getQueriedProposals(t, ctx, appCodec, querier, TestAddrs[0], TestAddrs[0], types.StatusNil, nil)
 	require.Equal(t, proposal2.ProposalID, proposals[0].ProposalID)
 }
 
@@ -323,45 +325,107 @@ func TestPaginatedVotesQuery(t *testing.T) {
 	querier := keeper.NewQuerier(app.GovKeeper)
 
 	// keeper preserves consistent order for each query, but this is not the insertion order
-	all := getQueriedVotes(t, ctx, appCodec, querier, proposal.ProposalID, 1, 0)
+	all, _ := getQueriedVotes(t, ctx, appCodec, querier, proposal.ProposalID, nil)
 	require.Equal(t, len(all), len(votes))
 
 	type testCase struct {
 		description string
-		page        int
-		limit       int
+		offset      uint64
+		limit       uint64
 		votes       []types.Vote
 	}
 	for _, tc := range []testCase{
 		{
 			description: "SkipAll",
-			page:        2,
-			limit:       len(all),
+			offset:      uint64(len(all)),
+			limit:       uint64(len(all)),
 		},
 		{
 			description: "GetFirstChunk",
-			page:        1,
 			limit:       10,
 			votes:       all[:10],
 		},
 		{
 			description: "GetSecondsChunk",
-			page:        2,
+			offset:      10,
 			limit:       10,
 			votes:       all[10:],
 		},
-		{
-			description: "InvalidPage",
-			page:        -1,
-		},
 	} {
 		tc := tc
 		t.Run(tc.description, func(t *testing.T) {
-			votes := getQueriedVotes(t, ctx, appCodec, querier, proposal.ProposalID, tc.page, tc.limit)
+			votes, _ := getQueriedVotes(t, ctx, appCodec, querier, proposal.ProposalID, &query.PageRequest{Offset: tc.offset, Limit: tc.limit})
 			require.Equal(t, len(tc.votes), len(votes))
 			for i := range votes {
 				require.Equal(t, tc.votes[i], votes[i])
 			}
 		})
 	}
+
+	// iterate over all the votes with the key of the next page
+	var (
+		key     []byte
+		queried []types.Vote
+	)
+	for i := 0; i < 3; i++ {
+		votes, pageRes := getQueriedVotes(t, ctx, appCodec, querier, proposal.ProposalID, &query.PageRequest{Key: key, Limit: 8})
+		queried = append(queried, votes...)
+		if pageRes.NextKey == nil {
+			break
+		}
+		require.Len(t, votes, 8)
+		require.Equal(t, all[len(queried)].Voter.Bytes(), pageRes.NextKey)
+		key = pageRes.NextKey
+	}
+	require.Equal(t, all, queried)
+}
+
+func TestPaginatedProposalsQuery(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	appCodec := codecstd.NewAppCodec(app.Codec())
+	querier := keeper.NewQuerier(app.GovKeeper)
+
+	voter := sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+	for i := uint64(1); i <= 10; i++ {
+		proposal := types.NewProposal(TestProposal, nil, i, time.Now(), time.Now(), false)
+		proposal.Status = types.StatusVotingPeriod
+		app.GovKeeper.SetProposal(ctx, proposal)
+
+		// the voter votes on the odd proposals only
+		if i%2 == 1 {
+			app.GovKeeper.SetVote(ctx, types.NewVote(i, voter, types.NewNonSplitVoteOption(types.OptionYes)))
+		}
+	}
+
+	queryProposals := func(key []byte) types.QueryProposalsResponse {
+		pagination := &query.PageRequest{Key: key, Limit: 2, CountTotal: true}
+		bz, err := querier(ctx, []string{types.QueryProposals}, abci.RequestQuery{
+			Data: appCodec.MustMarshalJSON(types.NewQueryProposalsParams(pagination, types.StatusNil, voter, nil)),
+		})
+		require.NoError(t, err)
+
+		var res types.QueryProposalsResponse
+		require.NoError(t, appCodec.UnmarshalJSON(bz, &res))
+		return res
+	}
+
+	// the next key is the key of the next proposal matching the filters
+	res := queryProposals(nil)
+	require.Len(t, res.Proposals, 2)
+	require.Equal(t, uint64(1), res.Proposals[0].ProposalID)
+	require.Equal(t, uint64(3), res.Proposals[1].ProposalID)
+	require.Equal(t, types.GetProposalIDBytes(5), res.Pagination.NextKey)
+	require.Equal(t, uint64(5), res.Pagination.Total)
+
+	res = queryProposals(res.Pagination.NextKey)
+	require.Len(t, res.Proposals, 2)
+	require.Equal(t, uint64(5), res.Proposals[0].ProposalID)
+	require.Equal(t, uint64(7), res.Proposals[1].ProposalID)
+	require.Zero(t, res.Pagination.Total)
+
+	res = queryProposals(res.Pagination.NextKey)
+	require.Len(t, res.Proposals, 1)
+	require.Equal(t, uint64(9), res.Proposals[0].ProposalID)
+	require.Nil(t, res.Pagination.NextKey)
 }
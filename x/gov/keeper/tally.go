@@ -27,17 +27,19 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			types.OptionEmpty,
+			nil,
 		)
 
 		return false
 	})
 
 	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
+		options := vote.WeightedOptions()
+
 		// if validator, just record it in the map
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = options
 			currValidators[valAddrStr] = val
 		}
 
@@ -54,20 +56,21 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 				delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
 				votingPower := delegatorShare.MulInt(val.BondedTokens)
 
-				results[vote.Option] = results[vote.Option].Add(votingPower)
+				for _, option := range options {
+					results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
 			}
 
 			return false
 		})
 
-		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
 		return false
 	})
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == types.OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -75,7 +78,9 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
 		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
@@ -104,11 +109,13 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		return false, true, tallyResults
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
+	// If more than the threshold of non-abstaining voters vote Yes, proposal passes.
+	// Expedited proposals use a higher threshold.
+	threshold := tallyParams.GetThreshold(proposal.Expedited)
+	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
 
-	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+	// Otherwise, the proposal fails
 	return false, false, tallyResults
 }
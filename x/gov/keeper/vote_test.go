@@ -18,7 +18,7 @@ func TestVotes(t *testing.T) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, sdk.NewInt(30000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 
@@ -56,10 +56,22 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, proposalID, vote.ProposalID)
 	require.Equal(t, types.OptionNoWithVeto, vote.Option)
 
+	// Test weighted vote
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	require.Error(t, app.GovKeeper.AddWeightedVote(ctx, proposalID, addrs[2], options[:1]), "weights not summing to 1")
+	require.NoError(t, app.GovKeeper.AddWeightedVote(ctx, proposalID, addrs[2], options))
+	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[2])
+	require.True(t, found)
+	require.Equal(t, types.OptionEmpty, vote.Option)
+	require.Equal(t, options, vote.Options)
+
 	// Test vote iterator
 	// NOTE order of deposits is determined by the addresses
 	votes := app.GovKeeper.GetAllVotes(ctx)
-	require.Len(t, votes, 2)
+	require.Len(t, votes, 3)
 	require.Equal(t, votes, app.GovKeeper.GetVotes(ctx, proposalID))
 	require.Equal(t, addrs[0], votes[0].Voter)
 	require.Equal(t, proposalID, votes[0].ProposalID)
@@ -67,4 +79,6 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, addrs[1], votes[1].Voter)
 	require.Equal(t, proposalID, votes[1].ProposalID)
 	require.Equal(t, types.OptionNoWithVeto, votes[1].Option)
+	require.Equal(t, addrs[2], votes[2].Voter)
+	require.Equal(t, options, votes[2].Options)
 }
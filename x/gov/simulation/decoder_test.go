@@ -35,11 +35,11 @@ func TestDecodeStore(t *testing.T) {
 	endTime := time.Now().UTC()
 
 	content := types.ContentFromProposalType("test", "test", types.ProposalTypeText)
-	proposal := types.NewProposal(content, 1, endTime, endTime.Add(24*time.Hour))
+	proposal := types.NewProposal(content, nil, 1, endTime, endTime.Add(24*time.Hour), false)
 	proposalIDBz := make([]byte, 8)
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := types.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
-	vote := types.NewVote(1, delAddr1, types.OptionYes)
+	vote := types.NewVote(1, delAddr1, types.NewNonSplitVoteOption(types.OptionYes))
 
 	kvPairs := tmkv.Pairs{
 		tmkv.Pair{Key: types.ProposalKey(1), Value: cdc.MustMarshalBinaryBare(proposal)},
@@ -14,12 +14,13 @@ import (
 )
 
 const (
-	keyVotingParams  = "votingparams"
-	keyDepositParams = "depositparams"
-	keyTallyParams   = "tallyparams"
-	subkeyQuorum     = "quorum"
-	subkeyThreshold  = "threshold"
-	subkeyVeto       = "veto"
+	keyVotingParams          = "votingparams"
+	keyDepositParams         = "depositparams"
+	keyTallyParams           = "tallyparams"
+	subkeyQuorum             = "quorum"
+	subkeyThreshold          = "threshold"
+	subkeyVeto               = "veto"
+	subkeyExpeditedThreshold = "expedited_threshold"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -28,7 +29,9 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{
 		simulation.NewSimParamChange(types.ModuleName, keyVotingParams,
 			func(r *rand.Rand) string {
-				return fmt.Sprintf(`{"voting_period": "%d"}`, GenVotingParamsVotingPeriod(r))
+				votingPeriod := GenVotingParamsVotingPeriod(r)
+				return fmt.Sprintf(`{"voting_period": "%d", "expedited_voting_period": "%d"}`,
+					votingPeriod, GenVotingParamsExpeditedVotingPeriod(r, votingPeriod))
 			},
 		),
 		simulation.NewSimParamChange(types.ModuleName, keyDepositParams,
@@ -45,6 +48,7 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 					{subkeyQuorum, GenTallyParamsQuorum(r)},
 					{subkeyThreshold, GenTallyParamsThreshold(r)},
 					{subkeyVeto, GenTallyParamsVeto(r)},
+					{subkeyExpeditedThreshold, GenTallyParamsExpeditedThreshold(r)},
 				}
 
 				pc := make(map[string]string)
@@ -8,14 +8,15 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 
-	AttributeKeyProposalResult     = "proposal_result"
-	AttributeKeyOption             = "option"
-	AttributeKeyProposalID         = "proposal_id"
-	AttributeKeyVotingPeriodStart  = "voting_period_start"
-	AttributeValueCategory         = "governance"
-	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
-	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
-	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
-	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
-	AttributeKeyProposalType       = "proposal_type"
+	AttributeKeyProposalResult              = "proposal_result"
+	AttributeKeyOption                      = "option"
+	AttributeKeyProposalID                  = "proposal_id"
+	AttributeKeyVotingPeriodStart           = "voting_period_start"
+	AttributeValueCategory                  = "governance"
+	AttributeValueProposalDropped           = "proposal_dropped"            // didn't meet min deposit
+	AttributeValueProposalPassed            = "proposal_passed"             // met vote quorum
+	AttributeValueProposalRejected          = "proposal_rejected"           // didn't meet vote quorum
+	AttributeValueProposalFailed            = "proposal_failed"             // error on proposal handler
+	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // didn't meet expedited threshold, converted to a regular proposal
+	AttributeKeyProposalType                = "proposal_type"
 )
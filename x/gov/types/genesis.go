@@ -71,5 +71,23 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	expeditedThreshold := data.TallyParams.ExpeditedThreshold
+	if expeditedThreshold.IsNil() || expeditedThreshold.LTE(threshold) || expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance expedited vote threshold should be greater than the vote threshold and less or equal to one, is %s",
+			expeditedThreshold)
+	}
+
+	expeditedMinDeposit := data.DepositParams.ExpeditedMinDeposit
+	if !expeditedMinDeposit.IsValid() || !expeditedMinDeposit.IsAllGT(data.DepositParams.MinDeposit) {
+		return fmt.Errorf("governance expedited deposit amount must be a valid sdk.Coins amount greater than the deposit amount, is %s",
+			expeditedMinDeposit.String())
+	}
+
+	expeditedVotingPeriod := data.VotingParams.ExpeditedVotingPeriod
+	if expeditedVotingPeriod <= 0 || expeditedVotingPeriod >= data.VotingParams.VotingPeriod {
+		return fmt.Errorf("governance expedited voting period should be positive and shorter than the voting period, is %s",
+			expeditedVotingPeriod)
+	}
+
 	return nil
 }
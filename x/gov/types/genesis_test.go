@@ -20,3 +20,19 @@ func TestEqualProposalID(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestValidateGenesisExpeditedParams(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	state := DefaultGenesisState()
+	state.TallyParams.ExpeditedThreshold = state.TallyParams.Threshold
+	require.Error(t, ValidateGenesis(state))
+
+	state = DefaultGenesisState()
+	state.DepositParams.ExpeditedMinDeposit = state.DepositParams.MinDeposit
+	require.Error(t, ValidateGenesis(state))
+
+	state = DefaultGenesisState()
+	state.VotingParams.ExpeditedVotingPeriod = state.VotingParams.VotingPeriod
+	require.Error(t, ValidateGenesis(state))
+}
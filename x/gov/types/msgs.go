@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,10 +13,11 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposalBase{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _ sdk.Msg = MsgSubmitProposalBase{}, MsgDeposit{}, MsgVote{}, MsgVoteWeighted{}
 
 // MsgSubmitProposalI defines the specific interface a concrete message must
 // implement in order to process governance proposals. The concrete MsgSubmitProposal
@@ -25,6 +28,8 @@ type MsgSubmitProposalI interface {
 	GetContent() Content
 	GetInitialDeposit() sdk.Coins
 	GetProposer() sdk.AccAddress
+	GetExpedited() bool
+	GetMsgs() []sdk.Msg
 }
 
 // NewMsgSubmitProposalBase creates a new MsgSubmitProposalBase.
@@ -73,6 +78,11 @@ func (msg MsgSubmitProposalBase) String() string {
 	return string(out)
 }
 
+// GetExpedited returns whether the proposal is expedited
+func (msg MsgSubmitProposalBase) GetExpedited() bool {
+	return msg.Expedited
+}
+
 // NewMsgDeposit creates a new MsgDeposit instance
 func NewMsgDeposit(depositor sdk.AccAddress, proposalID uint64, amount sdk.Coins) MsgDeposit {
 	return MsgDeposit{proposalID, depositor, amount}
@@ -156,6 +166,44 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
 
+// NewMsgVoteWeighted creates a message to cast a vote split across several
+// options on an active proposal
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter.String())
+	}
+
+	return msg.Options.ValidateBasic()
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
 // ---------------------------------------------------------------------------
 // Deprecated
 //
@@ -170,13 +218,15 @@ type MsgSubmitProposal struct {
 	Content        Content        `json:"content" yaml:"content"`
 	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive
 	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               //  Address of the proposer
+	Expedited      bool           `json:"expedited,omitempty" yaml:"expedited"`   //  Whether the proposal is expedited
+	Msgs           []sdk.Msg      `json:"msgs,omitempty" yaml:"msgs"`             //  Msgs executed by the governance module account if the proposal passes
 }
 
 // NewMsgSubmitProposal returns a (deprecated) MsgSubmitProposal message.
 //
 // TODO: Remove once client-side Protobuf migration has been completed.
 func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) MsgSubmitProposal {
-	return MsgSubmitProposal{content, initialDeposit, proposer}
+	return MsgSubmitProposal{Content: content, InitialDeposit: initialDeposit, Proposer: proposer}
 }
 
 // ValidateBasic implements Msg
@@ -196,13 +246,37 @@ func (msg MsgSubmitProposal) ValidateBasic() error {
 	if !IsValidProposalType(msg.Content.ProposalType()) {
 		return sdkerrors.Wrap(ErrInvalidProposalType, msg.Content.ProposalType())
 	}
+	if err := ValidateProposalMsgs(msg.Msgs); err != nil {
+		return err
+	}
 
 	return msg.Content.ValidateBasic()
 }
 
-// GetSignBytes implements Msg
+// GetSignBytes implements Msg. The msgs of the proposal are signed with their
+// own sign bytes, so that they do not need to be registered on the module codec.
 func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	msgs := msg.Msgs
+	msg.Msgs = nil
+
 	bz := ModuleCdc.MustMarshalJSON(msg)
+	if len(msgs) == 0 {
+		return sdk.MustSortJSON(bz)
+	}
+
+	msgsBz := make([]json.RawMessage, len(msgs))
+	for i, m := range msgs {
+		msgsBz[i] = m.GetSignBytes()
+	}
+
+	bz, err := json.Marshal(struct {
+		Proposal json.RawMessage   `json:"proposal"`
+		Msgs     []json.RawMessage `json:"msgs"`
+	}{bz, msgsBz})
+	if err != nil {
+		panic(err)
+	}
+
 	return sdk.MustSortJSON(bz)
 }
 
@@ -213,3 +287,5 @@ func (msg MsgSubmitProposal) Type() string                 { return TypeMsgSubmi
 func (msg MsgSubmitProposal) GetContent() Content          { return msg.Content }
 func (msg MsgSubmitProposal) GetInitialDeposit() sdk.Coins { return msg.InitialDeposit }
 func (msg MsgSubmitProposal) GetProposer() sdk.AccAddress  { return msg.Proposer }
+func (msg MsgSubmitProposal) GetExpedited() bool           { return msg.Expedited }
+func (msg MsgSubmitProposal) GetMsgs() []sdk.Msg           { return msg.Msgs }
@@ -57,6 +57,19 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitProposalMsgs(t *testing.T) {
+	content := ContentFromProposalType("Test Proposal", "the purpose of this proposal is to test", ProposalTypeText)
+	msg := NewMsgSubmitProposal(content, coinsPos, addrs[0])
+	bz := msg.GetSignBytes()
+
+	msg.Msgs = []sdk.Msg{sdk.NewTestMsg(addrs[1])}
+	require.NoError(t, msg.ValidateBasic())
+	require.NotEqual(t, bz, msg.GetSignBytes())
+
+	msg.Msgs = []sdk.Msg{nil}
+	require.Error(t, msg.ValidateBasic())
+}
+
 func TestMsgDepositGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	msg := NewMsgDeposit(addr, 0, coinsPos)
@@ -115,3 +128,31 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgVoteWeighted(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNo, half)}, true},
+		{sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{addrs[0], WeightedVoteOptions{}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half)}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionYes, half)}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDec(2)), NewWeightedVoteOption(OptionNo, sdk.NewDec(-1))}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.OneDec()), NewWeightedVoteOption(OptionNo, sdk.ZeroDec())}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(VoteOption(0x13), sdk.OneDec())}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, 0, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
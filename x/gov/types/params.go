@@ -12,15 +12,18 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.TokensFromConsensusPower(10)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVeto             = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.TokensFromConsensusPower(10)
+	DefaultExpeditedMinDepositTokens = sdk.TokensFromConsensusPower(50)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
+	DefaultVeto                      = sdk.NewDecWithPrec(334, 3)
 )
 
 // Parameter store key
@@ -41,15 +44,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // DepositParams defines the params around deposits for governance
 type DepositParams struct {
-	MinDeposit       sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`               //  Minimum deposit for a proposal to enter voting period.
-	MaxDepositPeriod time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	MinDeposit          sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`                     //  Minimum deposit for a proposal to enter voting period.
+	MaxDepositPeriod    time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"`       //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	ExpeditedMinDeposit sdk.Coins     `json:"expedited_min_deposit,omitempty" yaml:"expedited_min_deposit,omitempty"` //  Minimum deposit for an expedited proposal to enter voting period.
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration, expeditedMinDeposit sdk.Coins) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    maxDepositPeriod,
+		ExpeditedMinDeposit: expeditedMinDeposit,
 	}
 }
 
@@ -58,9 +63,19 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultExpeditedMinDepositTokens)),
 	)
 }
 
+// GetMinDeposit returns the minimum deposit for a regular or an expedited
+// proposal to enter voting period.
+func (dp DepositParams) GetMinDeposit(expedited bool) sdk.Coins {
+	if expedited {
+		return dp.ExpeditedMinDeposit
+	}
+	return dp.MinDeposit
+}
+
 // String implements stringer insterface
 func (dp DepositParams) String() string {
 	out, _ := yaml.Marshal(dp)
@@ -69,7 +84,8 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ExpeditedMinDeposit.IsEqual(dp2.ExpeditedMinDeposit)
 }
 
 func validateDepositParams(i interface{}) error {
@@ -84,34 +100,52 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !v.ExpeditedMinDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
+	if !v.ExpeditedMinDeposit.IsAllGT(v.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit must be greater than the minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
 
 	return nil
 }
 
 // TallyParams defines the params around Tallying votes in governance
 type TallyParams struct {
-	Quorum    sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`       //  Minimum percentage of total stake needed to vote for a result to be considered valid
-	Threshold sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"` //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
-	Veto      sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`           //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	Quorum             sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`                           //  Minimum percentage of total stake needed to vote for a result to be considered valid
+	Threshold          sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"`                     //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
+	Veto               sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`                               //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	ExpeditedThreshold sdk.Dec `json:"expedited_threshold,omitempty" yaml:"expedited_threshold,omitempty"` //  Minimum proportion of Yes votes for an expedited proposal to pass. Initial value: 0.667
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, veto sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, veto, expeditedThreshold sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:    quorum,
-		Threshold: threshold,
-		Veto:      veto,
+		Quorum:             quorum,
+		Threshold:          threshold,
+		Veto:               veto,
+		ExpeditedThreshold: expeditedThreshold,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVeto)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVeto, DefaultExpeditedThreshold)
+}
+
+// GetThreshold returns the minimum proportion of Yes votes for a regular or an
+// expedited proposal to pass.
+func (tp TallyParams) GetThreshold(expedited bool) sdk.Dec {
+	if expedited {
+		return tp.ExpeditedThreshold
+	}
+	return tp.Threshold
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.Veto.Equal(other.Veto)
+	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.Veto.Equal(other.Veto) &&
+		tp.ExpeditedThreshold.Equal(other.ExpeditedThreshold)
 }
 
 // String implements stringer insterface
@@ -144,30 +178,50 @@ func validateTallyParams(i interface{}) error {
 	if v.Veto.GT(sdk.OneDec()) {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
+	if v.ExpeditedThreshold.IsNil() || v.ExpeditedThreshold.LTE(v.Threshold) {
+		return fmt.Errorf("expedited vote threshold must be greater than the vote threshold: %s", v.ExpeditedThreshold)
+	}
+	if v.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
 
 	return nil
 }
 
 // VotingParams defines the params around Voting in governance
 type VotingParams struct {
-	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period.
+	VotingPeriod          time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"`                     //  Length of the voting period.
+	ExpeditedVotingPeriod time.Duration `json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period,omitempty"` //  Length of the voting period of expedited proposals.
+	KeepVotes             bool          `json:"keep_votes,omitempty" yaml:"keep_votes,omitempty"`                           //  Whether the votes are kept in the store after the proposal is tallied.
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration, keepVotes bool) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:          votingPeriod,
+		ExpeditedVotingPeriod: expeditedVotingPeriod,
+		KeepVotes:             keepVotes,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod, false)
+}
+
+// GetVotingPeriod returns the length of the voting period of a regular or an
+// expedited proposal.
+func (vp VotingParams) GetVotingPeriod(expedited bool) time.Duration {
+	if expedited {
+		return vp.ExpeditedVotingPeriod
+	}
+	return vp.VotingPeriod
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod &&
+		vp.KeepVotes == other.KeepVotes
 }
 
 // String implements stringer interface
@@ -185,6 +239,12 @@ func validateVotingParams(i interface{}) error {
 	if v.VotingPeriod <= 0 {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
+	if v.ExpeditedVotingPeriod <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+	if v.ExpeditedVotingPeriod >= v.VotingPeriod {
+		return fmt.Errorf("expedited voting period must be shorter than the voting period: %s", v.ExpeditedVotingPeriod)
+	}
 
 	return nil
 }
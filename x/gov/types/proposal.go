@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -20,12 +21,14 @@ const DefaultStartingProposalID uint64 = 1
 type Proposal struct {
 	Content `json:"content" yaml:"content"` // Proposal content interface
 	ProposalBase
+	Msgs []sdk.Msg `json:"msgs,omitempty" yaml:"msgs"` // Msgs executed by the governance module account if the proposal passes
 }
 
 // NewProposal creates a new Proposal instance
-func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) Proposal {
+func NewProposal(content Content, msgs []sdk.Msg, id uint64, submitTime, depositEndTime time.Time, expedited bool) Proposal {
 	return Proposal{
 		Content: content,
+		Msgs:    msgs,
 		ProposalBase: ProposalBase{
 			ProposalID:       id,
 			Status:           StatusDepositPeriod,
@@ -33,13 +36,27 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 			TotalDeposit:     sdk.NewCoins(),
 			SubmitTime:       submitTime,
 			DepositEndTime:   depositEndTime,
+			Expedited:        expedited,
 		},
 	}
 }
 
 // Equal returns true if two Proposal types are equal.
 func (p Proposal) Equal(other Proposal) bool {
-	return p.ProposalBase.Equal(other.ProposalBase) && p.Content.String() == other.Content.String()
+	if !p.ProposalBase.Equal(other.ProposalBase) || p.Content.String() != other.Content.String() {
+		return false
+	}
+	if len(p.Msgs) != len(other.Msgs) {
+		return false
+	}
+
+	for i, msg := range p.Msgs {
+		if !bytes.Equal(msg.GetSignBytes(), other.Msgs[i].GetSignBytes()) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // String implements stringer interface
@@ -82,6 +99,25 @@ type (
 	ProposalQueue []uint64
 )
 
+// NewMsgResult returns the MsgResult of a msg executed with the given result.
+func NewMsgResult(res *sdk.Result) MsgResult {
+	return MsgResult{Data: res.Data, Log: res.Log}
+}
+
+// ValidateProposalMsgs performs a basic validation of the msgs of a proposal.
+func ValidateProposalMsgs(msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		if msg == nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "msg %d is empty", i)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid msg %d", i)
+		}
+	}
+
+	return nil
+}
+
 // ProposalStatusFromString turns a string into a ProposalStatus
 func ProposalStatusFromString(str string) (ProposalStatus, error) {
 	switch str {
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // DONTCOVER
@@ -24,7 +25,6 @@ const (
 
 // QueryProposalParams Params for queries:
 // - 'custom/gov/proposal'
-// - 'custom/gov/deposits'
 // - 'custom/gov/tally'
 type QueryProposalParams struct {
 	ProposalID uint64
@@ -40,16 +40,28 @@ func NewQueryProposalParams(proposalID uint64) QueryProposalParams {
 // QueryProposalVotesParams used for queries to 'custom/gov/votes'.
 type QueryProposalVotesParams struct {
 	ProposalID uint64
-	Page       int
-	Limit      int
+	Pagination *query.PageRequest
 }
 
 // NewQueryProposalVotesParams creates new instance of the QueryProposalVotesParams.
-func NewQueryProposalVotesParams(proposalID uint64, page, limit int) QueryProposalVotesParams {
+func NewQueryProposalVotesParams(proposalID uint64, pagination *query.PageRequest) QueryProposalVotesParams {
 	return QueryProposalVotesParams{
 		ProposalID: proposalID,
-		Page:       page,
-		Limit:      limit,
+		Pagination: pagination,
+	}
+}
+
+// QueryProposalDepositsParams used for queries to 'custom/gov/deposits'.
+type QueryProposalDepositsParams struct {
+	ProposalID uint64
+	Pagination *query.PageRequest
+}
+
+// NewQueryProposalDepositsParams creates new instance of the QueryProposalDepositsParams.
+func NewQueryProposalDepositsParams(proposalID uint64, pagination *query.PageRequest) QueryProposalDepositsParams {
+	return QueryProposalDepositsParams{
+		ProposalID: proposalID,
+		Pagination: pagination,
 	}
 }
 
@@ -83,20 +95,60 @@ func NewQueryVoteParams(proposalID uint64, voter sdk.AccAddress) QueryVoteParams
 
 // QueryProposalsParams Params for query 'custom/gov/proposals'
 type QueryProposalsParams struct {
-	Page           int
-	Limit          int
+	Pagination     *query.PageRequest
 	Voter          sdk.AccAddress
 	Depositor      sdk.AccAddress
 	ProposalStatus ProposalStatus
 }
 
 // NewQueryProposalsParams creates a new instance of QueryProposalsParams
-func NewQueryProposalsParams(page, limit int, status ProposalStatus, voter, depositor sdk.AccAddress) QueryProposalsParams {
+func NewQueryProposalsParams(pagination *query.PageRequest, status ProposalStatus, voter, depositor sdk.AccAddress) QueryProposalsParams {
 	return QueryProposalsParams{
-		Page:           page,
-		Limit:          limit,
+		Pagination:     pagination,
 		Voter:          voter,
 		Depositor:      depositor,
 		ProposalStatus: status,
 	}
 }
+
+// QueryProposalsResponse is the response of the 'custom/gov/proposals' query.
+type QueryProposalsResponse struct {
+	Proposals  Proposals           `json:"proposals" yaml:"proposals"`
+	Pagination *query.PageResponse `json:"pagination" yaml:"pagination"`
+}
+
+// NewQueryProposalsResponse creates a new instance of QueryProposalsResponse
+func NewQueryProposalsResponse(proposals Proposals, pagination *query.PageResponse) QueryProposalsResponse {
+	return QueryProposalsResponse{
+		Proposals:  proposals,
+		Pagination: pagination,
+	}
+}
+
+// QueryVotesResponse is the response of the 'custom/gov/votes' query.
+type QueryVotesResponse struct {
+	Votes      Votes               `json:"votes" yaml:"votes"`
+	Pagination *query.PageResponse `json:"pagination" yaml:"pagination"`
+}
+
+// NewQueryVotesResponse creates a new instance of QueryVotesResponse
+func NewQueryVotesResponse(votes Votes, pagination *query.PageResponse) QueryVotesResponse {
+	return QueryVotesResponse{
+		Votes:      votes,
+		Pagination: pagination,
+	}
+}
+
+// QueryDepositsResponse is the response of the 'custom/gov/deposits' query.
+type QueryDepositsResponse struct {
+	Deposits   Deposits            `json:"deposits" yaml:"deposits"`
+	Pagination *query.PageResponse `json:"pagination" yaml:"pagination"`
+}
+
+// NewQueryDepositsResponse creates a new instance of QueryDepositsResponse
+func NewQueryDepositsResponse(deposits Deposits, pagination *query.PageResponse) QueryDepositsResponse {
+	return QueryDepositsResponse{
+		Deposits:   deposits,
+		Pagination: pagination,
+	}
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+)
+
+// RegisterTextualRenderers registers the SIGN_MODE_TEXTUAL renderers of the
+// governance module, which shows the vote options by name.
+func RegisterTextualRenderers(registry *textual.Registry) {
+	registry.Register(OptionEmpty, func(_ context.Context, v interface{}) (string, error) {
+		option := v.(VoteOption)
+		if !ValidVoteOption(option) {
+			return "", sdkerrors.Wrap(ErrInvalidVote, fmt.Sprintf("%d", option))
+		}
+
+		return option.String(), nil
+	})
+}
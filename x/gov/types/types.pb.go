@@ -117,6 +117,9 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 type MsgSubmitProposalBase struct {
 	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,1,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit" yaml:"initial_deposit"`
 	Proposer       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	// expedited defines whether the proposal uses the expedited voting period,
+	// threshold and minimum deposit
+	Expedited bool `protobuf:"varint,3,opt,name=expedited,proto3" json:"expedited,omitempty"`
 }
 
 func (m *MsgSubmitProposalBase) Reset()      { *m = MsgSubmitProposalBase{} }
@@ -190,6 +193,46 @@ func (m *MsgVote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVote proto.InternalMessageInfo
 
+// MsgVoteWeighted defines a message to cast a vote which splits the voting
+// power of the voter across several vote options
+type MsgVoteWeighted struct {
+	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
+	Options    WeightedVoteOptions                           `protobuf:"bytes,3,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
+}
+
+func (m *MsgVoteWeighted) Reset()      { *m = MsgVoteWeighted{} }
+func (*MsgVoteWeighted) ProtoMessage() {}
+func (*MsgVoteWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{2}
+}
+func (m *MsgVoteWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeighted.Merge(m, src)
+}
+func (m *MsgVoteWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeighted proto.InternalMessageInfo
+
 // MsgDeposit defines a message to submit a deposit to an existing proposal
 type MsgDeposit struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
@@ -200,7 +243,7 @@ type MsgDeposit struct {
 func (m *MsgDeposit) Reset()      { *m = MsgDeposit{} }
 func (*MsgDeposit) ProtoMessage() {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{2}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{3}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,7 +282,7 @@ type TextProposal struct {
 func (m *TextProposal) Reset()      { *m = TextProposal{} }
 func (*TextProposal) ProtoMessage() {}
 func (*TextProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{3}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{4}
 }
 func (m *TextProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,7 +321,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{4}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{5}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,13 +362,20 @@ type ProposalBase struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,7,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,8,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// expedited is set for proposals using the expedited voting period, threshold
+	// and minimum deposit. It is cleared when an expedited proposal fails and is
+	// converted to a regular one.
+	Expedited bool `protobuf:"varint,9,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// msg_results are the results of the msgs of a proposal, in the same order as
+	// the msgs, set once the proposal has passed and its msgs were executed.
+	MsgResults []MsgResult `protobuf:"bytes,10,rep,name=msg_results,json=msgResults,proto3" json:"msg_results" yaml:"msg_results"`
 }
 
 func (m *ProposalBase) Reset()         { *m = ProposalBase{} }
 func (m *ProposalBase) String() string { return proto.CompactTextString(m) }
 func (*ProposalBase) ProtoMessage()    {}
 func (*ProposalBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{5}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{6}
 }
 func (m *ProposalBase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,6 +404,47 @@ func (m *ProposalBase) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProposalBase proto.InternalMessageInfo
 
+// MsgResult defines the result of the execution of a msg of a passed proposal.
+type MsgResult struct {
+	// data is the data returned by the handler of the msg.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// log is the log returned by the handler of the msg.
+	Log string `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (m *MsgResult) Reset()         { *m = MsgResult{} }
+func (m *MsgResult) String() string { return proto.CompactTextString(m) }
+func (*MsgResult) ProtoMessage()    {}
+func (*MsgResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{7}
+}
+func (m *MsgResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResult.Merge(m, src)
+}
+func (m *MsgResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResult proto.InternalMessageInfo
+
 // TallyResult defines a standard tally for a proposal
 type TallyResult struct {
 	Yes        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=yes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"yes"`
@@ -365,7 +456,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{6}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{8}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,18 +485,59 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
+// WeightedVoteOption defines a vote option along with the fraction of the
+// voting power it receives
+type WeightedVoteOption struct {
+	Option VoteOption                             `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos_sdk.x.gov.v1.VoteOption" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{9}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // Vote defines a vote on a governance proposal. A vote corresponds to a proposal
-// ID, the voter, and the vote option.
+// ID, the voter, and the weighted vote options. The option of a vote which is not
+// split is also set for backwards compatibility.
 type Vote struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
 	Option     VoteOption                                    `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos_sdk.x.gov.v1.VoteOption" json:"option,omitempty"`
+	Options    WeightedVoteOptions                           `protobuf:"bytes,4,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{7}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{10}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,95 +571,109 @@ func init() {
 	proto.RegisterEnum("cosmos_sdk.x.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*MsgSubmitProposalBase)(nil), "cosmos_sdk.x.gov.v1.MsgSubmitProposalBase")
 	proto.RegisterType((*MsgVote)(nil), "cosmos_sdk.x.gov.v1.MsgVote")
+	proto.RegisterType((*MsgVoteWeighted)(nil), "cosmos_sdk.x.gov.v1.MsgVoteWeighted")
 	proto.RegisterType((*MsgDeposit)(nil), "cosmos_sdk.x.gov.v1.MsgDeposit")
 	proto.RegisterType((*TextProposal)(nil), "cosmos_sdk.x.gov.v1.TextProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos_sdk.x.gov.v1.Deposit")
 	proto.RegisterType((*ProposalBase)(nil), "cosmos_sdk.x.gov.v1.ProposalBase")
+	proto.RegisterType((*MsgResult)(nil), "cosmos_sdk.x.gov.v1.MsgResult")
 	proto.RegisterType((*TallyResult)(nil), "cosmos_sdk.x.gov.v1.TallyResult")
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos_sdk.x.gov.v1.WeightedVoteOption")
 	proto.RegisterType((*Vote)(nil), "cosmos_sdk.x.gov.v1.Vote")
 }
 
 func init() { proto.RegisterFile("x/gov/types/types.proto", fileDescriptor_a5ae5e91b5b3fb03) }
 
 var fileDescriptor_a5ae5e91b5b3fb03 = []byte{
-	// 1230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x8f, 0xd3, 0x46,
-	0x14, 0x8e, 0xb3, 0xbf, 0xd8, 0x49, 0x36, 0x6b, 0x66, 0x29, 0x9b, 0xba, 0xaa, 0x6d, 0x02, 0x42,
-	0x2b, 0x04, 0x5e, 0x58, 0x0e, 0x55, 0xa9, 0x54, 0x35, 0x26, 0x06, 0x8c, 0xd8, 0x38, 0xb2, 0xcd,
-	0x22, 0x5a, 0x55, 0x96, 0x77, 0x6d, 0xbc, 0x2e, 0x8e, 0x27, 0xcd, 0xcc, 0xa6, 0xec, 0xad, 0xea,
-	0xa1, 0x42, 0x39, 0x71, 0xe4, 0x12, 0x09, 0xa9, 0x1c, 0x50, 0x4f, 0xfd, 0x33, 0xf6, 0x56, 0x0e,
-	0xad, 0x84, 0x7a, 0x08, 0x65, 0x39, 0xb4, 0xea, 0xa1, 0x07, 0x2e, 0x95, 0x7a, 0xaa, 0xe2, 0x19,
-	0xb3, 0x4e, 0x76, 0xe9, 0xb2, 0xa2, 0x95, 0xaa, 0x5e, 0x92, 0xf8, 0xf9, 0xfb, 0xbe, 0x37, 0xef,
-	0xcd, 0x9b, 0x6f, 0x14, 0x30, 0x7f, 0x67, 0x31, 0x40, 0x9d, 0x45, 0xb2, 0xd9, 0xf2, 0x31, 0xfd,
-	0x54, 0x5a, 0x6d, 0x44, 0x10, 0x9c, 0x5b, 0x43, 0xb8, 0x89, 0xb0, 0x83, 0xbd, 0xdb, 0xca, 0x1d,
-	0x25, 0x40, 0x1d, 0xa5, 0x73, 0x4e, 0x38, 0xbc, 0x0b, 0x27, 0x9c, 0x24, 0xeb, 0x61, 0xdb, 0x73,
-	0x5a, 0x6e, 0x9b, 0x6c, 0x2e, 0x26, 0xa1, 0xc5, 0x00, 0x05, 0x68, 0xe7, 0x17, 0xc3, 0x49, 0x01,
-	0x42, 0x41, 0xe4, 0x53, 0xc8, 0xea, 0xc6, 0xad, 0x45, 0x12, 0x36, 0x7d, 0x4c, 0xdc, 0x66, 0x8b,
-	0x02, 0x2a, 0x7f, 0x70, 0xe0, 0xad, 0x65, 0x1c, 0x58, 0x1b, 0xab, 0xcd, 0x90, 0x34, 0xda, 0xa8,
-	0x85, 0xb0, 0x1b, 0xa9, 0x2e, 0xf6, 0xe1, 0x5d, 0x0e, 0xcc, 0x86, 0x71, 0x48, 0x42, 0x37, 0x72,
-	0x3c, 0xbf, 0x85, 0x70, 0x48, 0xca, 0x9c, 0x3c, 0xb6, 0x50, 0x58, 0x9a, 0x53, 0x32, 0xab, 0xec,
-	0x9c, 0x53, 0x2e, 0xa2, 0x30, 0x56, 0xaf, 0x6e, 0xf5, 0xa5, 0xdc, 0x8b, 0xbe, 0x74, 0x74, 0xd3,
-	0x6d, 0x46, 0x17, 0x2a, 0x23, 0xcc, 0xca, 0xb7, 0x4f, 0xa5, 0x85, 0x20, 0x24, 0xeb, 0x1b, 0xab,
-	0xca, 0x1a, 0x6a, 0x2e, 0x52, 0x01, 0xf6, 0x75, 0x06, 0x7b, 0xb7, 0x59, 0x75, 0x03, 0x29, 0x6c,
-	0x96, 0x18, 0xbb, 0x46, 0xc9, 0x70, 0x19, 0x1c, 0x6a, 0x25, 0x4b, 0xf3, 0xdb, 0xe5, 0xbc, 0xcc,
-	0x2d, 0x14, 0xd5, 0x73, 0x7f, 0xf6, 0xa5, 0x33, 0xaf, 0xa1, 0x57, 0x5d, 0x5b, 0xab, 0x7a, 0x5e,
-	0xdb, 0xc7, 0xd8, 0x7c, 0x29, 0x71, 0x61, 0xfc, 0xd7, 0x07, 0x12, 0x57, 0xf9, 0x85, 0x03, 0x53,
-	0xcb, 0x38, 0x58, 0x41, 0xc4, 0x87, 0x36, 0x28, 0xb4, 0x58, 0xed, 0x4e, 0xe8, 0x95, 0x39, 0x99,
-	0x5b, 0x18, 0x57, 0xcf, 0x6f, 0xf7, 0x25, 0x90, 0xb6, 0x44, 0xaf, 0xfd, 0xd6, 0x97, 0xb2, 0xa0,
-	0x17, 0x7d, 0x09, 0xd2, 0x52, 0x33, 0xc1, 0x8a, 0x09, 0xd2, 0x27, 0xdd, 0x83, 0x97, 0xc1, 0x44,
-	0x07, 0x91, 0x37, 0x59, 0x33, 0xe5, 0xc3, 0xf7, 0xc0, 0x24, 0x6a, 0x91, 0x10, 0xc5, 0xe5, 0x31,
-	0x99, 0x5b, 0x28, 0x2d, 0x49, 0xca, 0x1e, 0x63, 0xa2, 0x0c, 0x2a, 0x31, 0x12, 0x98, 0xc9, 0xe0,
-	0xac, 0xd2, 0xfb, 0x79, 0x00, 0x96, 0x71, 0x90, 0x76, 0xf3, 0xdf, 0x29, 0xd6, 0x00, 0xd3, 0x6c,
-	0xaf, 0xd1, 0x1b, 0x14, 0xbc, 0xa3, 0x01, 0x3f, 0x05, 0x93, 0x6e, 0x13, 0x6d, 0xc4, 0xa4, 0x3c,
-	0xf6, 0xea, 0xa9, 0x3b, 0x3b, 0x98, 0xba, 0x03, 0xcd, 0x16, 0x13, 0x65, 0xad, 0xb9, 0x06, 0x8a,
-	0xb6, 0x7f, 0xe7, 0xe5, 0xe0, 0xc3, 0x23, 0x60, 0x82, 0x84, 0x24, 0xf2, 0x93, 0xae, 0x4c, 0x9b,
-	0xf4, 0x01, 0xca, 0xa0, 0xe0, 0xf9, 0x78, 0xad, 0x1d, 0xd2, 0x4d, 0xc8, 0x27, 0xef, 0xb2, 0x21,
-	0xa6, 0xf6, 0x75, 0x1e, 0x4c, 0xa5, 0x5d, 0xd6, 0xf6, 0xea, 0xf2, 0x89, 0xe1, 0x2e, 0xff, 0x6f,
-	0xdb, 0xfa, 0xfd, 0x24, 0x28, 0x0e, 0x99, 0x89, 0xba, 0x57, 0x37, 0x8e, 0xed, 0x9a, 0xb9, 0x7c,
-	0x32, 0x6a, 0xd3, 0xcc, 0x42, 0x46, 0x5a, 0x71, 0x03, 0x4c, 0x62, 0xe2, 0x92, 0x0d, 0x9c, 0xf4,
-	0xa1, 0xb4, 0x74, 0x7c, 0xcf, 0x53, 0x90, 0xea, 0x59, 0x09, 0x54, 0x15, 0x76, 0x2c, 0xe9, 0xe5,
-	0x02, 0xa8, 0x4a, 0xc5, 0x64, 0x72, 0xf0, 0x73, 0x00, 0x6f, 0x85, 0xb1, 0x1b, 0x39, 0xc4, 0x8d,
-	0xa2, 0x4d, 0xa7, 0xed, 0xe3, 0x8d, 0x88, 0x24, 0x47, 0xad, 0xb0, 0x24, 0xef, 0x99, 0xc4, 0x1e,
-	0x00, 0xcd, 0x04, 0xa7, 0x1e, 0x63, 0xc6, 0xf7, 0x36, 0xcd, 0xb2, 0x5b, 0xa9, 0x62, 0xf2, 0x49,
-	0x30, 0x43, 0x82, 0x9f, 0x80, 0x02, 0x4e, 0x2c, 0xd7, 0x19, 0x18, 0x72, 0x79, 0x3c, 0xc9, 0x25,
-	0x28, 0xd4, 0xad, 0x95, 0xd4, 0xad, 0x15, 0x3b, 0x75, 0x6b, 0x55, 0x64, 0x59, 0xd8, 0xbc, 0x64,
-	0xc8, 0x95, 0x7b, 0x4f, 0x25, 0xce, 0x04, 0x34, 0x32, 0x20, 0xc0, 0x10, 0xf0, 0x6c, 0xbf, 0x1d,
-	0x3f, 0xf6, 0x68, 0x86, 0x89, 0x7d, 0x33, 0x1c, 0x67, 0x19, 0xe6, 0x69, 0x86, 0x51, 0x05, 0x9a,
-	0xa6, 0xc4, 0xc2, 0x5a, 0xec, 0x25, 0xa9, 0xbe, 0xe2, 0xc0, 0x0c, 0x41, 0x24, 0x73, 0x45, 0x4c,
-	0xbe, 0x7a, 0xaa, 0xae, 0xb0, 0x0c, 0x47, 0x68, 0x86, 0x21, 0xde, 0xc1, 0x2e, 0x88, 0x62, 0xc2,
-	0x4d, 0x8f, 0x5a, 0x04, 0x0e, 0x77, 0x10, 0x09, 0xe3, 0x60, 0xb0, 0xb3, 0x6d, 0xd6, 0xd2, 0xa9,
-	0x7d, 0x0b, 0x3e, 0xc1, 0x96, 0x53, 0xa6, 0xcb, 0xd9, 0x25, 0x41, 0x2b, 0x9e, 0xa5, 0x71, 0x6b,
-	0x10, 0x4e, 0x4a, 0xbe, 0x05, 0x58, 0x68, 0xa7, 0xb9, 0x87, 0xf6, 0xcd, 0x55, 0x19, 0xbe, 0x1d,
-	0x47, 0x04, 0x68, 0xa6, 0x19, 0x1a, 0x65, 0xad, 0xbd, 0x50, 0xbc, 0xff, 0x40, 0xe2, 0x1e, 0x3d,
-	0x90, 0xb8, 0xe4, 0x44, 0x6d, 0xe5, 0x41, 0x21, 0x3b, 0x40, 0x1f, 0x81, 0xb1, 0x4d, 0x1f, 0x53,
-	0x9b, 0x52, 0x95, 0x81, 0xfa, 0x4f, 0x7d, 0xe9, 0xe4, 0x6b, 0x34, 0x50, 0x8f, 0x89, 0x39, 0xa0,
-	0xc2, 0x2b, 0x60, 0xca, 0x5d, 0xc5, 0xc4, 0x0d, 0x99, 0xa1, 0x1d, 0x58, 0x25, 0xa5, 0xc3, 0x0f,
-	0x41, 0x3e, 0x46, 0xc9, 0x79, 0x39, 0xb8, 0x48, 0x3e, 0x46, 0x30, 0x00, 0xc5, 0x18, 0x39, 0x5f,
-	0x84, 0x64, 0xdd, 0xe9, 0xf8, 0x04, 0x25, 0xa7, 0x61, 0x5a, 0xd5, 0x0e, 0xa6, 0xf4, 0xa2, 0x2f,
-	0xcd, 0xd1, 0xe6, 0x66, 0xb5, 0x2a, 0x26, 0x88, 0xd1, 0x8d, 0x90, 0xac, 0xaf, 0xf8, 0x04, 0x31,
-	0x73, 0xfa, 0x91, 0x03, 0xe3, 0xc9, 0xad, 0xff, 0x0f, 0x59, 0xf4, 0x7f, 0xe4, 0x9a, 0x3f, 0xf5,
-	0x3b, 0x07, 0xc0, 0xce, 0x4b, 0x78, 0x1a, 0xcc, 0xaf, 0x18, 0xb6, 0xe6, 0x18, 0x0d, 0x5b, 0x37,
-	0xea, 0xce, 0xf5, 0xba, 0xd5, 0xd0, 0x2e, 0xea, 0x97, 0x74, 0xad, 0xc6, 0xe7, 0x84, 0xd9, 0x6e,
-	0x4f, 0x2e, 0x50, 0xa0, 0xd6, 0x6c, 0x91, 0x4d, 0x58, 0x01, 0xb3, 0x59, 0xf4, 0x4d, 0xcd, 0xe2,
-	0x39, 0x61, 0xa6, 0xdb, 0x93, 0xa7, 0x29, 0xea, 0xa6, 0x8f, 0xe1, 0x29, 0x30, 0x97, 0xc5, 0x54,
-	0x55, 0xcb, 0xae, 0xea, 0x75, 0x3e, 0x2f, 0x1c, 0xee, 0xf6, 0xe4, 0x19, 0x8a, 0xab, 0xb2, 0x99,
-	0x90, 0x41, 0x29, 0x8b, 0xad, 0x1b, 0xfc, 0x98, 0x50, 0xec, 0xf6, 0xe4, 0x43, 0x14, 0x56, 0x47,
-	0x70, 0x09, 0x94, 0x87, 0x11, 0xce, 0x0d, 0xdd, 0xbe, 0xe2, 0xac, 0x68, 0xb6, 0xc1, 0x8f, 0x0b,
-	0x47, 0xba, 0x3d, 0x99, 0x4f, 0xb1, 0xe9, 0x06, 0x0a, 0xc5, 0xbb, 0xdf, 0x88, 0xb9, 0x47, 0x0f,
-	0xc5, 0xdc, 0x77, 0x0f, 0xc5, 0xdc, 0xa9, 0x1f, 0xf2, 0xa0, 0x34, 0x6c, 0xf7, 0x50, 0x01, 0xef,
-	0x34, 0x4c, 0xa3, 0x61, 0x58, 0xd5, 0x6b, 0x8e, 0x65, 0x57, 0xed, 0xeb, 0xd6, 0x48, 0xe1, 0x49,
-	0x49, 0x14, 0x5c, 0x0f, 0x23, 0xf8, 0x01, 0x10, 0x47, 0xf1, 0x35, 0xad, 0x61, 0x58, 0xba, 0xed,
-	0x34, 0x34, 0x53, 0x37, 0x6a, 0x3c, 0x27, 0xcc, 0x77, 0x7b, 0xf2, 0x1c, 0xa5, 0x30, 0xc7, 0x69,
-	0xf8, 0xed, 0x10, 0x79, 0xf0, 0x7d, 0xf0, 0xee, 0x28, 0x79, 0xc5, 0xb0, 0xf5, 0xfa, 0xe5, 0x94,
-	0x9b, 0x17, 0x8e, 0x76, 0x7b, 0x32, 0xa4, 0xdc, 0x95, 0xe4, 0x74, 0x33, 0xea, 0x69, 0x70, 0x74,
-	0x94, 0xda, 0xa8, 0x5a, 0x96, 0x56, 0xe3, 0xc7, 0x04, 0xbe, 0xdb, 0x93, 0x8b, 0x94, 0xd3, 0x70,
-	0x31, 0xf6, 0x3d, 0x78, 0x16, 0x94, 0x47, 0xd1, 0xa6, 0x76, 0x55, 0xbb, 0x68, 0x6b, 0x35, 0x7e,
-	0x5c, 0x80, 0xdd, 0x9e, 0x5c, 0xa2, 0x78, 0xd3, 0xff, 0xcc, 0x5f, 0x23, 0xfe, 0x9e, 0xfa, 0x97,
-	0xaa, 0xfa, 0x35, 0xad, 0xc6, 0x4f, 0x64, 0xf5, 0x2f, 0xb9, 0x61, 0xe4, 0x7b, 0xc3, 0x6d, 0x55,
-	0xeb, 0x5b, 0xcf, 0xc4, 0xdc, 0x93, 0x67, 0x62, 0xee, 0xcb, 0x6d, 0x31, 0xb7, 0xb5, 0x2d, 0x72,
-	0x8f, 0xb7, 0x45, 0xee, 0xe7, 0x6d, 0x91, 0xbb, 0xf7, 0x5c, 0xcc, 0x3d, 0x7e, 0x2e, 0xe6, 0x9e,
-	0x3c, 0x17, 0x73, 0x1f, 0xff, 0xbd, 0x59, 0x67, 0xfe, 0xdf, 0xac, 0x4e, 0x26, 0x7e, 0x78, 0xfe,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x53, 0x05, 0xd1, 0xf5, 0x0c, 0x00, 0x00,
+	// 1401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6f, 0x13, 0xd7,
+	0x16, 0xf6, 0xd8, 0xce, 0x0f, 0x9f, 0x38, 0x89, 0xb9, 0xe1, 0x11, 0xbf, 0xe1, 0xbd, 0x99, 0xc1,
+	0x20, 0x5e, 0x84, 0xc0, 0x81, 0xb0, 0x78, 0x6a, 0x90, 0xaa, 0xda, 0x78, 0x02, 0x46, 0xc4, 0xb6,
+	0xc6, 0x26, 0x11, 0xad, 0xda, 0xd1, 0x24, 0x73, 0x99, 0x4c, 0xb1, 0x7d, 0x5d, 0xdf, 0x9b, 0x90,
+	0xec, 0xaa, 0x2e, 0x2a, 0xe4, 0x15, 0x9b, 0x56, 0x48, 0x95, 0x25, 0xa4, 0xb2, 0x40, 0xac, 0xba,
+	0xe9, 0xff, 0x10, 0x75, 0xc5, 0xa2, 0x0b, 0xd4, 0x85, 0x29, 0x61, 0xd1, 0xaa, 0x8b, 0x2e, 0x58,
+	0x76, 0x55, 0x79, 0xee, 0x9d, 0x64, 0xec, 0x98, 0x42, 0xa0, 0xa8, 0x55, 0x37, 0xc9, 0xf8, 0xf8,
+	0xfb, 0xbe, 0x73, 0xcf, 0x37, 0xe7, 0xde, 0x73, 0x0d, 0xd3, 0x9b, 0xb3, 0x0e, 0xd9, 0x98, 0x65,
+	0x5b, 0x0d, 0x4c, 0xf9, 0xdf, 0x74, 0xa3, 0x49, 0x18, 0x41, 0x53, 0xab, 0x84, 0xd6, 0x08, 0x35,
+	0xa9, 0x7d, 0x33, 0xbd, 0x99, 0x76, 0xc8, 0x46, 0x7a, 0xe3, 0x9c, 0x7c, 0x68, 0x1f, 0x4e, 0x3e,
+	0xc9, 0xd6, 0xdc, 0xa6, 0x6d, 0x36, 0xac, 0x26, 0xdb, 0x9a, 0xf5, 0x42, 0xb3, 0x0e, 0x71, 0xc8,
+	0xde, 0x93, 0xc0, 0xa9, 0x0e, 0x21, 0x4e, 0x15, 0x73, 0xc8, 0xca, 0xfa, 0x8d, 0x59, 0xe6, 0xd6,
+	0x30, 0x65, 0x56, 0xad, 0xc1, 0x01, 0xa9, 0x2f, 0xc2, 0xf0, 0xaf, 0x45, 0xea, 0x94, 0xd7, 0x57,
+	0x6a, 0x2e, 0x2b, 0x35, 0x49, 0x83, 0x50, 0xab, 0x9a, 0xb5, 0x28, 0x46, 0xb7, 0x25, 0x98, 0x74,
+	0xeb, 0x2e, 0x73, 0xad, 0xaa, 0x69, 0xe3, 0x06, 0xa1, 0x2e, 0x4b, 0x4a, 0x5a, 0x64, 0x66, 0x6c,
+	0x6e, 0x2a, 0x1d, 0x58, 0xe5, 0xc6, 0xb9, 0xf4, 0x45, 0xe2, 0xd6, 0xb3, 0x57, 0xb6, 0x3b, 0x6a,
+	0xe8, 0x79, 0x47, 0x3d, 0xb2, 0x65, 0xd5, 0xaa, 0xf3, 0xa9, 0x3e, 0x66, 0xea, 0xe1, 0x13, 0x75,
+	0xc6, 0x71, 0xd9, 0xda, 0xfa, 0x4a, 0x7a, 0x95, 0xd4, 0x66, 0xb9, 0x80, 0xf8, 0x77, 0x86, 0xda,
+	0x37, 0x45, 0x75, 0x5d, 0x29, 0x6a, 0x4c, 0x08, 0x76, 0x8e, 0x93, 0xd1, 0x22, 0x8c, 0x36, 0xbc,
+	0xa5, 0xe1, 0x66, 0x32, 0xac, 0x49, 0x33, 0xf1, 0xec, 0xb9, 0xdf, 0x3a, 0xea, 0x99, 0x57, 0xd0,
+	0xcb, 0xac, 0xae, 0x66, 0x6c, 0xbb, 0x89, 0x29, 0x35, 0x76, 0x25, 0xd0, 0x7f, 0x20, 0x86, 0x37,
+	0x1b, 0xd8, 0x76, 0x19, 0xb6, 0x93, 0x11, 0x4d, 0x9a, 0x19, 0x35, 0xf6, 0x02, 0xf3, 0xd1, 0x9f,
+	0xef, 0xa9, 0x52, 0xea, 0x27, 0x09, 0x46, 0x16, 0xa9, 0xb3, 0x44, 0x18, 0x46, 0x15, 0x18, 0x6b,
+	0x08, 0x67, 0x4c, 0xd7, 0x4e, 0x4a, 0x9a, 0x34, 0x13, 0xcd, 0x9e, 0xdf, 0xe9, 0xa8, 0xe0, 0x1b,
+	0x96, 0xcf, 0xfd, 0xd2, 0x51, 0x83, 0xa0, 0xe7, 0x1d, 0x15, 0x71, 0x23, 0x02, 0xc1, 0x94, 0x01,
+	0xfe, 0xa7, 0xbc, 0x8d, 0x2e, 0xc1, 0xd0, 0x06, 0x61, 0x6f, 0x52, 0x11, 0xe7, 0xa3, 0xff, 0xc3,
+	0x30, 0x69, 0x30, 0x97, 0xd4, 0xbd, 0x5a, 0x26, 0xe6, 0xd4, 0xf4, 0x80, 0x26, 0x4a, 0x77, 0x2b,
+	0x29, 0x7a, 0x30, 0x43, 0xc0, 0x45, 0xa5, 0x5f, 0x86, 0x61, 0x52, 0x54, 0xba, 0x8c, 0x5d, 0x67,
+	0x8d, 0x61, 0xfb, 0xef, 0x5e, 0xf1, 0x47, 0x30, 0xc2, 0x4b, 0xa0, 0xc9, 0x88, 0xd7, 0x91, 0xff,
+	0x1b, 0x58, 0xb2, 0x5f, 0xce, 0x5e, 0xe9, 0xd9, 0xa3, 0xdd, 0x2e, 0x7d, 0xf8, 0x44, 0x9d, 0xda,
+	0xff, 0x1d, 0x35, 0x7c, 0x51, 0x61, 0xcc, 0xdd, 0x30, 0xc0, 0x22, 0x75, 0xfc, 0x26, 0x7c, 0x3b,
+	0x9e, 0x14, 0x21, 0x26, 0xb6, 0x08, 0x79, 0x03, 0x5f, 0xf6, 0x34, 0xd0, 0x87, 0x30, 0x6c, 0xd5,
+	0xc8, 0x7a, 0x9d, 0x25, 0x23, 0x2f, 0xde, 0xac, 0x67, 0x85, 0x0d, 0xaf, 0xbe, 0x25, 0x85, 0xa8,
+	0xb0, 0xe6, 0x2a, 0xc4, 0x2b, 0x78, 0x73, 0xf7, 0xbc, 0x40, 0x87, 0x61, 0x88, 0xb9, 0xac, 0x8a,
+	0x3d, 0x57, 0x62, 0x06, 0xff, 0x80, 0x34, 0x18, 0xb3, 0x31, 0x5d, 0x6d, 0xba, 0xbc, 0x3b, 0xc3,
+	0xde, 0x77, 0xc1, 0x90, 0x50, 0xfb, 0x3c, 0x0c, 0x23, 0xbe, 0xcb, 0xfa, 0x20, 0x97, 0x4f, 0xf4,
+	0xba, 0xfc, 0x8f, 0xb5, 0xf5, 0xbb, 0x11, 0x88, 0xf7, 0x9c, 0xc1, 0xd9, 0x41, 0x6e, 0x1c, 0xdb,
+	0xd7, 0x73, 0x61, 0xaf, 0xd5, 0x62, 0xe2, 0xe4, 0xed, 0xb3, 0x62, 0x19, 0x86, 0x29, 0xb3, 0xd8,
+	0x3a, 0xf5, 0x7c, 0x98, 0x98, 0x3b, 0x3e, 0x70, 0xaf, 0xf8, 0x7a, 0x65, 0x0f, 0x9a, 0x95, 0xf7,
+	0x4e, 0xf2, 0xdd, 0x05, 0x70, 0x95, 0x94, 0x21, 0xe4, 0xd0, 0x27, 0x80, 0x6e, 0xb8, 0x75, 0xab,
+	0x6a, 0x32, 0xab, 0x5a, 0xdd, 0x32, 0x9b, 0x98, 0xae, 0x57, 0x99, 0x77, 0x06, 0x8d, 0xcd, 0x69,
+	0x03, 0x93, 0x54, 0xba, 0x40, 0xc3, 0xc3, 0x65, 0x8f, 0x89, 0x79, 0xf1, 0x6f, 0x9e, 0x65, 0xbf,
+	0x52, 0xca, 0x48, 0x78, 0xc1, 0x00, 0x09, 0x7d, 0x00, 0x63, 0xd4, 0x9b, 0x54, 0x66, 0x77, 0x8e,
+	0x25, 0xa3, 0x5e, 0x2e, 0x39, 0xcd, 0x87, 0x5c, 0xda, 0x1f, 0x72, 0xe9, 0x8a, 0x3f, 0xe4, 0xb2,
+	0x8a, 0xc8, 0x22, 0xfa, 0x25, 0x40, 0x4e, 0xdd, 0x79, 0xa2, 0x4a, 0x06, 0xf0, 0x48, 0x97, 0x80,
+	0x5c, 0x48, 0x88, 0xf7, 0x6d, 0xe2, 0xba, 0xcd, 0x33, 0x0c, 0xbd, 0x34, 0xc3, 0x71, 0x91, 0x61,
+	0x9a, 0x67, 0xe8, 0x57, 0xe0, 0x69, 0x26, 0x44, 0x58, 0xaf, 0xdb, 0x5e, 0xaa, 0xcf, 0x24, 0x18,
+	0x67, 0x84, 0x05, 0x26, 0xeb, 0xf0, 0x8b, 0xbb, 0xea, 0xb2, 0xc8, 0x70, 0x98, 0x67, 0xe8, 0xe1,
+	0x1d, 0x6c, 0xae, 0xc6, 0x3d, 0xae, 0xbf, 0xd5, 0xaa, 0x70, 0x68, 0x83, 0x30, 0xb7, 0xee, 0x74,
+	0xdf, 0x6c, 0x53, 0x58, 0x3a, 0xf2, 0xd2, 0x82, 0x4f, 0x88, 0xe5, 0x24, 0xf9, 0x72, 0xf6, 0x49,
+	0xf0, 0x8a, 0x27, 0x79, 0xbc, 0xdc, 0x0d, 0x7b, 0x25, 0xdf, 0x00, 0x11, 0xda, 0x33, 0x77, 0xf4,
+	0xa5, 0xb9, 0x52, 0xbd, 0x97, 0x8a, 0x3e, 0x01, 0x9e, 0x69, 0x9c, 0x47, 0x7d, 0x6b, 0x7b, 0x86,
+	0x7b, 0xac, 0x6f, 0xb8, 0x77, 0x1b, 0xa8, 0x46, 0x1d, 0xd1, 0x61, 0x34, 0x09, 0x9e, 0xeb, 0xca,
+	0xc0, 0x66, 0x5d, 0xa4, 0x8e, 0x68, 0x55, 0xb9, 0xb7, 0x89, 0x02, 0x02, 0x29, 0x03, 0x6a, 0x3e,
+	0x8c, 0xce, 0xc7, 0xef, 0xde, 0x53, 0xa5, 0x07, 0xf7, 0x54, 0xc9, 0xdb, 0xcc, 0x17, 0x20, 0xb6,
+	0x2b, 0x81, 0x10, 0x44, 0x6d, 0x8b, 0x59, 0xde, 0x0e, 0x8e, 0x1b, 0xde, 0x33, 0x4a, 0x40, 0xa4,
+	0x4a, 0x1c, 0x71, 0x2c, 0x76, 0x1f, 0xe7, 0x47, 0xef, 0xfa, 0xe4, 0xed, 0x30, 0x8c, 0x05, 0x1b,
+	0xff, 0x3d, 0x88, 0x6c, 0x61, 0xca, 0x8f, 0xd7, 0x6c, 0xba, 0xbb, 0x9e, 0x1f, 0x3a, 0xea, 0xc9,
+	0x57, 0x78, 0xf1, 0xf9, 0x3a, 0x33, 0xba, 0x54, 0x74, 0x19, 0x46, 0xac, 0x15, 0xca, 0x2c, 0x57,
+	0x1c, 0xc4, 0x07, 0x56, 0xf1, 0xe9, 0xe8, 0x5d, 0x08, 0xd7, 0x49, 0x32, 0xf2, 0x5a, 0x22, 0xe1,
+	0x3a, 0x41, 0x0e, 0xc4, 0xeb, 0xc4, 0xbc, 0xe5, 0xb2, 0x35, 0x73, 0x03, 0x33, 0xe2, 0xed, 0xe2,
+	0x58, 0x56, 0x3f, 0x98, 0xd2, 0xf3, 0x8e, 0x3a, 0xc5, 0x5f, 0x47, 0x50, 0x2b, 0x65, 0x40, 0x9d,
+	0x2c, 0xbb, 0x6c, 0x6d, 0x09, 0x33, 0x22, 0x0e, 0xd5, 0xaf, 0x24, 0x40, 0xfb, 0xa7, 0x7d, 0xe0,
+	0xd6, 0x24, 0x1d, 0xe8, 0xd6, 0x84, 0x16, 0x60, 0xf8, 0x96, 0x27, 0xf7, 0x1a, 0x3e, 0xe6, 0xf0,
+	0xaa, 0x21, 0xd8, 0x62, 0x75, 0xdf, 0x86, 0x21, 0xea, 0x5d, 0x32, 0xff, 0xa4, 0xc1, 0xf7, 0x97,
+	0xdf, 0x2a, 0x83, 0x97, 0xb3, 0xe8, 0x5b, 0xbb, 0x9c, 0x9d, 0xfa, 0x55, 0x02, 0x08, 0xbc, 0xcd,
+	0xd3, 0x30, 0xbd, 0x54, 0xac, 0xe8, 0x66, 0xb1, 0x54, 0xc9, 0x17, 0x0b, 0xe6, 0xb5, 0x42, 0xb9,
+	0xa4, 0x5f, 0xcc, 0x2f, 0xe4, 0xf5, 0x5c, 0x22, 0x24, 0x4f, 0xb6, 0xda, 0xda, 0x18, 0x07, 0xea,
+	0xb5, 0x06, 0xdb, 0x42, 0x29, 0x98, 0x0c, 0xa2, 0xaf, 0xeb, 0xe5, 0x84, 0x24, 0x8f, 0xb7, 0xda,
+	0x5a, 0x8c, 0xa3, 0xae, 0x63, 0x8a, 0x4e, 0xc1, 0x54, 0x10, 0x93, 0xc9, 0x96, 0x2b, 0x99, 0x7c,
+	0x21, 0x11, 0x96, 0x0f, 0xb5, 0xda, 0xda, 0x38, 0xc7, 0x65, 0xc4, 0x8e, 0xd0, 0x60, 0x22, 0x88,
+	0x2d, 0x14, 0x13, 0x11, 0x39, 0xde, 0x6a, 0x6b, 0xa3, 0x1c, 0x56, 0x20, 0x68, 0x0e, 0x92, 0xbd,
+	0x08, 0x73, 0x39, 0x5f, 0xb9, 0x6c, 0x2e, 0xe9, 0x95, 0x62, 0x22, 0x2a, 0x1f, 0x6e, 0xb5, 0xb5,
+	0x84, 0x8f, 0xf5, 0xdb, 0x57, 0x8e, 0xdf, 0xfe, 0x5a, 0x09, 0x3d, 0xb8, 0xaf, 0x84, 0xbe, 0xb9,
+	0xaf, 0x84, 0x4e, 0x7d, 0x1f, 0x86, 0x89, 0xde, 0x21, 0x8d, 0xd2, 0x70, 0xb4, 0x64, 0x14, 0x4b,
+	0xc5, 0x72, 0xe6, 0xaa, 0x59, 0xae, 0x64, 0x2a, 0xd7, 0xca, 0x7d, 0x85, 0x7b, 0x25, 0x71, 0x70,
+	0xc1, 0xad, 0xa2, 0x0b, 0xa0, 0xf4, 0xe3, 0x73, 0x7a, 0xa9, 0x58, 0xce, 0x57, 0xcc, 0x92, 0x6e,
+	0xe4, 0x8b, 0xb9, 0x84, 0x24, 0x4f, 0xb7, 0xda, 0xda, 0x14, 0xa7, 0x88, 0x39, 0x51, 0xc2, 0x4d,
+	0x97, 0xd8, 0xe8, 0x1d, 0xf8, 0x6f, 0x3f, 0x79, 0xa9, 0x58, 0xc9, 0x17, 0x2e, 0xf9, 0xdc, 0xb0,
+	0x7c, 0xa4, 0xd5, 0xd6, 0x10, 0xe7, 0x2e, 0x79, 0x67, 0xb2, 0xa0, 0x9e, 0x86, 0x23, 0xfd, 0xd4,
+	0x52, 0xa6, 0x5c, 0xd6, 0x73, 0x89, 0x88, 0x9c, 0x68, 0xb5, 0xb5, 0x38, 0xe7, 0x94, 0x2c, 0x4a,
+	0xb1, 0x8d, 0xce, 0x42, 0xb2, 0x1f, 0x6d, 0xe8, 0x57, 0xf4, 0x8b, 0x15, 0x3d, 0x97, 0x88, 0xca,
+	0xa8, 0xd5, 0xd6, 0x26, 0x38, 0xde, 0xc0, 0x1f, 0xe3, 0x55, 0x86, 0x07, 0xea, 0x2f, 0x64, 0xf2,
+	0x57, 0xf5, 0x5c, 0x62, 0x28, 0xa8, 0xbf, 0x60, 0xb9, 0x55, 0x6c, 0xf7, 0xda, 0x9a, 0x2d, 0x6c,
+	0x3f, 0x55, 0x42, 0x8f, 0x9f, 0x2a, 0xa1, 0x4f, 0x77, 0x94, 0xd0, 0xf6, 0x8e, 0x22, 0x3d, 0xda,
+	0x51, 0xa4, 0x1f, 0x77, 0x14, 0xe9, 0xce, 0x33, 0x25, 0xf4, 0xe8, 0x99, 0x12, 0x7a, 0xfc, 0x4c,
+	0x09, 0xbd, 0xff, 0xc7, 0x23, 0x36, 0xf0, 0x63, 0x7e, 0x65, 0xd8, 0x9b, 0x62, 0xe7, 0x7f, 0x1f,
+	0x00, 0x67, 0x2f, 0x1c, 0x70, 0xe2, 0x0f, 0x00, 0x00,
 }
 
 func (this *MsgSubmitProposalBase) Equal(that interface{}) bool {
@@ -560,6 +706,9 @@ func (this *MsgSubmitProposalBase) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Proposer, that1.Proposer) {
 		return false
 	}
+	if this.Expedited != that1.Expedited {
+		return false
+	}
 	return true
 }
 func (this *MsgVote) Equal(that interface{}) bool {
@@ -592,6 +741,41 @@ func (this *MsgVote) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgVoteWeighted) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgVoteWeighted)
+	if !ok {
+		that2, ok := that.(MsgVoteWeighted)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalID != that1.ProposalID {
+		return false
+	}
+	if !bytes.Equal(this.Voter, that1.Voter) {
+		return false
+	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *MsgDeposit) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -737,6 +921,44 @@ func (this *ProposalBase) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if this.Expedited != that1.Expedited {
+		return false
+	}
+	if len(this.MsgResults) != len(that1.MsgResults) {
+		return false
+	}
+	for i := range this.MsgResults {
+		if !this.MsgResults[i].Equal(&that1.MsgResults[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgResult)
+	if !ok {
+		that2, ok := that.(MsgResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Log != that1.Log {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -772,6 +994,33 @@ func (this *TallyResult) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *WeightedVoteOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedVoteOption)
+	if !ok {
+		that2, ok := that.(WeightedVoteOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Option != that1.Option {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
 func (this *Vote) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -800,6 +1049,14 @@ func (this *Vote) Equal(that interface{}) bool {
 	if this.Option != that1.Option {
 		return false
 	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -813,6 +1070,8 @@ type ProposalBaseFace interface {
 	GetTotalDeposit() github_com_cosmos_cosmos_sdk_types.Coins
 	GetVotingStartTime() time.Time
 	GetVotingEndTime() time.Time
+	GetExpedited() bool
+	GetMsgResults() []MsgResult
 }
 
 func (this *ProposalBase) Proto() github_com_gogo_protobuf_proto.Message {
@@ -855,6 +1114,14 @@ func (this *ProposalBase) GetVotingEndTime() time.Time {
 	return this.VotingEndTime
 }
 
+func (this *ProposalBase) GetExpedited() bool {
+	return this.Expedited
+}
+
+func (this *ProposalBase) GetMsgResults() []MsgResult {
+	return this.MsgResults
+}
+
 func NewProposalBaseFromFace(that ProposalBaseFace) *ProposalBase {
 	this := &ProposalBase{}
 	this.ProposalID = that.GetProposalID()
@@ -865,6 +1132,8 @@ func NewProposalBaseFromFace(that ProposalBaseFace) *ProposalBase {
 	this.TotalDeposit = that.GetTotalDeposit()
 	this.VotingStartTime = that.GetVotingStartTime()
 	this.VotingEndTime = that.GetVotingEndTime()
+	this.Expedited = that.GetExpedited()
+	this.MsgResults = that.GetMsgResults()
 	return this
 }
 
@@ -888,6 +1157,16 @@ func (m *MsgSubmitProposalBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Proposer) > 0 {
 		i -= len(m.Proposer)
 		copy(dAtA[i:], m.Proposer)
@@ -952,6 +1231,55 @@ func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVoteWeighted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1107,6 +1435,30 @@ func (m *ProposalBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err1 != nil {
 		return 0, err1
@@ -1176,7 +1528,7 @@ func (m *ProposalBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+func (m *MsgResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1186,33 +1538,70 @@ func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.NoWithVeto.Size()
-		i -= size
-		if _, err := m.NoWithVeto.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.No.Size()
-		i -= size
-		if _, err := m.No.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NoWithVeto.Size()
+		i -= size
+		if _, err := m.NoWithVeto.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.No.Size()
+		i -= size
+		if _, err := m.No.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
@@ -1239,6 +1628,44 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1259,6 +1686,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Option))
 		i--
@@ -1306,6 +1747,9 @@ func (m *MsgSubmitProposalBase) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1328,6 +1772,28 @@ func (m *MsgVote) Size() (n int) {
 	return n
 }
 
+func (m *MsgVoteWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalID))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgDeposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1417,6 +1883,32 @@ func (m *ProposalBase) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.Expedited {
+		n += 2
+	}
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1437,6 +1929,20 @@ func (m *TallyResult) Size() (n int) {
 	return n
 }
 
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovTypes(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1453,6 +1959,12 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovTypes(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1559,6 +2071,26 @@ func (m *MsgSubmitProposalBase) Unmarshal(dAtA []byte) error {
 				m.Proposer = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1708,7 +2240,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1731,10 +2263,10 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1758,7 +2290,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1785,14 +2317,14 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1819,8 +2351,8 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1848,7 +2380,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TextProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1871,17 +2403,36 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TextProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TextProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1891,29 +2442,31 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1923,23 +2476,25 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1965,7 +2520,7 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1988,17 +2543,17 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: TextProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TextProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.ProposalID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2008,14 +2563,131 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2371,6 +3043,179 @@ func (m *ProposalBase) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, MsgResult{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2584,6 +3429,112 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2685,6 +3636,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3,15 +3,32 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// NewVote creates a new Vote instance
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+// NewVote creates a new Vote instance. The Option of the vote is only set when
+// the vote is not split across several options.
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	option := OptionEmpty
+	if len(options) == 1 {
+		option = options[0].Option
+	}
+
+	return Vote{ProposalID: proposalID, Voter: voter, Option: option, Options: options}
+}
+
+// WeightedOptions returns the weighted options of the vote. Votes stored before
+// weighted voting only have an Option, which receives the whole voting power.
+func (v Vote) WeightedOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 && v.Option != OptionEmpty {
+		return NewNonSplitVoteOption(v.Option)
+	}
+	return v.Options
 }
 
 func (v Vote) String() string {
@@ -43,7 +60,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.WeightedOptions())
 	}
 	return out
 }
@@ -53,6 +70,65 @@ func (v Vote) Empty() bool {
 	return v.Equal(Vote{})
 }
 
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+// NewNonSplitVoteOption returns the weighted options of a vote which gives all
+// the voting power to a single option.
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+// String implements the Stringer interface.
+func (o WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", o.Option, o.Weight)
+}
+
+// WeightedVoteOptions is the collection of weighted options of a vote
+type WeightedVoteOptions []WeightedVoteOption
+
+// String implements the Stringer interface.
+func (opts WeightedVoteOptions) String() string {
+	out := make([]string, len(opts))
+	for i, o := range opts {
+		out[i] = o.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// ValidateBasic checks that the options are valid and distinct, and that their
+// weights are positive and sum up to one.
+func (opts WeightedVoteOptions) ValidateBasic() error {
+	if len(opts) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "no vote option")
+	}
+
+	seen := make(map[VoteOption]bool)
+	total := sdk.ZeroDec()
+	for _, o := range opts {
+		if !ValidVoteOption(o.Option) {
+			return sdkerrors.Wrap(ErrInvalidVote, o.Option.String())
+		}
+		if seen[o.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicate vote option %s", o.Option)
+		}
+		seen[o.Option] = true
+
+		if o.Weight.IsNil() || !o.Weight.IsPositive() || o.Weight.GT(sdk.OneDec()) {
+			return sdkerrors.Wrapf(ErrInvalidVote, "invalid weight %s for option %s", o.Weight, o.Option)
+		}
+		total = total.Add(o.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight %s is not 1", total)
+	}
+
+	return nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
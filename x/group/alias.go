@@ -0,0 +1,146 @@
+package group
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// nolint
+
+const (
+	ModuleName                             = types.ModuleName
+	StoreKey                               = types.StoreKey
+	RouterKey                              = types.RouterKey
+	QuerierRoute                           = types.QuerierRoute
+	MaxMetadataLength                      = types.MaxMetadataLength
+	QueryGroup                             = types.QueryGroup
+	QueryGroupMembers                      = types.QueryGroupMembers
+	QueryGroupsByAdmin                     = types.QueryGroupsByAdmin
+	QueryGroupPolicy                       = types.QueryGroupPolicy
+	QueryGroupPoliciesByGroup              = types.QueryGroupPoliciesByGroup
+	QueryProposal                          = types.QueryProposal
+	QueryProposalsByGroupPolicy            = types.QueryProposalsByGroupPolicy
+	QueryVote                              = types.QueryVote
+	QueryVotesByProposal                   = types.QueryVotesByProposal
+	TypeMsgCreateGroup                     = types.TypeMsgCreateGroup
+	TypeMsgUpdateGroupMembers              = types.TypeMsgUpdateGroupMembers
+	TypeMsgUpdateGroupAdmin                = types.TypeMsgUpdateGroupAdmin
+	TypeMsgCreateGroupPolicy               = types.TypeMsgCreateGroupPolicy
+	TypeMsgUpdateGroupPolicyDecisionPolicy = types.TypeMsgUpdateGroupPolicyDecisionPolicy
+	TypeMsgSubmitProposal                  = types.TypeMsgSubmitProposal
+	TypeMsgVote                            = types.TypeMsgVote
+	TypeMsgExec                            = types.TypeMsgExec
+	EventTypeCreateGroup                   = types.EventTypeCreateGroup
+	EventTypeUpdateGroup                   = types.EventTypeUpdateGroup
+	EventTypeCreateGroupPolicy             = types.EventTypeCreateGroupPolicy
+	EventTypeUpdateGroupPolicy             = types.EventTypeUpdateGroupPolicy
+	EventTypeSubmitProposal                = types.EventTypeSubmitProposal
+	EventTypeVote                          = types.EventTypeVote
+	EventTypeExec                          = types.EventTypeExec
+	AttributeValueCategory                 = types.AttributeValueCategory
+	AttributeKeyGroupID                    = types.AttributeKeyGroupID
+	AttributeKeyAddress                    = types.AttributeKeyAddress
+	AttributeKeyProposalID                 = types.AttributeKeyProposalID
+	AttributeKeyVoter                      = types.AttributeKeyVoter
+	AttributeKeyOption                     = types.AttributeKeyOption
+	AttributeKeyExecutorResult             = types.AttributeKeyExecutorResult
+	OptionYes                              = types.OptionYes
+	OptionAbstain                          = types.OptionAbstain
+	OptionNo                               = types.OptionNo
+	OptionNoWithVeto                       = types.OptionNoWithVeto
+	StatusSubmitted                        = types.StatusSubmitted
+	StatusClosed                           = types.StatusClosed
+	StatusAborted                          = types.StatusAborted
+	ResultUnfinalized                      = types.ResultUnfinalized
+	ResultAccepted                         = types.ResultAccepted
+	ResultRejected                         = types.ResultRejected
+	ExecutorNotRun                         = types.ExecutorNotRun
+	ExecutorSuccess                        = types.ExecutorSuccess
+	ExecutorFailure                        = types.ExecutorFailure
+)
+
+var (
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+
+	RegisterCodec                         = types.RegisterCodec
+	ModuleCdc                             = types.ModuleCdc
+	NewGroupInfo                          = types.NewGroupInfo
+	NewMember                             = types.NewMember
+	NewGroupMember                        = types.NewGroupMember
+	NewGroupPolicyInfo                    = types.NewGroupPolicyInfo
+	GroupPolicyAddress                    = types.GroupPolicyAddress
+	NewThresholdDecisionPolicy            = types.NewThresholdDecisionPolicy
+	NewPercentageDecisionPolicy           = types.NewPercentageDecisionPolicy
+	NewVote                               = types.NewVote
+	NewTallyResult                        = types.NewTallyResult
+	EmptyTallyResult                      = types.EmptyTallyResult
+	VoteOptionFromString                  = types.VoteOptionFromString
+	ValidVoteOption                       = types.ValidVoteOption
+	NewMsgCreateGroup                     = types.NewMsgCreateGroup
+	NewMsgUpdateGroupMembers              = types.NewMsgUpdateGroupMembers
+	NewMsgUpdateGroupAdmin                = types.NewMsgUpdateGroupAdmin
+	NewMsgCreateGroupPolicy               = types.NewMsgCreateGroupPolicy
+	NewMsgUpdateGroupPolicyDecisionPolicy = types.NewMsgUpdateGroupPolicyDecisionPolicy
+	NewMsgSubmitProposal                  = types.NewMsgSubmitProposal
+	NewMsgVote                            = types.NewMsgVote
+	NewMsgExec                            = types.NewMsgExec
+	NewGenesisState                       = types.NewGenesisState
+	DefaultGenesisState                   = types.DefaultGenesisState
+	NewQueryGroupParams                   = types.NewQueryGroupParams
+	NewQueryGroupsByAdminParams           = types.NewQueryGroupsByAdminParams
+	NewQueryGroupPolicyParams             = types.NewQueryGroupPolicyParams
+	NewQueryProposalParams                = types.NewQueryProposalParams
+	NewQueryVoteParams                    = types.NewQueryVoteParams
+	GroupKey                              = types.GroupKey
+	GroupMemberKey                        = types.GroupMemberKey
+	GroupPolicyKey                        = types.GroupPolicyKey
+	ProposalKey                           = types.ProposalKey
+	VoteKey                               = types.VoteKey
+	ErrGroupNotFound                      = types.ErrGroupNotFound
+	ErrGroupPolicyNotFound                = types.ErrGroupPolicyNotFound
+	ErrProposalNotFound                   = types.ErrProposalNotFound
+	ErrInvalidMember                      = types.ErrInvalidMember
+	ErrInvalidDecisionPolicy              = types.ErrInvalidDecisionPolicy
+	ErrInvalidVoteOption                  = types.ErrInvalidVoteOption
+	ErrNotMember                          = types.ErrNotMember
+	ErrAlreadyVoted                       = types.ErrAlreadyVoted
+	ErrVotingPeriodEnded                  = types.ErrVotingPeriodEnded
+	ErrModified                           = types.ErrModified
+	ErrInvalidProposalStatus              = types.ErrInvalidProposalStatus
+	ErrProposalNotFinalized               = types.ErrProposalNotFinalized
+	ErrVoteNotFound                       = types.ErrVoteNotFound
+)
+
+type (
+	Keeper = keeper.Keeper
+
+	GroupInfo                          = types.GroupInfo
+	Member                             = types.Member
+	Members                            = types.Members
+	GroupMember                        = types.GroupMember
+	GroupPolicyInfo                    = types.GroupPolicyInfo
+	ThresholdDecisionPolicy            = types.ThresholdDecisionPolicy
+	PercentageDecisionPolicy           = types.PercentageDecisionPolicy
+	Proposal                           = types.Proposal
+	Vote                               = types.Vote
+	TallyResult                        = types.TallyResult
+	VoteOption                         = types.VoteOption
+	ProposalStatus                     = types.ProposalStatus
+	ProposalResult                     = types.ProposalResult
+	ExecutorResult                     = types.ExecutorResult
+	MsgCreateGroup                     = types.MsgCreateGroup
+	MsgUpdateGroupMembers              = types.MsgUpdateGroupMembers
+	MsgUpdateGroupAdmin                = types.MsgUpdateGroupAdmin
+	MsgCreateGroupPolicy               = types.MsgCreateGroupPolicy
+	MsgUpdateGroupPolicyDecisionPolicy = types.MsgUpdateGroupPolicyDecisionPolicy
+	MsgSubmitProposal                  = types.MsgSubmitProposal
+	MsgVote                            = types.MsgVote
+	MsgExec                            = types.MsgExec
+	GenesisState                       = types.GenesisState
+	QueryGroupParams                   = types.QueryGroupParams
+	QueryGroupsByAdminParams           = types.QueryGroupsByAdminParams
+	QueryGroupPolicyParams             = types.QueryGroupPolicyParams
+	QueryProposalParams                = types.QueryProposalParams
+	QueryVoteParams                    = types.QueryVoteParams
+)
@@ -0,0 +1,339 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// GetQueryCmd returns the query commands for the group module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the group module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryGroup(cdc),
+		GetCmdQueryGroupMembers(cdc),
+		GetCmdQueryGroupsByAdmin(cdc),
+		GetCmdQueryGroupPolicy(cdc),
+		GetCmdQueryGroupPoliciesByGroup(cdc),
+		GetCmdQueryProposal(cdc),
+		GetCmdQueryProposalsByGroupPolicy(cdc),
+		GetCmdQueryVote(cdc),
+		GetCmdQueryVotesByProposal(cdc),
+	)...)
+
+	return queryCmd
+}
+
+// GetCmdQueryGroup returns the command handler for querying a group.
+func GetCmdQueryGroup(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group [group-id]",
+		Short: "Query a group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a group by its id.
+
+Example:
+$ %s query %s group 1
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := parseGroupID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var group types.GroupInfo
+			return queryAndPrint(cdc, types.QueryGroup, types.NewQueryGroupParams(groupID), &group)
+		},
+	}
+}
+
+// GetCmdQueryGroupMembers returns the command handler for querying the members
+// of a group.
+func GetCmdQueryGroupMembers(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group-members [group-id]",
+		Short: "Query the members of a group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the members of a group and their weights.
+
+Example:
+$ %s query %s group-members 1
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := parseGroupID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var members []types.GroupMember
+			return queryAndPrint(cdc, types.QueryGroupMembers, types.NewQueryGroupParams(groupID), &members)
+		},
+	}
+}
+
+// GetCmdQueryGroupsByAdmin returns the command handler for querying the groups
+// administered by an account.
+func GetCmdQueryGroupsByAdmin(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "groups-by-admin [admin]",
+		Short: "Query the groups administered by an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the groups administered by an account.
+
+Example:
+$ %s query %s groups-by-admin cosmos1skjw..
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var groups []types.GroupInfo
+			return queryAndPrint(cdc, types.QueryGroupsByAdmin, types.NewQueryGroupsByAdminParams(admin), &groups)
+		},
+	}
+}
+
+// GetCmdQueryGroupPolicy returns the command handler for querying a group
+// policy.
+func GetCmdQueryGroupPolicy(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group-policy [group-policy-address]",
+		Short: "Query a group policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a group policy by the address of its account.
+
+Example:
+$ %s query %s group-policy cosmos1skjw..
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var policy types.GroupPolicyInfo
+			return queryAndPrint(cdc, types.QueryGroupPolicy, types.NewQueryGroupPolicyParams(address), &policy)
+		},
+	}
+}
+
+// GetCmdQueryGroupPoliciesByGroup returns the command handler for querying the
+// policies of a group.
+func GetCmdQueryGroupPoliciesByGroup(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group-policies [group-id]",
+		Short: "Query the policies of a group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the group policy accounts of a group.
+
+Example:
+$ %s query %s group-policies 1
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := parseGroupID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var policies []types.GroupPolicyInfo
+			return queryAndPrint(cdc, types.QueryGroupPoliciesByGroup, types.NewQueryGroupParams(groupID), &policies)
+		},
+	}
+}
+
+// GetCmdQueryProposal returns the command handler for querying a group
+// proposal.
+func GetCmdQueryProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proposal [proposal-id]",
+		Short: "Query a group proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a group proposal by its id.
+
+Example:
+$ %s query %s proposal 1
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposal types.Proposal
+			return queryAndPrint(cdc, types.QueryProposal, types.NewQueryProposalParams(proposalID), &proposal)
+		},
+	}
+}
+
+// GetCmdQueryProposalsByGroupPolicy returns the command handler for querying
+// the proposals of a group policy.
+func GetCmdQueryProposalsByGroupPolicy(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proposals [group-policy-address]",
+		Short: "Query the proposals of a group policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the proposals submitted to a group policy account.
+
+Example:
+$ %s query %s proposals cosmos1skjw..
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposals []types.Proposal
+			return queryAndPrint(cdc, types.QueryProposalsByGroupPolicy, types.NewQueryGroupPolicyParams(address), &proposals)
+		},
+	}
+}
+
+// GetCmdQueryVote returns the command handler for querying the vote of a voter
+// on a group proposal.
+func GetCmdQueryVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vote [proposal-id] [voter]",
+		Short: "Query the vote of a voter on a group proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vote of a group member on a group proposal.
+
+Example:
+$ %s query %s vote 1 cosmos1skjw..
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+
+			voter, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			var vote types.Vote
+			return queryAndPrint(cdc, types.QueryVote, types.NewQueryVoteParams(proposalID, voter), &vote)
+		},
+	}
+}
+
+// GetCmdQueryVotesByProposal returns the command handler for querying the
+// votes on a group proposal.
+func GetCmdQueryVotesByProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "votes [proposal-id]",
+		Short: "Query the votes on a group proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the votes cast on a group proposal.
+
+Example:
+$ %s query %s votes 1
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var votes []types.Vote
+			return queryAndPrint(cdc, types.QueryVotesByProposal, types.NewQueryProposalParams(proposalID), &votes)
+		},
+	}
+}
+
+// queryAndPrint queries the group querier at path with params, and prints the
+// response unmarshaled into out.
+func queryAndPrint(cdc *codec.Codec, path string, params, out interface{}) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	if err := cdc.UnmarshalJSON(res, out); err != nil {
+		return fmt.Errorf("failed to unmarshal %s response: %w", path, err)
+	}
+
+	return cliCtx.PrintOutput(out)
+}
+
+func parseGroupID(arg string) (uint64, error) {
+	groupID, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("group id %s not a valid uint, please input a valid group id", arg)
+	}
+
+	return groupID, nil
+}
+
+func parseProposalID(arg string) (uint64, error) {
+	proposalID, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("proposal id %s not a valid uint, please input a valid proposal id", arg)
+	}
+
+	return proposalID, nil
+}
@@ -0,0 +1,452 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/group/exported"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// FlagMetadata is the flag of the metadata of the groups, group policies,
+// proposals and votes.
+const FlagMetadata = "metadata"
+
+// decision policy kinds of the group policy commands
+const (
+	policyThreshold  = "threshold"
+	policyPercentage = "percentage"
+)
+
+// GetTxCmd returns the transaction commands for the group module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Group transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(flags.PostCommands(
+		GetCmdCreateGroup(cdc),
+		GetCmdUpdateGroupMembers(cdc),
+		GetCmdUpdateGroupAdmin(cdc),
+		GetCmdCreateGroupPolicy(cdc),
+		GetCmdUpdateGroupPolicyDecisionPolicy(cdc),
+		GetCmdSubmitProposal(cdc),
+		GetCmdVote(cdc),
+		GetCmdExec(cdc),
+	)...)
+
+	return txCmd
+}
+
+// GetCmdCreateGroup returns a CLI command handler for creating a
+// MsgCreateGroup transaction.
+func GetCmdCreateGroup(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [members-json-file]",
+		Short: "Create a group administered by the signer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group administered by the signer, with the members of a JSON file.
+
+Example:
+$ %s tx %s create-group members.json --metadata="my group" --from=mykey
+
+Where members.json contains:
+
+[
+  {"address": "cosmos1skjw..", "weight": "1", "metadata": "alice"},
+  {"address": "cosmos1skjx..", "weight": "2", "metadata": "bob"}
+]
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			members, err := readMembers(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGroup(cliCtx.GetFromAddress(), members, viper.GetString(FlagMetadata))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "The metadata of the group")
+
+	return cmd
+}
+
+// GetCmdUpdateGroupMembers returns a CLI command handler for creating a
+// MsgUpdateGroupMembers transaction.
+func GetCmdUpdateGroupMembers(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-members [group-id] [members-json-file]",
+		Short: "Add, update or remove members of a group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add, update or remove the members of a JSON file to a group administered by the
+signer. The members with a zero weight are removed from the group.
+
+Example:
+$ %s tx %s update-group-members 1 members.json --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			groupID, err := parseGroupID(args[0])
+			if err != nil {
+				return err
+			}
+
+			members, err := readMembers(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupMembers(cliCtx.GetFromAddress(), groupID, members)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdUpdateGroupAdmin returns a CLI command handler for creating a
+// MsgUpdateGroupAdmin transaction.
+func GetCmdUpdateGroupAdmin(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-admin [group-id] [new-admin]",
+		Short: "Transfer the administration of a group to a new admin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the administration of a group administered by the signer to a new admin.
+
+Example:
+$ %s tx %s update-group-admin 1 cosmos1skjw.. --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			groupID, err := parseGroupID(args[0])
+			if err != nil {
+				return err
+			}
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupAdmin(cliCtx.GetFromAddress(), groupID, newAdmin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdCreateGroupPolicy returns a CLI command handler for creating a
+// MsgCreateGroupPolicy transaction.
+func GetCmdCreateGroupPolicy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-policy [group-id] [threshold|percentage] [value] [voting-period]",
+		Short: "Create a group policy account for a group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group policy account for a group administered by the signer. Its proposals
+are accepted once the weight of the yes votes reaches a threshold, or a percentage of the total
+weight of the group, before the end of the voting period.
+
+Example:
+$ %s tx %s create-group-policy 1 threshold 2 24h --metadata="treasury" --from=mykey
+$ %s tx %s create-group-policy 1 percentage 0.5 72h --from=mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			groupID, err := parseGroupID(args[0])
+			if err != nil {
+				return err
+			}
+
+			decisionPolicy, err := parseDecisionPolicy(args[1], args[2], args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGroupPolicy(cliCtx.GetFromAddress(), groupID, viper.GetString(FlagMetadata), decisionPolicy)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "The metadata of the group policy")
+
+	return cmd
+}
+
+// GetCmdUpdateGroupPolicyDecisionPolicy returns a CLI command handler for
+// creating a MsgUpdateGroupPolicyDecisionPolicy transaction.
+func GetCmdUpdateGroupPolicyDecisionPolicy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-decision-policy [group-policy-address] [threshold|percentage] [value] [voting-period]",
+		Short: "Replace the decision policy of a group policy account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the decision policy of a group policy account administered by the signer.
+The proposals submitted before the update cannot be voted on or executed anymore.
+
+Example:
+$ %s tx %s update-group-policy-decision-policy cosmos1skjw.. threshold 3 24h --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			decisionPolicy, err := parseDecisionPolicy(args[1], args[2], args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupPolicyDecisionPolicy(cliCtx.GetFromAddress(), address, decisionPolicy)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitProposal returns a CLI command handler for creating a
+// MsgSubmitProposal transaction.
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [group-policy-address] [tx-json-file]",
+		Short: "Submit a proposal to execute msgs on behalf of a group policy account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to execute the msgs of a generated transaction on behalf of a group
+policy account, which must be their only signer. The signer must be a member of the group of the policy.
+
+Example:
+$ %s tx bank send cosmos1skjw.. cosmos1skjx.. 10stake --generate-only > tx.json
+$ %s tx %s submit-proposal cosmos1skjw.. tx.json --metadata="pay bob" --from=mykey
+`,
+				version.ClientName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := authclient.ReadStdTxFromFile(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitProposal(
+				address, []sdk.AccAddress{cliCtx.GetFromAddress()}, viper.GetString(FlagMetadata), stdTx.Msgs,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "The metadata of the proposal")
+
+	return cmd
+}
+
+// GetCmdVote returns a CLI command handler for creating a MsgVote transaction.
+func GetCmdVote(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [option]",
+		Short: "Vote on a group proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on a proposal as a member of the group of its group policy. The option is one
+of Yes, No, Abstain and NoWithVeto.
+
+Example:
+$ %s tx %s vote 1 Yes --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+
+			option, err := types.VoteOptionFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVote(proposalID, cliCtx.GetFromAddress(), option, viper.GetString(FlagMetadata))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "The metadata of the vote")
+
+	return cmd
+}
+
+// GetCmdExec returns a CLI command handler for creating a MsgExec transaction.
+func GetCmdExec(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [proposal-id]",
+		Short: "Execute the msgs of an accepted group proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Tally a proposal and, if it is accepted, execute its msgs on behalf of its group policy
+account. Any account can execute a proposal.
+
+Example:
+$ %s tx %s exec 1 --from=mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExec(proposalID, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func readMembers(cdc *codec.Codec, path string) (types.Members, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var members types.Members
+	if err := cdc.UnmarshalJSON(bz, &members); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal members: %w", err)
+	}
+
+	return members, nil
+}
+
+func parseDecisionPolicy(kind, value, votingPeriod string) (exported.DecisionPolicy, error) {
+	period, err := time.ParseDuration(votingPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := sdk.NewDecFromStr(value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case policyThreshold:
+		return types.NewThresholdDecisionPolicy(dec, period), nil
+
+	case policyPercentage:
+		return types.NewPercentageDecisionPolicy(dec, period), nil
+
+	default:
+		return nil, fmt.Errorf("invalid decision policy %s, expected %s or %s", kind, policyThreshold, policyPercentage)
+	}
+}
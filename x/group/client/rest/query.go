@@ -0,0 +1,111 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		fmt.Sprintf("/group/groups/{%s}", RestParamGroupID),
+		queryByGroupHandler(cliCtx, types.QueryGroup),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/group/groups/{%s}/members", RestParamGroupID),
+		queryByGroupHandler(cliCtx, types.QueryGroupMembers),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/group/groups/{%s}/policies", RestParamGroupID),
+		queryByGroupHandler(cliCtx, types.QueryGroupPoliciesByGroup),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/group/policies/{%s}", RestParamAddress),
+		queryByGroupPolicyHandler(cliCtx, types.QueryGroupPolicy),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/group/policies/{%s}/proposals", RestParamAddress),
+		queryByGroupPolicyHandler(cliCtx, types.QueryProposalsByGroupPolicy),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/group/proposals/{%s}", RestParamProposalID),
+		queryByProposalHandler(cliCtx, types.QueryProposal),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/group/proposals/{%s}/votes", RestParamProposalID),
+		queryByProposalHandler(cliCtx, types.QueryVotesByProposal),
+	).Methods("GET")
+}
+
+// queryByGroupHandler returns a handler querying the group querier at path
+// with the group id of the request.
+func queryByGroupHandler(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		groupID, err := strconv.ParseUint(mux.Vars(r)[RestParamGroupID], 10, 64)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		query(w, r, cliCtx, path, types.NewQueryGroupParams(groupID))
+	}
+}
+
+// queryByGroupPolicyHandler returns a handler querying the group querier at
+// path with the group policy address of the request.
+func queryByGroupPolicyHandler(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		address, err := sdk.AccAddressFromBech32(mux.Vars(r)[RestParamAddress])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		query(w, r, cliCtx, path, types.NewQueryGroupPolicyParams(address))
+	}
+}
+
+// queryByProposalHandler returns a handler querying the group querier at path
+// with the proposal id of the request.
+func queryByProposalHandler(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		proposalID, err := strconv.ParseUint(mux.Vars(r)[RestParamProposalID], 10, 64)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		query(w, r, cliCtx, path, types.NewQueryProposalParams(proposalID))
+	}
+}
+
+func query(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, path string, params interface{}) {
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return
+	}
+
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if rest.CheckInternalServerError(w, err) {
+		return
+	}
+
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, res)
+}
@@ -0,0 +1,19 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// REST path parameters
+const (
+	RestParamGroupID    = "group-id"
+	RestParamAddress    = "address"
+	RestParamProposalID = "proposal-id"
+)
+
+// RegisterRoutes registers the REST routes of the group module.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
@@ -0,0 +1,24 @@
+package exported
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DecisionPolicy decides whether the proposals of a group policy account are
+// accepted, from the votes of the members of its group.
+type DecisionPolicy interface {
+	// GetVotingPeriod returns the duration during which the members can vote
+	// on a proposal.
+	GetVotingPeriod() time.Duration
+
+	// Allow returns whether a proposal is accepted given the sum of the
+	// weights of the yes votes and of all the votes cast, the total weight of
+	// the group, and whether the voting period has ended. final is true once
+	// the result cannot change anymore.
+	Allow(yesWeight, votedWeight, totalWeight sdk.Dec, votingPeriodEnded bool) (allow bool, final bool)
+
+	// ValidateBasic performs basic validation of the decision policy.
+	ValidateBasic() error
+}
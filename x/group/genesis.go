@@ -0,0 +1,72 @@
+package group
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the group module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	k.SetSequences(ctx, gs.GroupSeq, gs.GroupPolicySeq, gs.ProposalSeq)
+
+	for _, group := range gs.Groups {
+		k.SetGroup(ctx, group)
+	}
+	for _, gm := range gs.GroupMembers {
+		k.SetGroupMember(ctx, gm)
+	}
+	for _, policy := range gs.GroupPolicies {
+		k.SetGroupPolicy(ctx, policy)
+	}
+	for _, proposal := range gs.Proposals {
+		k.SetProposal(ctx, proposal)
+	}
+	for _, vote := range gs.Votes {
+		k.SetVote(ctx, vote)
+	}
+}
+
+// ExportGenesis returns the group module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	groups := []GroupInfo{}
+	k.IterateGroups(ctx, func(group GroupInfo) bool {
+		groups = append(groups, group)
+		return false
+	})
+
+	members := []GroupMember{}
+	k.IterateAllGroupMembers(ctx, func(gm GroupMember) bool {
+		members = append(members, gm)
+		return false
+	})
+
+	policies := []GroupPolicyInfo{}
+	k.IterateGroupPolicies(ctx, func(policy GroupPolicyInfo) bool {
+		policies = append(policies, policy)
+		return false
+	})
+
+	proposals := []Proposal{}
+	k.IterateProposals(ctx, func(proposal Proposal) bool {
+		proposals = append(proposals, proposal)
+		return false
+	})
+
+	votes := []Vote{}
+	k.IterateAllVotes(ctx, func(vote Vote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+
+	return NewGenesisState(
+		k.GetGroupSequence(ctx), groups, members,
+		k.GetGroupPolicySequence(ctx), policies,
+		k.GetProposalSequence(ctx), proposals, votes,
+	)
+}
@@ -0,0 +1,146 @@
+package group
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHandler returns a handler for the group module's msgs.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgCreateGroup:
+			return handleCreateGroup(ctx, k, msg)
+
+		case MsgUpdateGroupMembers:
+			return handleUpdateGroupMembers(ctx, k, msg)
+
+		case MsgUpdateGroupAdmin:
+			return handleUpdateGroupAdmin(ctx, k, msg)
+
+		case MsgCreateGroupPolicy:
+			return handleCreateGroupPolicy(ctx, k, msg)
+
+		case MsgUpdateGroupPolicyDecisionPolicy:
+			return handleUpdateGroupPolicyDecisionPolicy(ctx, k, msg)
+
+		case MsgSubmitProposal:
+			return handleSubmitProposal(ctx, k, msg)
+
+		case MsgVote:
+			return handleVote(ctx, k, msg)
+
+		case MsgExec:
+			return handleExec(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleCreateGroup(ctx sdk.Context, k Keeper, msg MsgCreateGroup) (*sdk.Result, error) {
+	groupID, err := k.CreateGroup(ctx, msg.Admin, msg.Members, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+	return &sdk.Result{
+		Data:   sdk.Uint64ToBigEndian(groupID),
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+func handleUpdateGroupMembers(ctx sdk.Context, k Keeper, msg MsgUpdateGroupMembers) (*sdk.Result, error) {
+	if err := k.UpdateGroupMembers(ctx, msg.Admin, msg.GroupID, msg.MemberUpdates); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleUpdateGroupAdmin(ctx sdk.Context, k Keeper, msg MsgUpdateGroupAdmin) (*sdk.Result, error) {
+	if err := k.UpdateGroupAdmin(ctx, msg.Admin, msg.GroupID, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleCreateGroupPolicy(ctx sdk.Context, k Keeper, msg MsgCreateGroupPolicy) (*sdk.Result, error) {
+	address, err := k.CreateGroupPolicy(ctx, msg.Admin, msg.GroupID, msg.Metadata, msg.DecisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+	return &sdk.Result{
+		Data:   address.Bytes(),
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+func handleUpdateGroupPolicyDecisionPolicy(
+	ctx sdk.Context, k Keeper, msg MsgUpdateGroupPolicyDecisionPolicy,
+) (*sdk.Result, error) {
+	if err := k.UpdateGroupPolicyDecisionPolicy(ctx, msg.Admin, msg.Address, msg.DecisionPolicy); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleSubmitProposal(ctx sdk.Context, k Keeper, msg MsgSubmitProposal) (*sdk.Result, error) {
+	proposalID, err := k.SubmitProposal(ctx, msg.Address, msg.Proposers, msg.Metadata, msg.Msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, proposer := range msg.Proposers {
+		emitMessageEvent(ctx, proposer)
+	}
+
+	return &sdk.Result{
+		Data:   sdk.Uint64ToBigEndian(proposalID),
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+func handleVote(ctx sdk.Context, k Keeper, msg MsgVote) (*sdk.Result, error) {
+	if err := k.Vote(ctx, msg.ProposalID, msg.Voter, msg.Option, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Voter)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleExec(ctx sdk.Context, k Keeper, msg MsgExec) (*sdk.Result, error) {
+	res, err := k.Exec(ctx, msg.ProposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Executor)
+
+	// the events of the group module come first, then those of the executed
+	// msgs
+	res.Events = append(ctx.EventManager().ABCIEvents(), res.Events...)
+	return res, nil
+}
+
+func emitMessageEvent(ctx sdk.Context, sender sdk.AccAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+}
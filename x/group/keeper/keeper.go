@@ -0,0 +1,679 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/exported"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// Keeper manages the groups, their policy accounts, and the proposals voted on
+// by the members of the groups to execute msgs on behalf of these accounts.
+type Keeper struct {
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
+	router        sdk.Router
+	accountKeeper types.AccountKeeper
+}
+
+// NewKeeper creates a group Keeper. The msgs of the accepted proposals are
+// dispatched to the handlers of the router, which is usually the Router of the
+// BaseApp. The codec must have all the msgs which can be proposed registered.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router sdk.Router, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		router:        router,
+		accountKeeper: ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// CreateGroup creates a group administered by admin with the given members,
+// and returns its id.
+func (k Keeper) CreateGroup(ctx sdk.Context, admin sdk.AccAddress, members types.Members, metadata string) (uint64, error) {
+	groupID := k.nextSequence(ctx, types.GroupSeqKey)
+
+	totalWeight := sdk.ZeroDec()
+	for _, m := range members {
+		if !m.Weight.IsPositive() {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidMember, "weight of %s must be positive", m.Address)
+		}
+		if _, found := k.GetGroupMember(ctx, groupID, m.Address); found {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidMember, "duplicate member %s", m.Address)
+		}
+
+		k.SetGroupMember(ctx, types.NewGroupMember(groupID, m))
+		totalWeight = totalWeight.Add(m.Weight)
+	}
+
+	k.SetGroup(ctx, types.NewGroupInfo(groupID, admin, metadata, 1, totalWeight))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGroup,
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		),
+	)
+
+	return groupID, nil
+}
+
+// UpdateGroupMembers adds, updates or removes members of a group, and bumps its
+// version. A member with a zero weight is removed. Only the admin of the group
+// can update its members.
+func (k Keeper) UpdateGroupMembers(ctx sdk.Context, admin sdk.AccAddress, groupID uint64, updates types.Members) error {
+	group, err := k.getGroupOfAdmin(ctx, admin, groupID)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range updates {
+		previous, found := k.GetGroupMember(ctx, groupID, m.Address)
+		switch {
+		case found:
+			group.TotalWeight = group.TotalWeight.Sub(previous.Weight)
+		case m.Weight.IsZero():
+			return sdkerrors.Wrapf(types.ErrNotMember, "cannot remove %s", m.Address)
+		}
+
+		if m.Weight.IsZero() {
+			ctx.KVStore(k.storeKey).Delete(types.GroupMemberKey(groupID, m.Address))
+			continue
+		}
+
+		k.SetGroupMember(ctx, types.NewGroupMember(groupID, m))
+		group.TotalWeight = group.TotalWeight.Add(m.Weight)
+	}
+
+	group.Version++
+	k.SetGroup(ctx, group)
+	k.emitUpdateGroup(ctx, groupID)
+
+	return nil
+}
+
+// UpdateGroupAdmin transfers the administration of a group to newAdmin. Only
+// the admin of the group can transfer it.
+func (k Keeper) UpdateGroupAdmin(ctx sdk.Context, admin sdk.AccAddress, groupID uint64, newAdmin sdk.AccAddress) error {
+	group, err := k.getGroupOfAdmin(ctx, admin, groupID)
+	if err != nil {
+		return err
+	}
+
+	group.Admin = newAdmin
+	k.SetGroup(ctx, group)
+	k.emitUpdateGroup(ctx, groupID)
+
+	return nil
+}
+
+// GetGroup returns a group and true, or an empty group and false if there is
+// none with this id.
+func (k Keeper) GetGroup(ctx sdk.Context, groupID uint64) (group types.GroupInfo, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupKey(groupID))
+	if len(bz) == 0 {
+		return group, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &group)
+	return group, true
+}
+
+// SetGroup stores a group.
+func (k Keeper) SetGroup(ctx sdk.Context, group types.GroupInfo) {
+	bz := k.cdc.MustMarshalBinaryBare(group)
+	ctx.KVStore(k.storeKey).Set(types.GroupKey(group.GroupID), bz)
+}
+
+// IterateGroups iterates over all the groups, until cb returns true.
+func (k Keeper) IterateGroups(ctx sdk.Context, cb func(types.GroupInfo) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var group types.GroupInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &group)
+
+		if cb(group) {
+			break
+		}
+	}
+}
+
+// GetGroupMember returns a member of a group and true, or an empty member and
+// false if the address is not a member of the group.
+func (k Keeper) GetGroupMember(ctx sdk.Context, groupID uint64, address sdk.AccAddress) (types.Member, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupMemberKey(groupID, address))
+	if len(bz) == 0 {
+		return types.Member{}, false
+	}
+
+	var gm types.GroupMember
+	k.cdc.MustUnmarshalBinaryBare(bz, &gm)
+	return gm.Member, true
+}
+
+// SetGroupMember stores a member of a group. The total weight of the group is
+// not updated.
+func (k Keeper) SetGroupMember(ctx sdk.Context, gm types.GroupMember) {
+	bz := k.cdc.MustMarshalBinaryBare(gm)
+	ctx.KVStore(k.storeKey).Set(types.GroupMemberKey(gm.GroupID, gm.Member.Address), bz)
+}
+
+// IterateGroupMembers iterates over the members of a group, until cb returns
+// true.
+func (k Keeper) IterateGroupMembers(ctx sdk.Context, groupID uint64, cb func(types.GroupMember) (stop bool)) {
+	k.iterateGroupMembers(ctx, types.GroupMembersPrefix(groupID), cb)
+}
+
+// IterateAllGroupMembers iterates over the members of all the groups, until cb
+// returns true.
+func (k Keeper) IterateAllGroupMembers(ctx sdk.Context, cb func(types.GroupMember) (stop bool)) {
+	k.iterateGroupMembers(ctx, types.GroupMemberKeyPrefix, cb)
+}
+
+// CreateGroupPolicy creates a group policy account for a group, and returns its
+// address. Only the admin of the group can create its policies, which are
+// administered by the same account.
+func (k Keeper) CreateGroupPolicy(
+	ctx sdk.Context, admin sdk.AccAddress, groupID uint64, metadata string, decisionPolicy exported.DecisionPolicy,
+) (sdk.AccAddress, error) {
+	if _, err := k.getGroupOfAdmin(ctx, admin, groupID); err != nil {
+		return nil, err
+	}
+
+	// the address of the account is derived from a sequence number, skipping
+	// the addresses of existing accounts such as the ones which already
+	// received coins
+	var address sdk.AccAddress
+	for {
+		address = types.GroupPolicyAddress(k.nextSequence(ctx, types.GroupPolicySeqKey))
+		if k.accountKeeper.GetAccount(ctx, address) == nil {
+			break
+		}
+	}
+
+	k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, address))
+	k.SetGroupPolicy(ctx, types.NewGroupPolicyInfo(address, groupID, admin, metadata, 1, decisionPolicy))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGroupPolicy,
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+	)
+
+	return address, nil
+}
+
+// UpdateGroupPolicyDecisionPolicy replaces the decision policy of a group
+// policy, and bumps its version. Only the admin of the policy can update it.
+func (k Keeper) UpdateGroupPolicyDecisionPolicy(
+	ctx sdk.Context, admin, address sdk.AccAddress, decisionPolicy exported.DecisionPolicy,
+) error {
+	policy, found := k.GetGroupPolicy(ctx, address)
+	if !found {
+		return sdkerrors.Wrap(types.ErrGroupPolicyNotFound, address.String())
+	}
+	if !policy.Admin.Equals(admin) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of group policy %s", admin, address)
+	}
+
+	policy.DecisionPolicy = decisionPolicy
+	policy.Version++
+	k.SetGroupPolicy(ctx, policy)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateGroupPolicy,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetGroupPolicy returns a group policy and true, or an empty policy and false
+// if there is none at this address.
+func (k Keeper) GetGroupPolicy(ctx sdk.Context, address sdk.AccAddress) (policy types.GroupPolicyInfo, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupPolicyKey(address))
+	if len(bz) == 0 {
+		return policy, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &policy)
+	return policy, true
+}
+
+// SetGroupPolicy stores a group policy and indexes it by group.
+func (k Keeper) SetGroupPolicy(ctx sdk.Context, policy types.GroupPolicyInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupPolicyKey(policy.Address), k.cdc.MustMarshalBinaryBare(policy))
+	store.Set(types.GroupPolicyByGroupKey(policy.GroupID, policy.Address), policy.Address.Bytes())
+}
+
+// IterateGroupPoliciesByGroup iterates over the policies of a group, until cb
+// returns true.
+func (k Keeper) IterateGroupPoliciesByGroup(ctx sdk.Context, groupID uint64, cb func(types.GroupPolicyInfo) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupPoliciesByGroupPrefix(groupID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		policy, _ := k.GetGroupPolicy(ctx, iterator.Value())
+		if cb(policy) {
+			break
+		}
+	}
+}
+
+// IterateGroupPolicies iterates over all the group policies, until cb returns
+// true.
+func (k Keeper) IterateGroupPolicies(ctx sdk.Context, cb func(types.GroupPolicyInfo) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupPolicyKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.GroupPolicyInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &policy)
+
+		if cb(policy) {
+			break
+		}
+	}
+}
+
+// SubmitProposal submits a proposal to execute msgs on behalf of a group policy
+// account, and returns its id. The proposers must be members of the group of
+// the policy.
+func (k Keeper) SubmitProposal(
+	ctx sdk.Context, address sdk.AccAddress, proposers []sdk.AccAddress, metadata string, msgs []sdk.Msg,
+) (uint64, error) {
+	policy, found := k.GetGroupPolicy(ctx, address)
+	if !found {
+		return 0, sdkerrors.Wrap(types.ErrGroupPolicyNotFound, address.String())
+	}
+
+	group, found := k.GetGroup(ctx, policy.GroupID)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrGroupNotFound, "%d", policy.GroupID)
+	}
+
+	for _, proposer := range proposers {
+		if _, found := k.GetGroupMember(ctx, group.GroupID, proposer); !found {
+			return 0, sdkerrors.Wrapf(types.ErrNotMember, "proposer %s", proposer)
+		}
+	}
+
+	for i, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(address) {
+				return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "msg %d must be signed by the group policy account only", i)
+			}
+		}
+	}
+
+	proposalID := k.nextSequence(ctx, types.ProposalSeqKey)
+	k.SetProposal(ctx, types.Proposal{
+		ProposalID:         proposalID,
+		Address:            address,
+		Metadata:           metadata,
+		Proposers:          proposers,
+		SubmitTime:         ctx.BlockTime(),
+		GroupVersion:       group.Version,
+		GroupPolicyVersion: policy.Version,
+		Status:             types.StatusSubmitted,
+		Result:             types.ResultUnfinalized,
+		VoteState:          types.EmptyTallyResult(),
+		VotingPeriodEnd:    ctx.BlockTime().Add(policy.DecisionPolicy.GetVotingPeriod()),
+		ExecutorResult:     types.ExecutorNotRun,
+		Msgs:               msgs,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+	)
+
+	return proposalID, nil
+}
+
+// GetProposal returns a proposal and true, or an empty proposal and false if
+// there is none with this id.
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal types.Proposal, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ProposalKey(proposalID))
+	if len(bz) == 0 {
+		return proposal, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+	return proposal, true
+}
+
+// SetProposal stores a proposal.
+func (k Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
+	bz := k.cdc.MustMarshalBinaryBare(proposal)
+	ctx.KVStore(k.storeKey).Set(types.ProposalKey(proposal.ProposalID), bz)
+}
+
+// IterateProposals iterates over all the proposals, until cb returns true.
+func (k Keeper) IterateProposals(ctx sdk.Context, cb func(types.Proposal) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ProposalKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &proposal)
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// Vote casts the vote of a member of the group on a proposal, with the current
+// weight of the member. The proposal is closed as soon as its decision policy
+// makes its result final.
+func (k Keeper) Vote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, option types.VoteOption, metadata string) error {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrProposalNotFound, "%d", proposalID)
+	}
+	if proposal.Status != types.StatusSubmitted {
+		return sdkerrors.Wrapf(types.ErrInvalidProposalStatus, "proposal %d is %s", proposalID, proposal.Status)
+	}
+	if !ctx.BlockTime().Before(proposal.VotingPeriodEnd) {
+		return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "proposal %d", proposalID)
+	}
+
+	policy, group, err := k.getProposalPolicyAndGroup(ctx, proposal)
+	if err != nil {
+		return err
+	}
+
+	member, found := k.GetGroupMember(ctx, group.GroupID, voter)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotMember, "voter %s", voter)
+	}
+	if _, found := k.GetVote(ctx, proposalID, voter); found {
+		return sdkerrors.Wrapf(types.ErrAlreadyVoted, "voter %s on proposal %d", voter, proposalID)
+	}
+
+	proposal.VoteState, err = proposal.VoteState.Add(option, member.Weight)
+	if err != nil {
+		return err
+	}
+
+	k.SetVote(ctx, types.NewVote(proposalID, voter, option, metadata, ctx.BlockTime()))
+	tally(&proposal, policy, group, false)
+	k.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVote,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetVote returns the vote of a voter on a proposal and true, or an empty vote
+// and false if the voter did not vote on the proposal.
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (vote types.Vote, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.VoteKey(proposalID, voter))
+	if len(bz) == 0 {
+		return vote, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	return vote, true
+}
+
+// SetVote stores a vote.
+func (k Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
+	bz := k.cdc.MustMarshalBinaryBare(vote)
+	ctx.KVStore(k.storeKey).Set(types.VoteKey(vote.ProposalID, vote.Voter), bz)
+}
+
+// IterateVotes iterates over the votes on a proposal, until cb returns true.
+func (k Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(types.Vote) (stop bool)) {
+	k.iterateVotes(ctx, types.VotesPrefix(proposalID), cb)
+}
+
+// IterateAllVotes iterates over the votes on all the proposals, until cb
+// returns true.
+func (k Keeper) IterateAllVotes(ctx sdk.Context, cb func(types.Vote) (stop bool)) {
+	k.iterateVotes(ctx, types.VoteKeyPrefix, cb)
+}
+
+// Exec tallies a proposal and, once it is accepted, executes its msgs on
+// behalf of the group policy account. The msgs are routed to the handlers of
+// the router, and their state changes are only committed if all of them
+// succeed. A failed execution is recorded in the proposal, and can be retried.
+// A proposal whose group or group policy was modified since its submission is
+// aborted.
+func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) (*sdk.Result, error) {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrProposalNotFound, "%d", proposalID)
+	}
+
+	switch proposal.Status {
+	case types.StatusSubmitted:
+		policy, group, err := k.getProposalPolicyAndGroup(ctx, proposal)
+		switch {
+		case types.ErrModified.Is(err):
+			proposal.Status = types.StatusAborted
+		case err != nil:
+			return nil, err
+		default:
+			tally(&proposal, policy, group, !ctx.BlockTime().Before(proposal.VotingPeriodEnd))
+			if proposal.Status == types.StatusSubmitted {
+				return nil, sdkerrors.Wrapf(types.ErrProposalNotFinalized, "proposal %d", proposalID)
+			}
+		}
+
+	case types.StatusClosed:
+		if proposal.Result != types.ResultAccepted || proposal.ExecutorResult == types.ExecutorSuccess {
+			return nil, sdkerrors.Wrapf(
+				types.ErrInvalidProposalStatus, "proposal %d is %s with executor result %s",
+				proposalID, proposal.Result, proposal.ExecutorResult,
+			)
+		}
+
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalidProposalStatus, "proposal %d is %s", proposalID, proposal.Status)
+	}
+
+	res := &sdk.Result{}
+	if proposal.Status == types.StatusClosed && proposal.Result == types.ResultAccepted {
+		cacheCtx, writeCache := ctx.CacheContext()
+		msgsRes, err := k.dispatchMsgs(cacheCtx, proposal.Msgs)
+		if err != nil {
+			proposal.ExecutorResult = types.ExecutorFailure
+			k.Logger(ctx).Info("proposal execution failed", "proposal", proposalID, "err", err)
+		} else {
+			proposal.ExecutorResult = types.ExecutorSuccess
+			writeCache()
+			res = msgsRes
+			res.Events = append(cacheCtx.EventManager().ABCIEvents(), res.Events...)
+		}
+	}
+
+	k.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExec,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyExecutorResult, proposal.ExecutorResult.String()),
+		),
+	)
+
+	return res, nil
+}
+
+// GetGroupSequence returns the id of the last created group.
+func (k Keeper) GetGroupSequence(ctx sdk.Context) uint64 {
+	return sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(types.GroupSeqKey))
+}
+
+// GetGroupPolicySequence returns the sequence number of the address of the
+// last created group policy.
+func (k Keeper) GetGroupPolicySequence(ctx sdk.Context) uint64 {
+	return sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(types.GroupPolicySeqKey))
+}
+
+// GetProposalSequence returns the id of the last submitted proposal.
+func (k Keeper) GetProposalSequence(ctx sdk.Context) uint64 {
+	return sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(types.ProposalSeqKey))
+}
+
+// SetSequences stores the sequences of the groups, group policies and
+// proposals.
+func (k Keeper) SetSequences(ctx sdk.Context, groupSeq, groupPolicySeq, proposalSeq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupSeqKey, sdk.Uint64ToBigEndian(groupSeq))
+	store.Set(types.GroupPolicySeqKey, sdk.Uint64ToBigEndian(groupPolicySeq))
+	store.Set(types.ProposalSeqKey, sdk.Uint64ToBigEndian(proposalSeq))
+}
+
+// tally updates the result of a proposal from its votes, closing it once the
+// result is final.
+func tally(proposal *types.Proposal, policy types.GroupPolicyInfo, group types.GroupInfo, votingPeriodEnded bool) {
+	allow, final := policy.DecisionPolicy.Allow(
+		proposal.VoteState.Yes, proposal.VoteState.TotalCounts(), group.TotalWeight, votingPeriodEnded,
+	)
+	if !final {
+		return
+	}
+
+	proposal.Status = types.StatusClosed
+	proposal.Result = types.ResultRejected
+	if allow {
+		proposal.Result = types.ResultAccepted
+	}
+}
+
+// dispatchMsgs executes the msgs of a proposal, and merges their results into
+// a single one.
+func (k Keeper) dispatchMsgs(ctx sdk.Context, msgs []sdk.Msg) (*sdk.Result, error) {
+	var (
+		data   []byte
+		events []abci.Event
+	)
+
+	for i, msg := range msgs {
+		handler := k.router.Route(ctx, msg.Route())
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msg.Route(), i)
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		data = append(data, res.Data...)
+		events = append(events, res.Events...)
+	}
+
+	return &sdk.Result{Data: data, Events: events}, nil
+}
+
+// getProposalPolicyAndGroup returns the group policy and group of a proposal,
+// or an error if any of them was modified since the proposal was submitted.
+func (k Keeper) getProposalPolicyAndGroup(ctx sdk.Context, proposal types.Proposal) (types.GroupPolicyInfo, types.GroupInfo, error) {
+	policy, found := k.GetGroupPolicy(ctx, proposal.Address)
+	if !found {
+		return policy, types.GroupInfo{}, sdkerrors.Wrap(types.ErrGroupPolicyNotFound, proposal.Address.String())
+	}
+
+	group, found := k.GetGroup(ctx, policy.GroupID)
+	if !found {
+		return policy, group, sdkerrors.Wrapf(types.ErrGroupNotFound, "%d", policy.GroupID)
+	}
+
+	if policy.Version != proposal.GroupPolicyVersion || group.Version != proposal.GroupVersion {
+		return policy, group, sdkerrors.Wrapf(types.ErrModified, "proposal %d", proposal.ProposalID)
+	}
+
+	return policy, group, nil
+}
+
+// getGroupOfAdmin returns a group, or an error if it does not exist or is not
+// administered by admin.
+func (k Keeper) getGroupOfAdmin(ctx sdk.Context, admin sdk.AccAddress, groupID uint64) (types.GroupInfo, error) {
+	group, found := k.GetGroup(ctx, groupID)
+	if !found {
+		return group, sdkerrors.Wrapf(types.ErrGroupNotFound, "%d", groupID)
+	}
+	if !group.Admin.Equals(admin) {
+		return group, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of group %d", admin, groupID)
+	}
+
+	return group, nil
+}
+
+func (k Keeper) emitUpdateGroup(ctx sdk.Context, groupID uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateGroup,
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		),
+	)
+}
+
+// nextSequence increments the sequence stored under key and returns its new
+// value. The sequences start at 1.
+func (k Keeper) nextSequence(ctx sdk.Context, key []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	seq := sdk.BigEndianToUint64(store.Get(key)) + 1
+	store.Set(key, sdk.Uint64ToBigEndian(seq))
+	return seq
+}
+
+func (k Keeper) iterateGroupMembers(ctx sdk.Context, prefix []byte, cb func(types.GroupMember) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var gm types.GroupMember
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &gm)
+
+		if cb(gm) {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateVotes(ctx sdk.Context, prefix []byte, cb func(types.Vote) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+
+		if cb(vote) {
+			break
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	querier sdk.Querier
+
+	admin     sdk.AccAddress
+	member1   sdk.AccAddress
+	member2   sdk.AccAddress
+	member3   sdk.AccAddress
+	recipient sdk.AccAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(checkTx, abci.Header{Height: 1, Time: time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)})
+	suite.querier = keeper.NewQuerier(app.GroupKeeper)
+
+	suite.admin = sdk.AccAddress([]byte("admin_______________"))
+	suite.member1 = sdk.AccAddress([]byte("member1_____________"))
+	suite.member2 = sdk.AccAddress([]byte("member2_____________"))
+	suite.member3 = sdk.AccAddress([]byte("member3_____________"))
+	suite.recipient = sdk.AccAddress([]byte("recipient___________"))
+}
+
+// createGroupPolicy creates a group of the three members, with weights 1, 2
+// and 3, and a policy account of the group holding 1000atom.
+func (suite *KeeperTestSuite) createGroupPolicy(threshold int64) (uint64, sdk.AccAddress) {
+	ctx := suite.ctx
+	k := suite.app.GroupKeeper
+
+	members := types.Members{
+		types.NewMember(suite.member1, sdk.NewDec(1), ""),
+		types.NewMember(suite.member2, sdk.NewDec(2), ""),
+		types.NewMember(suite.member3, sdk.NewDec(3), ""),
+	}
+	groupID, err := k.CreateGroup(ctx, suite.admin, members, "group")
+	suite.Require().NoError(err)
+
+	policy := types.NewThresholdDecisionPolicy(sdk.NewDec(threshold), time.Hour)
+	address, err := k.CreateGroupPolicy(ctx, suite.admin, groupID, "policy", policy)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(ctx, address, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+
+	return groupID, address
+}
+
+func (suite *KeeperTestSuite) TestGroups() {
+	ctx := suite.ctx
+	k := suite.app.GroupKeeper
+
+	groupID, address := suite.createGroupPolicy(3)
+
+	group, found := k.GetGroup(ctx, groupID)
+	suite.Require().True(found)
+	suite.Equal(types.NewGroupInfo(groupID, suite.admin, "group", 1, sdk.NewDec(6)), group)
+
+	policy, found := k.GetGroupPolicy(ctx, address)
+	suite.Require().True(found)
+	suite.Equal(groupID, policy.GroupID)
+	suite.NotNil(suite.app.AccountKeeper.GetAccount(ctx, address))
+
+	// the policy accounts skip the addresses of existing accounts
+	taken := types.GroupPolicyAddress(k.GetGroupPolicySequence(ctx) + 1)
+	suite.app.AccountKeeper.SetAccount(ctx, suite.app.AccountKeeper.NewAccountWithAddress(ctx, taken))
+	address2, err := k.CreateGroupPolicy(ctx, suite.admin, groupID, "", types.NewPercentageDecisionPolicy(sdk.OneDec(), time.Hour))
+	suite.Require().NoError(err)
+	suite.NotEqual(taken, address2)
+
+	// only the admin can update the group
+	update := types.Members{types.NewMember(suite.member1, sdk.ZeroDec(), ""), types.NewMember(suite.admin, sdk.NewDec(4), "")}
+	suite.Require().Error(k.UpdateGroupMembers(ctx, suite.member1, groupID, update))
+	suite.Require().NoError(k.UpdateGroupMembers(ctx, suite.admin, groupID, update))
+
+	group, _ = k.GetGroup(ctx, groupID)
+	suite.Equal(uint64(2), group.Version)
+	suite.Equal(sdk.NewDec(9), group.TotalWeight)
+	_, found = k.GetGroupMember(ctx, groupID, suite.member1)
+	suite.False(found)
+
+	// members which are not in the group cannot be removed
+	err = k.UpdateGroupMembers(ctx, suite.admin, groupID, types.Members{types.NewMember(suite.member1, sdk.ZeroDec(), "")})
+	suite.Require().True(types.ErrNotMember.Is(err))
+
+	suite.Require().Error(k.UpdateGroupAdmin(ctx, suite.member2, groupID, suite.member2))
+	suite.Require().NoError(k.UpdateGroupAdmin(ctx, suite.admin, groupID, suite.member2))
+	group, _ = k.GetGroup(ctx, groupID)
+	suite.Equal(suite.member2, group.Admin)
+
+	// the policies of a group are indexed
+	var policies []sdk.AccAddress
+	k.IterateGroupPoliciesByGroup(ctx, groupID, func(p types.GroupPolicyInfo) bool {
+		policies = append(policies, p.Address)
+		return false
+	})
+	suite.ElementsMatch([]sdk.AccAddress{address, address2}, policies)
+}
+
+func (suite *KeeperTestSuite) TestProposalExecution() {
+	ctx := suite.ctx
+	k := suite.app.GroupKeeper
+
+	_, address := suite.createGroupPolicy(4)
+	send := bank.NewMsgSend(address, suite.recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+
+	// the proposers must be members, and the msgs signed by the policy account
+	_, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{suite.admin}, "", []sdk.Msg{send})
+	suite.Require().True(types.ErrNotMember.Is(err))
+	other := bank.NewMsgSend(suite.member1, suite.recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	_, err = k.SubmitProposal(ctx, address, []sdk.AccAddress{suite.member1}, "", []sdk.Msg{other})
+	suite.Require().Error(err)
+
+	proposalID, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{suite.member1}, "pay", []sdk.Msg{send})
+	suite.Require().NoError(err)
+
+	// the proposal cannot be executed before it is accepted
+	_, err = k.Exec(ctx, proposalID)
+	suite.Require().True(types.ErrProposalNotFinalized.Is(err))
+
+	suite.Require().NoError(k.Vote(ctx, proposalID, suite.member1, types.OptionYes, ""))
+	suite.Require().True(types.ErrAlreadyVoted.Is(k.Vote(ctx, proposalID, suite.member1, types.OptionNo, "")))
+	suite.Require().True(types.ErrNotMember.Is(k.Vote(ctx, proposalID, suite.admin, types.OptionYes, "")))
+
+	suite.Require().NoError(k.Vote(ctx, proposalID, suite.member3, types.OptionYes, ""))
+	proposal, _ := k.GetProposal(ctx, proposalID)
+	suite.Equal(types.StatusClosed, proposal.Status)
+	suite.Equal(types.ResultAccepted, proposal.Result)
+	suite.Equal(sdk.NewDec(4), proposal.VoteState.Yes)
+
+	// no more votes once the result is final
+	suite.Require().True(types.ErrInvalidProposalStatus.Is(k.Vote(ctx, proposalID, suite.member2, types.OptionNo, "")))
+
+	res, err := k.Exec(ctx, proposalID)
+	suite.Require().NoError(err)
+	suite.NotEmpty(res.Events)
+	proposal, _ = k.GetProposal(ctx, proposalID)
+	suite.Equal(types.ExecutorSuccess, proposal.ExecutorResult)
+	suite.Equal(sdk.NewInt(900), suite.app.BankKeeper.GetBalance(ctx, address, "atom").Amount)
+	suite.Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(ctx, suite.recipient, "atom").Amount)
+
+	// a proposal is executed once
+	_, err = k.Exec(ctx, proposalID)
+	suite.Require().True(types.ErrInvalidProposalStatus.Is(err))
+}
+
+func (suite *KeeperTestSuite) TestProposalExecutionFailure() {
+	ctx := suite.ctx
+	k := suite.app.GroupKeeper
+
+	_, address := suite.createGroupPolicy(1)
+
+	// the second msg fails, so the first one is reverted
+	msgs := []sdk.Msg{
+		bank.NewMsgSend(address, suite.recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", 100))),
+		bank.NewMsgSend(address, suite.recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))),
+	}
+	proposalID, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{suite.member1}, "", msgs)
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.Vote(ctx, proposalID, suite.member1, types.OptionYes, ""))
+
+	_, err = k.Exec(ctx, proposalID)
+	suite.Require().NoError(err)
+	proposal, _ := k.GetProposal(ctx, proposalID)
+	suite.Equal(types.ExecutorFailure, proposal.ExecutorResult)
+	suite.Equal(sdk.NewInt(1000), suite.app.BankKeeper.GetBalance(ctx, address, "atom").Amount)
+
+	// the execution can be retried once the account is funded
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(ctx, address, sdk.NewCoins(sdk.NewInt64Coin("atom", 1100))))
+	_, err = k.Exec(ctx, proposalID)
+	suite.Require().NoError(err)
+	proposal, _ = k.GetProposal(ctx, proposalID)
+	suite.Equal(types.ExecutorSuccess, proposal.ExecutorResult)
+	suite.Equal(sdk.NewInt(1100), suite.app.BankKeeper.GetBalance(ctx, suite.recipient, "atom").Amount)
+}
+
+func (suite *KeeperTestSuite) TestProposalRejectedOrAborted() {
+	ctx := suite.ctx
+	k := suite.app.GroupKeeper
+
+	groupID, address := suite.createGroupPolicy(4)
+	send := bank.NewMsgSend(address, suite.recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+
+	// the proposal is rejected once the voting period ends without enough yes
+	// votes
+	rejected, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{suite.member1}, "", []sdk.Msg{send})
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.Vote(ctx, rejected, suite.member3, types.OptionYes, ""))
+
+	endCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	suite.Require().True(types.ErrVotingPeriodEnded.Is(k.Vote(endCtx, rejected, suite.member1, types.OptionYes, "")))
+	_, err = k.Exec(endCtx, rejected)
+	suite.Require().NoError(err)
+	proposal, _ := k.GetProposal(endCtx, rejected)
+	suite.Equal(types.StatusClosed, proposal.Status)
+	suite.Equal(types.ResultRejected, proposal.Result)
+	suite.Equal(types.ExecutorNotRun, proposal.ExecutorResult)
+
+	// the proposal is aborted once the group is modified
+	aborted, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{suite.member1}, "", []sdk.Msg{send})
+	suite.Require().NoError(err)
+	update := types.Members{types.NewMember(suite.member1, sdk.NewDec(5), "")}
+	suite.Require().NoError(k.UpdateGroupMembers(ctx, suite.admin, groupID, update))
+
+	suite.Require().True(types.ErrModified.Is(k.Vote(ctx, aborted, suite.member1, types.OptionYes, "")))
+	_, err = k.Exec(ctx, aborted)
+	suite.Require().NoError(err)
+	proposal, _ = k.GetProposal(ctx, aborted)
+	suite.Equal(types.StatusAborted, proposal.Status)
+	suite.Equal(sdk.NewInt(1000), suite.app.BankKeeper.GetBalance(ctx, address, "atom").Amount)
+}
+
+func (suite *KeeperTestSuite) TestQuery() {
+	ctx := suite.ctx
+	k := suite.app.GroupKeeper
+	cdc := suite.app.Codec()
+
+	groupID, address := suite.createGroupPolicy(1)
+	proposalID, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{suite.member1}, "", nil)
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.Vote(ctx, proposalID, suite.member2, types.OptionNo, ""))
+
+	query := func(path string, params interface{}) ([]byte, error) {
+		req := abci.RequestQuery{
+			Path: strings.Join([]string{"custom", types.QuerierRoute, path}, "/"),
+			Data: cdc.MustMarshalJSON(params),
+		}
+		return suite.querier(ctx, []string{path}, req)
+	}
+
+	bz, err := query(types.QueryGroupMembers, types.NewQueryGroupParams(groupID))
+	suite.Require().NoError(err)
+	var members []types.GroupMember
+	suite.Require().NoError(cdc.UnmarshalJSON(bz, &members))
+	suite.Len(members, 3)
+
+	bz, err = query(types.QueryProposalsByGroupPolicy, types.NewQueryGroupPolicyParams(address))
+	suite.Require().NoError(err)
+	var proposals []types.Proposal
+	suite.Require().NoError(cdc.UnmarshalJSON(bz, &proposals))
+	suite.Require().Len(proposals, 1)
+	suite.Equal(proposalID, proposals[0].ProposalID)
+
+	bz, err = query(types.QueryVote, types.NewQueryVoteParams(proposalID, suite.member2))
+	suite.Require().NoError(err)
+	var vote types.Vote
+	suite.Require().NoError(cdc.UnmarshalJSON(bz, &vote))
+	suite.Equal(types.OptionNo, vote.Option)
+
+	_, err = query(types.QueryVote, types.NewQueryVoteParams(proposalID, suite.member3))
+	suite.Require().Error(err)
+	_, err = query(types.QueryGroup, types.NewQueryGroupParams(groupID+1))
+	suite.Require().Error(err)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
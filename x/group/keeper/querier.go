@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// NewQuerier returns a new sdk.Querier for the group module.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		var (
+			res []byte
+			err error
+		)
+
+		switch path[0] {
+		case types.QueryGroup:
+			res, err = queryGroup(ctx, req, k)
+
+		case types.QueryGroupMembers:
+			res, err = queryGroupMembers(ctx, req, k)
+
+		case types.QueryGroupsByAdmin:
+			res, err = queryGroupsByAdmin(ctx, req, k)
+
+		case types.QueryGroupPolicy:
+			res, err = queryGroupPolicy(ctx, req, k)
+
+		case types.QueryGroupPoliciesByGroup:
+			res, err = queryGroupPoliciesByGroup(ctx, req, k)
+
+		case types.QueryProposal:
+			res, err = queryProposal(ctx, req, k)
+
+		case types.QueryProposalsByGroupPolicy:
+			res, err = queryProposalsByGroupPolicy(ctx, req, k)
+
+		case types.QueryVote:
+			res, err = queryVote(ctx, req, k)
+
+		case types.QueryVotesByProposal:
+			res, err = queryVotesByProposal(ctx, req, k)
+
+		default:
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+
+		return res, err
+	}
+}
+
+func queryGroup(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	group, found := k.GetGroup(ctx, params.GroupID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGroupNotFound, "%d", params.GroupID)
+	}
+
+	return marshalJSON(k, group)
+}
+
+func queryGroupMembers(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	members := []types.GroupMember{}
+	k.IterateGroupMembers(ctx, params.GroupID, func(gm types.GroupMember) bool {
+		members = append(members, gm)
+		return false
+	})
+
+	return marshalJSON(k, members)
+}
+
+func queryGroupsByAdmin(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupsByAdminParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	groups := []types.GroupInfo{}
+	k.IterateGroups(ctx, func(group types.GroupInfo) bool {
+		if group.Admin.Equals(params.Admin) {
+			groups = append(groups, group)
+		}
+		return false
+	})
+
+	return marshalJSON(k, groups)
+}
+
+func queryGroupPolicy(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupPolicyParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	policy, found := k.GetGroupPolicy(ctx, params.Address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrGroupPolicyNotFound, params.Address.String())
+	}
+
+	return marshalJSON(k, policy)
+}
+
+func queryGroupPoliciesByGroup(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	policies := []types.GroupPolicyInfo{}
+	k.IterateGroupPoliciesByGroup(ctx, params.GroupID, func(policy types.GroupPolicyInfo) bool {
+		policies = append(policies, policy)
+		return false
+	})
+
+	return marshalJSON(k, policies)
+}
+
+func queryProposal(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposal, found := k.GetProposal(ctx, params.ProposalID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrProposalNotFound, "%d", params.ProposalID)
+	}
+
+	return marshalJSON(k, proposal)
+}
+
+func queryProposalsByGroupPolicy(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupPolicyParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposals := []types.Proposal{}
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		if proposal.Address.Equals(params.Address) {
+			proposals = append(proposals, proposal)
+		}
+		return false
+	})
+
+	return marshalJSON(k, proposals)
+}
+
+func queryVote(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryVoteParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	vote, found := k.GetVote(ctx, params.ProposalID, params.Voter)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrVoteNotFound, "voter %s on proposal %d", params.Voter, params.ProposalID)
+	}
+
+	return marshalJSON(k, vote)
+}
+
+func queryVotesByProposal(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	votes := []types.Vote{}
+	k.IterateVotes(ctx, params.ProposalID, func(vote types.Vote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+
+	return marshalJSON(k, votes)
+}
+
+func marshalJSON(k Keeper, o interface{}) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, o)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
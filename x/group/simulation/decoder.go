@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding group type
+func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.GroupSeqKey),
+		bytes.Equal(kvA.Key[:1], types.GroupPolicySeqKey),
+		bytes.Equal(kvA.Key[:1], types.ProposalSeqKey):
+		return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+	case bytes.Equal(kvA.Key[:1], types.GroupKeyPrefix):
+		var groupA, groupB types.GroupInfo
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &groupA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &groupB)
+		return fmt.Sprintf("%v\n%v", groupA, groupB)
+
+	case bytes.Equal(kvA.Key[:1], types.GroupMemberKeyPrefix):
+		var memberA, memberB types.GroupMember
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &memberA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &memberB)
+		return fmt.Sprintf("%v\n%v", memberA, memberB)
+
+	case bytes.Equal(kvA.Key[:1], types.GroupPolicyKeyPrefix):
+		var policyA, policyB types.GroupPolicyInfo
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &policyA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &policyB)
+		return fmt.Sprintf("%v\n%v", policyA, policyB)
+
+	case bytes.Equal(kvA.Key[:1], types.GroupPolicyByGroupKeyPrefix):
+		return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+	case bytes.Equal(kvA.Key[:1], types.ProposalKeyPrefix):
+		var proposalA, proposalB types.Proposal
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &proposalA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &proposalB)
+		return fmt.Sprintf("%v\n%v", proposalA, proposalB)
+
+	case bytes.Equal(kvA.Key[:1], types.VoteKeyPrefix):
+		var voteA, voteB types.Vote
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &voteA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &voteB)
+		return fmt.Sprintf("%v\n%v", voteA, voteB)
+
+	default:
+		panic(fmt.Sprintf("invalid group key %X", kvA.Key))
+	}
+}
@@ -0,0 +1,85 @@
+package simulation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var (
+	adminAddr  = sdk.AccAddress([]byte("admin_______________"))
+	memberAddr = sdk.AccAddress([]byte("member______________"))
+	policyAddr = types.GroupPolicyAddress(1)
+)
+
+func makeTestCodec() (cdc *codec.Codec) {
+	cdc = codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	return
+}
+
+func TestDecodeStore(t *testing.T) {
+	cdc := makeTestCodec()
+	submitTime := time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	group := types.NewGroupInfo(1, adminAddr, "group", 1, sdk.OneDec())
+	member := types.NewGroupMember(1, types.NewMember(memberAddr, sdk.OneDec(), "member"))
+	policy := types.NewGroupPolicyInfo(
+		policyAddr, 1, adminAddr, "policy", 1, types.NewThresholdDecisionPolicy(sdk.OneDec(), time.Hour),
+	)
+	proposal := types.Proposal{
+		ProposalID:      1,
+		Address:         policyAddr,
+		Proposers:       []sdk.AccAddress{memberAddr},
+		SubmitTime:      submitTime,
+		Status:          types.StatusSubmitted,
+		VoteState:       types.EmptyTallyResult(),
+		VotingPeriodEnd: submitTime.Add(time.Hour),
+	}
+	vote := types.NewVote(1, memberAddr, types.OptionYes, "", submitTime)
+
+	kvPairs := tmkv.Pairs{
+		tmkv.Pair{Key: types.GroupSeqKey, Value: sdk.Uint64ToBigEndian(1)},
+		tmkv.Pair{Key: types.GroupKey(1), Value: cdc.MustMarshalBinaryBare(group)},
+		tmkv.Pair{Key: types.GroupMemberKey(1, memberAddr), Value: cdc.MustMarshalBinaryBare(member)},
+		tmkv.Pair{Key: types.GroupPolicyKey(policyAddr), Value: cdc.MustMarshalBinaryBare(policy)},
+		tmkv.Pair{Key: types.GroupPolicyByGroupKey(1, policyAddr), Value: policyAddr.Bytes()},
+		tmkv.Pair{Key: types.ProposalKey(1), Value: cdc.MustMarshalBinaryBare(proposal)},
+		tmkv.Pair{Key: types.VoteKey(1, memberAddr), Value: cdc.MustMarshalBinaryBare(vote)},
+		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"sequence", "1\n1"},
+		{"GroupInfo", fmt.Sprintf("%v\n%v", group, group)},
+		{"GroupMember", fmt.Sprintf("%v\n%v", member, member)},
+		{"GroupPolicyInfo", fmt.Sprintf("%v\n%v", policy, policy)},
+		{"GroupPolicyByGroup", fmt.Sprintf("%v\n%v", policyAddr, policyAddr)},
+		{"Proposal", fmt.Sprintf("%v\n%v", proposal, proposal)},
+		{"Vote", fmt.Sprintf("%v\n%v", vote, vote)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { DecodeStore(cdc, kvPairs[i], kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, DecodeStore(cdc, kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// RandomizedGenState generates a random GenesisState for group. The groups are
+// created by the simulation operations, so it has none.
+func RandomizedGenState(simState *module.SimulationState) {
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
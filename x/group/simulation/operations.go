@@ -0,0 +1,341 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/exported"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateGroup        = "op_weight_msg_create_group"
+	OpWeightMsgUpdateGroupMembers = "op_weight_msg_update_group_members"
+	OpWeightMsgCreateGroupPolicy  = "op_weight_msg_create_group_policy"
+	OpWeightMsgSubmitProposal     = "op_weight_msg_submit_group_proposal"
+	OpWeightMsgVote               = "op_weight_msg_group_vote"
+	OpWeightMsgExec               = "op_weight_msg_group_exec"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc *codec.Codec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var weightMsgCreateGroup int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateGroup, &weightMsgCreateGroup, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateGroup = simappparams.DefaultWeightMsgCreateGroup
+		},
+	)
+
+	var weightMsgUpdateGroupMembers int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateGroupMembers, &weightMsgUpdateGroupMembers, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateGroupMembers = simappparams.DefaultWeightMsgUpdateGroupMembers
+		},
+	)
+
+	var weightMsgCreateGroupPolicy int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateGroupPolicy, &weightMsgCreateGroupPolicy, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateGroupPolicy = simappparams.DefaultWeightMsgCreateGroupPolicy
+		},
+	)
+
+	var weightMsgSubmitProposal int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitProposal, &weightMsgSubmitProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitProposal = simappparams.DefaultWeightMsgSubmitGroupProposal
+		},
+	)
+
+	var weightMsgVote int
+	appParams.GetOrGenerate(cdc, OpWeightMsgVote, &weightMsgVote, nil,
+		func(_ *rand.Rand) {
+			weightMsgVote = simappparams.DefaultWeightMsgGroupVote
+		},
+	)
+
+	var weightMsgExec int
+	appParams.GetOrGenerate(cdc, OpWeightMsgExec, &weightMsgExec, nil,
+		func(_ *rand.Rand) {
+			weightMsgExec = simappparams.DefaultWeightMsgGroupExec
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateGroup,
+			SimulateMsgCreateGroup(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateGroupMembers,
+			SimulateMsgUpdateGroupMembers(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateGroupPolicy,
+			SimulateMsgCreateGroupPolicy(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSubmitProposal,
+			SimulateMsgSubmitProposal(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVote,
+			SimulateMsgVote(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgExec,
+			SimulateMsgExec(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateGroup generates a MsgCreateGroup with random members among
+// the simulation accounts.
+func SimulateMsgCreateGroup(ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		admin, _ := simtypes.RandomAcc(r, accs)
+
+		var members types.Members
+		seen := make(map[string]bool)
+		for i := simtypes.RandIntBetween(r, 1, 5); i > 0; i-- {
+			member, _ := simtypes.RandomAcc(r, accs)
+			if seen[member.Address.String()] {
+				continue
+			}
+			seen[member.Address.String()] = true
+
+			weight := sdk.NewDec(int64(simtypes.RandIntBetween(r, 1, 10)))
+			members = append(members, types.NewMember(member.Address, weight, simtypes.RandStringOfLength(r, 10)))
+		}
+
+		msg := types.NewMsgCreateGroup(admin.Address, members, simtypes.RandStringOfLength(r, 10))
+		return deliver(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// SimulateMsgUpdateGroupMembers generates a MsgUpdateGroupMembers changing the
+// weight of a member of a group administered by a simulation account.
+func SimulateMsgUpdateGroupMembers(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		group, admin, found := randomGroup(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		var member types.Member
+		k.IterateGroupMembers(ctx, group.GroupID, func(gm types.GroupMember) bool {
+			member = gm.Member
+			return r.Intn(2) == 0
+		})
+		if member.Address.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		member.Weight = sdk.NewDec(int64(simtypes.RandIntBetween(r, 1, 10)))
+		msg := types.NewMsgUpdateGroupMembers(admin.Address, group.GroupID, types.Members{member})
+		return deliver(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// SimulateMsgCreateGroupPolicy generates a MsgCreateGroupPolicy with a random
+// decision policy, for a group administered by a simulation account.
+func SimulateMsgCreateGroupPolicy(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		group, admin, found := randomGroup(r, ctx, k, accs)
+		if !found || !group.TotalWeight.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		votingPeriod := time.Duration(simtypes.RandIntBetween(r, 1, 60)) * time.Minute
+
+		var decisionPolicy exported.DecisionPolicy
+		if r.Intn(2) == 0 {
+			threshold := sdk.NewDec(int64(simtypes.RandIntBetween(r, 1, int(group.TotalWeight.TruncateInt64())+1)))
+			decisionPolicy = types.NewThresholdDecisionPolicy(threshold, votingPeriod)
+		} else {
+			percentage := sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 101)), 2)
+			decisionPolicy = types.NewPercentageDecisionPolicy(percentage, votingPeriod)
+		}
+
+		msg := types.NewMsgCreateGroupPolicy(admin.Address, group.GroupID, simtypes.RandStringOfLength(r, 10), decisionPolicy)
+		return deliver(r, app, ctx, ak, bk, chainID, msg, admin)
+	}
+}
+
+// SimulateMsgSubmitProposal generates a MsgSubmitProposal sending some of the
+// coins of a group policy account, proposed by a member of its group.
+func SimulateMsgSubmitProposal(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			policy   types.GroupPolicyInfo
+			proposer simtypes.Account
+			found    bool
+		)
+
+		k.IterateGroupPolicies(ctx, func(p types.GroupPolicyInfo) bool {
+			k.IterateGroupMembers(ctx, p.GroupID, func(gm types.GroupMember) bool {
+				proposer, found = simtypes.FindAccount(accs, gm.Member.Address)
+				return found
+			})
+			policy = p
+			return found && r.Intn(2) == 0
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		var msgs []sdk.Msg
+		amount := simtypes.RandSubsetCoins(r, bk.SpendableCoins(ctx, policy.Address))
+		if !amount.Empty() && bk.IsSendEnabledCoins(ctx, amount...) == nil {
+			recipient, _ := simtypes.RandomAcc(r, accs)
+			msgs = append(msgs, banktypes.NewMsgSend(policy.Address, recipient.Address, amount))
+		}
+
+		msg := types.NewMsgSubmitProposal(
+			policy.Address, []sdk.AccAddress{proposer.Address}, simtypes.RandStringOfLength(r, 10), msgs,
+		)
+		return deliver(r, app, ctx, ak, bk, chainID, msg, proposer)
+	}
+}
+
+// SimulateMsgVote generates a MsgVote with a random option, cast by a member
+// of the group of a proposal which can be voted on.
+func SimulateMsgVote(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			proposal types.Proposal
+			voter    simtypes.Account
+			found    bool
+		)
+
+		k.IterateProposals(ctx, func(p types.Proposal) bool {
+			if p.Status != types.StatusSubmitted || !ctx.BlockTime().Before(p.VotingPeriodEnd) {
+				return false
+			}
+
+			policy, _ := k.GetGroupPolicy(ctx, p.Address)
+			group, _ := k.GetGroup(ctx, policy.GroupID)
+			if policy.Version != p.GroupPolicyVersion || group.Version != p.GroupVersion {
+				return false
+			}
+
+			k.IterateGroupMembers(ctx, group.GroupID, func(gm types.GroupMember) bool {
+				if _, voted := k.GetVote(ctx, p.ProposalID, gm.Member.Address); voted {
+					return false
+				}
+				voter, found = simtypes.FindAccount(accs, gm.Member.Address)
+				return found
+			})
+			proposal = p
+			return found
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		options := []types.VoteOption{types.OptionYes, types.OptionAbstain, types.OptionNo, types.OptionNoWithVeto}
+		msg := types.NewMsgVote(proposal.ProposalID, voter.Address, options[r.Intn(len(options))], "")
+		return deliver(r, app, ctx, ak, bk, chainID, msg, voter)
+	}
+}
+
+// SimulateMsgExec generates a MsgExec, sent by a random account, of a proposal
+// which is accepted or whose voting period has ended.
+func SimulateMsgExec(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			proposal types.Proposal
+			found    bool
+		)
+
+		k.IterateProposals(ctx, func(p types.Proposal) bool {
+			switch p.Status {
+			case types.StatusSubmitted:
+				found = !ctx.BlockTime().Before(p.VotingPeriodEnd)
+			case types.StatusClosed:
+				found = p.Result == types.ResultAccepted && p.ExecutorResult != types.ExecutorSuccess
+			}
+			proposal = p
+			return found
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		executor, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgExec(proposal.ProposalID, executor.Address)
+		return deliver(r, app, ctx, ak, bk, chainID, msg, executor)
+	}
+}
+
+// randomGroup returns a random group administered by a simulation account, and
+// its admin.
+func randomGroup(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+) (group types.GroupInfo, admin simtypes.Account, found bool) {
+	k.IterateGroups(ctx, func(g types.GroupInfo) bool {
+		if acc, ok := simtypes.FindAccount(accs, g.Admin); ok {
+			group, admin, found = g, acc, true
+		}
+		return found && r.Intn(2) == 0
+	})
+
+	return group, admin, found
+}
+
+// deliver signs msg with the key of signer, paying random fees, and delivers
+// it.
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	chainID string, msg sdk.Msg, signer simtypes.Account,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, signer.Address)
+	spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+	fees, err := simtypes.RandomFees(r, ctx, spendable)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName), nil, err
+	}
+
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		signer.PrivKey,
+	)
+
+	_, _, err = app.Deliver(tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
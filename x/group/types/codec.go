@@ -0,0 +1,36 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/group/exported"
+)
+
+// RegisterCodec registers the necessary x/group interfaces and concrete types
+// on the provided Amino codec.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*exported.DecisionPolicy)(nil), nil)
+	cdc.RegisterConcrete(&ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy", nil)
+	cdc.RegisterConcrete(&PercentageDecisionPolicy{}, "cosmos-sdk/PercentageDecisionPolicy", nil)
+
+	cdc.RegisterConcrete(MsgCreateGroup{}, "cosmos-sdk/MsgCreateGroup", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupMembers{}, "cosmos-sdk/MsgUpdateGroupMembers", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupAdmin{}, "cosmos-sdk/MsgUpdateGroupAdmin", nil)
+	cdc.RegisterConcrete(MsgCreateGroupPolicy{}, "cosmos-sdk/MsgCreateGroupPolicy", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupPolicyDecisionPolicy{}, "cosmos-sdk/MsgUpdateGroupPolicyDecisionPolicy", nil)
+	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/group/MsgSubmitProposal", nil)
+	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/group/MsgVote", nil)
+	cdc.RegisterConcrete(MsgExec{}, "cosmos-sdk/group/MsgExec", nil)
+}
+
+// ModuleCdc references the global x/group module codec. The decision policies
+// are interfaces, so the module is serialized with Amino, both in the store
+// and in JSON. The msgs of the proposals are not registered on it: the
+// proposals are serialized with the codec of the application.
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/group module sentinel errors
+var (
+	ErrGroupNotFound         = sdkerrors.Register(ModuleName, 2, "group not found")
+	ErrGroupPolicyNotFound   = sdkerrors.Register(ModuleName, 3, "group policy not found")
+	ErrProposalNotFound      = sdkerrors.Register(ModuleName, 4, "proposal not found")
+	ErrInvalidMember         = sdkerrors.Register(ModuleName, 5, "invalid member")
+	ErrInvalidDecisionPolicy = sdkerrors.Register(ModuleName, 6, "invalid decision policy")
+	ErrInvalidVoteOption     = sdkerrors.Register(ModuleName, 7, "invalid vote option")
+	ErrNotMember             = sdkerrors.Register(ModuleName, 8, "not a member of the group")
+	ErrAlreadyVoted          = sdkerrors.Register(ModuleName, 9, "already voted")
+	ErrVotingPeriodEnded     = sdkerrors.Register(ModuleName, 10, "voting period ended")
+	ErrModified              = sdkerrors.Register(ModuleName, 11, "group or group policy modified since the proposal submission")
+	ErrInvalidProposalStatus = sdkerrors.Register(ModuleName, 12, "invalid proposal status")
+	ErrProposalNotFinalized  = sdkerrors.Register(ModuleName, 13, "proposal not finalized")
+	ErrVoteNotFound          = sdkerrors.Register(ModuleName, 14, "vote not found")
+)
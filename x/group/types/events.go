@@ -0,0 +1,21 @@
+package types
+
+// group module event types
+const (
+	EventTypeCreateGroup       = "create_group"
+	EventTypeUpdateGroup       = "update_group"
+	EventTypeCreateGroupPolicy = "create_group_policy"
+	EventTypeUpdateGroupPolicy = "update_group_policy"
+	EventTypeSubmitProposal    = "submit_proposal"
+	EventTypeVote              = "vote"
+	EventTypeExec              = "exec"
+
+	AttributeValueCategory = ModuleName
+
+	AttributeKeyGroupID        = "group_id"
+	AttributeKeyAddress        = "address"
+	AttributeKeyProposalID     = "proposal_id"
+	AttributeKeyVoter          = "voter"
+	AttributeKeyOption         = "option"
+	AttributeKeyExecutorResult = "executor_result"
+)
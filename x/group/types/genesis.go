@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState defines the group module's genesis state.
+type GenesisState struct {
+	GroupSeq       uint64            `json:"group_seq" yaml:"group_seq"`
+	Groups         []GroupInfo       `json:"groups" yaml:"groups"`
+	GroupMembers   []GroupMember     `json:"group_members" yaml:"group_members"`
+	GroupPolicySeq uint64            `json:"group_policy_seq" yaml:"group_policy_seq"`
+	GroupPolicies  []GroupPolicyInfo `json:"group_policies" yaml:"group_policies"`
+	ProposalSeq    uint64            `json:"proposal_seq" yaml:"proposal_seq"`
+	Proposals      []Proposal        `json:"proposals" yaml:"proposals"`
+	Votes          []Vote            `json:"votes" yaml:"votes"`
+}
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(
+	groupSeq uint64, groups []GroupInfo, groupMembers []GroupMember,
+	groupPolicySeq uint64, groupPolicies []GroupPolicyInfo,
+	proposalSeq uint64, proposals []Proposal, votes []Vote,
+) GenesisState {
+	return GenesisState{
+		GroupSeq:       groupSeq,
+		Groups:         groups,
+		GroupMembers:   groupMembers,
+		GroupPolicySeq: groupPolicySeq,
+		GroupPolicies:  groupPolicies,
+		ProposalSeq:    proposalSeq,
+		Proposals:      proposals,
+		Votes:          votes,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state, without any group.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(0, []GroupInfo{}, []GroupMember{}, 0, []GroupPolicyInfo{}, 0, []Proposal{}, []Vote{})
+}
+
+// Validate performs basic genesis state validation, returning an error upon
+// any failure.
+func (gs GenesisState) Validate() error {
+	groups := make(map[uint64]bool, len(gs.Groups))
+	for _, g := range gs.Groups {
+		if err := g.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid group %d: %w", g.GroupID, err)
+		}
+		if g.GroupID > gs.GroupSeq {
+			return fmt.Errorf("group id %d is greater than the group sequence %d", g.GroupID, gs.GroupSeq)
+		}
+		groups[g.GroupID] = true
+	}
+
+	for _, gm := range gs.GroupMembers {
+		if !groups[gm.GroupID] {
+			return fmt.Errorf("member %s of unknown group %d", gm.Member.Address, gm.GroupID)
+		}
+		if err := gm.Member.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid member of group %d: %w", gm.GroupID, err)
+		}
+	}
+
+	policies := make(map[string]bool, len(gs.GroupPolicies))
+	for _, p := range gs.GroupPolicies {
+		if err := p.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid group policy %s: %w", p.Address, err)
+		}
+		if !groups[p.GroupID] {
+			return fmt.Errorf("group policy %s of unknown group %d", p.Address, p.GroupID)
+		}
+		policies[p.Address.String()] = true
+	}
+
+	proposals := make(map[uint64]bool, len(gs.Proposals))
+	for _, p := range gs.Proposals {
+		if err := p.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid proposal %d: %w", p.ProposalID, err)
+		}
+		if p.ProposalID > gs.ProposalSeq {
+			return fmt.Errorf("proposal id %d is greater than the proposal sequence %d", p.ProposalID, gs.ProposalSeq)
+		}
+		if !policies[p.Address.String()] {
+			return fmt.Errorf("proposal %d of unknown group policy %s", p.ProposalID, p.Address)
+		}
+		proposals[p.ProposalID] = true
+	}
+
+	for _, v := range gs.Votes {
+		if err := v.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid vote of %s: %w", v.Voter, err)
+		}
+		if !proposals[v.ProposalID] {
+			return fmt.Errorf("vote of %s on unknown proposal %d", v.Voter, v.ProposalID)
+		}
+	}
+
+	return nil
+}
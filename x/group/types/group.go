@@ -0,0 +1,198 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/exported"
+)
+
+// MaxMetadataLength is the maximum length of the metadata of the groups, group
+// members, group policies, proposals and votes.
+const MaxMetadataLength = 255
+
+// GroupInfo is a group of weighted members, administered by an account. Its
+// version is incremented each time its members change, so that the pending
+// proposals of its policies are not decided on outdated weights.
+type GroupInfo struct {
+	GroupID     uint64         `json:"group_id" yaml:"group_id"`
+	Admin       sdk.AccAddress `json:"admin" yaml:"admin"`
+	Metadata    string         `json:"metadata" yaml:"metadata"`
+	Version     uint64         `json:"version" yaml:"version"`
+	TotalWeight sdk.Dec        `json:"total_weight" yaml:"total_weight"`
+}
+
+// NewGroupInfo returns a new GroupInfo.
+func NewGroupInfo(groupID uint64, admin sdk.AccAddress, metadata string, version uint64, totalWeight sdk.Dec) GroupInfo {
+	return GroupInfo{
+		GroupID:     groupID,
+		Admin:       admin,
+		Metadata:    metadata,
+		Version:     version,
+		TotalWeight: totalWeight,
+	}
+}
+
+// ValidateBasic performs basic validation of the group.
+func (g GroupInfo) ValidateBasic() error {
+	if g.GroupID == 0 {
+		return sdkerrors.Wrap(ErrGroupNotFound, "group id cannot be 0")
+	}
+	if g.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if g.TotalWeight.IsNil() || g.TotalWeight.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidMember, "total weight cannot be negative")
+	}
+
+	return validateMetadata(g.Metadata)
+}
+
+// String implements the Stringer interface.
+func (g GroupInfo) String() string {
+	out, _ := yaml.Marshal(g)
+	return string(out)
+}
+
+// Member is a weighted member of a group.
+type Member struct {
+	Address  sdk.AccAddress `json:"address" yaml:"address"`
+	Weight   sdk.Dec        `json:"weight" yaml:"weight"`
+	Metadata string         `json:"metadata" yaml:"metadata"`
+}
+
+// NewMember returns a new Member.
+func NewMember(address sdk.AccAddress, weight sdk.Dec, metadata string) Member {
+	return Member{
+		Address:  address,
+		Weight:   weight,
+		Metadata: metadata,
+	}
+}
+
+// ValidateBasic performs basic validation of the member. A member with a zero
+// weight is valid, it is removed from its group.
+func (m Member) ValidateBasic() error {
+	if m.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing member address")
+	}
+	if m.Weight.IsNil() || m.Weight.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidMember, "weight of %s cannot be negative", m.Address)
+	}
+
+	return validateMetadata(m.Metadata)
+}
+
+// Members is a list of group members.
+type Members []Member
+
+// ValidateBasic performs basic validation of the members, which must have
+// distinct addresses.
+func (ms Members) ValidateBasic() error {
+	seen := make(map[string]bool, len(ms))
+	for _, m := range ms {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+
+		addr := m.Address.String()
+		if seen[addr] {
+			return sdkerrors.Wrapf(ErrInvalidMember, "duplicate member %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// GroupMember is a member of a group, as it is stored by the keeper.
+type GroupMember struct {
+	GroupID uint64 `json:"group_id" yaml:"group_id"`
+	Member  Member `json:"member" yaml:"member"`
+}
+
+// NewGroupMember returns a new GroupMember.
+func NewGroupMember(groupID uint64, member Member) GroupMember {
+	return GroupMember{
+		GroupID: groupID,
+		Member:  member,
+	}
+}
+
+// String implements the Stringer interface.
+func (gm GroupMember) String() string {
+	out, _ := yaml.Marshal(gm)
+	return string(out)
+}
+
+// GroupPolicyInfo is an account of a group, whose msgs are executed by the
+// proposals accepted by the group under its decision policy. Its version is
+// incremented each time its decision policy changes.
+type GroupPolicyInfo struct {
+	Address        sdk.AccAddress          `json:"address" yaml:"address"`
+	GroupID        uint64                  `json:"group_id" yaml:"group_id"`
+	Admin          sdk.AccAddress          `json:"admin" yaml:"admin"`
+	Metadata       string                  `json:"metadata" yaml:"metadata"`
+	Version        uint64                  `json:"version" yaml:"version"`
+	DecisionPolicy exported.DecisionPolicy `json:"decision_policy" yaml:"decision_policy"`
+}
+
+// NewGroupPolicyInfo returns a new GroupPolicyInfo.
+func NewGroupPolicyInfo(
+	address sdk.AccAddress, groupID uint64, admin sdk.AccAddress, metadata string,
+	version uint64, decisionPolicy exported.DecisionPolicy,
+) GroupPolicyInfo {
+	return GroupPolicyInfo{
+		Address:        address,
+		GroupID:        groupID,
+		Admin:          admin,
+		Metadata:       metadata,
+		Version:        version,
+		DecisionPolicy: decisionPolicy,
+	}
+}
+
+// ValidateBasic performs basic validation of the group policy.
+func (p GroupPolicyInfo) ValidateBasic() error {
+	if p.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing group policy address")
+	}
+	if p.GroupID == 0 {
+		return sdkerrors.Wrap(ErrGroupNotFound, "group id cannot be 0")
+	}
+	if p.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if p.DecisionPolicy == nil {
+		return sdkerrors.Wrap(ErrInvalidDecisionPolicy, "missing decision policy")
+	}
+	if err := p.DecisionPolicy.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return validateMetadata(p.Metadata)
+}
+
+// String implements the Stringer interface.
+func (p GroupPolicyInfo) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// GroupPolicyAddress returns the address of the account of the group policy
+// created with the given sequence number.
+func GroupPolicyAddress(seq uint64) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/policy/%d", ModuleName, seq))))
+}
+
+func validateMetadata(metadata string) error {
+	if len(metadata) > MaxMetadataLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "metadata cannot be longer than %d characters", MaxMetadataLength)
+	}
+
+	return nil
+}
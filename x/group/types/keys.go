@@ -0,0 +1,93 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "group"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore keys
+var (
+	GroupSeqKey       = []byte{0x00}
+	GroupPolicySeqKey = []byte{0x01}
+	ProposalSeqKey    = []byte{0x02}
+
+	// GroupKeyPrefix is the prefix of the groups, stored by id
+	GroupKeyPrefix = []byte{0x10}
+	// GroupMemberKeyPrefix is the prefix of the group members, stored by
+	// group id and then address
+	GroupMemberKeyPrefix = []byte{0x11}
+
+	// GroupPolicyKeyPrefix is the prefix of the group policies, stored by
+	// address
+	GroupPolicyKeyPrefix = []byte{0x20}
+	// GroupPolicyByGroupKeyPrefix is the prefix of the index of the group
+	// policies by group id
+	GroupPolicyByGroupKeyPrefix = []byte{0x21}
+
+	// ProposalKeyPrefix is the prefix of the proposals, stored by id
+	ProposalKeyPrefix = []byte{0x30}
+	// VoteKeyPrefix is the prefix of the votes, stored by proposal id and
+	// then voter
+	VoteKeyPrefix = []byte{0x31}
+)
+
+// GroupKey returns the key of a group: 0x10<groupID>.
+func GroupKey(groupID uint64) []byte {
+	return append(GroupKeyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GroupMembersPrefix returns the prefix of the members of a group:
+// 0x11<groupID>.
+func GroupMembersPrefix(groupID uint64) []byte {
+	return append(GroupMemberKeyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GroupMemberKey returns the key of a group member: 0x11<groupID><address>.
+func GroupMemberKey(groupID uint64, member sdk.AccAddress) []byte {
+	return append(GroupMembersPrefix(groupID), member.Bytes()...)
+}
+
+// GroupPolicyKey returns the key of a group policy: 0x20<address>.
+func GroupPolicyKey(address sdk.AccAddress) []byte {
+	return append(GroupPolicyKeyPrefix, address.Bytes()...)
+}
+
+// GroupPoliciesByGroupPrefix returns the prefix of the index of the policies
+// of a group: 0x21<groupID>.
+func GroupPoliciesByGroupPrefix(groupID uint64) []byte {
+	return append(GroupPolicyByGroupKeyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GroupPolicyByGroupKey returns the key of the index of a group policy by
+// group id: 0x21<groupID><address>.
+func GroupPolicyByGroupKey(groupID uint64, address sdk.AccAddress) []byte {
+	return append(GroupPoliciesByGroupPrefix(groupID), address.Bytes()...)
+}
+
+// ProposalKey returns the key of a proposal: 0x30<proposalID>.
+func ProposalKey(proposalID uint64) []byte {
+	return append(ProposalKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// VotesPrefix returns the prefix of the votes on a proposal: 0x31<proposalID>.
+func VotesPrefix(proposalID uint64) []byte {
+	return append(VoteKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// VoteKey returns the key of a vote: 0x31<proposalID><voter>.
+func VoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(VotesPrefix(proposalID), voter.Bytes()...)
+}
@@ -0,0 +1,471 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/exported"
+)
+
+// group message types
+const (
+	TypeMsgCreateGroup                     = "create_group"
+	TypeMsgUpdateGroupMembers              = "update_group_members"
+	TypeMsgUpdateGroupAdmin                = "update_group_admin"
+	TypeMsgCreateGroupPolicy               = "create_group_policy"
+	TypeMsgUpdateGroupPolicyDecisionPolicy = "update_group_policy_decision_policy"
+	TypeMsgSubmitProposal                  = "submit_proposal"
+	TypeMsgVote                            = "vote"
+	TypeMsgExec                            = "exec"
+)
+
+var (
+	_ sdk.Msg = MsgCreateGroup{}
+	_ sdk.Msg = MsgUpdateGroupMembers{}
+	_ sdk.Msg = MsgUpdateGroupAdmin{}
+	_ sdk.Msg = MsgCreateGroupPolicy{}
+	_ sdk.Msg = MsgUpdateGroupPolicyDecisionPolicy{}
+	_ sdk.Msg = MsgSubmitProposal{}
+	_ sdk.Msg = MsgVote{}
+	_ sdk.Msg = MsgExec{}
+)
+
+// MsgCreateGroup creates a group with the given members, administered by the
+// signer.
+type MsgCreateGroup struct {
+	Admin    sdk.AccAddress `json:"admin" yaml:"admin"`
+	Members  Members        `json:"members" yaml:"members"`
+	Metadata string         `json:"metadata" yaml:"metadata"`
+}
+
+// NewMsgCreateGroup returns a new MsgCreateGroup.
+func NewMsgCreateGroup(admin sdk.AccAddress, members Members, metadata string) MsgCreateGroup {
+	return MsgCreateGroup{
+		Admin:    admin,
+		Members:  members,
+		Metadata: metadata,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateGroup) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateGroup) Type() string { return TypeMsgCreateGroup }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateGroup) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if err := msg.Members.ValidateBasic(); err != nil {
+		return err
+	}
+	for _, m := range msg.Members {
+		if !m.Weight.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidMember, "weight of %s must be positive", m.Address)
+		}
+	}
+
+	return validateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCreateGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. The admin signs the msg.
+func (msg MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgUpdateGroupMembers adds, updates or removes members of a group. A member
+// with a zero weight is removed from the group.
+type MsgUpdateGroupMembers struct {
+	Admin         sdk.AccAddress `json:"admin" yaml:"admin"`
+	GroupID       uint64         `json:"group_id" yaml:"group_id"`
+	MemberUpdates Members        `json:"member_updates" yaml:"member_updates"`
+}
+
+// NewMsgUpdateGroupMembers returns a new MsgUpdateGroupMembers.
+func NewMsgUpdateGroupMembers(admin sdk.AccAddress, groupID uint64, memberUpdates Members) MsgUpdateGroupMembers {
+	return MsgUpdateGroupMembers{
+		Admin:         admin,
+		GroupID:       groupID,
+		MemberUpdates: memberUpdates,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMembers) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMembers) Type() string { return TypeMsgUpdateGroupMembers }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMembers) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.GroupID == 0 {
+		return sdkerrors.Wrap(ErrGroupNotFound, "group id cannot be 0")
+	}
+	if len(msg.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMember, "no member updates")
+	}
+
+	return msg.MemberUpdates.ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. The admin signs the msg.
+func (msg MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgUpdateGroupAdmin transfers the administration of a group to a new admin.
+type MsgUpdateGroupAdmin struct {
+	Admin    sdk.AccAddress `json:"admin" yaml:"admin"`
+	GroupID  uint64         `json:"group_id" yaml:"group_id"`
+	NewAdmin sdk.AccAddress `json:"new_admin" yaml:"new_admin"`
+}
+
+// NewMsgUpdateGroupAdmin returns a new MsgUpdateGroupAdmin.
+func NewMsgUpdateGroupAdmin(admin sdk.AccAddress, groupID uint64, newAdmin sdk.AccAddress) MsgUpdateGroupAdmin {
+	return MsgUpdateGroupAdmin{
+		Admin:    admin,
+		GroupID:  groupID,
+		NewAdmin: newAdmin,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) Type() string { return TypeMsgUpdateGroupAdmin }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.GroupID == 0 {
+		return sdkerrors.Wrap(ErrGroupNotFound, "group id cannot be 0")
+	}
+	if msg.NewAdmin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing new admin address")
+	}
+	if msg.NewAdmin.Equals(msg.Admin) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "new admin is the same as the admin")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. The current admin signs the
+// msg.
+func (msg MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgCreateGroupPolicy creates a group policy account for a group, with the
+// given decision policy.
+type MsgCreateGroupPolicy struct {
+	Admin          sdk.AccAddress          `json:"admin" yaml:"admin"`
+	GroupID        uint64                  `json:"group_id" yaml:"group_id"`
+	Metadata       string                  `json:"metadata" yaml:"metadata"`
+	DecisionPolicy exported.DecisionPolicy `json:"decision_policy" yaml:"decision_policy"`
+}
+
+// NewMsgCreateGroupPolicy returns a new MsgCreateGroupPolicy.
+func NewMsgCreateGroupPolicy(
+	admin sdk.AccAddress, groupID uint64, metadata string, decisionPolicy exported.DecisionPolicy,
+) MsgCreateGroupPolicy {
+	return MsgCreateGroupPolicy{
+		Admin:          admin,
+		GroupID:        groupID,
+		Metadata:       metadata,
+		DecisionPolicy: decisionPolicy,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateGroupPolicy) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateGroupPolicy) Type() string { return TypeMsgCreateGroupPolicy }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateGroupPolicy) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.GroupID == 0 {
+		return sdkerrors.Wrap(ErrGroupNotFound, "group id cannot be 0")
+	}
+	if msg.DecisionPolicy == nil {
+		return sdkerrors.Wrap(ErrInvalidDecisionPolicy, "missing decision policy")
+	}
+	if err := msg.DecisionPolicy.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return validateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCreateGroupPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. The admin of the group signs
+// the msg.
+func (msg MsgCreateGroupPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgUpdateGroupPolicyDecisionPolicy replaces the decision policy of a group
+// policy account.
+type MsgUpdateGroupPolicyDecisionPolicy struct {
+	Admin          sdk.AccAddress          `json:"admin" yaml:"admin"`
+	Address        sdk.AccAddress          `json:"address" yaml:"address"`
+	DecisionPolicy exported.DecisionPolicy `json:"decision_policy" yaml:"decision_policy"`
+}
+
+// NewMsgUpdateGroupPolicyDecisionPolicy returns a new
+// MsgUpdateGroupPolicyDecisionPolicy.
+func NewMsgUpdateGroupPolicyDecisionPolicy(
+	admin, address sdk.AccAddress, decisionPolicy exported.DecisionPolicy,
+) MsgUpdateGroupPolicyDecisionPolicy {
+	return MsgUpdateGroupPolicyDecisionPolicy{
+		Admin:          admin,
+		Address:        address,
+		DecisionPolicy: decisionPolicy,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyDecisionPolicy) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyDecisionPolicy) Type() string {
+	return TypeMsgUpdateGroupPolicyDecisionPolicy
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyDecisionPolicy) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing group policy address")
+	}
+	if msg.DecisionPolicy == nil {
+		return sdkerrors.Wrap(ErrInvalidDecisionPolicy, "missing decision policy")
+	}
+
+	return msg.DecisionPolicy.ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateGroupPolicyDecisionPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. The admin of the group policy
+// signs the msg.
+func (msg MsgUpdateGroupPolicyDecisionPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgSubmitProposal submits a proposal to execute msgs on behalf of a group
+// policy account. The proposers must be members of the group of the policy,
+// and the msgs must be signed by the group policy account only.
+type MsgSubmitProposal struct {
+	Address   sdk.AccAddress   `json:"address" yaml:"address"`
+	Proposers []sdk.AccAddress `json:"proposers" yaml:"proposers"`
+	Metadata  string           `json:"metadata" yaml:"metadata"`
+	Msgs      []sdk.Msg        `json:"msgs" yaml:"msgs"`
+}
+
+// NewMsgSubmitProposal returns a new MsgSubmitProposal.
+func NewMsgSubmitProposal(address sdk.AccAddress, proposers []sdk.AccAddress, metadata string, msgs []sdk.Msg) MsgSubmitProposal {
+	return MsgSubmitProposal{
+		Address:   address,
+		Proposers: proposers,
+		Metadata:  metadata,
+		Msgs:      msgs,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSubmitProposal) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSubmitProposal) Type() string { return TypeMsgSubmitProposal }
+
+// ValidateBasic implements the sdk.Msg interface. It validates the msgs of the
+// proposal as well.
+func (msg MsgSubmitProposal) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing group policy address")
+	}
+	if len(msg.Proposers) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing proposers")
+	}
+
+	seen := make(map[string]bool, len(msg.Proposers))
+	for _, proposer := range msg.Proposers {
+		if proposer.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing proposer address")
+		}
+		if seen[proposer.String()] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "duplicate proposer %s", proposer)
+		}
+		seen[proposer.String()] = true
+	}
+
+	for i, m := range msg.Msgs {
+		for _, signer := range m.GetSigners() {
+			if !signer.Equals(msg.Address) {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "msg %d must be signed by the group policy account only", i)
+			}
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+	}
+
+	return validateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements the sdk.Msg interface. The msgs of the proposal are
+// signed with their own sign bytes, so that they do not need to be registered
+// on the module codec.
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	msgs := make([]json.RawMessage, len(msg.Msgs))
+	for i, m := range msg.Msgs {
+		msgs[i] = m.GetSignBytes()
+	}
+
+	bz, err := json.Marshal(struct {
+		Address   sdk.AccAddress    `json:"address"`
+		Proposers []sdk.AccAddress  `json:"proposers"`
+		Metadata  string            `json:"metadata"`
+		Msgs      []json.RawMessage `json:"msgs"`
+	}{msg.Address, msg.Proposers, msg.Metadata, msgs})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements the sdk.Msg interface. All the proposers sign the msg.
+func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	return msg.Proposers
+}
+
+// MsgVote casts the vote of a group member on a proposal.
+type MsgVote struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	Option     VoteOption     `json:"option" yaml:"option"`
+	Metadata   string         `json:"metadata" yaml:"metadata"`
+}
+
+// NewMsgVote returns a new MsgVote.
+func NewMsgVote(proposalID uint64, voter sdk.AccAddress, option VoteOption, metadata string) MsgVote {
+	return MsgVote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Option:     option,
+		Metadata:   metadata,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgVote) Type() string { return TypeMsgVote }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgVote) ValidateBasic() error {
+	if msg.ProposalID == 0 {
+		return sdkerrors.Wrap(ErrProposalNotFound, "proposal id cannot be 0")
+	}
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing voter address")
+	}
+	if !ValidVoteOption(msg.Option) {
+		return sdkerrors.Wrap(ErrInvalidVoteOption, msg.Option.String())
+	}
+
+	return validateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. The voter signs the msg.
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgExec executes the msgs of an accepted proposal. Any account can execute
+// a proposal.
+type MsgExec struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Executor   sdk.AccAddress `json:"executor" yaml:"executor"`
+}
+
+// NewMsgExec returns a new MsgExec.
+func NewMsgExec(proposalID uint64, executor sdk.AccAddress) MsgExec {
+	return MsgExec{
+		ProposalID: proposalID,
+		Executor:   executor,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgExec) ValidateBasic() error {
+	if msg.ProposalID == 0 {
+		return sdkerrors.Wrap(ErrProposalNotFound, "proposal id cannot be 0")
+	}
+	if msg.Executor.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing executor address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. The executor signs the msg.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Executor}
+}
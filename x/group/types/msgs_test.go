@@ -0,0 +1,144 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var (
+	adminAddr  = sdk.AccAddress([]byte("admin_______________"))
+	memberAddr = sdk.AccAddress([]byte("member______________"))
+	policyAddr = types.GroupPolicyAddress(1)
+)
+
+func TestMsgCreateGroup(t *testing.T) {
+	member := types.NewMember(memberAddr, sdk.OneDec(), "")
+
+	cases := map[string]struct {
+		msg   types.MsgCreateGroup
+		valid bool
+	}{
+		"valid":            {types.NewMsgCreateGroup(adminAddr, types.Members{member}, "metadata"), true},
+		"no members":       {types.NewMsgCreateGroup(adminAddr, nil, ""), true},
+		"missing admin":    {types.NewMsgCreateGroup(nil, types.Members{member}, ""), false},
+		"duplicate member": {types.NewMsgCreateGroup(adminAddr, types.Members{member, member}, ""), false},
+		"zero weight":      {types.NewMsgCreateGroup(adminAddr, types.Members{types.NewMember(memberAddr, sdk.ZeroDec(), "")}, ""), false},
+		"negative weight":  {types.NewMsgCreateGroup(adminAddr, types.Members{types.NewMember(memberAddr, sdk.NewDec(-1), "")}, ""), false},
+		"missing member":   {types.NewMsgCreateGroup(adminAddr, types.Members{types.NewMember(nil, sdk.OneDec(), "")}, ""), false},
+		"long metadata":    {types.NewMsgCreateGroup(adminAddr, types.Members{member}, string(make([]byte, types.MaxMetadataLength+1))), false},
+	}
+
+	for name, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, name)
+			require.Equal(t, []sdk.AccAddress{adminAddr}, tc.msg.GetSigners(), name)
+		} else {
+			require.Error(t, err, name)
+		}
+	}
+}
+
+func TestMsgUpdateGroupMembers(t *testing.T) {
+	cases := map[string]struct {
+		msg   types.MsgUpdateGroupMembers
+		valid bool
+	}{
+		"valid":         {types.NewMsgUpdateGroupMembers(adminAddr, 1, types.Members{types.NewMember(memberAddr, sdk.OneDec(), "")}), true},
+		"removal":       {types.NewMsgUpdateGroupMembers(adminAddr, 1, types.Members{types.NewMember(memberAddr, sdk.ZeroDec(), "")}), true},
+		"no updates":    {types.NewMsgUpdateGroupMembers(adminAddr, 1, nil), false},
+		"no group":      {types.NewMsgUpdateGroupMembers(adminAddr, 0, types.Members{types.NewMember(memberAddr, sdk.OneDec(), "")}), false},
+		"missing admin": {types.NewMsgUpdateGroupMembers(nil, 1, types.Members{types.NewMember(memberAddr, sdk.OneDec(), "")}), false},
+	}
+
+	for name, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, name)
+		} else {
+			require.Error(t, err, name)
+		}
+	}
+}
+
+func TestMsgCreateGroupPolicy(t *testing.T) {
+	policy := types.NewThresholdDecisionPolicy(sdk.OneDec(), time.Hour)
+
+	cases := map[string]struct {
+		msg   types.MsgCreateGroupPolicy
+		valid bool
+	}{
+		"valid":          {types.NewMsgCreateGroupPolicy(adminAddr, 1, "", policy), true},
+		"missing admin":  {types.NewMsgCreateGroupPolicy(nil, 1, "", policy), false},
+		"no group":       {types.NewMsgCreateGroupPolicy(adminAddr, 0, "", policy), false},
+		"no policy":      {types.NewMsgCreateGroupPolicy(adminAddr, 1, "", nil), false},
+		"invalid policy": {types.NewMsgCreateGroupPolicy(adminAddr, 1, "", types.NewThresholdDecisionPolicy(sdk.OneDec(), 0)), false},
+	}
+
+	for name, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, name)
+		} else {
+			require.Error(t, err, name)
+		}
+	}
+}
+
+func TestMsgSubmitProposal(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	send := bank.NewMsgSend(policyAddr, memberAddr, coins)
+	proposers := []sdk.AccAddress{memberAddr}
+
+	cases := map[string]struct {
+		msg   types.MsgSubmitProposal
+		valid bool
+	}{
+		"valid":              {types.NewMsgSubmitProposal(policyAddr, proposers, "", []sdk.Msg{send}), true},
+		"no msgs":            {types.NewMsgSubmitProposal(policyAddr, proposers, "", nil), true},
+		"missing address":    {types.NewMsgSubmitProposal(nil, proposers, "", []sdk.Msg{send}), false},
+		"no proposers":       {types.NewMsgSubmitProposal(policyAddr, nil, "", []sdk.Msg{send}), false},
+		"duplicate proposer": {types.NewMsgSubmitProposal(policyAddr, []sdk.AccAddress{memberAddr, memberAddr}, "", nil), false},
+		"other signer":       {types.NewMsgSubmitProposal(policyAddr, proposers, "", []sdk.Msg{bank.NewMsgSend(memberAddr, policyAddr, coins)}), false},
+		"invalid msg":        {types.NewMsgSubmitProposal(policyAddr, proposers, "", []sdk.Msg{bank.NewMsgSend(policyAddr, memberAddr, nil)}), false},
+	}
+
+	for name, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, name)
+			require.Equal(t, proposers, tc.msg.GetSigners(), name)
+			require.NotPanics(t, func() { tc.msg.GetSignBytes() }, name)
+		} else {
+			require.Error(t, err, name)
+		}
+	}
+}
+
+func TestMsgVote(t *testing.T) {
+	cases := map[string]struct {
+		msg   types.MsgVote
+		valid bool
+	}{
+		"valid":          {types.NewMsgVote(1, memberAddr, types.OptionYes, ""), true},
+		"no proposal":    {types.NewMsgVote(0, memberAddr, types.OptionYes, ""), false},
+		"missing voter":  {types.NewMsgVote(1, nil, types.OptionNo, ""), false},
+		"invalid option": {types.NewMsgVote(1, memberAddr, types.VoteOption(0x05), ""), false},
+	}
+
+	for name, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, name)
+			require.Equal(t, []sdk.AccAddress{memberAddr}, tc.msg.GetSigners(), name)
+		} else {
+			require.Error(t, err, name)
+		}
+	}
+}
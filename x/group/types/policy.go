@@ -0,0 +1,124 @@
+package types
+
+import (
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/exported"
+)
+
+var (
+	_ exported.DecisionPolicy = (*ThresholdDecisionPolicy)(nil)
+	_ exported.DecisionPolicy = (*PercentageDecisionPolicy)(nil)
+)
+
+// ThresholdDecisionPolicy accepts a proposal once the weight of the yes votes
+// reaches a threshold. If the threshold is greater than the total weight of
+// the group, the yes votes of all the members are needed.
+type ThresholdDecisionPolicy struct {
+	Threshold    sdk.Dec       `json:"threshold" yaml:"threshold"`
+	VotingPeriod time.Duration `json:"voting_period" yaml:"voting_period"`
+}
+
+// NewThresholdDecisionPolicy returns a new ThresholdDecisionPolicy.
+func NewThresholdDecisionPolicy(threshold sdk.Dec, votingPeriod time.Duration) *ThresholdDecisionPolicy {
+	return &ThresholdDecisionPolicy{
+		Threshold:    threshold,
+		VotingPeriod: votingPeriod,
+	}
+}
+
+// GetVotingPeriod implements the DecisionPolicy interface.
+func (p ThresholdDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.VotingPeriod
+}
+
+// Allow implements the DecisionPolicy interface.
+func (p ThresholdDecisionPolicy) Allow(yesWeight, votedWeight, totalWeight sdk.Dec, votingPeriodEnded bool) (bool, bool) {
+	return allow(sdk.MinDec(p.Threshold, totalWeight), yesWeight, votedWeight, totalWeight, votingPeriodEnded)
+}
+
+// ValidateBasic implements the DecisionPolicy interface.
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if p.Threshold.IsNil() || !p.Threshold.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidDecisionPolicy, "threshold must be positive")
+	}
+
+	return validateVotingPeriod(p.VotingPeriod)
+}
+
+// String implements the Stringer interface.
+func (p ThresholdDecisionPolicy) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// PercentageDecisionPolicy accepts a proposal once the weight of the yes votes
+// reaches a percentage of the total weight of the group.
+type PercentageDecisionPolicy struct {
+	Percentage   sdk.Dec       `json:"percentage" yaml:"percentage"`
+	VotingPeriod time.Duration `json:"voting_period" yaml:"voting_period"`
+}
+
+// NewPercentageDecisionPolicy returns a new PercentageDecisionPolicy.
+func NewPercentageDecisionPolicy(percentage sdk.Dec, votingPeriod time.Duration) *PercentageDecisionPolicy {
+	return &PercentageDecisionPolicy{
+		Percentage:   percentage,
+		VotingPeriod: votingPeriod,
+	}
+}
+
+// GetVotingPeriod implements the DecisionPolicy interface.
+func (p PercentageDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.VotingPeriod
+}
+
+// Allow implements the DecisionPolicy interface.
+func (p PercentageDecisionPolicy) Allow(yesWeight, votedWeight, totalWeight sdk.Dec, votingPeriodEnded bool) (bool, bool) {
+	return allow(totalWeight.Mul(p.Percentage), yesWeight, votedWeight, totalWeight, votingPeriodEnded)
+}
+
+// ValidateBasic implements the DecisionPolicy interface.
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	if p.Percentage.IsNil() || !p.Percentage.IsPositive() || p.Percentage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalidDecisionPolicy, "percentage must be positive and at most 1")
+	}
+
+	return validateVotingPeriod(p.VotingPeriod)
+}
+
+// String implements the Stringer interface.
+func (p PercentageDecisionPolicy) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// allow accepts a proposal once the yes votes reach the threshold, and rejects
+// it once they cannot reach it anymore or the voting period has ended.
+func allow(threshold, yesWeight, votedWeight, totalWeight sdk.Dec, votingPeriodEnded bool) (bool, bool) {
+	if !totalWeight.IsPositive() {
+		return false, true
+	}
+	if yesWeight.GTE(threshold) {
+		return true, true
+	}
+
+	// the members who did not vote yet cannot bring the yes votes to the
+	// threshold
+	if yesWeight.Add(totalWeight.Sub(votedWeight)).LT(threshold) {
+		return false, true
+	}
+
+	return false, votingPeriodEnded
+}
+
+func validateVotingPeriod(votingPeriod time.Duration) error {
+	if votingPeriod <= 0 {
+		return sdkerrors.Wrap(ErrInvalidDecisionPolicy, "voting period must be positive")
+	}
+
+	return nil
+}
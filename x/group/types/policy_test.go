@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/exported"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+func TestDecisionPolicyValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		policy exported.DecisionPolicy
+		valid  bool
+	}{
+		"threshold":              {types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour), true},
+		"zero threshold":         {types.NewThresholdDecisionPolicy(sdk.ZeroDec(), time.Hour), false},
+		"nil threshold":          {types.NewThresholdDecisionPolicy(sdk.Dec{}, time.Hour), false},
+		"no voting period":       {types.NewThresholdDecisionPolicy(sdk.NewDec(2), 0), false},
+		"percentage":             {types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(5, 1), time.Hour), true},
+		"full percentage":        {types.NewPercentageDecisionPolicy(sdk.OneDec(), time.Hour), true},
+		"percentage above 1":     {types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(11, 1), time.Hour), false},
+		"zero percentage":        {types.NewPercentageDecisionPolicy(sdk.ZeroDec(), time.Hour), false},
+		"negative voting period": {types.NewPercentageDecisionPolicy(sdk.OneDec(), -time.Hour), false},
+	}
+
+	for name, tc := range cases {
+		err := tc.policy.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, name)
+		} else {
+			require.Error(t, err, name)
+		}
+	}
+}
+
+func TestDecisionPolicyAllow(t *testing.T) {
+	threshold := types.NewThresholdDecisionPolicy(sdk.NewDec(3), time.Hour)
+	percentage := types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(5, 1), time.Hour)
+
+	cases := map[string]struct {
+		policy      exported.DecisionPolicy
+		yes, voted  int64
+		totalWeight int64
+		ended       bool
+		allow       bool
+		final       bool
+	}{
+		"threshold reached":                 {threshold, 3, 3, 10, false, true, true},
+		"threshold not reached":             {threshold, 2, 5, 10, false, false, false},
+		"threshold not reached at the end":  {threshold, 2, 5, 10, true, false, true},
+		"threshold out of reach":            {threshold, 1, 9, 10, false, false, true},
+		"threshold above total weight":      {threshold, 2, 2, 2, false, true, true},
+		"empty group":                       {threshold, 0, 0, 0, false, false, true},
+		"percentage reached":                {percentage, 5, 6, 10, false, true, true},
+		"percentage not reached":            {percentage, 4, 4, 10, false, false, false},
+		"percentage not reached at the end": {percentage, 4, 4, 10, true, false, true},
+		"percentage out of reach":           {percentage, 1, 7, 10, false, false, true},
+	}
+
+	for name, tc := range cases {
+		allow, final := tc.policy.Allow(sdk.NewDec(tc.yes), sdk.NewDec(tc.voted), sdk.NewDec(tc.totalWeight), tc.ended)
+		require.Equal(t, tc.allow, allow, name)
+		require.Equal(t, tc.final, final, name)
+	}
+}
@@ -0,0 +1,369 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Proposal is a proposal to execute msgs on behalf of a group policy account,
+// submitted by members of its group.
+type Proposal struct {
+	ProposalID         uint64           `json:"proposal_id" yaml:"proposal_id"`
+	Address            sdk.AccAddress   `json:"address" yaml:"address"`
+	Metadata           string           `json:"metadata" yaml:"metadata"`
+	Proposers          []sdk.AccAddress `json:"proposers" yaml:"proposers"`
+	SubmitTime         time.Time        `json:"submit_time" yaml:"submit_time"`
+	GroupVersion       uint64           `json:"group_version" yaml:"group_version"`
+	GroupPolicyVersion uint64           `json:"group_policy_version" yaml:"group_policy_version"`
+	Status             ProposalStatus   `json:"status" yaml:"status"`
+	Result             ProposalResult   `json:"result" yaml:"result"`
+	VoteState          TallyResult      `json:"vote_state" yaml:"vote_state"`
+	VotingPeriodEnd    time.Time        `json:"voting_period_end" yaml:"voting_period_end"`
+	ExecutorResult     ExecutorResult   `json:"executor_result" yaml:"executor_result"`
+	Msgs               []sdk.Msg        `json:"msgs" yaml:"msgs"`
+}
+
+// ValidateBasic performs basic validation of the proposal.
+func (p Proposal) ValidateBasic() error {
+	if p.ProposalID == 0 {
+		return sdkerrors.Wrap(ErrProposalNotFound, "proposal id cannot be 0")
+	}
+	if p.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing group policy address")
+	}
+	if len(p.Proposers) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing proposers")
+	}
+	if err := p.VoteState.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return validateMetadata(p.Metadata)
+}
+
+// String implements the Stringer interface.
+func (p Proposal) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Vote is the vote of a group member on a proposal.
+type Vote struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	Option     VoteOption     `json:"option" yaml:"option"`
+	Metadata   string         `json:"metadata" yaml:"metadata"`
+	SubmitTime time.Time      `json:"submit_time" yaml:"submit_time"`
+}
+
+// NewVote returns a new Vote.
+func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption, metadata string, submitTime time.Time) Vote {
+	return Vote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Option:     option,
+		Metadata:   metadata,
+		SubmitTime: submitTime,
+	}
+}
+
+// ValidateBasic performs basic validation of the vote.
+func (v Vote) ValidateBasic() error {
+	if v.ProposalID == 0 {
+		return sdkerrors.Wrap(ErrProposalNotFound, "proposal id cannot be 0")
+	}
+	if v.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing voter address")
+	}
+	if !ValidVoteOption(v.Option) {
+		return sdkerrors.Wrap(ErrInvalidVoteOption, v.Option.String())
+	}
+
+	return validateMetadata(v.Metadata)
+}
+
+// String implements the Stringer interface.
+func (v Vote) String() string {
+	out, _ := yaml.Marshal(v)
+	return string(out)
+}
+
+// TallyResult is the sum of the weights of the votes cast on a proposal, per
+// option.
+type TallyResult struct {
+	Yes        sdk.Dec `json:"yes" yaml:"yes"`
+	No         sdk.Dec `json:"no" yaml:"no"`
+	Abstain    sdk.Dec `json:"abstain" yaml:"abstain"`
+	NoWithVeto sdk.Dec `json:"no_with_veto" yaml:"no_with_veto"`
+}
+
+// NewTallyResult returns a new TallyResult.
+func NewTallyResult(yes, no, abstain, noWithVeto sdk.Dec) TallyResult {
+	return TallyResult{
+		Yes:        yes,
+		No:         no,
+		Abstain:    abstain,
+		NoWithVeto: noWithVeto,
+	}
+}
+
+// EmptyTallyResult returns a TallyResult without any vote.
+func EmptyTallyResult() TallyResult {
+	return NewTallyResult(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+}
+
+// Add returns the tally with the weight of a vote added to its option.
+func (t TallyResult) Add(option VoteOption, weight sdk.Dec) (TallyResult, error) {
+	switch option {
+	case OptionYes:
+		t.Yes = t.Yes.Add(weight)
+	case OptionNo:
+		t.No = t.No.Add(weight)
+	case OptionAbstain:
+		t.Abstain = t.Abstain.Add(weight)
+	case OptionNoWithVeto:
+		t.NoWithVeto = t.NoWithVeto.Add(weight)
+	default:
+		return t, sdkerrors.Wrap(ErrInvalidVoteOption, option.String())
+	}
+
+	return t, nil
+}
+
+// TotalCounts returns the sum of the weights of all the votes.
+func (t TallyResult) TotalCounts() sdk.Dec {
+	return t.Yes.Add(t.No).Add(t.Abstain).Add(t.NoWithVeto)
+}
+
+// ValidateBasic performs basic validation of the tally.
+func (t TallyResult) ValidateBasic() error {
+	for _, weight := range []sdk.Dec{t.Yes, t.No, t.Abstain, t.NoWithVeto} {
+		if weight.IsNil() || weight.IsNegative() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "tally weights cannot be negative")
+		}
+	}
+
+	return nil
+}
+
+// VoteOption is the option of a vote on a proposal.
+type VoteOption byte
+
+// Vote options
+const (
+	OptionYes        VoteOption = 0x01
+	OptionAbstain    VoteOption = 0x02
+	OptionNo         VoteOption = 0x03
+	OptionNoWithVeto VoteOption = 0x04
+)
+
+var voteOptionNames = map[VoteOption]string{
+	OptionYes:        "Yes",
+	OptionAbstain:    "Abstain",
+	OptionNo:         "No",
+	OptionNoWithVeto: "NoWithVeto",
+}
+
+// VoteOptionFromString returns a VoteOption from a string. It returns an error
+// if the string is invalid.
+func VoteOptionFromString(str string) (VoteOption, error) {
+	for option, name := range voteOptionNames {
+		if name == str {
+			return option, nil
+		}
+	}
+
+	return VoteOption(0xff), fmt.Errorf("'%s' is not a valid vote option", str)
+}
+
+// ValidVoteOption returns true if the vote option is valid and false otherwise.
+func ValidVoteOption(option VoteOption) bool {
+	_, ok := voteOptionNames[option]
+	return ok
+}
+
+// String implements the Stringer interface.
+func (vo VoteOption) String() string {
+	return voteOptionNames[vo]
+}
+
+// MarshalYAML marshals the vote option to YAML as a string.
+func (vo VoteOption) MarshalYAML() (interface{}, error) {
+	return vo.String(), nil
+}
+
+// MarshalJSON marshals the vote option to JSON as a string.
+func (vo VoteOption) MarshalJSON() ([]byte, error) {
+	return json.Marshal(vo.String())
+}
+
+// UnmarshalJSON unmarshals the vote option from a JSON string.
+func (vo *VoteOption) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	option, err := VoteOptionFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*vo = option
+	return nil
+}
+
+// ProposalStatus is the status of a proposal.
+type ProposalStatus byte
+
+// Proposal statuses
+const (
+	// StatusSubmitted is the status of a proposal which can still be voted on
+	// or executed.
+	StatusSubmitted ProposalStatus = 0x01
+	// StatusClosed is the status of a proposal whose result is final.
+	StatusClosed ProposalStatus = 0x02
+	// StatusAborted is the status of a proposal whose group or group policy
+	// was modified before its result was final.
+	StatusAborted ProposalStatus = 0x03
+)
+
+var proposalStatusNames = map[ProposalStatus]string{
+	StatusSubmitted: "Submitted",
+	StatusClosed:    "Closed",
+	StatusAborted:   "Aborted",
+}
+
+// String implements the Stringer interface.
+func (s ProposalStatus) String() string {
+	return proposalStatusNames[s]
+}
+
+// MarshalYAML marshals the proposal status to YAML as a string.
+func (s ProposalStatus) MarshalYAML() (interface{}, error) {
+	return s.String(), nil
+}
+
+// MarshalJSON marshals the proposal status to JSON as a string.
+func (s ProposalStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON unmarshals the proposal status from a JSON string.
+func (s *ProposalStatus) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	for status, name := range proposalStatusNames {
+		if name == str {
+			*s = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("'%s' is not a valid proposal status", str)
+}
+
+// ProposalResult is the result of the tally of a proposal.
+type ProposalResult byte
+
+// Proposal results
+const (
+	ResultUnfinalized ProposalResult = 0x00
+	ResultAccepted    ProposalResult = 0x01
+	ResultRejected    ProposalResult = 0x02
+)
+
+var proposalResultNames = map[ProposalResult]string{
+	ResultUnfinalized: "Unfinalized",
+	ResultAccepted:    "Accepted",
+	ResultRejected:    "Rejected",
+}
+
+// String implements the Stringer interface.
+func (r ProposalResult) String() string {
+	return proposalResultNames[r]
+}
+
+// MarshalYAML marshals the proposal result to YAML as a string.
+func (r ProposalResult) MarshalYAML() (interface{}, error) {
+	return r.String(), nil
+}
+
+// MarshalJSON marshals the proposal result to JSON as a string.
+func (r ProposalResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON unmarshals the proposal result from a JSON string.
+func (r *ProposalResult) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	for result, name := range proposalResultNames {
+		if name == str {
+			*r = result
+			return nil
+		}
+	}
+
+	return fmt.Errorf("'%s' is not a valid proposal result", str)
+}
+
+// ExecutorResult is the result of the execution of the msgs of a proposal.
+type ExecutorResult byte
+
+// Executor results
+const (
+	ExecutorNotRun  ExecutorResult = 0x00
+	ExecutorSuccess ExecutorResult = 0x01
+	ExecutorFailure ExecutorResult = 0x02
+)
+
+var executorResultNames = map[ExecutorResult]string{
+	ExecutorNotRun:  "NotRun",
+	ExecutorSuccess: "Success",
+	ExecutorFailure: "Failure",
+}
+
+// String implements the Stringer interface.
+func (r ExecutorResult) String() string {
+	return executorResultNames[r]
+}
+
+// MarshalYAML marshals the executor result to YAML as a string.
+func (r ExecutorResult) MarshalYAML() (interface{}, error) {
+	return r.String(), nil
+}
+
+// MarshalJSON marshals the executor result to JSON as a string.
+func (r ExecutorResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON unmarshals the executor result from a JSON string.
+func (r *ExecutorResult) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	for result, name := range executorResultNames {
+		if name == str {
+			*r = result
+			return nil
+		}
+	}
+
+	return fmt.Errorf("'%s' is not a valid executor result", str)
+}
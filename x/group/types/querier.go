@@ -0,0 +1,74 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier routes for the group module
+const (
+	QueryGroup                  = "group"
+	QueryGroupMembers           = "group-members"
+	QueryGroupsByAdmin          = "groups-by-admin"
+	QueryGroupPolicy            = "group-policy"
+	QueryGroupPoliciesByGroup   = "group-policies-by-group"
+	QueryProposal               = "proposal"
+	QueryProposalsByGroupPolicy = "proposals-by-group-policy"
+	QueryVote                   = "vote"
+	QueryVotesByProposal        = "votes-by-proposal"
+)
+
+// QueryGroupParams defines the parameters for querying a group, its members or
+// its policies.
+type QueryGroupParams struct {
+	GroupID uint64 `json:"group_id" yaml:"group_id"`
+}
+
+// NewQueryGroupParams returns a new QueryGroupParams.
+func NewQueryGroupParams(groupID uint64) QueryGroupParams {
+	return QueryGroupParams{GroupID: groupID}
+}
+
+// QueryGroupsByAdminParams defines the parameters for querying the groups
+// administered by an account.
+type QueryGroupsByAdminParams struct {
+	Admin sdk.AccAddress `json:"admin" yaml:"admin"`
+}
+
+// NewQueryGroupsByAdminParams returns a new QueryGroupsByAdminParams.
+func NewQueryGroupsByAdminParams(admin sdk.AccAddress) QueryGroupsByAdminParams {
+	return QueryGroupsByAdminParams{Admin: admin}
+}
+
+// QueryGroupPolicyParams defines the parameters for querying a group policy or
+// its proposals.
+type QueryGroupPolicyParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewQueryGroupPolicyParams returns a new QueryGroupPolicyParams.
+func NewQueryGroupPolicyParams(address sdk.AccAddress) QueryGroupPolicyParams {
+	return QueryGroupPolicyParams{Address: address}
+}
+
+// QueryProposalParams defines the parameters for querying a proposal or its
+// votes.
+type QueryProposalParams struct {
+	ProposalID uint64 `json:"proposal_id" yaml:"proposal_id"`
+}
+
+// NewQueryProposalParams returns a new QueryProposalParams.
+func NewQueryProposalParams(proposalID uint64) QueryProposalParams {
+	return QueryProposalParams{ProposalID: proposalID}
+}
+
+// QueryVoteParams defines the parameters for querying the vote of a voter on a
+// proposal.
+type QueryVoteParams struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+}
+
+// NewQueryVoteParams returns a new QueryVoteParams.
+func NewQueryVoteParams(proposalID uint64, voter sdk.AccAddress) QueryVoteParams {
+	return QueryVoteParams{ProposalID: proposalID, Voter: voter}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -33,10 +34,12 @@ $ %s query ibc client states
 		Example: fmt.Sprintf("%s query ibc client states", version.ClientName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			page := viper.GetInt(flags.FlagPage)
-			limit := viper.GetInt(flags.FlagLimit)
+			pageReq, err := client.ReadPageRequest()
+			if err != nil {
+				return err
+			}
 
-			clientStates, _, err := utils.QueryAllClientStates(cliCtx, page, limit)
+			clientStates, _, err := utils.QueryAllClientStates(cliCtx, pageReq)
 			if err != nil {
 				return err
 			}
@@ -44,8 +47,7 @@ $ %s query ibc client states
 			return cliCtx.PrintOutput(clientStates)
 		},
 	}
-	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of light clients to to query for")
-	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of light clients to query for")
+	flags.AddPaginationFlagsToCmd(cmd, "light clients")
 	return cmd
 }
 
@@ -26,14 +26,16 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 // @Summary Query client states
 // @Tags IBC
 // @Produce  json
-// @Param page query int false "The page number to query" default(1)
+// @Param page_key query string false "The base64 encoded key to start the page at"
+// @Param offset query int false "The number of results to skip"
 // @Param limit query int false "The number of results per page" default(100)
+// @Param count_total query bool false "Whether to count the total number of results"
 // @Success 200 {object} QueryClientState "OK"
 // @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
 // @Router /ibc/clients [get]
 func queryAllClientStatesFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		pageReq, err := rest.ParsePageRequest(r)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -44,7 +46,7 @@ func queryAllClientStatesFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		clients, height, err := utils.QueryAllClientStates(cliCtx, page, limit)
+		clients, height, err := utils.QueryAllClientStates(cliCtx, pageReq)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -7,6 +7,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
@@ -16,23 +17,25 @@ import (
 
 // QueryAllClientStates returns all the light client states. It _does not_ return
 // any merkle proof.
-func QueryAllClientStates(cliCtx context.CLIContext, page, limit int) ([]exported.ClientState, int64, error) {
-	params := types.NewQueryAllClientsParams(page, limit)
+func QueryAllClientStates(
+	cliCtx context.CLIContext, pagination *query.PageRequest,
+) (types.QueryAllClientsResponse, int64, error) {
+	params := types.NewQueryAllClientsParams(pagination)
 	bz, err := cliCtx.Codec.MarshalJSON(params)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to marshal query params: %w", err)
+		return types.QueryAllClientsResponse{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
 	}
 
 	route := fmt.Sprintf("custom/%s/%s/%s", "ibc", types.QuerierRoute, types.QueryAllClients)
 	res, height, err := cliCtx.QueryWithData(route, bz)
 	if err != nil {
-		return nil, 0, err
+		return types.QueryAllClientsResponse{}, 0, err
 	}
 
-	var clients []exported.ClientState
+	var clients types.QueryAllClientsResponse
 	err = cliCtx.Codec.UnmarshalJSON(res, &clients)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to unmarshal light clients: %w", err)
+		return types.QueryAllClientsResponse{}, 0, fmt.Errorf("failed to unmarshal light clients: %w", err)
 	}
 	return clients, height, nil
 }
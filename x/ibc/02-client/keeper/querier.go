@@ -3,12 +3,14 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
 // QuerierClients defines the sdk.Querier to query all the light client states.
@@ -19,16 +21,22 @@ func QuerierClients(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, e
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	clients := k.GetAllClients(ctx)
-
-	start, end := client.Paginate(len(clients), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
-		clients = []exported.ClientState{}
-	} else {
-		clients = clients[start:end]
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ibctypes.KeyClientPrefix)
+	clients := []exported.ClientState{}
+
+	pageRes, err := query.Paginate(store, params.Pagination, func(_, value []byte) error {
+		var clientState exported.ClientState
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(value, &clientState); err != nil {
+			return err
+		}
+		clients = append(clients, clientState)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	res, err := codec.MarshalJSONIndent(k.cdc, clients)
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewQueryAllClientsResponse(clients, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto/merkle"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
@@ -20,15 +21,28 @@ const (
 // QueryAllClientsParams defines the parameters necessary for querying for all
 // light client states.
 type QueryAllClientsParams struct {
-	Page  int `json:"page" yaml:"page"`
-	Limit int `json:"limit" yaml:"limit"`
+	Pagination *query.PageRequest `json:"pagination" yaml:"pagination"`
 }
 
 // NewQueryAllClientsParams creates a new QueryAllClientsParams instance.
-func NewQueryAllClientsParams(page, limit int) QueryAllClientsParams {
+func NewQueryAllClientsParams(pagination *query.PageRequest) QueryAllClientsParams {
 	return QueryAllClientsParams{
-		Page:  page,
-		Limit: limit,
+		Pagination: pagination,
+	}
+}
+
+// QueryAllClientsResponse defines the response of the query for all light
+// client states.
+type QueryAllClientsResponse struct {
+	ClientStates []exported.ClientState `json:"client_states" yaml:"client_states"`
+	Pagination   *query.PageResponse    `json:"pagination" yaml:"pagination"`
+}
+
+// NewQueryAllClientsResponse creates a new QueryAllClientsResponse instance.
+func NewQueryAllClientsResponse(clientStates []exported.ClientState, pagination *query.PageResponse) QueryAllClientsResponse {
+	return QueryAllClientsResponse{
+		ClientStates: clientStates,
+		Pagination:   pagination,
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -31,10 +32,12 @@ $ %s query ibc connection connections
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			page := viper.GetInt(flags.FlagPage)
-			limit := viper.GetInt(flags.FlagLimit)
+			pageReq, err := client.ReadPageRequest()
+			if err != nil {
+				return err
+			}
 
-			connections, _, err := utils.QueryAllConnections(cliCtx, page, limit)
+			connections, _, err := utils.QueryAllConnections(cliCtx, pageReq)
 			if err != nil {
 				return err
 			}
@@ -42,8 +45,7 @@ $ %s query ibc connection connections
 			return cliCtx.PrintOutput(connections)
 		},
 	}
-	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of light clients to to query for")
-	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of light clients to query for")
+	flags.AddPaginationFlagsToCmd(cmd, "connections")
 	return cmd
 }
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
@@ -17,23 +18,25 @@ import (
 
 // QueryAllConnections returns all the connections. It _does not_ return
 // any merkle proof.
-func QueryAllConnections(cliCtx context.CLIContext, page, limit int) ([]types.IdentifiedConnectionEnd, int64, error) {
-	params := types.NewQueryAllConnectionsParams(page, limit)
+func QueryAllConnections(
+	cliCtx context.CLIContext, pagination *query.PageRequest,
+) (types.QueryAllConnectionsResponse, int64, error) {
+	params := types.NewQueryAllConnectionsParams(pagination)
 	bz, err := cliCtx.Codec.MarshalJSON(params)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to marshal query params: %w", err)
+		return types.QueryAllConnectionsResponse{}, 0, fmt.Errorf("failed to marshal query params: %w", err)
 	}
 
 	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllConnections)
 	res, height, err := cliCtx.QueryWithData(route, bz)
 	if err != nil {
-		return nil, 0, err
+		return types.QueryAllConnectionsResponse{}, 0, err
 	}
 
-	var connections []types.IdentifiedConnectionEnd
+	var connections types.QueryAllConnectionsResponse
 	err = cliCtx.Codec.UnmarshalJSON(res, &connections)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to unmarshal connections: %w", err)
+		return types.QueryAllConnectionsResponse{}, 0, fmt.Errorf("failed to unmarshal connections: %w", err)
 	}
 	return connections, height, nil
 }
@@ -3,11 +3,13 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
 // QuerierConnections defines the sdk.Querier to query all the connections.
@@ -18,16 +20,27 @@ func QuerierConnections(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byt
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	connections := k.GetAllConnections(ctx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ibctypes.KeyConnectionPrefix)
+	connections := []types.IdentifiedConnectionEnd{}
 
-	start, end := client.Paginate(len(connections), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
-		connections = []types.IdentifiedConnectionEnd{}
-	} else {
-		connections = connections[start:end]
+	pageRes, err := query.Paginate(store, params.Pagination, func(key, value []byte) error {
+		var connection types.ConnectionEnd
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(value, &connection); err != nil {
+			return err
+		}
+
+		// the keys of the prefix store are of the form "/{connection-id}"
+		connections = append(connections, types.IdentifiedConnectionEnd{
+			Connection: connection,
+			Identifier: string(key[1:]),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	res, err := codec.MarshalJSONIndent(k.cdc, connections)
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewQueryAllConnectionsResponse(connections, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
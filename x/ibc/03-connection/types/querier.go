@@ -5,6 +5,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto/merkle"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
@@ -45,15 +46,28 @@ func NewConnectionResponse(
 // QueryAllConnectionsParams defines the parameters necessary for querying for all
 // connections.
 type QueryAllConnectionsParams struct {
-	Page  int `json:"page" yaml:"page"`
-	Limit int `json:"limit" yaml:"limit"`
+	Pagination *query.PageRequest `json:"pagination" yaml:"pagination"`
 }
 
 // NewQueryAllConnectionsParams creates a new QueryAllConnectionsParams instance.
-func NewQueryAllConnectionsParams(page, limit int) QueryAllConnectionsParams {
+func NewQueryAllConnectionsParams(pagination *query.PageRequest) QueryAllConnectionsParams {
 	return QueryAllConnectionsParams{
-		Page:  page,
-		Limit: limit,
+		Pagination: pagination,
+	}
+}
+
+// QueryAllConnectionsResponse defines the response of the query for all
+// connections.
+type QueryAllConnectionsResponse struct {
+	Connections []IdentifiedConnectionEnd `json:"connections" yaml:"connections"`
+	Pagination  *query.PageResponse       `json:"pagination" yaml:"pagination"`
+}
+
+// NewQueryAllConnectionsResponse creates a new QueryAllConnectionsResponse instance.
+func NewQueryAllConnectionsResponse(connections []IdentifiedConnectionEnd, pagination *query.PageResponse) QueryAllConnectionsResponse {
+	return QueryAllConnectionsResponse{
+		Connections: connections,
+		Pagination:  pagination,
 	}
 }
 
@@ -3,11 +3,13 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
 // QuerierChannels defines the sdk.Querier to query all the channels.
@@ -18,21 +20,9 @@ func QuerierChannels(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	channels := k.GetAllChannels(ctx)
-
-	start, end := client.Paginate(len(channels), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
-		channels = []types.IdentifiedChannel{}
-	} else {
-		channels = channels[start:end]
-	}
-
-	res, err := codec.MarshalJSONIndent(k.cdc, channels)
-	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
-	}
-
-	return res, nil
+	return k.queryChannels(ctx, params.Pagination, func(types.IdentifiedChannel) bool {
+		return true
+	})
 }
 
 // QuerierConnectionChannels defines the sdk.Querier to query all the channels for a connection.
@@ -43,23 +33,42 @@ func QuerierConnectionChannels(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	channels := k.GetAllChannels(ctx)
+	return k.queryChannels(ctx, params.Pagination, func(channel types.IdentifiedChannel) bool {
+		return channel.Channel.ConnectionHops[0] == params.Connection
+	})
+}
+
+// queryChannels paginates over the stored channels which are matched by the
+// given filter and returns them along with the page response as JSON.
+func (k Keeper) queryChannels(
+	ctx sdk.Context, pagination *query.PageRequest, filter func(types.IdentifiedChannel) bool,
+) ([]byte, error) {
+	keyPrefix := ibctypes.GetChannelPortsKeysPrefix(ibctypes.KeyChannelPrefix)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	channels := []types.IdentifiedChannel{}
 
-	connectionChannels := []types.IdentifiedChannel{}
-	for _, channel := range channels {
-		if channel.Channel.ConnectionHops[0] == params.Connection {
-			connectionChannels = append(connectionChannels, channel)
+	pageRes, err := query.FilteredPaginate(store, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var channel types.Channel
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(value, &channel); err != nil {
+			return false, err
 		}
-	}
 
-	start, end := client.Paginate(len(connectionChannels), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
-		connectionChannels = []types.IdentifiedChannel{}
-	} else {
-		connectionChannels = channels[start:end]
+		portID, channelID := ibctypes.MustParseChannelPath(string(keyPrefix) + string(key))
+		identifiedChannel := types.IdentifiedChannel{Channel: channel, PortIdentifier: portID, ChannelIdentifier: channelID}
+		if !filter(identifiedChannel) {
+			return false, nil
+		}
+
+		if accumulate {
+			channels = append(channels, identifiedChannel)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	res, err := codec.MarshalJSONIndent(k.cdc, connectionChannels)
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewQueryChannelsResponse(channels, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestQuerierConnectionChannels() {
+	ctx := suite.chainB.GetContext()
+	channelKeeper := suite.chainB.App.IBCKeeper.ChannelKeeper
+
+	newChannel := func(connectionID string) types.Channel {
+		return types.Channel{
+			State:          exported.INIT,
+			Ordering:       testChannelOrder,
+			Counterparty:   types.NewCounterparty(testPort1, testChannel1),
+			ConnectionHops: []string{connectionID},
+			Version:        testChannelVersion,
+		}
+	}
+
+	channelKeeper.SetChannel(ctx, testPort1, testChannel1, newChannel(testConnectionIDA))
+	channelKeeper.SetChannel(ctx, testPort2, testChannel2, newChannel(testConnectionIDB))
+	channelKeeper.SetChannel(ctx, testPort3, testChannel3, newChannel(testConnectionIDA))
+
+	queryChannels := func(pagination *query.PageRequest) types.QueryChannelsResponse {
+		bz, err := suite.cdc.MarshalJSON(types.NewQueryConnectionChannelsParams(testConnectionIDA, pagination))
+		suite.Require().NoError(err)
+
+		res, err := keeper.QuerierConnectionChannels(ctx, abci.RequestQuery{Data: bz}, channelKeeper)
+		suite.Require().NoError(err)
+
+		var channels types.QueryChannelsResponse
+		suite.Require().NoError(suite.cdc.UnmarshalJSON(res, &channels))
+		return channels
+	}
+
+	res := queryChannels(&query.PageRequest{Limit: 1, CountTotal: true})
+	suite.Require().Len(res.Channels, 1)
+	suite.Require().Equal(testPort1, res.Channels[0].PortIdentifier)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	// the channel of the other connection is skipped
+	res = queryChannels(&query.PageRequest{Key: res.Pagination.NextKey, Limit: 1})
+	suite.Require().Len(res.Channels, 1)
+	suite.Require().Equal(testPort3, res.Channels[0].PortIdentifier)
+	suite.Require().Equal(testChannel3, res.Channels[0].ChannelIdentifier)
+	suite.Require().Nil(res.Pagination.NextKey)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto/merkle"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
@@ -46,32 +47,43 @@ func NewChannelResponse(
 // QueryAllChannelsParams defines the parameters necessary for querying for all
 // channels.
 type QueryAllChannelsParams struct {
-	Page  int `json:"page" yaml:"page"`
-	Limit int `json:"limit" yaml:"limit"`
+	Pagination *query.PageRequest `json:"pagination" yaml:"pagination"`
 }
 
 // NewQueryAllChannelsParams creates a new QueryAllChannelsParams instance.
-func NewQueryAllChannelsParams(page, limit int) QueryAllChannelsParams {
+func NewQueryAllChannelsParams(pagination *query.PageRequest) QueryAllChannelsParams {
 	return QueryAllChannelsParams{
-		Page:  page,
-		Limit: limit,
+		Pagination: pagination,
 	}
 }
 
 // QueryConnectionChannelsParams defines the parameters necessary for querying
 // for all channels associated with a given connection.
 type QueryConnectionChannelsParams struct {
-	Connection string `json:"connection" yaml:"connection"`
-	Page       int    `json:"page" yaml:"page"`
-	Limit      int    `json:"limit" yaml:"limit"`
+	Connection string             `json:"connection" yaml:"connection"`
+	Pagination *query.PageRequest `json:"pagination" yaml:"pagination"`
 }
 
 // NewQueryConnectionChannelsParams creates a new QueryConnectionChannelsParams instance.
-func NewQueryConnectionChannelsParams(connection string, page, limit int) QueryConnectionChannelsParams {
+func NewQueryConnectionChannelsParams(connection string, pagination *query.PageRequest) QueryConnectionChannelsParams {
 	return QueryConnectionChannelsParams{
 		Connection: connection,
-		Page:       page,
-		Limit:      limit,
+		Pagination: pagination,
+	}
+}
+
+// QueryChannelsResponse defines the response of the queries for all channels
+// and for the channels associated with a given connection.
+type QueryChannelsResponse struct {
+	Channels   []IdentifiedChannel `json:"channels" yaml:"channels"`
+	Pagination *query.PageResponse `json:"pagination" yaml:"pagination"`
+}
+
+// NewQueryChannelsResponse creates a new QueryChannelsResponse instance.
+func NewQueryChannelsResponse(channels []IdentifiedChannel, pagination *query.PageResponse) QueryChannelsResponse {
+	return QueryChannelsResponse{
+		Channels:   channels,
+		Pagination: pagination,
 	}
 }
 
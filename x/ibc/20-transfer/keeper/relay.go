@@ -95,6 +95,11 @@ func (k Keeper) createOutgoingPacket(
 			}
 		}
 
+		// the coins whose sends are disabled cannot leave the chain either
+		if err := k.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+			return err
+		}
+
 		// escrow tokens if the destination chain is the same as the sender's
 		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
 
@@ -114,6 +119,10 @@ func (k Keeper) createOutgoingPacket(
 			}
 		}
 
+		if err := k.bankKeeper.IsSendEnabledCoins(ctx, amount...); err != nil {
+			return err
+		}
+
 		// transfer the coins to the module account and burn them
 		if err := k.supplyKeeper.SendCoinsFromAccountToModule(
 			ctx, sender, types.GetModuleAccountName(), amount,
@@ -39,6 +39,9 @@ Proper vetting of a parameter change proposal should prevent this from happening
 (no deposits should occur during the governance process), but it should be noted
 regardless.
 
+Urgent parameter changes can set "expedited" to true, to use the shorter voting
+period, higher threshold and higher minimum deposit of expedited proposals.
+
 Example:
 $ %s tx gov submit-proposal param-change <path/to/proposal.json> --from=<key_or_address>
 
@@ -54,7 +57,8 @@ Where proposal.json contains:
       "value": 105
     }
   ],
-  "deposit": "1000stake"
+  "deposit": "1000stake",
+  "expedited": false
 }
 `,
 				version.ClientName,
@@ -81,6 +85,7 @@ Where proposal.json contains:
 			}
 
 			msg := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			msg.Expedited = proposal.Expedited
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -121,6 +126,9 @@ Proper vetting of a parameter change proposal should prevent this from happening
 (no deposits should occur during the governance process), but it should be noted
 regardless.
 
+Urgent parameter changes can set "expedited" to true, to use the shorter voting
+period, higher threshold and higher minimum deposit of expedited proposals.
+
 Example:
 $ %s tx gov submit-proposal param-change <path/to/proposal.json> --from=<key_or_address>
 
@@ -136,7 +144,8 @@ Where proposal.json contains:
       "value": 105
     }
   ],
-  "deposit": "1000stake"
+  "deposit": "1000stake",
+  "expedited": false
 }
 `,
 				version.ClientName,
@@ -161,6 +170,7 @@ Where proposal.json contains:
 			}
 
 			msg := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			msg.Expedited = proposal.Expedited
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -37,6 +37,7 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		content := proposal.NewParameterChangeProposal(req.Title, req.Description, req.Changes.ToParamChanges())
 
 		msg := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		msg.Expedited = req.Expedited
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
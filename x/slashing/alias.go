@@ -59,6 +59,7 @@ var (
 	DefaultParams                            = types.DefaultParams
 	NewQuerySigningInfoParams                = types.NewQuerySigningInfoParams
 	NewQuerySigningInfosParams               = types.NewQuerySigningInfosParams
+	NewQuerySigningInfosResponse             = types.NewQuerySigningInfosResponse
 	NewValidatorSigningInfo                  = types.NewValidatorSigningInfo
 
 	// variable aliases
@@ -77,13 +78,14 @@ var (
 )
 
 type (
-	Hooks                   = keeper.Hooks
-	Keeper                  = keeper.Keeper
-	GenesisState            = types.GenesisState
-	MissedBlock             = types.MissedBlock
-	MsgUnjail               = types.MsgUnjail
-	Params                  = types.Params
-	QuerySigningInfoParams  = types.QuerySigningInfoParams
-	QuerySigningInfosParams = types.QuerySigningInfosParams
-	ValidatorSigningInfo    = types.ValidatorSigningInfo
+	Hooks                     = keeper.Hooks
+	Keeper                    = keeper.Keeper
+	GenesisState              = types.GenesisState
+	MissedBlock               = types.MissedBlock
+	MsgUnjail                 = types.MsgUnjail
+	Params                    = types.Params
+	QuerySigningInfoParams    = types.QuerySigningInfoParams
+	QuerySigningInfosParams   = types.QuerySigningInfosParams
+	QuerySigningInfosResponse = types.QuerySigningInfosResponse
+	ValidatorSigningInfo      = types.ValidatorSigningInfo
 )
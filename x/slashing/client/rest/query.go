@@ -64,7 +64,7 @@ func signingInfoHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 // http request handler to query signing info
 func signingInfoHandlerListFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		pageReq, err := rest.ParsePageRequest(r)
 		if rest.CheckBadRequestError(w, err) {
 			return
 		}
@@ -74,7 +74,7 @@ func signingInfoHandlerListFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		params := types.NewQuerySigningInfosParams(page, limit)
+		params := types.NewQuerySigningInfosParams(pageReq)
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if rest.CheckInternalServerError(w, err) {
 			return
@@ -3,10 +3,11 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
@@ -69,21 +70,32 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	var signingInfos []types.ValidatorSigningInfo
+	// the limit of the page defaults to the maximum number of validators
+	pagination := params.Pagination
+	if pagination == nil || pagination.Limit == 0 {
+		req := query.PageRequest{Limit: uint64(k.sk.MaxValidators(ctx))}
+		if pagination != nil {
+			req.Key, req.Offset, req.CountTotal = pagination.Key, pagination.Offset, pagination.CountTotal
+		}
+		pagination = &req
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSigningInfoKey)
+	signingInfos := []types.ValidatorSigningInfo{}
 
-	k.IterateValidatorSigningInfos(ctx, func(consAddr sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var info types.ValidatorSigningInfo
+		if err := k.cdc.UnmarshalBinaryBare(value, &info); err != nil {
+			return err
+		}
 		signingInfos = append(signingInfos, info)
-		return false
+		return nil
 	})
-
-	start, end := client.Paginate(len(signingInfos), params.Page, params.Limit, int(k.sk.MaxValidators(ctx)))
-	if start < 0 || end < 0 {
-		signingInfos = []types.ValidatorSigningInfo{}
-	} else {
-		signingInfos = signingInfos[start:end]
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, signingInfos)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewQuerySigningInfosResponse(signingInfos, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
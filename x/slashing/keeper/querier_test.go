@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -9,6 +11,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -51,3 +55,43 @@ func TestQueryParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, app.SlashingKeeper.GetParams(ctx), params)
 }
+
+func TestQuerySigningInfos(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	querier := keeper.NewQuerier(app.SlashingKeeper)
+
+	consAddrs := make([]sdk.ConsAddress, 3)
+	for i := range consAddrs {
+		consAddrs[i] = sdk.ConsAddress(bytes.Repeat([]byte{byte(i + 1)}, sdk.AddrLen))
+		info := types.NewValidatorSigningInfo(consAddrs[i], int64(i), 0, time.Unix(0, 0), false, 0)
+		app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddrs[i], info)
+	}
+
+	querySigningInfos := func(pagination *query.PageRequest) types.QuerySigningInfosResponse {
+		bz, err := querier(ctx, []string{types.QuerySigningInfos}, abci.RequestQuery{
+			Data: app.Codec().MustMarshalJSON(types.NewQuerySigningInfosParams(pagination)),
+		})
+		require.NoError(t, err)
+
+		var res types.QuerySigningInfosResponse
+		require.NoError(t, app.Codec().UnmarshalJSON(bz, &res))
+		return res
+	}
+
+	// the limit defaults to the maximum number of validators
+	res := querySigningInfos(nil)
+	require.Len(t, res.SigningInfos, 3)
+	require.Nil(t, res.Pagination.NextKey)
+
+	res = querySigningInfos(&query.PageRequest{Limit: 2, CountTotal: true})
+	require.Len(t, res.SigningInfos, 2)
+	require.Equal(t, consAddrs[0], res.SigningInfos[0].Address)
+	require.Equal(t, consAddrs[2].Bytes(), res.Pagination.NextKey)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res = querySigningInfos(&query.PageRequest{Key: res.Pagination.NextKey, Limit: 2})
+	require.Len(t, res.SigningInfos, 1)
+	require.Equal(t, consAddrs[2], res.SigningInfos[0].Address)
+	require.Nil(t, res.Pagination.NextKey)
+}
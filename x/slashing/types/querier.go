@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // DONTCOVER
@@ -27,10 +28,22 @@ func NewQuerySigningInfoParams(consAddr sdk.ConsAddress) QuerySigningInfoParams
 // QuerySigningInfosParams defines the params for the following queries:
 // - 'custom/slashing/signingInfos'
 type QuerySigningInfosParams struct {
-	Page, Limit int
+	Pagination *query.PageRequest
 }
 
 // NewQuerySigningInfosParams creates a new QuerySigningInfosParams instance
-func NewQuerySigningInfosParams(page, limit int) QuerySigningInfosParams {
-	return QuerySigningInfosParams{page, limit}
+func NewQuerySigningInfosParams(pagination *query.PageRequest) QuerySigningInfosParams {
+	return QuerySigningInfosParams{pagination}
+}
+
+// QuerySigningInfosResponse defines the response of the following queries:
+// - 'custom/slashing/signingInfos'
+type QuerySigningInfosResponse struct {
+	SigningInfos []ValidatorSigningInfo `json:"signing_infos" yaml:"signing_infos"`
+	Pagination   *query.PageResponse    `json:"pagination" yaml:"pagination"`
+}
+
+// NewQuerySigningInfosResponse creates a new QuerySigningInfosResponse instance
+func NewQuerySigningInfosResponse(signingInfos []ValidatorSigningInfo, pagination *query.PageResponse) QuerySigningInfosResponse {
+	return QuerySigningInfosResponse{signingInfos, pagination}
 }
@@ -52,7 +52,9 @@ var (
 	NewKeeper                          = keeper.NewKeeper
 	ParamKeyTable                      = keeper.ParamKeyTable
 	NewQuerier                         = keeper.NewQuerier
+	NewQueryServer                     = keeper.NewQueryServer
 	RegisterCodec                      = types.RegisterCodec
+	RegisterQueryService               = types.RegisterQueryService
 	NewCommissionRates                 = types.NewCommissionRates
 	NewCommission                      = types.NewCommission
 	NewCommissionWithTime              = types.NewCommissionWithTime
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	bankutils "github.com/cosmos/cosmos-sdk/x/bank/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -170,7 +171,7 @@ $ %s tx staking delegate cosmosvaloper1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 10
 
 			cliCtx := context.NewCLIContextWithInput(inBuf).WithMarshaler(m)
 
-			amount, err := sdk.ParseCoin(args[1])
+			amount, err := bankutils.ParseCoin(cliCtx, args[1])
 			if err != nil {
 				return err
 			}
@@ -228,7 +229,7 @@ $ %s tx staking redelegate cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 				return err
 			}
 
-			amount, err := sdk.ParseCoin(args[2])
+			amount, err := bankutils.ParseCoin(cliCtx, args[2])
 			if err != nil {
 				return err
 			}
@@ -272,7 +273,7 @@ $ %s tx staking unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100s
 				return err
 			}
 
-			amount, err := sdk.ParseCoin(args[1])
+			amount, err := bankutils.ParseCoin(cliCtx, args[1])
 			if err != nil {
 				return err
 			}
@@ -290,7 +291,7 @@ $ %s tx staking unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100s
 
 func NewBuildCreateValidatorMsg(cliCtx context.CLIContext, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
 	amounstStr := viper.GetString(FlagAmount)
-	amount, err := sdk.ParseCoin(amounstStr)
+	amount, err := bankutils.ParseCoin(cliCtx, amounstStr)
 	if err != nil {
 		return txf, nil, err
 	}
@@ -566,7 +567,7 @@ $ %s tx staking delegate cosmosvaloper1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 10
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
 			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
 
-			amount, err := sdk.ParseCoin(args[1])
+			amount, err := bankutils.ParseCoin(cliCtx, args[1])
 			if err != nil {
 				return err
 			}
@@ -614,7 +615,7 @@ $ %s tx staking redelegate cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 				return err
 			}
 
-			amount, err := sdk.ParseCoin(args[2])
+			amount, err := bankutils.ParseCoin(cliCtx, args[2])
 			if err != nil {
 				return err
 			}
@@ -651,7 +652,7 @@ $ %s tx staking unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100s
 				return err
 			}
 
-			amount, err := sdk.ParseCoin(args[1])
+			amount, err := bankutils.ParseCoin(cliCtx, args[1])
 			if err != nil {
 				return err
 			}
@@ -665,7 +666,7 @@ $ %s tx staking unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100s
 // BuildCreateValidatorMsg makes a new MsgCreateValidator.
 func BuildCreateValidatorMsg(cliCtx context.CLIContext, txBldr auth.TxBuilder) (auth.TxBuilder, sdk.Msg, error) {
 	amounstStr := viper.GetString(FlagAmount)
-	amount, err := sdk.ParseCoin(amounstStr)
+	amount, err := bankutils.ParseCoin(cliCtx, amounstStr)
 	if err != nil {
 		return txBldr, nil, err
 	}
@@ -263,7 +263,7 @@ func delegatorValidatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 // HTTP request handler to query list of validators
 func validatorsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		pageReq, err := rest.ParsePageRequest(r)
 		if rest.CheckBadRequestError(w, err) {
 			return
 		}
@@ -278,7 +278,7 @@ func validatorsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			status = sdk.BondStatusBonded
 		}
 
-		params := types.NewQueryValidatorsParams(page, limit, status)
+		params := types.NewQueryValidatorsParams(pageReq, status)
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if rest.CheckBadRequestError(w, err) {
 			return
@@ -812,6 +812,117 @@ func (k Keeper) CompleteRedelegation(
 	return balances, nil
 }
 
+// TransferDelegation moves up to wantShares of the delegation of fromAddr to
+// the validator to toAddr and returns the shares moved. The tokens remain bonded
+// to the validator. Nothing is moved while fromAddr has a redelegation to the
+// validator in progress, as slashing the redelegation would then find no shares
+// to take.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) sdk.Dec {
+
+	transferred := sdk.ZeroDec()
+
+	if k.HasReceivingRedelegation(ctx, fromAddr, valAddr) {
+		return transferred
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return transferred
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	transferred = sdk.MinDec(delFrom.Shares, wantShares)
+	if !transferred.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+	delFrom.Shares = delFrom.Shares.Sub(transferred)
+	if delFrom.Shares.IsZero() {
+		k.RemoveDelegation(ctx, delFrom)
+	} else {
+		k.SetDelegation(ctx, delFrom)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+
+	// as on an unbonding, jail the validator if its self delegation drops below
+	// its minimum
+	if fromAddr.Equals(validator.OperatorAddress) && !validator.Jailed &&
+		validator.TokensFromShares(delFrom.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+
+		k.jailValidator(ctx, validator)
+	}
+
+	return transferred
+}
+
+// TransferUnbonding moves up to wantAmt of the unbonding delegation of fromAddr
+// from the validator to toAddr, starting with the entries completing last, and
+// returns the amount moved. The moved entries keep their completion time.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+
+	transferred := sdk.ZeroInt()
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	for i := len(ubdFrom.Entries) - 1; i >= 0 && wantAmt.IsPositive(); i-- {
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		entry := ubdFrom.Entries[i]
+		amt := sdk.MinInt(entry.Balance, wantAmt)
+		if !amt.IsPositive() {
+			continue
+		}
+
+		if amt.Equal(entry.Balance) {
+			ubdFrom.RemoveEntry(int64(i))
+		} else {
+			entry.Balance = entry.Balance.Sub(amt)
+			entry.InitialBalance = sdk.MaxInt(entry.InitialBalance.Sub(amt), entry.Balance)
+			ubdFrom.Entries[i] = entry
+		}
+
+		ubdTo := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, amt)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		transferred = transferred.Add(amt)
+		wantAmt = wantAmt.Sub(amt)
+	}
+
+	if len(ubdFrom.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubdFrom)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubdFrom)
+	}
+
+	return transferred
+}
+
 // ValidateUnbondAmount validates that a given unbond or redelegation amount is
 // valied based on upon the converted shares. If the amount is valid, the total
 // amount of respective shares is returned, otherwise an error is returned.
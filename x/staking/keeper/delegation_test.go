@@ -1015,3 +1015,90 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(10))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.AfterValidatorCreated(ctx, valAddrs[0])
+
+	delTokens := sdk.TokensFromConsensusPower(6)
+	shares, err := app.StakingKeeper.Delegate(ctx, delAddrs[1], delTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// move part of the delegation to a new delegator
+	transferred := app.StakingKeeper.TransferDelegation(ctx, delAddrs[1], delAddrs[0], valAddrs[0], shares.QuoInt64(3))
+	require.Equal(t, shares.QuoInt64(3), transferred)
+
+	delFrom, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, shares.Sub(transferred), delFrom.Shares)
+
+	delTo, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, transferred, delTo.Shares)
+
+	// at most the shares of the delegation are moved
+	transferred = app.StakingKeeper.TransferDelegation(ctx, delAddrs[1], delAddrs[0], valAddrs[0], shares)
+	require.Equal(t, delFrom.Shares, transferred)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.False(t, found)
+
+	delTo, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, shares, delTo.Shares)
+	require.Equal(t, delTokens, app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[0]))
+	require.True(t, app.StakingKeeper.GetDelegatorBonded(ctx, delAddrs[1]).IsZero())
+
+	// nothing is moved without a delegation
+	transferred = app.StakingKeeper.TransferDelegation(ctx, delAddrs[0], delAddrs[1], valAddrs[1], shares)
+	require.True(t, transferred.IsZero())
+}
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	completionTime := ctx.BlockHeader().Time.Add(time.Hour)
+	ubd := types.NewUnbondingDelegation(delAddrs[0], valAddrs[0], 0, completionTime, sdk.NewInt(5))
+	ubd.AddEntry(1, completionTime.Add(time.Hour), sdk.NewInt(10))
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+	require.Equal(t, sdk.NewInt(15), app.StakingKeeper.GetDelegatorUnbonding(ctx, delAddrs[0]))
+
+	// the entries completing last are moved first
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(12))
+	require.Equal(t, sdk.NewInt(12), transferred)
+
+	ubdFrom, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdFrom.Entries, 1)
+	require.Equal(t, sdk.NewInt(3), ubdFrom.Entries[0].Balance)
+	require.Equal(t, completionTime, ubdFrom.Entries[0].CompletionTime)
+
+	ubdTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdTo.Entries, 2)
+	require.Equal(t, sdk.NewInt(10), ubdTo.Entries[0].Balance)
+	require.Equal(t, completionTime.Add(time.Hour), ubdTo.Entries[0].CompletionTime)
+	require.Equal(t, sdk.NewInt(2), ubdTo.Entries[1].Balance)
+	require.Equal(t, completionTime, ubdTo.Entries[1].CompletionTime)
+
+	// the moved entries complete with the queue
+	queueCtx := ctx.WithBlockTime(completionTime.Add(time.Hour))
+	require.Len(t, app.StakingKeeper.DequeueAllMatureUBDQueue(queueCtx, queueCtx.BlockTime()), 2)
+
+	// at most the unbonding amount is moved
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(12))
+	require.Equal(t, sdk.NewInt(3), transferred)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(15), app.StakingKeeper.GetDelegatorUnbonding(ctx, delAddrs[1]))
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type queryServer struct {
+	k Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the staking gRPC query service
+// backed by the given Keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Validator implements the Query/Validator gRPC method.
+func (q queryServer) Validator(goCtx context.Context, req *types.QueryValidatorRequest) (*types.QueryValidatorResponse, error) {
+	if req.ValidatorAddr.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	validator, found := q.k.GetValidator(ctx, req.ValidatorAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	return &types.QueryValidatorResponse{Validator: validator}, nil
+}
+
+// Validators implements the Query/Validators gRPC method.
+func (q queryServer) Validators(goCtx context.Context, req *types.QueryValidatorsRequest) (*types.QueryValidatorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	validators, pageRes, err := q.k.GetValidatorsPaginated(ctx, req.Status, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryValidatorsResponse{Validators: validators, Pagination: pageRes}, nil
+}
+
+// Delegation implements the Query/Delegation gRPC method.
+func (q queryServer) Delegation(goCtx context.Context, req *types.QueryDelegationRequest) (*types.QueryDelegationResponse, error) {
+	if req.DelegatorAddr.Empty() || req.ValidatorAddr.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "delegator and validator addresses cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegation, found := q.k.GetDelegation(ctx, req.DelegatorAddr, req.ValidatorAddr)
+	if !found {
+		return nil, types.ErrNoDelegation
+	}
+
+	delResp, err := delegationToDelegationResponse(ctx, q.k, delegation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationResponse{Delegation: delResp.Delegation, Balance: delResp.Balance}, nil
+}
+
+// Params implements the Query/Params gRPC method.
+func (q queryServer) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: q.k.GetParams(ctx)}, nil
+}
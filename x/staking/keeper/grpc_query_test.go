@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestQueryServerValidators(t *testing.T) {
+	_, app, ctx := createTestInput()
+	queryServer := staking.NewQueryServer(app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(10000))
+	addrVal1, addrVal2 := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])
+
+	val1 := types.NewValidator(addrVal1, PKs[0], types.Description{})
+	app.StakingKeeper.SetValidator(ctx, val1)
+	val2 := types.NewValidator(addrVal2, PKs[1], types.Description{})
+	val2.Status = sdk.Bonded
+	app.StakingKeeper.SetValidator(ctx, val2)
+
+	_, err := queryServer.Validator(sdk.WrapSDKContext(ctx), &types.QueryValidatorRequest{})
+	require.Error(t, err)
+
+	_, err = queryServer.Validator(sdk.WrapSDKContext(ctx), &types.QueryValidatorRequest{ValidatorAddr: sdk.ValAddress(PKs[2].Address())})
+	require.Equal(t, types.ErrNoValidatorFound, err)
+
+	valRes, err := queryServer.Validator(sdk.WrapSDKContext(ctx), &types.QueryValidatorRequest{ValidatorAddr: addrVal1})
+	require.NoError(t, err)
+	require.True(ValEq(t, val1, valRes.Validator))
+
+	// the validators are returned in operator address order
+	all := []types.Validator{val1, val2}
+	if bytes.Compare(addrVal1, addrVal2) > 0 {
+		all = []types.Validator{val2, val1}
+	}
+
+	testCases := []struct {
+		status     string
+		pagination *query.PageRequest
+		expected   []types.Validator
+	}{
+		{sdk.BondStatusUnbonded, nil, []types.Validator{val1}},
+		{sdk.BondStatusBonded, nil, []types.Validator{val2}},
+		{"bonded", &query.PageRequest{Limit: 1}, []types.Validator{val2}},
+		{"", nil, all},
+		{sdk.BondStatusBonded, &query.PageRequest{Offset: 1, Limit: 1}, []types.Validator{}},
+		{sdk.BondStatusUnbonding, nil, []types.Validator{}},
+	}
+
+	for i, tc := range testCases {
+		res, err := queryServer.Validators(sdk.WrapSDKContext(ctx), &types.QueryValidatorsRequest{
+			Status: tc.status, Pagination: tc.pagination,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Validators, len(tc.expected), "case %d", i)
+		for j := range tc.expected {
+			require.True(ValEq(t, tc.expected[j], res.Validators[j]))
+		}
+	}
+}
+
+func TestQueryServerDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+	queryServer := staking.NewQueryServer(app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(10000))
+	addrAcc1, addrAcc2 := addrs[0], addrs[1]
+	addrVal1 := sdk.ValAddress(addrAcc1)
+
+	val1 := types.NewValidator(addrVal1, PKs[0], types.Description{})
+	app.StakingKeeper.SetValidator(ctx, val1)
+	app.StakingKeeper.SetValidatorByPowerIndex(ctx, val1)
+
+	req := &types.QueryDelegationRequest{DelegatorAddr: addrAcc2, ValidatorAddr: addrVal1}
+	_, err := queryServer.Delegation(sdk.WrapSDKContext(ctx), req)
+	require.Equal(t, types.ErrNoDelegation, err)
+
+	delTokens := sdk.TokensFromConsensusPower(20)
+	_, err = app.StakingKeeper.Delegate(ctx, addrAcc2, delTokens, sdk.Unbonded, val1, true)
+	require.NoError(t, err)
+
+	_, err = queryServer.Delegation(sdk.WrapSDKContext(ctx), &types.QueryDelegationRequest{DelegatorAddr: addrAcc2})
+	require.Error(t, err)
+
+	res, err := queryServer.Delegation(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrAcc2, addrVal1)
+	require.True(t, found)
+	require.Equal(t, delegation, res.Delegation)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, delTokens), res.Balance)
+
+	paramsRes, err := queryServer.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, app.StakingKeeper.GetParams(ctx), paramsRes.Params)
+}
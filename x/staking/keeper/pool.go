@@ -62,7 +62,7 @@ func (k Keeper) TotalBondedTokens(ctx sdk.Context) sdk.Int {
 
 // StakingTokenSupply staking tokens from the total supply
 func (k Keeper) StakingTokenSupply(ctx sdk.Context) sdk.Int {
-	return k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(k.BondDenom(ctx))
+	return k.supplyKeeper.GetSupplyOf(ctx, k.BondDenom(ctx))
 }
 
 // BondedRatio the fraction of the staking tokens which are currently bonded
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -156,7 +157,7 @@ func TestQueryValidators(t *testing.T) {
 	require.Len(t, queriedValidators, 3)
 
 	for i, s := range status {
-		queryValsParams := types.NewQueryValidatorsParams(1, int(params.MaxValidators), s.String())
+		queryValsParams := types.NewQueryValidatorsParams(&query.PageRequest{Limit: uint64(params.MaxValidators)}, s.String())
 		bz, err := cdc.MarshalJSON(queryValsParams)
 		require.NoError(t, err)
 
@@ -168,12 +169,12 @@ func TestQueryValidators(t *testing.T) {
 		res, err := querier(ctx, []string{types.QueryValidators}, req)
 		require.NoError(t, err)
 
-		var validatorsResp []types.Validator
+		var validatorsResp types.QueryValidatorsResponse
 		err = cdc.UnmarshalJSON(res, &validatorsResp)
 		require.NoError(t, err)
 
-		require.Equal(t, 1, len(validatorsResp))
-		require.ElementsMatch(t, validators[i].OperatorAddress, validatorsResp[0].OperatorAddress)
+		require.Equal(t, 1, len(validatorsResp.Validators))
+		require.ElementsMatch(t, validators[i].OperatorAddress, validatorsResp.Validators[0].OperatorAddress)
 	}
 
 	// Query each validator
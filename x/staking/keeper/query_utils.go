@@ -119,3 +119,30 @@ func (k Keeper) GetAllRedelegations(
 
 	return redelegations
 }
+
+// GetDelegatorBonded returns the amount of tokens bonded by all the delegations
+// of a delegator.
+func (k Keeper) GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	bonded := sdk.ZeroDec()
+	for _, delegation := range k.GetAllDelegatorDelegations(ctx, delegator) {
+		validator, found := k.GetValidator(ctx, delegation.ValidatorAddress)
+		if found {
+			bonded = bonded.Add(validator.TokensFromSharesTruncated(delegation.Shares))
+		}
+	}
+
+	return bonded.RoundInt()
+}
+
+// GetDelegatorUnbonding returns the amount of tokens of all the unbonding
+// delegations of a delegator.
+func (k Keeper) GetDelegatorUnbonding(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	unbonding := sdk.ZeroInt()
+	for _, ubd := range k.GetAllUnbondingDelegations(ctx, delegator) {
+		for _, entry := range ubd.Entries {
+			unbonding = unbonding.Add(entry.Balance)
+		}
+	}
+
+	return unbonding
+}
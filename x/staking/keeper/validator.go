@@ -3,11 +3,14 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -220,6 +223,44 @@ func (k Keeper) GetAllValidators(ctx sdk.Context) (validators []types.Validator)
 	return validators
 }
 
+// GetValidatorsPaginated returns a page of the validators with the given bond
+// status, compared case-insensitively, in operator address order. An empty
+// status matches all the validators. The limit of the page defaults to the
+// maximum number of validators.
+func (k Keeper) GetValidatorsPaginated(ctx sdk.Context, status string, pagination *query.PageRequest) ([]types.Validator, *query.PageResponse, error) {
+	if pagination == nil || pagination.Limit == 0 {
+		req := query.PageRequest{Limit: uint64(k.MaxValidators(ctx))}
+		if pagination != nil {
+			req.Key, req.Offset, req.CountTotal = pagination.Key, pagination.Offset, pagination.CountTotal
+		}
+		pagination = &req
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorsKey)
+	validators := []types.Validator{}
+
+	pageRes, err := query.FilteredPaginate(store, pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		validator, err := types.UnmarshalValidator(k.cdc, value)
+		if err != nil {
+			return false, err
+		}
+
+		if status != "" && !strings.EqualFold(validator.GetStatus().String(), status) {
+			return false, nil
+		}
+
+		if accumulate {
+			validators = append(validators, validator)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return validators, pageRes, nil
+}
+
 // return a given amount of all the validators
 func (k Keeper) GetValidators(ctx sdk.Context, maxRetrieve uint32) (validators []types.Validator) {
 	store := ctx.KVStore(k.storeKey)
@@ -152,6 +152,11 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// RegisterQueryService registers the gRPC query service of the staking module.
+func (am AppModule) RegisterQueryService(server sdk.GRPCServer) {
+	RegisterQueryService(server, NewQueryServer(am.keeper))
+}
+
 // InitGenesis performs genesis initialization for the staking module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
@@ -30,7 +30,7 @@ type BankKeeper interface {
 
 // SupplyKeeper defines the expected supply Keeper (noalias)
 type SupplyKeeper interface {
-	GetSupply(ctx sdk.Context) supplyexported.SupplyI
+	GetSupplyOf(ctx sdk.Context, denom string) sdk.Int
 
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx sdk.Context, moduleName string) supplyexported.ModuleAccountI
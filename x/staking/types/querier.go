@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // query endpoints supported by the staking Querier
@@ -90,12 +91,12 @@ func NewQueryRedelegationParams(delegatorAddr sdk.AccAddress,
 // QueryValidatorsParams defines the params for the following queries:
 // - 'custom/staking/validators'
 type QueryValidatorsParams struct {
-	Page, Limit int
-	Status      string
+	Pagination *query.PageRequest
+	Status     string
 }
 
-func NewQueryValidatorsParams(page, limit int, status string) QueryValidatorsParams {
-	return QueryValidatorsParams{page, limit, status}
+func NewQueryValidatorsParams(pagination *query.PageRequest, status string) QueryValidatorsParams {
+	return QueryValidatorsParams{pagination, status}
 }
 
 // QueryHistoricalInfoParams defines the params for the following queries:
@@ -108,3 +109,9 @@ type QueryHistoricalInfoParams struct {
 func NewQueryHistoricalInfoParams(height int64) QueryHistoricalInfoParams {
 	return QueryHistoricalInfoParams{height}
 }
+
+// RegisterQueryService registers the gRPC query service of the module on a
+// gRPC server, such as a *grpc.Server or the gRPC query router of BaseApp.
+func RegisterQueryService(server sdk.GRPCServer, srv QueryServer) {
+	server.RegisterService(&_Query_serviceDesc, srv)
+}
@@ -25,7 +25,7 @@ var (
 	TotalSupply           = keeper.TotalSupply
 	NewKeeper             = keeper.NewKeeper
 	NewQuerier            = keeper.NewQuerier
-	SupplyKey             = keeper.SupplyKey
+	NewQueryServer        = keeper.NewQueryServer
 	NewModuleAddress      = types.NewModuleAddress
 	NewEmptyModuleAccount = types.NewEmptyModuleAccount
 	NewModuleAccount      = types.NewModuleAccount
@@ -36,7 +36,9 @@ var (
 	DefaultSupply         = types.DefaultSupply
 
 	// variable aliases
-	ModuleCdc = types.ModuleCdc
+	ModuleCdc       = types.ModuleCdc
+	LegacySupplyKey = keeper.LegacySupplyKey
+	SupplyKeyPrefix = keeper.SupplyKeyPrefix
 )
 
 type (
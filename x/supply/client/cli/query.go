@@ -28,6 +28,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	supplyQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryTotalSupply(cdc),
+		GetCmdQuerySupplyOf(cdc),
 	)...)
 
 	return supplyQueryCmd
@@ -35,7 +36,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 // GetCmdQueryTotalSupply implements the query total supply command.
 func GetCmdQueryTotalSupply(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "total [denom]",
 		Args:  cobra.MaximumNArgs(1),
 		Short: "Query the total supply of coins of the chain",
@@ -45,11 +46,13 @@ func GetCmdQueryTotalSupply(cdc *codec.Codec) *cobra.Command {
 
 Example:
 $ %s query %s total
+$ %s query %s total --page-key=c3Rha2U= --limit=10
 
 To query for the total supply of a specific coin denomination use:
 $ %s query %s total stake
 `,
 				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+				version.ClientName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -61,10 +64,41 @@ $ %s query %s total stake
 			return querySupplyOf(cliCtx, cdc, args[0])
 		},
 	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "the total supply")
+	return cmd
+}
+
+// GetCmdQuerySupplyOf implements the query supply of a denomination command.
+func GetCmdQuerySupplyOf(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "supply-of [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the total supply of a coin denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total supply of a single coin denomination, without
+going through the supply of all the other denominations.
+
+Example:
+$ %s query %s supply-of stake
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			return querySupplyOf(cliCtx, cdc, args[0])
+		},
+	}
 }
 
 func queryTotalSupply(cliCtx context.CLIContext, cdc *codec.Codec) error {
-	params := types.NewQueryTotalSupplyParams(1, 0) // no pagination
+	pageReq, err := client.ReadPageRequest()
+	if err != nil {
+		return err
+	}
+
+	params := types.NewQueryTotalSupplyParams(pageReq)
 	bz, err := cdc.MarshalJSON(params)
 	if err != nil {
 		return err
@@ -75,7 +109,7 @@ func queryTotalSupply(cliCtx context.CLIContext, cdc *codec.Codec) error {
 		return err
 	}
 
-	var totalSupply sdk.Coins
+	var totalSupply types.QueryTotalSupplyResponse
 	err = cdc.UnmarshalJSON(res, &totalSupply)
 	if err != nil {
 		return err
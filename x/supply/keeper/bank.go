@@ -111,10 +111,10 @@ func (k Keeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) err
 	}
 
 	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Inflate(amt)
-
-	k.SetSupply(ctx, supply)
+	for _, coin := range amt {
+		supply := k.GetSupplyOf(ctx, coin.Denom)
+		k.SetSupplyOf(ctx, sdk.NewCoin(coin.Denom, supply.Add(coin.Amount)))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("minted %s from %s module account", amt.String(), moduleName))
@@ -140,9 +140,10 @@ func (k Keeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) err
 	}
 
 	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Deflate(amt)
-	k.SetSupply(ctx, supply)
+	for _, coin := range amt {
+		supply := k.GetSupplyOf(ctx, coin.Denom)
+		k.SetSupplyOf(ctx, sdk.NewCoin(coin.Denom, supply.Sub(coin.Amount)))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("burned %s from %s module account", amt.String(), moduleName))
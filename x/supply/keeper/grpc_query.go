@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/supply/types"
+)
+
+type queryServer struct {
+	k Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the supply gRPC query service
+// backed by the given Keeper.
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// TotalSupply implements the Query/TotalSupply gRPC method.
+func (q queryServer) TotalSupply(goCtx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	supply, pageRes, err := q.k.GetPaginatedTotalSupply(ctx, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.QueryTotalSupplyResponse{Supply: supply, Pagination: pageRes}, nil
+}
+
+// SupplyOf implements the Query/SupplyOf gRPC method.
+func (q queryServer) SupplyOf(goCtx context.Context, req *types.QuerySupplyOfRequest) (*types.QuerySupplyOfResponse, error) {
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount := sdk.NewCoin(req.Denom, q.k.GetSupplyOf(ctx, req.Denom))
+
+	return &types.QuerySupplyOfResponse{Amount: amount}, nil
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keep "github.com/cosmos/cosmos-sdk/x/supply/keeper"
+	"github.com/cosmos/cosmos-sdk/x/supply/types"
+)
+
+func TestQueryServer_TotalSupply(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	queryServer := keep.NewQueryServer(app.SupplyKeeper)
+
+	supplyCoins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("photon", 20))
+	app.SupplyKeeper.SetSupply(ctx, types.NewSupply(supplyCoins))
+
+	req := &types.QueryTotalSupplyRequest{Pagination: &query.PageRequest{Limit: 1}}
+	res, err := queryServer.TotalSupply(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, supplyCoins[:1], res.Supply)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	req = &types.QueryTotalSupplyRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}}
+	res, err = queryServer.TotalSupply(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, supplyCoins[1:], res.Supply)
+	require.Nil(t, res.Pagination.NextKey)
+}
+
+func TestQueryServer_SupplyOf(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	queryServer := keep.NewQueryServer(app.SupplyKeeper)
+
+	app.SupplyKeeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("atom", 10))))
+
+	_, err := queryServer.SupplyOf(sdk.WrapSDKContext(ctx), &types.QuerySupplyOfRequest{})
+	require.Error(t, err)
+
+	res, err := queryServer.SupplyOf(sdk.WrapSDKContext(ctx), &types.QuerySupplyOfRequest{Denom: "atom"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("atom", 10), res.Amount)
+
+	res, err = queryServer.SupplyOf(sdk.WrapSDKContext(ctx), &types.QuerySupplyOfRequest{Denom: "photon"})
+	require.NoError(t, err)
+	require.True(t, res.Amount.IsZero())
+}
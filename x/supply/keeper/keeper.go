@@ -5,7 +5,9 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/supply/exported"
 	"github.com/cosmos/cosmos-sdk/x/supply/types"
 )
@@ -44,31 +46,142 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-// GetSupply retrieves the Supply from store
+// GetSupply retrieves the Supply from store, holding the total supply of all
+// the denominations.
 func (k Keeper) GetSupply(ctx sdk.Context) exported.SupplyI {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(SupplyKey)
+	total := sdk.NewCoins()
+	k.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		total = append(total, coin)
+		return false
+	})
+
+	return types.NewSupply(total)
+}
+
+// SetSupply sets the Supply to store, replacing the total supply of all the
+// denominations.
+func (k Keeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
+	supplyStore := prefix.NewStore(ctx.KVStore(k.storeKey), SupplyKeyPrefix)
+
+	var denoms [][]byte
+	iterator := supplyStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, denom := range denoms {
+		supplyStore.Delete(denom)
+	}
+
+	for _, coin := range supply.GetTotal() {
+		k.SetSupplyOf(ctx, coin)
+	}
+}
+
+// GetSupplyOf retrieves the total supply of the given denomination, which is
+// zero if it has never been minted.
+func (k Keeper) GetSupplyOf(ctx sdk.Context, denom string) sdk.Int {
+	supplyStore := prefix.NewStore(ctx.KVStore(k.storeKey), SupplyKeyPrefix)
+
+	bz := supplyStore.Get([]byte(denom))
 	if bz == nil {
-		panic("stored supply should not have been nil")
+		return sdk.ZeroInt()
 	}
 
-	supply, err := k.cdc.UnmarshalSupply(bz)
+	return unmarshalSupplyAmount(bz)
+}
+
+// SetSupplyOf sets the total supply of the denomination of the given coin,
+// deleting it from store if it is zero.
+func (k Keeper) SetSupplyOf(ctx sdk.Context, coin sdk.Coin) {
+	supplyStore := prefix.NewStore(ctx.KVStore(k.storeKey), SupplyKeyPrefix)
+
+	if coin.IsZero() {
+		supplyStore.Delete([]byte(coin.Denom))
+		return
+	}
+
+	bz, err := coin.Amount.Marshal()
 	if err != nil {
 		panic(err)
 	}
 
-	return supply
+	supplyStore.Set([]byte(coin.Denom), bz)
 }
 
-// SetSupply sets the Supply to store
-func (k Keeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
+// IterateTotalSupply iterates over the total supply of all the denominations,
+// in ascending denomination order, and calls the given callback on each of
+// them. The iteration stops when the callback returns true.
+func (k Keeper) IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool) {
+	supplyStore := prefix.NewStore(ctx.KVStore(k.storeKey), SupplyKeyPrefix)
+
+	iterator := supplyStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		coin := sdk.NewCoin(string(iterator.Key()), unmarshalSupplyAmount(iterator.Value()))
+		if cb(coin) {
+			break
+		}
+	}
+}
+
+// GetPaginatedTotalSupply returns the page of the total supply requested by the
+// given pagination, in ascending denomination order.
+func (k Keeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	supplyStore := prefix.NewStore(ctx.KVStore(k.storeKey), SupplyKeyPrefix)
+
+	supply := sdk.NewCoins()
+	pageRes, err := query.Paginate(supplyStore, pagination, func(key, value []byte) error {
+		supply = append(supply, sdk.NewCoin(string(key), unmarshalSupplyAmount(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return supply, pageRes, nil
+}
+
+// MigrateLegacySupply moves the total supply stored as a single Supply under
+// the legacy key to the per-denomination layout. It is meant to be called from
+// an x/upgrade handler, and does nothing if the legacy supply has already been
+// migrated.
+func (k Keeper) MigrateLegacySupply(ctx sdk.Context) error {
 	store := ctx.KVStore(k.storeKey)
-	bz, err := k.cdc.MarshalSupply(supply)
+
+	bz := store.Get(LegacySupplyKey)
+	if bz == nil {
+		return nil
+	}
+
+	supply, err := k.cdc.UnmarshalSupply(bz)
 	if err != nil {
+		return err
+	}
+
+	if err := supply.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, coin := range supply.GetTotal() {
+		k.SetSupplyOf(ctx, coin)
+	}
+
+	store.Delete(LegacySupplyKey)
+
+	k.Logger(ctx).Info(fmt.Sprintf("migrated the supply of %d denominations", len(supply.GetTotal())))
+	return nil
+}
+
+func unmarshalSupplyAmount(bz []byte) sdk.Int {
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
 		panic(err)
 	}
 
-	store.Set(SupplyKey, bz)
+	return amount
 }
 
 // ValidatePermissions validates that the module account has been granted
@@ -28,6 +28,45 @@ func TestSupply(t *testing.T) {
 	require.Equal(t, totalSupply, total)
 }
 
+func TestSupplyOf(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	app.SupplyKeeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("photon", 20))))
+	require.Equal(t, sdk.NewInt(10), app.SupplyKeeper.GetSupplyOf(ctx, "atom"))
+	require.Equal(t, sdk.ZeroInt(), app.SupplyKeeper.GetSupplyOf(ctx, "btc"))
+
+	app.SupplyKeeper.SetSupplyOf(ctx, sdk.NewInt64Coin("btc", 30))
+	app.SupplyKeeper.SetSupplyOf(ctx, sdk.NewInt64Coin("atom", 0))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("btc", 30), sdk.NewInt64Coin("photon", 20)), app.SupplyKeeper.GetSupply(ctx).GetTotal())
+
+	// setting the supply replaces the supply of all the denominations
+	app.SupplyKeeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("atom", 40))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 40)), app.SupplyKeeper.GetSupply(ctx).GetTotal())
+	require.Equal(t, sdk.ZeroInt(), app.SupplyKeeper.GetSupplyOf(ctx, "btc"))
+}
+
+func TestMigrateLegacySupply(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	legacySupply := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("photon", 20))
+	bz, err := codecstd.NewAppCodec(app.Codec()).MarshalSupply(types.NewSupply(legacySupply))
+	require.NoError(t, err)
+
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	app.SupplyKeeper.SetSupply(ctx, types.DefaultSupply())
+	store.Set(keep.LegacySupplyKey, bz)
+
+	require.NoError(t, app.SupplyKeeper.MigrateLegacySupply(ctx))
+	require.Equal(t, legacySupply, app.SupplyKeeper.GetSupply(ctx).GetTotal())
+	require.False(t, store.Has(keep.LegacySupplyKey))
+
+	// migrating again is a no-op
+	require.NoError(t, app.SupplyKeeper.MigrateLegacySupply(ctx))
+	require.Equal(t, legacySupply, app.SupplyKeeper.GetSupply(ctx).GetTotal())
+}
+
 func TestValidatePermissions(t *testing.T) {
 	app := simapp.Setup(false)
 
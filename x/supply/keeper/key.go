@@ -3,7 +3,10 @@ package keeper
 // Keys for supply store
 // Items are stored with the following key: values
 //
-// - 0x00: Supply
+// - 0x00: Supply (legacy, before the supply is stored per denomination)
+//
+// - 0x01<denom_bytes>: sdk.Int
 var (
-	SupplyKey = []byte{0x00}
+	LegacySupplyKey = []byte{0x00}
+	SupplyKeyPrefix = []byte{0x01}
 )
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	keep "github.com/cosmos/cosmos-sdk/x/supply/keeper"
 	"github.com/cosmos/cosmos-sdk/x/supply/types"
 )
@@ -40,7 +41,7 @@ func TestNewQuerier(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, bz)
 
-	queryTotalSupplyParams := types.NewQueryTotalSupplyParams(1, 20)
+	queryTotalSupplyParams := types.NewQueryTotalSupplyParams(nil)
 	bz, errRes := cdc.MarshalJSON(queryTotalSupplyParams)
 	require.Nil(t, errRes)
 
@@ -79,34 +80,53 @@ func TestQuerySupply(t *testing.T) {
 
 	keeper.SetSupply(ctx, types.NewSupply(supplyCoins))
 
-	queryTotalSupplyParams := types.NewQueryTotalSupplyParams(1, 10)
+	queryTotalSupplyParams := types.NewQueryTotalSupplyParams(&query.PageRequest{Limit: 10})
 	bz, errRes := cdc.MarshalJSON(queryTotalSupplyParams)
 	require.Nil(t, errRes)
 
-	query := abci.RequestQuery{
+	req := abci.RequestQuery{
 		Path: "",
 		Data: []byte{},
 	}
 
-	query.Path = fmt.Sprintf("/custom/supply/%s", types.QueryTotalSupply)
-	query.Data = bz
+	req.Path = fmt.Sprintf("/custom/supply/%s", types.QueryTotalSupply)
+	req.Data = bz
 
-	res, err := querier(ctx, []string{types.QueryTotalSupply}, query)
+	res, err := querier(ctx, []string{types.QueryTotalSupply}, req)
 	require.Nil(t, err)
 
-	var totalCoins sdk.Coins
-	errRes = cdc.UnmarshalJSON(res, &totalCoins)
+	var totalSupply types.QueryTotalSupplyResponse
+	errRes = cdc.UnmarshalJSON(res, &totalSupply)
 	require.Nil(t, errRes)
-	require.Equal(t, supplyCoins, totalCoins)
+	require.Equal(t, supplyCoins, totalSupply.Supply)
+	require.Nil(t, totalSupply.Pagination.NextKey)
+
+	// the total supply is paginated with the denominations as keys
+	req.Data = cdc.MustMarshalJSON(types.NewQueryTotalSupplyParams(&query.PageRequest{Limit: 3, CountTotal: true}))
+	res, err = querier(ctx, []string{types.QueryTotalSupply}, req)
+	require.Nil(t, err)
+	totalSupply = types.QueryTotalSupplyResponse{}
+	require.Nil(t, cdc.UnmarshalJSON(res, &totalSupply))
+	require.Equal(t, supplyCoins[:3], totalSupply.Supply)
+	require.Equal(t, []byte(supplyCoins[3].Denom), totalSupply.Pagination.NextKey)
+	require.Equal(t, uint64(4), totalSupply.Pagination.Total)
+
+	req.Data = cdc.MustMarshalJSON(types.NewQueryTotalSupplyParams(&query.PageRequest{Key: totalSupply.Pagination.NextKey}))
+	res, err = querier(ctx, []string{types.QueryTotalSupply}, req)
+	require.Nil(t, err)
+	totalSupply = types.QueryTotalSupplyResponse{}
+	require.Nil(t, cdc.UnmarshalJSON(res, &totalSupply))
+	require.Equal(t, supplyCoins[3:], totalSupply.Supply)
+	require.Nil(t, totalSupply.Pagination.NextKey)
 
 	querySupplyParams := types.NewQuerySupplyOfParams(sdk.DefaultBondDenom)
 	bz, errRes = cdc.MarshalJSON(querySupplyParams)
 	require.Nil(t, errRes)
 
-	query.Path = fmt.Sprintf("/custom/supply/%s", types.QuerySupplyOf)
-	query.Data = bz
+	req.Path = fmt.Sprintf("/custom/supply/%s", types.QuerySupplyOf)
+	req.Data = bz
 
-	res, err = querier(ctx, []string{types.QuerySupplyOf}, query)
+	res, err = querier(ctx, []string{types.QuerySupplyOf}, req)
 	require.Nil(t, err)
 
 	var supply sdk.Int
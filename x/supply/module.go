@@ -16,6 +16,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/supply/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/supply/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/supply/keeper"
 	"github.com/cosmos/cosmos-sdk/x/supply/simulation"
 	"github.com/cosmos/cosmos-sdk/x/supply/types"
 )
@@ -117,6 +118,11 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// RegisterQueryService registers the gRPC query service of the supply module.
+func (am AppModule) RegisterQueryService(server sdk.GRPCServer) {
+	types.RegisterQueryService(server, keeper.NewQueryServer(am.keeper))
+}
+
 // InitGenesis performs genesis initialization for the supply module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
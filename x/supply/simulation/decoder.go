@@ -7,6 +7,7 @@ import (
 	tmkv "github.com/tendermint/tendermint/libs/kv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/supply/keeper"
 	"github.com/cosmos/cosmos-sdk/x/supply/types"
 )
@@ -14,11 +15,21 @@ import (
 // DecodeStore unmarshals the KVPair's Value to the corresponding supply type
 func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 	switch {
-	case bytes.Equal(kvA.Key[:1], keeper.SupplyKey):
+	case bytes.Equal(kvA.Key[:1], keeper.SupplyKeyPrefix):
+		var supplyA, supplyB sdk.Int
+		if err := supplyA.Unmarshal(kvA.Value); err != nil {
+			panic(err)
+		}
+		if err := supplyB.Unmarshal(kvB.Value); err != nil {
+			panic(err)
+		}
+		return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+
+	case bytes.Equal(kvA.Key[:1], keeper.LegacySupplyKey):
 		var supplyA, supplyB types.Supply
 		cdc.MustUnmarshalBinaryBare(kvA.Value, &supplyA)
 		cdc.MustUnmarshalBinaryBare(kvB.Value, &supplyB)
-		return fmt.Sprintf("%v\n%v", supplyB, supplyB)
+		return fmt.Sprintf("%v\n%v", supplyA, supplyB)
 
 	default:
 		panic(fmt.Sprintf("invalid supply key %X", kvA.Key))
@@ -25,9 +25,12 @@ func TestDecodeStore(t *testing.T) {
 	cdc := makeTestCodec()
 
 	totalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	supplyOf, err := sdk.NewInt(1000).Marshal()
+	require.NoError(t, err)
 
 	kvPairs := tmkv.Pairs{
-		tmkv.Pair{Key: keeper.SupplyKey, Value: cdc.MustMarshalBinaryBare(totalSupply)},
+		tmkv.Pair{Key: append(keeper.SupplyKeyPrefix, []byte(sdk.DefaultBondDenom)...), Value: supplyOf},
+		tmkv.Pair{Key: keeper.LegacySupplyKey, Value: cdc.MustMarshalBinaryBare(totalSupply)},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -35,7 +38,8 @@ func TestDecodeStore(t *testing.T) {
 		name        string
 		expectedLog string
 	}{
-		{"Supply", fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"SupplyOf", fmt.Sprintf("%v\n%v", sdk.NewInt(1000), sdk.NewInt(1000))},
+		{"LegacySupply", fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
 		{"other", ""},
 	}
 
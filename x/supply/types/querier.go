@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
 // query endpoints supported by the supply Querier
 const (
 	QueryTotalSupply = "total_supply"
@@ -10,12 +15,20 @@ const (
 //
 // - 'custom/supply/totalSupply'
 type QueryTotalSupplyParams struct {
-	Page, Limit int
+	Pagination *query.PageRequest
 }
 
 // NewQueryTotalSupplyParams creates a new instance to query the total supply
-func NewQueryTotalSupplyParams(page, limit int) QueryTotalSupplyParams {
-	return QueryTotalSupplyParams{page, limit}
+func NewQueryTotalSupplyParams(pagination *query.PageRequest) QueryTotalSupplyParams {
+	return QueryTotalSupplyParams{pagination}
+}
+
+// NewQueryTotalSupplyResponse creates a new instance of the total supply
+// response of the following queries:
+//
+// - 'custom/supply/totalSupply'
+func NewQueryTotalSupplyResponse(supply sdk.Coins, pagination *query.PageResponse) QueryTotalSupplyResponse {
+	return QueryTotalSupplyResponse{Supply: supply, Pagination: pagination}
 }
 
 // QuerySupplyOfParams defines the params for the following queries:
@@ -30,3 +43,9 @@ type QuerySupplyOfParams struct {
 func NewQuerySupplyOfParams(denom string) QuerySupplyOfParams {
 	return QuerySupplyOfParams{denom}
 }
+
+// RegisterQueryService registers the gRPC query service of the module on a
+// gRPC server, such as a *grpc.Server or the gRPC query router of BaseApp.
+func RegisterQueryService(server sdk.GRPCServer, srv QueryServer) {
+	server.RegisterService(&_Query_serviceDesc, srv)
+}
@@ -31,6 +31,7 @@ type PlanRequest struct {
 	UpgradeHeight int64        `json:"upgrade_height" yaml:"upgrade_height"`
 	UpgradeTime   string       `json:"upgrade_time" yaml:"upgrade_time"`
 	UpgradeInfo   string       `json:"upgrade_info" yaml:"upgrade_info"`
+	Expedited     bool         `json:"expedited" yaml:"expedited"`
 }
 
 // CancelRequest defines a proposal to cancel a current plan.
@@ -39,6 +40,7 @@ type CancelRequest struct {
 	Title       string       `json:"title" yaml:"title"`
 	Description string       `json:"description" yaml:"description"`
 	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Expedited   bool         `json:"expedited" yaml:"expedited"`
 }
 
 func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
@@ -77,6 +79,7 @@ func postPlanHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		plan := types.Plan{Name: req.UpgradeName, Time: t, Height: req.UpgradeHeight, Info: req.UpgradeInfo}
 		content := types.NewSoftwareUpgradeProposal(req.Title, req.Description, plan)
 		msg := gov.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		msg.Expedited = req.Expedited
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
@@ -105,6 +108,7 @@ func cancelPlanHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description)
 		msg := gov.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		msg.Expedited = req.Expedited
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}